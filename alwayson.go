@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+)
+
+const alwaysOnRejoinDelay = 5 * time.Second
+
+// restoreAlwaysOn joins the 24/7 channels of all guilds, it's called once the lavalink node is connected
+func (b *Bot) restoreAlwaysOn() {
+	guildIDs, err := b.EntClient.Guild.Query().Where(guild.AlwaysOn(true)).IDs(context.TODO())
+	if err != nil {
+		slog.Error("Failed to query 24/7 guilds", "err", err)
+		return
+	}
+	for _, guildID := range guildIDs {
+		b.joinAlwaysOn(guildID)
+	}
+}
+
+// joinAlwaysOn joins the 24/7 channel of the guild and starts the fallback if nothing is playing
+func (b *Bot) joinAlwaysOn(guildID snowflake.ID) bool {
+	settings := b.Guilds.Get(guildID).currentSettings()
+	if !settings.AlwaysOn || settings.AlwaysOnChannelID == nil {
+		return false
+	}
+	if ok := b.updateVoiceState(guildID, settings.AlwaysOnChannelID); !ok {
+		return false
+	}
+	if player := b.Lavalink.ExistingPlayer(guildID); player == nil || player.Track() == nil {
+		b.playFallback(guildID)
+	}
+	return true
+}
+
+// rejoinAlwaysOn schedules joining the 24/7 channel again after the bot got disconnected
+func (b *Bot) rejoinAlwaysOn(guildID snowflake.ID) {
+	time.AfterFunc(alwaysOnRejoinDelay, withTaskRecover("AlwaysOnRejoin", guildTags(guildID), func() {
+		if voiceState, ok := b.Client.Caches().VoiceState(guildID, b.Client.ID()); ok && voiceState.ChannelID != nil {
+			return
+		}
+		slog.Info("Rejoining 24/7 channel", "guild_id", guildID)
+		b.joinAlwaysOn(guildID)
+	}))
+}
+
+// playFallback queues and plays the fallback playlist or stream of a 24/7 guild
+func (b *Bot) playFallback(guildID snowflake.ID) bool {
+	settings := b.Guilds.Get(guildID).currentSettings()
+	if !settings.AlwaysOn || settings.FallbackQuery == "" {
+		return false
+	}
+
+	query := settings.FallbackQuery
+	if !urlPattern.MatchString(query) {
+		query = lavalink.SearchTypeYouTube.Apply(query)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	loadResult, err := loadTracks(ctx, b.Lavalink.BestNode(), query)
+	if err != nil {
+		slog.Error("Failed to load fallback", "guild_id", guildID, "query", query, "err", err)
+		return false
+	}
+
+	queue := b.Guilds.GetQueue(guildID)
+	switch data := loadResult.Data.(type) {
+	case lavalink.Track:
+		queue.Add(data)
+	case lavalink.Playlist:
+		queue.Add(data.Tracks...)
+	case lavalink.Search:
+		if len(data) == 0 {
+			return false
+		}
+		queue.Add(data[0])
+	default:
+		return false
+	}
+
+	track, ok := queue.Next()
+	if !ok {
+		return false
+	}
+	player := b.Lavalink.Player(guildID)
+	if err = player.Update(context.TODO(), lavalink.WithTrack(track), lavalink.WithVolume(currentConfig().Defaults.Volume)); err != nil {
+		slog.Error("Failed to play fallback", "guild_id", guildID, "track", track.Info.Identifier, "err", err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,323 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+)
+
+const apiMaxBodySize = 16 << 10
+
+//go:embed openapi.yaml
+var openAPIDocument []byte
+
+// APIPlayerState is the state returned by the REST API
+type APIPlayerState struct {
+	GuildID    snowflake.ID   `json:"guild_id"`
+	Track      *WebhookTrack  `json:"track"`
+	TTS        bool           `json:"tts"`
+	Position   int64          `json:"position_ms"`
+	Paused     bool           `json:"paused"`
+	Volume     int            `json:"volume"`
+	RepeatMode QueueType      `json:"repeat_mode"`
+	Queue      []WebhookTrack `json:"queue"`
+	Length     int64          `json:"queue_length_ms"`
+}
+
+type apiGuildHandler func(w http.ResponseWriter, r *http.Request, guildID snowflake.ID)
+
+func newAPIToken() string {
+	token := make([]byte, 32)
+	_, _ = rand.Read(token)
+	return hex.EncodeToString(token)
+}
+
+// registerAPI adds the REST API routes, every route is authenticated with the api token of its guild
+func (b *Bot) registerAPI() {
+	b.HTTP.HandleFunc("GET /api/openapi.yaml", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		_, _ = w.Write(openAPIDocument)
+	})
+	b.HTTP.HandleFunc("GET /api/guilds/{guild}/player", b.apiAuth(b.apiPlayer))
+	b.HTTP.HandleFunc("POST /api/guilds/{guild}/player/play", b.apiAuth(b.apiPlay))
+	b.HTTP.HandleFunc("POST /api/guilds/{guild}/player/pause", b.apiAuth(b.apiPause(true)))
+	b.HTTP.HandleFunc("POST /api/guilds/{guild}/player/resume", b.apiAuth(b.apiPause(false)))
+	b.HTTP.HandleFunc("POST /api/guilds/{guild}/player/skip", b.apiAuth(b.apiSkip))
+	b.HTTP.HandleFunc("POST /api/guilds/{guild}/player/seek", b.apiAuth(b.apiSeek))
+	b.HTTP.HandleFunc("PUT /api/guilds/{guild}/player/volume", b.apiAuth(b.apiVolume))
+	b.HTTP.HandleFunc("POST /api/guilds/{guild}/queue/move", b.apiAuth(b.apiMoveQueue))
+	b.HTTP.HandleFunc("DELETE /api/guilds/{guild}/queue/{position}", b.apiAuth(b.apiRemoveQueue))
+	b.HTTP.HandleFunc("GET /api/guilds/{guild}/events", b.apiAuth(b.Stream.ServeStream))
+}
+
+func writeAPIJSON(w http.ResponseWriter, status int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+func writeAPIMessage(w http.ResponseWriter, status int, message string) {
+	writeAPIJSON(w, status, map[string]string{"message": message})
+}
+
+func readAPIBody(w http.ResponseWriter, r *http.Request, body any) bool {
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, apiMaxBodySize)).Decode(body); err != nil {
+		writeAPIMessage(w, http.StatusBadRequest, "invalid body")
+		return false
+	}
+	return true
+}
+
+// writeAPIError maps the errors of the player actions to a response
+func writeAPIError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, errNoPlayer):
+		writeAPIMessage(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, errInvalidVolume):
+		writeAPIMessage(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, errNoTracks):
+		writeAPIMessage(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, errNotInVoice), errors.Is(err, errOtherChannel), errors.As(err, new(*InsufficientPointsError)):
+		writeAPIMessage(w, http.StatusConflict, err.Error())
+	case errors.Is(err, errLoadTracks):
+		writeAPIMessage(w, http.StatusBadGateway, err.Error())
+	default:
+		slog.Error("API request failed", "err", err)
+		writeAPIMessage(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// apiAuth checks the bearer token or the token query parameter against the api token of the guild in the path
+func (b *Bot) apiAuth(handler apiGuildHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		guildID, err := snowflake.Parse(r.PathValue("guild"))
+		if err != nil {
+			writeAPIMessage(w, http.StatusNotFound, "unknown guild")
+			return
+		}
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			// browsers can't set headers on websocket requests
+			token = r.URL.Query().Get("token")
+		}
+		if token == "" {
+			writeAPIMessage(w, http.StatusUnauthorized, "missing token")
+			return
+		}
+		authorized, err := b.EntClient.Guild.Query().Where(guild.ID(guildID), guild.APIToken(token)).Exist(r.Context())
+		if err != nil {
+			slog.Error("Failed to check api token", "guild_id", guildID, "err", err)
+			writeAPIMessage(w, http.StatusInternalServerError, "failed to check token")
+			return
+		}
+		if !authorized {
+			writeAPIMessage(w, http.StatusUnauthorized, "invalid token")
+			return
+		}
+		handler(w, r, guildID)
+	}
+}
+
+// apiToken returns the api token of the guild, a token is generated on first use or when regenerate is set
+func (b *Bot) apiToken(ctx context.Context, guildID snowflake.ID, regenerate bool) (string, error) {
+	dbGuild, err := b.EntClient.Guild.Get(ctx, guildID)
+	if err != nil {
+		return "", err
+	}
+	if dbGuild.APIToken != nil && *dbGuild.APIToken != "" && !regenerate {
+		return *dbGuild.APIToken, nil
+	}
+	token := newAPIToken()
+	if _, err = dbGuild.Update().SetAPIToken(token).Save(ctx); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (b *Bot) playerState(guildID snowflake.ID) APIPlayerState {
+	guild := b.Guilds.Get(guildID)
+	queue := guild.queue.Snapshot()
+	state := APIPlayerState{
+		GuildID:    guildID,
+		RepeatMode: queue.Type,
+		Queue:      make([]WebhookTrack, 0, len(queue.Tracks)),
+		Length:     queue.Length.Milliseconds(),
+	}
+	for _, track := range queue.Tracks {
+		state.Queue = append(state.Queue, newWebhookTrack(track))
+	}
+
+	player := b.Lavalink.ExistingPlayer(guildID)
+	if player == nil {
+		return state
+	}
+	state.Paused = player.Paused()
+	state.Volume = player.Volume()
+	if track := player.Track(); track != nil {
+		webhookTrack := newWebhookTrack(*track)
+		state.Track = &webhookTrack
+		state.TTS = isTTSTrack(*track)
+		state.Position = player.Position().Milliseconds()
+	}
+	// the music is paused for announcements, report the music volume
+	guild.mu.Lock()
+	if interrupted := guild.interrupted; interrupted != nil {
+		state.Volume = interrupted.volume
+	}
+	guild.mu.Unlock()
+	return state
+}
+
+func (b *Bot) apiPlayer(w http.ResponseWriter, _ *http.Request, guildID snowflake.ID) {
+	writeAPIJSON(w, http.StatusOK, b.playerState(guildID))
+}
+
+// apiPlay plays or queues the query on behalf of a member, who has to be in a voice channel like with /play
+func (b *Bot) apiPlay(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	var body struct {
+		Query  string       `json:"query"`
+		UserID snowflake.ID `json:"user_id"`
+		Next   bool         `json:"next"`
+	}
+	if !readAPIBody(w, r, &body) {
+		return
+	}
+	if strings.TrimSpace(body.Query) == "" {
+		writeAPIMessage(w, http.StatusBadRequest, "query is required")
+		return
+	}
+	b.playForMember(w, guildID, body.UserID, body.Query, body.Next)
+}
+
+// member returns the member from the cache, members aren't cached so it usually comes from the rest api
+func (b *Bot) member(guildID snowflake.ID, userID snowflake.ID) (discord.Member, error) {
+	if member, ok := b.Client.Caches().Member(guildID, userID); ok {
+		return member, nil
+	}
+	member, err := b.Client.Rest().GetMember(guildID, userID)
+	if err != nil {
+		return discord.Member{}, err
+	}
+	return *member, nil
+}
+
+// playForMember runs the query through playOrQueue like /play and replies with its message
+func (b *Bot) playForMember(w http.ResponseWriter, guildID snowflake.ID, userID snowflake.ID, query string, next bool) {
+	member, err := b.member(guildID, userID)
+	if err != nil {
+		writeAPIMessage(w, http.StatusNotFound, "unknown member")
+		return
+	}
+
+	var response discord.Embed
+	err = b.playOrQueue(guildID, member, query, next, func(embed discord.Embed) {
+		response = embed
+		b.updatePlayerMessage(guildID)
+	})
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	writeAPIMessage(w, http.StatusOK, response.Description)
+}
+
+func (b *Bot) apiPause(paused bool) apiGuildHandler {
+	return func(w http.ResponseWriter, _ *http.Request, guildID snowflake.ID) {
+		if err := b.setPaused(guildID, paused); err != nil {
+			writeAPIError(w, err)
+			return
+		}
+		writeAPIJSON(w, http.StatusOK, b.playerState(guildID))
+	}
+}
+
+func (b *Bot) apiSkip(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	body := struct {
+		Amount int `json:"amount"`
+	}{Amount: 1}
+	if r.ContentLength != 0 && !readAPIBody(w, r, &body) {
+		return
+	}
+	if body.Amount < 1 {
+		writeAPIMessage(w, http.StatusBadRequest, "amount must be at least 1")
+		return
+	}
+	if _, err := b.skipTracks(guildID, body.Amount); err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, b.playerState(guildID))
+}
+
+func (b *Bot) apiSeek(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	var body struct {
+		Position int64 `json:"position_ms"`
+	}
+	if !readAPIBody(w, r, &body) {
+		return
+	}
+	if body.Position < 0 {
+		writeAPIMessage(w, http.StatusBadRequest, "position must not be negative")
+		return
+	}
+	if err := b.seekTo(guildID, lavalink.Duration(body.Position)); err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, b.playerState(guildID))
+}
+
+func (b *Bot) apiVolume(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	var body struct {
+		Volume int `json:"volume"`
+	}
+	if !readAPIBody(w, r, &body) {
+		return
+	}
+	if err := b.setVolume(guildID, body.Volume); err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, b.playerState(guildID))
+}
+
+// apiMoveQueue moves a track of the queue, positions start at 1 like in /queue
+func (b *Bot) apiMoveQueue(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	var body struct {
+		From int `json:"from"`
+		To   int `json:"to"`
+	}
+	if !readAPIBody(w, r, &body) {
+		return
+	}
+	if !b.moveQueueTrack(guildID, body.From-1, body.To-1) {
+		writeAPIMessage(w, http.StatusBadRequest, "position out of range")
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, b.playerState(guildID))
+}
+
+func (b *Bot) apiRemoveQueue(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	position, err := strconv.Atoi(r.PathValue("position"))
+	if err != nil {
+		writeAPIMessage(w, http.StatusBadRequest, "invalid position")
+		return
+	}
+	if _, ok := b.removeQueueTrack(guildID, position-1); !ok {
+		writeAPIMessage(w, http.StatusNotFound, "position out of range")
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, b.playerState(guildID))
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+const testAPIToken = "token"
+
+// newTestAPI returns a bot serving the REST API, testAPIToken belongs to testGuildID
+func newTestAPI(t *testing.T) (*Bot, *fakeDiscord, *fakeLavalink) {
+	t.Helper()
+	b, discordClient, lavalinkClient := newTestBot(t)
+	if err := b.EntClient.Guild.UpdateOneID(testGuildID).SetAPIToken(testAPIToken).Exec(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	b.registerAPI()
+	return b, discordClient, lavalinkClient
+}
+
+func serveTestAPI(b *Bot, method string, path string, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	request.Header.Set("Authorization", "Bearer "+testAPIToken)
+	recorder := httptest.NewRecorder()
+	b.HTTP.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func testAPIPath(path string) string {
+	return "/api/guilds/" + testGuildID.String() + path
+}
+
+func TestAPIAuth(t *testing.T) {
+	b, _, _ := newTestAPI(t)
+	otherGuildID := snowflake.ID(2001)
+	if err := b.EntClient.Guild.Create().SetID(otherGuildID).SetName("other").SetAPIToken("other token").Exec(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		header  string
+		want    int
+		wantMsg string
+	}{
+		{name: "bearer token", path: testAPIPath("/player"), header: "Bearer " + testAPIToken, want: http.StatusOK},
+		{name: "query token", path: testAPIPath("/player?token=" + testAPIToken), want: http.StatusOK},
+		{name: "missing token", path: testAPIPath("/player"), want: http.StatusUnauthorized, wantMsg: "missing token"},
+		{name: "empty bearer token", path: testAPIPath("/player"), header: "Bearer ", want: http.StatusUnauthorized, wantMsg: "missing token"},
+		{name: "wrong token", path: testAPIPath("/player"), header: "Bearer guess", want: http.StatusUnauthorized, wantMsg: "invalid token"},
+		{name: "token of another guild", path: testAPIPath("/player"), header: "Bearer other token", want: http.StatusUnauthorized, wantMsg: "invalid token"},
+		{name: "guild without a token", path: "/api/guilds/2002/player", header: "Bearer " + testAPIToken, want: http.StatusUnauthorized, wantMsg: "invalid token"},
+		{name: "invalid guild", path: "/api/guilds/music/player", header: "Bearer " + testAPIToken, want: http.StatusNotFound, wantMsg: "unknown guild"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.header != "" {
+				request.Header.Set("Authorization", tt.header)
+			}
+			recorder := httptest.NewRecorder()
+			b.HTTP.ServeHTTP(recorder, request)
+			if recorder.Code != tt.want {
+				t.Fatalf("served %d %s, want %d", recorder.Code, recorder.Body.String(), tt.want)
+			}
+			if tt.wantMsg != "" && !strings.Contains(recorder.Body.String(), tt.wantMsg) {
+				t.Errorf("body = %s, want %q", recorder.Body.String(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestAPINoPlayer(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{method: http.MethodPost, path: "/player/pause"},
+		{method: http.MethodPost, path: "/player/resume"},
+		{method: http.MethodPost, path: "/player/skip"},
+		{method: http.MethodPost, path: "/player/seek", body: `{"position_ms":1000}`},
+		{method: http.MethodPut, path: "/player/volume", body: `{"volume":50}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			b, _, _ := newTestAPI(t)
+			recorder := serveTestAPI(b, tt.method, testAPIPath(tt.path), tt.body)
+			if recorder.Code != http.StatusNotFound || !strings.Contains(recorder.Body.String(), errNoPlayer.Error()) {
+				t.Errorf("served %d %s, want %d %q", recorder.Code, recorder.Body.String(), http.StatusNotFound, errNoPlayer)
+			}
+		})
+	}
+}
+
+func TestAPIInvalidRequests(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		want   int
+	}{
+		{name: "play without body", method: http.MethodPost, path: "/player/play", want: http.StatusBadRequest},
+		{name: "play not json", method: http.MethodPost, path: "/player/play", body: `query=song`, want: http.StatusBadRequest},
+		{name: "play blank query", method: http.MethodPost, path: "/player/play", body: `{"query":"  ","user_id":"5000"}`, want: http.StatusBadRequest},
+		{name: "play too large", method: http.MethodPost, path: "/player/play", body: `{"query":"` + strings.Repeat("a", apiMaxBodySize) + `"}`, want: http.StatusBadRequest},
+		{name: "skip zero", method: http.MethodPost, path: "/player/skip", body: `{"amount":0}`, want: http.StatusBadRequest},
+		{name: "skip negative", method: http.MethodPost, path: "/player/skip", body: `{"amount":-2}`, want: http.StatusBadRequest},
+		{name: "skip not json", method: http.MethodPost, path: "/player/skip", body: `two`, want: http.StatusBadRequest},
+		{name: "seek negative", method: http.MethodPost, path: "/player/seek", body: `{"position_ms":-1}`, want: http.StatusBadRequest},
+		{name: "seek string", method: http.MethodPost, path: "/player/seek", body: `{"position_ms":"1:00"}`, want: http.StatusBadRequest},
+		{name: "seek without body", method: http.MethodPost, path: "/player/seek", want: http.StatusBadRequest},
+		{name: "volume too loud", method: http.MethodPut, path: "/player/volume", body: `{"volume":101}`, want: http.StatusBadRequest},
+		{name: "volume negative", method: http.MethodPut, path: "/player/volume", body: `{"volume":-1}`, want: http.StatusBadRequest},
+		{name: "volume string", method: http.MethodPut, path: "/player/volume", body: `{"volume":"loud"}`, want: http.StatusBadRequest},
+		{name: "move not json", method: http.MethodPost, path: "/queue/move", body: `1 to 2`, want: http.StatusBadRequest},
+		{name: "move from zero", method: http.MethodPost, path: "/queue/move", body: `{"from":0,"to":1}`, want: http.StatusBadRequest},
+		{name: "move from past the end", method: http.MethodPost, path: "/queue/move", body: `{"from":3,"to":1}`, want: http.StatusBadRequest},
+		{name: "move to past the end", method: http.MethodPost, path: "/queue/move", body: `{"from":1,"to":3}`, want: http.StatusBadRequest},
+		{name: "remove not a number", method: http.MethodDelete, path: "/queue/first", want: http.StatusBadRequest},
+		{name: "remove zero", method: http.MethodDelete, path: "/queue/0", want: http.StatusNotFound},
+		{name: "remove past the end", method: http.MethodDelete, path: "/queue/3", want: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _, lavalinkClient := newTestAPI(t)
+			lavalinkClient.Player(testGuildID)
+			b.Guilds.GetQueue(testGuildID).Add(newFakeTrack("a"), newFakeTrack("b"))
+
+			recorder := serveTestAPI(b, tt.method, testAPIPath(tt.path), tt.body)
+			if recorder.Code != tt.want {
+				t.Fatalf("served %d %s, want %d", recorder.Code, recorder.Body.String(), tt.want)
+			}
+			if queue := b.Guilds.GetQueue(testGuildID).Snapshot(); len(queue.Tracks) != 2 || queue.Tracks[0].Info.Identifier != "a" {
+				t.Errorf("queue = %v, want it unchanged", queue.Tracks)
+			}
+			if player := lavalinkClient.ExistingPlayer(testGuildID); player.Volume() != 100 || player.Track() != nil || player.Position() != 0 {
+				t.Errorf("player changed to volume %d, track %v at %d", player.Volume(), player.Track(), player.Position())
+			}
+		})
+	}
+}
+
+func TestAPIQueue(t *testing.T) {
+	b, _, lavalinkClient := newTestAPI(t)
+	lavalinkClient.Player(testGuildID)
+	b.Guilds.GetQueue(testGuildID).Add(newFakeTrack("a"), newFakeTrack("b"), newFakeTrack("c"))
+
+	queueOf := func(recorder *httptest.ResponseRecorder) string {
+		t.Helper()
+		var state APIPlayerState
+		if err := json.NewDecoder(recorder.Body).Decode(&state); err != nil {
+			t.Fatal(err)
+		}
+		var identifiers []string
+		for _, track := range state.Queue {
+			identifiers = append(identifiers, track.Identifier)
+		}
+		return strings.Join(identifiers, ",")
+	}
+
+	recorder := serveTestAPI(b, http.MethodPost, testAPIPath("/queue/move"), `{"from":3,"to":1}`)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("move served %d %s", recorder.Code, recorder.Body.String())
+	}
+	if got := queueOf(recorder); got != "c,a,b" {
+		t.Errorf("queue after move = %s, want c,a,b", got)
+	}
+	recorder = serveTestAPI(b, http.MethodDelete, testAPIPath("/queue/2"), "")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("remove served %d %s", recorder.Code, recorder.Body.String())
+	}
+	if got := queueOf(recorder); got != "c,b" {
+		t.Errorf("queue after remove = %s, want c,b", got)
+	}
+}
+
+func TestAPIPlay(t *testing.T) {
+	voiceChannelID, otherVoiceChannelID := testVoiceChannelID, snowflake.ID(4001)
+	tests := []struct {
+		name        string
+		body        string
+		memberVoice *snowflake.ID
+		botVoice    *snowflake.ID
+		playing     bool
+		load        func(identifier string) (*lavalink.LoadResult, error)
+		want        int
+		wantQueued  int
+	}{
+		{name: "plays", body: `{"query":"song","user_id":"5000"}`, memberVoice: &voiceChannelID, want: http.StatusOK},
+		{name: "queues", body: `{"query":"song","user_id":"5000"}`, memberVoice: &voiceChannelID, botVoice: &voiceChannelID, playing: true, want: http.StatusOK, wantQueued: 1},
+		{name: "unknown member", body: `{"query":"song","user_id":"5001"}`, memberVoice: &voiceChannelID, want: http.StatusNotFound},
+		{name: "member not in voice", body: `{"query":"song","user_id":"5000"}`, want: http.StatusConflict},
+		{name: "bot in another channel", body: `{"query":"song","user_id":"5000"}`, memberVoice: &voiceChannelID, botVoice: &otherVoiceChannelID, want: http.StatusConflict},
+		{name: "play next without points", body: `{"query":"song","user_id":"5000","next":true}`, memberVoice: &voiceChannelID, botVoice: &voiceChannelID, playing: true, want: http.StatusConflict},
+		{
+			name:        "no tracks found",
+			body:        `{"query":"song","user_id":"5000"}`,
+			memberVoice: &voiceChannelID,
+			load: func(string) (*lavalink.LoadResult, error) {
+				return &lavalink.LoadResult{LoadType: lavalink.LoadTypeEmpty, Data: lavalink.Empty{}}, nil
+			},
+			want: http.StatusNotFound,
+		},
+		{
+			name:        "load exception",
+			body:        `{"query":"song","user_id":"5000"}`,
+			memberVoice: &voiceChannelID,
+			load: func(string) (*lavalink.LoadResult, error) {
+				return &lavalink.LoadResult{LoadType: lavalink.LoadTypeError, Data: lavalink.Exception{Message: "video unavailable"}}, nil
+			},
+			want: http.StatusBadGateway,
+		},
+		{
+			name:        "lavalink unreachable",
+			body:        `{"query":"song","user_id":"5000"}`,
+			memberVoice: &voiceChannelID,
+			load: func(string) (*lavalink.LoadResult, error) {
+				return nil, errors.New("connection refused")
+			},
+			want: http.StatusBadGateway,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, discordClient, lavalinkClient := newTestAPI(t)
+			discordClient.rest.members[testAuthorID] = discord.Member{GuildID: testGuildID, User: discord.User{ID: testAuthorID, Username: "mint"}}
+			discordClient.joinVoice(testGuildID, testAuthorID, tt.memberVoice)
+			discordClient.joinVoice(testGuildID, testBotID, tt.botVoice)
+			lavalinkClient.node.load = tt.load
+			if tt.playing {
+				playing := newFakeTrack("playing")
+				lavalinkClient.node.addTrack(playing)
+				if err := lavalinkClient.Player(testGuildID).Update(context.Background(), lavalink.WithTrack(playing)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			recorder := serveTestAPI(b, http.MethodPost, testAPIPath("/player/play"), tt.body)
+			if recorder.Code != tt.want {
+				t.Fatalf("served %d %s, want %d", recorder.Code, recorder.Body.String(), tt.want)
+			}
+			if queued := b.Guilds.GetQueue(testGuildID).Len(); queued != tt.wantQueued {
+				t.Errorf("queued %d tracks, want %d", queued, tt.wantQueued)
+			}
+			played := tt.want == http.StatusOK && !tt.playing
+			if player := lavalinkClient.ExistingPlayer(testGuildID); played && (player == nil || player.Track() == nil || player.Track().Info.Identifier != lavalink.SearchTypeYouTube.Apply("song")) {
+				t.Errorf("player = %v, want the loaded track playing", player)
+			}
+		})
+	}
+}
+
+// TestAPIConcurrentAccess reads and changes the queue and the announcement state from API requests
+// while the listeners do the same, it's meant to be run with -race
+func TestAPIConcurrentAccess(t *testing.T) {
+	b, discordClient, lavalinkClient := newTestAPI(t)
+	voiceChannelID := testVoiceChannelID
+	discordClient.joinVoice(testGuildID, testBotID, &voiceChannelID)
+	player := lavalinkClient.Player(testGuildID)
+	queue := b.Guilds.GetQueue(testGuildID)
+	for i := range 20 {
+		queue.Add(newFakeTrack(strconv.Itoa(i)))
+	}
+
+	var wg sync.WaitGroup
+	run := func(iterations int, do func(i int)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range iterations {
+				do(i)
+			}
+		}()
+	}
+	run(50, func(int) { serveTestAPI(b, http.MethodGet, testAPIPath("/player"), "") })
+	run(50, func(int) { serveTestAPI(b, http.MethodPost, testAPIPath("/queue/move"), `{"from":1,"to":2}`) })
+	run(20, func(int) { serveTestAPI(b, http.MethodDelete, testAPIPath("/queue/1"), "") })
+	run(50, func(int) { serveTestAPI(b, http.MethodPut, testAPIPath("/player/volume"), `{"volume":40}`) })
+	run(50, func(int) { b.overlayState(testGuildID) })
+	run(50, func(i int) { queue.Add(newFakeTrack("added " + strconv.Itoa(i))) })
+	run(20, func(i int) {
+		clip := newFakeTrack("clip " + strconv.Itoa(i))
+		if err := b.playAnnouncement(testGuildID, voiceChannelID, Announcement{ID: strconv.Itoa(i), Text: "hi"}, clip); err != nil {
+			t.Error(err)
+		}
+	})
+	run(20, func(int) {
+		track := player.Track()
+		if track == nil || !isTTSTrack(*track) {
+			return
+		}
+		b.nextAnnouncement(player, lavalink.TrackEndEvent{GuildID_: testGuildID, Track: *track, Reason: lavalink.TrackEndReasonFinished})
+	})
+	wg.Wait()
+}
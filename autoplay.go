@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+const historySize = 50
+
+// TrackUserData is attached to the tracks sent to lavalink and comes back with the player events
+type TrackUserData struct {
+	Autoplay bool `json:"autoplay,omitempty"`
+	TTS      bool `json:"tts,omitempty"`
+	// Requester is the name of the member who queued the track
+	Requester string `json:"requester,omitempty"`
+	// Announcement describes the message a TTS clip belongs to
+	Announcement *Announcement `json:"announcement,omitempty"`
+}
+
+func getTrackUserData(track lavalink.Track) TrackUserData {
+	var userData TrackUserData
+	if len(track.UserData) == 0 {
+		return userData
+	}
+	if err := track.UserData.Unmarshal(&userData); err != nil {
+		slog.Debug("Failed to read track user data", "track", track.Info.Identifier, "err", err)
+	}
+	return userData
+}
+
+func isAutoplayTrack(track *lavalink.Track) bool {
+	return track != nil && getTrackUserData(*track).Autoplay
+}
+
+// addHistory remembers the identifier of a played track, the oldest entries are dropped
+func (g *Guild) addHistory(track lavalink.Track) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.history = append(g.history, track.Info.Identifier)
+	if len(g.history) > historySize {
+		g.history = g.history[len(g.history)-historySize:]
+	}
+}
+
+func (g *Guild) inHistory(track lavalink.Track) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return slices.Contains(g.history, track.Info.Identifier)
+}
+
+// relatedTrackQueries returns the queries used to find tracks related to the given one, in order of preference
+func relatedTrackQueries(track lavalink.Track) []string {
+	var queries []string
+	if track.Info.SourceName == "youtube" {
+		queries = append(queries, fmt.Sprintf("https://www.youtube.com/watch?v=%s&list=RD%s", track.Info.Identifier, track.Info.Identifier))
+	}
+	if track.Info.Author != "" {
+		queries = append(queries, lavalink.SearchTypeYouTube.Apply(track.Info.Author))
+	}
+	return queries
+}
+
+// autoplay picks a track related to lastTrack that wasn't played recently
+func (b *Bot) autoplay(guildID snowflake.ID, lastTrack lavalink.Track) (lavalink.Track, bool) {
+	guild := b.Guilds.Get(guildID)
+	if !guild.currentSettings().Autoplay {
+		return lavalink.Track{}, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, query := range relatedTrackQueries(lastTrack) {
+		loadResult, err := loadTracks(ctx, b.Lavalink.BestNode(), query)
+		if err != nil {
+			slog.Error("Failed to load related tracks", "guild_id", guildID, "query", query, "err", err)
+			continue
+		}
+
+		var tracks []lavalink.Track
+		switch data := loadResult.Data.(type) {
+		case lavalink.Playlist:
+			tracks = data.Tracks
+		case lavalink.Search:
+			tracks = data
+		}
+		for _, track := range tracks {
+			if track.Info.Identifier == lastTrack.Info.Identifier || guild.inHistory(track) {
+				continue
+			}
+			track, err = track.WithUserData(TrackUserData{Autoplay: true})
+			if err != nil {
+				slog.Error("Failed to mark autoplay track", "guild_id", guildID, "track", track.Info.Identifier, "err", err)
+				continue
+			}
+			return track, true
+		}
+	}
+	return lavalink.Track{}, false
+}
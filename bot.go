@@ -2,9 +2,12 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"net/url"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/loukhin/probably-a-music-bot/ent"
@@ -14,7 +17,6 @@ import (
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgolink/v3/disgolink"
 	"github.com/disgoorg/disgolink/v3/lavalink"
-	"github.com/disgoorg/log"
 	"github.com/disgoorg/snowflake/v2"
 )
 
@@ -24,6 +26,12 @@ func newBot() *Bot {
 		bot:    b,
 		guilds: make(map[snowflake.ID]*Guild),
 	}
+	b.HTTP = http.NewServeMux()
+	b.TTSProviders = make(map[string]TTSProvider)
+	b.TTSCache = NewTTSCache(currentConfig().TTS.CacheSize)
+	b.Webhooks = NewWebhookDispatcher(b)
+	b.Overlays = NewOverlayHub(b)
+	b.Stream = NewPlayerStream(b)
 	return b
 }
 
@@ -33,36 +41,64 @@ type Bot struct {
 	Guilds    *GuildManager
 	Handlers  map[string]func(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error
 	Lavalink  disgolink.Client
+
+	HTTP         *http.ServeMux
+	TTSProviders map[string]TTSProvider
+	TTSCache     *TTSCache
+	Webhooks     *WebhookDispatcher
+	Overlays     *OverlayHub
+	Stream       *PlayerStream
+
+	pronunciations pronunciationCache
+	ttsNames       ttsNameCache
+
+	// commandsRegistered gates the readiness probe
+	commandsRegistered atomic.Bool
 }
 
+// updateVoiceState joins the channel or leaves the voice channel when channelID is nil
 func (b *Bot) updateVoiceState(guildID snowflake.ID, channelID *snowflake.ID) bool {
+	return b.updateGuildVoiceState(b.Guilds.Get(guildID), guildID, channelID)
+}
+
+// updateGuildVoiceState is updateVoiceState for callers that already hold the lock of the guild,
+// it doesn't look the guild up again because that locks the guild manager
+func (b *Bot) updateGuildVoiceState(guild *Guild, guildID snowflake.ID, channelID *snowflake.ID) bool {
+	guild.leaving.Store(channelID == nil)
 	if err := b.Client.UpdateVoiceState(context.TODO(), guildID, channelID, false, true); err != nil {
-		log.Errorf("error while connecting to channel: %s", err)
+		slog.Error("Failed to update voice state", "guild_id", guildID, "channel_id", channelID, "err", err)
 		return false
 	}
 	return true
 }
 
 func (b *Bot) updatePlayerMessage(guildID snowflake.ID) {
+	b.Overlays.Publish(guildID)
 	guildPlayer := b.Guilds.GetGuildPlayer(guildID)
 	if guildPlayer.channelID == nil || guildPlayer.messageID == nil {
 		return
 	}
 	playerMessage, err := b.Client.Rest().GetMessage(*guildPlayer.channelID, *guildPlayer.messageID)
 	if err != nil || playerMessage == nil {
-		log.Debug(err)
+		slog.Debug("Failed to get player message", "guild_id", guildID, "err", err)
 		return
 	}
 
+	guild := b.Guilds.Get(guildID)
+	guild.mu.Lock()
+	notice, idle, sleep := guild.notice, guild.idle, guild.sleep
+	guild.mu.Unlock()
+
 	var (
 		playerEmbed, queueEmbed discord.EmbedBuilder
 		description             string
 		messageUpdate           discord.MessageUpdateBuilder
 		queueDuration           discord.EmbedField
 		loopStatus              discord.EmbedFooter
+		playerStatus            []string
 	)
 	isInline := true
-	queue := b.Guilds.GetQueue(guildID)
+	queue := b.Guilds.GetQueue(guildID).Snapshot()
 	queueLength := len(queue.Tracks)
 	queueEmbed.SetTitlef("Queue list (%d)", queueLength)
 	if queueLength == 0 {
@@ -90,6 +126,9 @@ func (b *Bot) updatePlayerMessage(guildID snowflake.ID) {
 			playStatus = "⏸️"
 		}
 		playerEmbed.SetTitlef("%s %s", playStatus, playingTrack.Info.Title)
+		if isAutoplayTrack(playingTrack) {
+			playerEmbed.SetAuthorName("🔀 Autoplay")
+		}
 		playerEmbed.SetURL(*playingTrack.Info.URI)
 		if playingTrack.Info.ArtworkURL != nil {
 			playerEmbed.SetImage(*playingTrack.Info.ArtworkURL)
@@ -98,9 +137,20 @@ func (b *Bot) updatePlayerMessage(guildID snowflake.ID) {
 		}
 	} else {
 		playerEmbed.SetTitle("Nothing currently playing")
+		if notice != "" {
+			playerStatus = append(playerStatus, notice)
+		}
 		playerEmbed.SetImage("https://images.pexels.com/videos/3045163/free-video-3045163.jpg?auto=compress&cs=tinysrgb&dpr=1")
 	}
 
+	if idle != nil {
+		playerStatus = append(playerStatus, formatIdleCountdown(idle))
+	}
+	if sleep != nil {
+		playerStatus = append(playerStatus, formatSleepCountdown(sleep))
+	}
+	playerEmbed.SetDescription(strings.Join(playerStatus, "\n"))
+
 	loopStatus.Text = fmt.Sprintf("Mode: %s", queue.Type)
 	playerEmbed.SetEmbedFooter(&loopStatus)
 
@@ -109,24 +159,26 @@ func (b *Bot) updatePlayerMessage(guildID snowflake.ID) {
 
 	_, err = b.Client.Rest().UpdateMessage(playerMessage.ChannelID, playerMessage.ID, messageUpdate.Build())
 	if err != nil {
-		log.Error(err)
+		slog.Error("Failed to update player message", "guild_id", guildID, "err", err)
 	}
 }
 
-func (b *Bot) playOrQueue(guildID snowflake.ID, user discord.Member, query string, responseFunc func(embed discord.Embed)) {
+// playOrQueue plays the query or adds it to the queue, with priority it's put in front of the queue for the play next cost in points.
+// The returned error tells why nothing was queued, its message was already sent through responseFunc.
+func (b *Bot) playOrQueue(guildID snowflake.ID, user discord.Member, query string, priority bool, responseFunc func(embed discord.Embed)) error {
 	var embed discord.EmbedBuilder
 	embed.SetColor(16705372)
 	voiceState, ok := b.Client.Caches().VoiceState(guildID, user.User.ID)
 	if !ok || voiceState.ChannelID == nil {
 		embed.SetDescription("Please join a VoiceChannel to use this command")
 		responseFunc(embed.Build())
-		return
+		return errNotInVoice
 	}
 	botVoiceState, ok := b.Client.Caches().VoiceState(guildID, b.Client.ID())
 	if ok && botVoiceState.ChannelID != nil && botVoiceState.ChannelID.String() != voiceState.ChannelID.String() {
 		embed.SetDescription("Bot was already in other channel")
 		responseFunc(embed.Build())
-		return
+		return errOtherChannel
 	}
 
 	if !urlPattern.MatchString(query) {
@@ -135,17 +187,27 @@ func (b *Bot) playOrQueue(guildID snowflake.ID, user discord.Member, query strin
 
 	queue := b.Guilds.GetQueue(guildID)
 	player := b.Lavalink.Player(guildID)
-	_ = player.Update(context.TODO(), lavalink.WithVolume(40))
+	_ = b.setMusicVolume(player, currentConfig().Defaults.Volume)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	loadResult, err := b.Lavalink.BestNode().LoadTracks(ctx, query)
+	loadResult, err := loadTracks(ctx, b.Lavalink.BestNode(), query)
 	if err != nil {
-		log.Error(err)
+		slog.Error("Failed to load tracks", "guild_id", guildID, "query", query, "err", err)
+		embed.SetDescription("error while loading track:\n" + err.Error())
+		responseFunc(embed.Build())
+		return fmt.Errorf("%w: %w", errLoadTracks, err)
 	}
 	tracks := loadResult.Data
+	// tracks queued by a human take over from autoplay right away
+	playNow := player.Track() == nil || isAutoplayTrack(player.Track())
+	queuedVerb := "Queued"
+	if priority {
+		queuedVerb = "Playing next"
+	}
 
+	var loadedTracks []lavalink.Track
 	switch loadResult.LoadType {
 	case lavalink.LoadTypeTrack, lavalink.LoadTypeSearch:
 		var track lavalink.Track
@@ -154,14 +216,14 @@ func (b *Bot) playOrQueue(guildID snowflake.ID, user discord.Member, query strin
 		} else {
 			track = tracks.(lavalink.Track)
 		}
-		if player.Track() == nil {
+		if playNow {
 			message := fmt.Sprintf("▶ Playing [%s](%s) `%s`", track.Info.Title, *track.Info.URI, formatDuration(track.Info.Length))
 			embed.SetDescription(message)
 		} else {
-			message := fmt.Sprintf("Queued [%s](%s) `%s`", track.Info.Title, *track.Info.URI, formatDuration(track.Info.Length))
+			message := fmt.Sprintf("%s [%s](%s) `%s`", queuedVerb, track.Info.Title, *track.Info.URI, formatDuration(track.Info.Length))
 			embed.SetDescription(message)
 		}
-		queue.Add(track)
+		loadedTracks = []lavalink.Track{track}
 	case lavalink.LoadTypePlaylist:
 		var playlistLength lavalink.Duration
 		playlists := tracks.(lavalink.Playlist)
@@ -169,42 +231,68 @@ func (b *Bot) playOrQueue(guildID snowflake.ID, user discord.Member, query strin
 		for _, track := range tracks {
 			playlistLength += track.Info.Length
 		}
-		if player.Track() == nil {
+		if playNow {
 			message := fmt.Sprintf("▶ Playing %d tracks from [%s](%s) playlist `%s`", len(tracks), playlists.Info.Name, query, formatDuration(playlistLength))
 			embed.SetDescription(message)
 		} else {
-			message := fmt.Sprintf("Queued %d tracks from [%s](%s) playlist `%s`", len(tracks), playlists.Info.Name, query, formatDuration(playlistLength))
+			message := fmt.Sprintf("%s %d tracks from [%s](%s) playlist `%s`", queuedVerb, len(tracks), playlists.Info.Name, query, formatDuration(playlistLength))
 			embed.SetDescription(message)
 		}
-		queue.Add(tracks...)
+		loadedTracks = tracks
 	case lavalink.LoadTypeEmpty:
 		embed.SetDescription("No tracks found")
 		responseFunc(embed.Build())
-		return
+		return errNoTracks
 	case lavalink.LoadTypeError:
-		embed.SetDescription("error while loading track:\n" + err.Error())
+		exception := tracks.(lavalink.Exception)
+		embed.SetDescription("error while loading track:\n" + exception.Error())
 		responseFunc(embed.Build())
-		return
+		return fmt.Errorf("%w: %w", errLoadTracks, exception)
+	}
+
+	for i, track := range loadedTracks {
+		if track, err := track.WithUserData(TrackUserData{Requester: user.EffectiveName()}); err == nil {
+			loadedTracks[i] = track
+		}
+	}
+
+	// nothing to skip when the tracks play right away, play next is only paid for when they wait in the queue
+	if priority && !playNow {
+		if err := b.spendPoints(ctx, guildID, user.User.ID, currentConfig().Points.PlayNextCost); err != nil {
+			embed.SetDescription(fmt.Sprintf("Can't play next: %s", err))
+			responseFunc(embed.Build())
+			return err
+		}
+		queue.AddNext(loadedTracks...)
+	} else {
+		queue.Add(loadedTracks...)
 	}
 
-	if player.Track() == nil {
+	if playNow {
 		if track, ok := queue.Next(); ok {
 			if ok := b.updateVoiceState(guildID, voiceState.ChannelID); !ok {
-				log.Info("not ok")
-				return
+				embed.SetDescription("Failed to join the voice channel")
+				responseFunc(embed.Build())
+				return errJoinVoice
 			}
 			err := player.Update(context.TODO(), lavalink.WithTrack(track))
 			if err != nil {
-				log.Error(err)
+				slog.Error("Failed to play track", "guild_id", guildID, "track", track.Info.Identifier, "err", err)
 			}
 		}
 	}
 	responseFunc(embed.Build())
+	return nil
 }
 
-func (b *Bot) textToSpeech(guildID snowflake.ID, user discord.Member, text string, bitsAmount int, responseFunc func(embed discord.Embed)) {
+// textToSpeech reads the text in the voice channel of the user, cost points are taken from the user once the clips are ready
+func (b *Bot) textToSpeech(guildID snowflake.ID, user discord.Member, text string, bitsAmount int, cost int64, voiceName string, responseFunc func(embed discord.Embed)) {
 	var embed discord.EmbedBuilder
 	embed.SetColor(16705372)
+	tier := cheerTierFor(bitsAmount)
+	if bitsAmount != 0 {
+		embed.SetColor(tier.Color)
+	}
 	voiceState, ok := b.Client.Caches().VoiceState(guildID, user.User.ID)
 	if !ok || voiceState.ChannelID == nil {
 		embed.SetDescription("Please join a VoiceChannel to use this command")
@@ -218,82 +306,48 @@ func (b *Bot) textToSpeech(guildID snowflake.ID, user discord.Member, text strin
 		return
 	}
 
-	queue := b.Guilds.GetQueue(guildID)
-	player := b.Lavalink.Player(guildID)
-	_ = player.Update(context.TODO(), lavalink.WithVolume(100))
-
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	if bitsAmount != 0 {
-		text = fmt.Sprintf("%d bits / / / / %s", bitsAmount, text)
-		loadBitsResult, err := b.Lavalink.BestNode().LoadTracks(ctx, "https://files.loukhin.com/bits.ogg")
-		if err != nil {
-			log.Error(err)
-		}
-		bitsTracks := loadBitsResult.Data.(lavalink.Track)
-		queue.Add(bitsTracks)
-	}
-
-	type Input struct {
-		Text string `json:"text"`
-	}
-
-	type Voice struct {
-		LanguageCode string `json:"languageCode"`
-		Name         string `json:"name"`
-		SsmlGender   string `json:"ssmlGender"`
+	voice, err := b.resolveTTSVoice(ctx, guildID, user.User.ID, voiceName)
+	if err != nil {
+		embed.SetDescription(fmt.Sprintf("Error while choosing voice: %s", err))
+		responseFunc(embed.Build())
+		return
 	}
 
-	type AudioConfig struct {
-		AudioEncoding string  `json:"audioEncoding"`
-		SpeakingRate  float64 `json:"speakingRate"`
+	if bitsAmount != 0 {
+		text = fmt.Sprintf("%d bits. %s", bitsAmount, text)
 	}
-
-	type Config struct {
-		Input       Input       `json:"input"`
-		Voice       Voice       `json:"voice"`
-		AudioConfig AudioConfig `json:"audioConfig"`
+	clips, err := b.synthesizeClips(ctx, guildID, voice, text, bitsAmount)
+	if errors.Is(err, errNothingToRead) {
+		embed.SetDescription("Nothing to read")
+		responseFunc(embed.Build())
+		return
 	}
-
-	jsonStr, _ := json.Marshal(&Config{
-		Input: Input{
-			Text: fmt.Sprintf("%s / / / /", text), // add padding to prevent cutoff?
-		},
-		Voice: Voice{
-			LanguageCode: "th-TH",
-			Name:         "th-TH-Neural2-C",
-		},
-		AudioConfig: AudioConfig{
-			AudioEncoding: "OGG_OPUS",
-			SpeakingRate:  0.8,
-		},
-	})
-
-	query := url.Values{}
-	query.Add("config", string(jsonStr))
-
-	loadResult, err := b.Lavalink.BestNode().LoadTracks(ctx, fmt.Sprintf("tts://?%s", query.Encode()))
 	if err != nil {
-		log.Error(err)
+		slog.Error("Failed to synthesize TTS", "guild_id", guildID, "user_id", user.User.ID, "err", err)
 		embed.SetDescription("Text too long?")
 		responseFunc(embed.Build())
 		return
 	}
-	track := loadResult.Data.(lavalink.Track)
-
-	queue.Add(track)
 
-	if player.Track() == nil {
-		if track, ok := queue.Next(); ok {
-			if ok := b.updateVoiceState(guildID, voiceState.ChannelID); !ok {
-				log.Info("not ok")
-				return
-			}
-			err := player.Update(context.TODO(), lavalink.WithTrack(track))
-			if err != nil {
-				log.Error(err)
-			}
+	if err = b.spendPoints(ctx, guildID, user.User.ID, cost); err != nil {
+		embed.SetDescription(fmt.Sprintf("Can't read the message: %s", err))
+		responseFunc(embed.Build())
+		return
+	}
+	if err = b.playAnnouncement(guildID, *voiceState.ChannelID, newAnnouncement(text, bitsAmount), clips...); err != nil {
+		b.refundPoints(ctx, guildID, user.User.ID, cost)
+		slog.Error("Failed to play TTS", "guild_id", guildID, "user_id", user.User.ID, "err", err)
+		embed.SetDescription("Failed to play TTS")
+		responseFunc(embed.Build())
+		return
+	}
+	if bitsAmount != 0 {
+		embed.SetThumbnail(tier.GIFURL)
+		if err = b.addDonatedBits(ctx, guildID, user.User.ID, bitsAmount); err != nil {
+			slog.Error("Failed to save donated bits", "guild_id", guildID, "user_id", user.User.ID, "err", err)
 		}
 	}
 	embed.SetDescription(text)
@@ -303,7 +357,7 @@ func (b *Bot) textToSpeech(guildID snowflake.ID, user discord.Member, text strin
 func (b *Bot) createPlayerMessage(guildID snowflake.ID, channelID snowflake.ID) bool {
 	guild, err := b.EntClient.Guild.Get(context.TODO(), guildID)
 	if err != nil {
-		log.Error(err)
+		slog.Error("Failed to load guild", "guild_id", guildID, "err", err)
 		return false
 	}
 
@@ -312,11 +366,13 @@ func (b *Bot) createPlayerMessage(guildID snowflake.ID, channelID snowflake.ID)
 		guildPlayer := b.Guilds.GetGuildPlayer(guildID)
 		message, err = b.Client.Rest().CreateMessage(channelID, discord.NewMessageCreateBuilder().SetContent("Join a voice channel and queue songs by name or url in here.").Build())
 		if err != nil {
-			log.Error(err)
+			slog.Error("Failed to create player message", "guild_id", guildID, "channel_id", channelID, "err", err)
+			return false
 		}
 		guild, err = guild.Update().SetPlayerChannelID(channelID).SetPlayerMessageID(message.ID).Save(context.TODO())
 		if err != nil {
-			log.Error(err)
+			slog.Error("Failed to save player message", "guild_id", guildID, "err", err)
+			return false
 		}
 		guildPlayer.channelID = guild.PlayerChannelID
 		guildPlayer.messageID = guild.PlayerMessageID
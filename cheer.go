@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent"
+	"github.com/loukhin/probably-a-music-bot/ent/cheersound"
+	"github.com/loukhin/probably-a-music-bot/ent/member"
+)
+
+const (
+	defaultCheerSoundURL = "https://files.loukhin.com/bits.ogg"
+	// maxCheerSoundSize matches the size limit of the cheer sound column
+	maxCheerSoundSize = 2 << 20
+	// cheerLeaderboardSize is the number of members shown by /bits-leaderboard
+	cheerLeaderboardSize = 10
+	// cheerSoundTimeout bounds downloading and storing an uploaded cheer sound
+	cheerSoundTimeout = 30 * time.Second
+)
+
+// CheerTier is a Twitch style cheer tier, the highest tier not above the amount of bits is used
+type CheerTier struct {
+	MinAmount int
+	Color     int
+	GIFURL    string
+}
+
+var cheerTiers = []CheerTier{
+	{MinAmount: 1, Color: 0x979797, GIFURL: "https://d3aqoihi2n8ty8.cloudfront.net/actions/cheer/dark/animated/1/4.gif"},
+	{MinAmount: 100, Color: 0x9c3ee8, GIFURL: "https://d3aqoihi2n8ty8.cloudfront.net/actions/cheer/dark/animated/100/4.gif"},
+	{MinAmount: 1000, Color: 0x1db2a5, GIFURL: "https://d3aqoihi2n8ty8.cloudfront.net/actions/cheer/dark/animated/1000/4.gif"},
+	{MinAmount: 5000, Color: 0x0099fe, GIFURL: "https://d3aqoihi2n8ty8.cloudfront.net/actions/cheer/dark/animated/5000/4.gif"},
+	{MinAmount: 10000, Color: 0xf43021, GIFURL: "https://d3aqoihi2n8ty8.cloudfront.net/actions/cheer/dark/animated/10000/4.gif"},
+}
+
+func cheerTierFor(amount int) CheerTier {
+	tier := cheerTiers[0]
+	for _, cheerTier := range cheerTiers {
+		if amount >= cheerTier.MinAmount {
+			tier = cheerTier
+		}
+	}
+	return tier
+}
+
+// cheerTierChoices are the tiers offered by /cheer-sound
+func cheerTierChoices() []discord.ApplicationCommandOptionChoiceInt {
+	choices := make([]discord.ApplicationCommandOptionChoiceInt, len(cheerTiers))
+	for i, tier := range cheerTiers {
+		choices[i] = discord.ApplicationCommandOptionChoiceInt{
+			Name:  fmt.Sprintf("%d bits", tier.MinAmount),
+			Value: tier.MinAmount,
+		}
+	}
+	return choices
+}
+
+// defaultCheerSound returns the sound of a tier without an uploaded sound, cheer.sound_url may contain {tier}
+func defaultCheerSound(tier CheerTier) string {
+	soundURL := currentConfig().Cheer.SoundURL
+	if soundURL == "" {
+		return defaultCheerSoundURL
+	}
+	return strings.ReplaceAll(soundURL, "{tier}", strconv.Itoa(tier.MinAmount))
+}
+
+// loadCheerSound loads the sound of the tier, preferring the one uploaded by the guild
+func (b *Bot) loadCheerSound(ctx context.Context, guildID snowflake.ID, tier CheerTier) (lavalink.Track, error) {
+	identifier := defaultCheerSound(tier)
+	exists, err := b.EntClient.CheerSound.Query().
+		Where(cheersound.GuildID(guildID), cheersound.Tier(tier.MinAmount)).
+		Exist(ctx)
+	if err != nil {
+		return lavalink.Track{}, err
+	}
+	if publicURL := currentConfig().HTTP.PublicURL; exists && publicURL != "" {
+		identifier = fmt.Sprintf("%s/cheers/%s/%d", strings.TrimSuffix(publicURL, "/"), guildID, tier.MinAmount)
+	}
+	return loadSingleTrack(ctx, b.Lavalink.BestNode(), identifier)
+}
+
+// downloadCheerSound fetches an uploaded attachment, refusing anything that isn't a small audio file
+func downloadCheerSound(ctx context.Context, attachment discord.Attachment) ([]byte, string, error) {
+	contentType := ""
+	if attachment.ContentType != nil {
+		contentType = *attachment.ContentType
+	}
+	if !isAudioFile(contentType) {
+		return nil, "", errors.New("the file is not an audio file")
+	}
+	if attachment.Size > maxCheerSoundSize {
+		return nil, "", fmt.Errorf("the file is larger than %d MiB", maxCheerSoundSize>>20)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, attachment.URL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("download failed with status %s", response.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxCheerSoundSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) > maxCheerSoundSize {
+		return nil, "", fmt.Errorf("the file is larger than %d MiB", maxCheerSoundSize>>20)
+	}
+	return data, contentType, nil
+}
+
+func (b *Bot) saveCheerSound(ctx context.Context, guildID snowflake.ID, tier int, attachment discord.Attachment) error {
+	data, contentType, err := downloadCheerSound(ctx, attachment)
+	if err != nil {
+		return err
+	}
+	return b.EntClient.CheerSound.Create().
+		SetGuildID(guildID).
+		SetTier(tier).
+		SetFileName(attachment.Filename).
+		SetContentType(contentType).
+		SetData(data).
+		OnConflict(
+			sql.ConflictColumns(cheersound.FieldGuildID, cheersound.FieldTier),
+			sql.ResolveWithNewValues(),
+			sql.ResolveWith(func(u *sql.UpdateSet) {
+				u.SetIgnore(cheersound.FieldCreatedAt)
+			}),
+		).Exec(ctx)
+}
+
+// serveCheerSound serves the uploaded cheer sounds to lavalink
+func (b *Bot) serveCheerSound(w http.ResponseWriter, r *http.Request) {
+	guildID, err := snowflake.Parse(r.PathValue("guild"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	tier, err := strconv.Atoi(r.PathValue("tier"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	sound, err := b.EntClient.CheerSound.Query().
+		Where(cheersound.GuildID(guildID), cheersound.Tier(tier)).
+		Only(r.Context())
+	if ent.IsNotFound(err) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", sound.ContentType)
+	http.ServeContent(w, r, sound.FileName, sound.UpdatedAt, bytes.NewReader(sound.Data))
+}
+
+// addDonatedBits adds the bits to the running total of the member
+func (b *Bot) addDonatedBits(ctx context.Context, guildID snowflake.ID, userID snowflake.ID, amount int) error {
+	return b.EntClient.Member.Create().
+		SetGuildID(guildID).
+		SetUserID(userID).
+		SetBitsDonated(int64(amount)).
+		OnConflict(
+			sql.ConflictColumns(member.FieldGuildID, member.FieldUserID),
+		).
+		Update(func(u *ent.MemberUpsert) {
+			u.AddBitsDonated(int64(amount))
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+}
+
+func (b *Bot) formatCheerLeaderboard(ctx context.Context, guildID snowflake.ID) (string, error) {
+	members, err := b.EntClient.Member.Query().
+		Where(member.GuildID(guildID), member.BitsDonatedGT(0)).
+		Order(ent.Desc(member.FieldBitsDonated)).
+		Limit(cheerLeaderboardSize).
+		All(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(members) == 0 {
+		return "Nobody donated any bits yet", nil
+	}
+	var builder strings.Builder
+	builder.WriteString("Top donators:\n")
+	for i, dbMember := range members {
+		builder.WriteString(fmt.Sprintf("%d. <@%s> %d bits\n", i+1, dbMember.UserID, dbMember.BitsDonated))
+	}
+	return builder.String(), nil
+}
@@ -0,0 +1,81 @@
+// mock-discord fakes the Discord OAuth2 provider and the REST endpoints the dashboard uses, for testing the dashboard locally.
+//
+// Run the bot with DISCORD_AUTHORIZE_URL=http://localhost:8090/oauth2/authorize and DISCORD_API_URL=http://localhost:8090/api/v10,
+// every login succeeds as the given user who owns the given guild. The bot still has to be in that guild.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"net/url"
+)
+
+func main() {
+	address := flag.String("address", ":8090", "address to listen on")
+	userID := flag.String("user", "", "id of the logged in user")
+	guildID := flag.String("guild", "", "id of the guild the user manages")
+	guildName := flag.String("guild-name", "Mock Guild", "name of the guild")
+	flag.Parse()
+	if *userID == "" || *guildID == "" {
+		log.Fatal("-user and -guild are required")
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /oauth2/authorize", func(w http.ResponseWriter, r *http.Request) {
+		redirectURL, err := url.Parse(r.URL.Query().Get("redirect_uri"))
+		if err != nil || redirectURL.Host == "" {
+			http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+			return
+		}
+		query := redirectURL.Query()
+		query.Set("code", "mock")
+		query.Set("state", r.URL.Query().Get("state"))
+		redirectURL.RawQuery = query.Encode()
+		http.Redirect(w, r, redirectURL.String(), http.StatusFound)
+	})
+	mux.HandleFunc("POST /api/v10/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"access_token":  "mock-access-token",
+			"token_type":    "Bearer",
+			"expires_in":    604800,
+			"refresh_token": "mock-refresh-token",
+			"scope":         "identify guilds",
+		})
+	})
+	mux.HandleFunc("GET /api/v10/users/@me", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"id":            *userID,
+			"username":      "mock",
+			"discriminator": "0",
+			"global_name":   "Mock User",
+			"avatar":        nil,
+		})
+	})
+	mux.HandleFunc("GET /api/v10/users/@me/guilds", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []map[string]any{{
+			"id":          *guildID,
+			"name":        *guildName,
+			"icon":        nil,
+			"owner":       true,
+			"permissions": "8",
+			"features":    []string{},
+		}})
+	})
+
+	log.Printf("Mock Discord listening on %s", *address)
+	log.Fatal(http.ListenAndServe(*address, logRequests(mux)))
+}
+
+func writeJSON(w http.ResponseWriter, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+func logRequests(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.Printf("%s %s", r.Method, r.URL.Path)
+		handler.ServeHTTP(w, r)
+	})
+}
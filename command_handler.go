@@ -2,11 +2,24 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent"
+	"github.com/loukhin/probably-a-music-bot/ent/cheersound"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/member"
+	"github.com/loukhin/probably-a-music-bot/ent/pronunciation"
+	"github.com/loukhin/probably-a-music-bot/ent/webhookdelivery"
+	"github.com/loukhin/probably-a-music-bot/ent/webhooksubscription"
 )
 
 func (b *Bot) shuffle(event *events.ApplicationCommandInteractionCreate, _ discord.SlashCommandInteractionData) error {
@@ -21,13 +34,10 @@ func (b *Bot) shuffle(event *events.ApplicationCommandInteractionCreate, _ disco
 }
 
 func (b *Bot) volume(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
-	player := b.Lavalink.ExistingPlayer(*event.GuildID())
-	if player == nil {
-		return updateInteractionResponse(event, "No player found")
-	}
-
 	volume := data.Int("level")
-	if err := player.Update(context.TODO(), lavalink.WithVolume(volume)); err != nil {
+	if err := b.setVolume(*event.GuildID(), volume); errors.Is(err, errNoPlayer) {
+		return updateInteractionResponse(event, "No player found")
+	} else if err != nil {
 		return updateInteractionResponse(event, fmt.Sprintf("Error while setting volume: `%s`", err))
 	}
 
@@ -35,18 +45,15 @@ func (b *Bot) volume(event *events.ApplicationCommandInteractionCreate, data dis
 }
 
 func (b *Bot) seek(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
-	player := b.Lavalink.ExistingPlayer(*event.GuildID())
-	if player == nil {
-		return updateInteractionResponse(event, "No player found")
-	}
-
 	position := data.Int("position")
 	unit, ok := data.OptInt("unit")
 	if !ok {
 		unit = 1
 	}
 	finalPosition := lavalink.Duration(position * unit)
-	if err := player.Update(context.TODO(), lavalink.WithPosition(finalPosition)); err != nil {
+	if err := b.seekTo(*event.GuildID(), finalPosition); errors.Is(err, errNoPlayer) {
+		return updateInteractionResponse(event, "No player found")
+	} else if err != nil {
 		return updateInteractionResponse(event, fmt.Sprintf("Error while seeking: `%s`", err))
 	}
 
@@ -54,30 +61,21 @@ func (b *Bot) seek(event *events.ApplicationCommandInteractionCreate, data disco
 }
 
 func (b *Bot) skip(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
-	player := b.Lavalink.ExistingPlayer(*event.GuildID())
-	queue := b.Guilds.GetQueue(*event.GuildID())
-	if player == nil || queue == nil {
-		return updateInteractionResponse(event, "No player found")
-	}
-
 	amount, ok := data.OptInt("amount")
 	if !ok {
 		amount = 1
 	}
 
-	track, ok := queue.Skip(amount)
-	if !ok {
-		player.Update(context.TODO(), lavalink.WithNullTrack())
-		b.updatePlayerMessage(*event.GuildID())
-		b.updateVoiceState(*event.GuildID(), nil)
-		return updateInteractionResponse(event, "No tracks left in queue, stopped player")
+	playing, err := b.skipTracks(*event.GuildID(), amount)
+	if errors.Is(err, errNoPlayer) {
+		return updateInteractionResponse(event, "No player found")
 	}
-
-	if err := player.Update(context.TODO(), lavalink.WithTrack(track)); err != nil {
+	if err != nil {
 		return updateInteractionResponse(event, fmt.Sprintf("Error while skipping track: `%s`", err))
 	}
-
-	b.updatePlayerMessage(*event.GuildID())
+	if !playing {
+		return updateInteractionResponse(event, "No tracks left in queue, stopped player")
+	}
 	return updateInteractionResponse(event, "Skipped track")
 }
 
@@ -87,10 +85,8 @@ func (b *Bot) repeatType(event *events.ApplicationCommandInteractionCreate, data
 		return updateInteractionResponse(event, "No player found")
 	}
 
-	queue.Type = QueueType(data.String("mode"))
-	queue.RecalculateDuration()
-	b.updatePlayerMessage(*event.GuildID())
-	return updateInteractionResponse(event, fmt.Sprintf("Repeat mode set to `%s`", queue.Type))
+	b.setRepeatMode(*event.GuildID(), QueueType(data.String("mode")))
+	return updateInteractionResponse(event, fmt.Sprintf("Repeat mode set to `%s`", queue.Type()))
 }
 
 func (b *Bot) clearQueue(event *events.ApplicationCommandInteractionCreate, _ discord.SlashCommandInteractionData) error {
@@ -105,25 +101,20 @@ func (b *Bot) clearQueue(event *events.ApplicationCommandInteractionCreate, _ di
 }
 
 func (b *Bot) removeQueue(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
-	queue := b.Guilds.GetQueue(*event.GuildID())
-	if queue == nil {
-		return updateInteractionResponse(event, "No player found")
-	}
-
-	removedTrack, ok := queue.Remove(data.Int("id") - 1)
+	removedTrack, ok := b.removeQueueTrack(*event.GuildID(), data.Int("id")-1)
 	if !ok {
 		return updateInteractionResponse(event, "Can't remove track")
 	}
-	b.updatePlayerMessage(*event.GuildID())
 	return updateInteractionResponse(event, fmt.Sprintf("Removed [%s](%s)", removedTrack.Info.Title, *removedTrack.Info.URI))
 }
 
 func (b *Bot) queue(event *events.ApplicationCommandInteractionCreate, _ discord.SlashCommandInteractionData) error {
-	queue := b.Guilds.GetQueue(*event.GuildID())
-	if queue == nil {
+	guildQueue := b.Guilds.GetQueue(*event.GuildID())
+	if guildQueue == nil {
 		return updateInteractionResponse(event, "No player found")
 	}
 
+	queue := guildQueue.Snapshot()
 	if len(queue.Tracks) == 0 {
 		return updateInteractionResponse(event, "No tracks in queue")
 	}
@@ -142,7 +133,7 @@ func (b *Bot) pause(event *events.ApplicationCommandInteractionCreate, _ discord
 		return updateInteractionResponse(event, "No player found")
 	}
 
-	if err := player.Update(context.TODO(), lavalink.WithPaused(!player.Paused())); err != nil {
+	if err := b.setPaused(*event.GuildID(), !player.Paused()); err != nil {
 		return updateInteractionResponse(event, fmt.Sprintf("Error while pausing: `%s`", err))
 	}
 
@@ -150,7 +141,6 @@ func (b *Bot) pause(event *events.ApplicationCommandInteractionCreate, _ discord
 	if player.Paused() {
 		status = "paused"
 	}
-	b.updatePlayerMessage(*event.GuildID())
 	return updateInteractionResponse(event, fmt.Sprintf("Player is now %s", status))
 }
 
@@ -164,7 +154,8 @@ func (b *Bot) stop(event *events.ApplicationCommandInteractionCreate, _ discord.
 		return updateInteractionResponse(event, fmt.Sprintf("Error while stopping: `%s`", err))
 	}
 
-	b.updatePlayerMessage(*event.GuildID())
+	// the stopped track doesn't start the next one, so the idle timer is started here like when the queue ends
+	b.startIdleTimer(*event.GuildID(), IdleReasonQueueEnded)
 	return updateInteractionResponse(event, "Player stopped")
 }
 
@@ -173,6 +164,9 @@ func (b *Bot) disconnect(event *events.ApplicationCommandInteractionCreate, _ di
 	if player == nil {
 		return updateInteractionResponse(event, "No player found")
 	}
+	if b.Guilds.Get(*event.GuildID()).currentSettings().AlwaysOn {
+		return updateInteractionResponse(event, "24/7 mode is enabled, disable it with `/24-7` first")
+	}
 
 	if ok := b.updateVoiceState(*event.GuildID(), nil); !ok {
 		return updateInteractionResponse(event, "Error while disconnecting")
@@ -200,7 +194,18 @@ func (b *Bot) play(event *events.ApplicationCommandInteractionCreate, data disco
 	query := data.String("query")
 
 	var err error
-	b.playOrQueue(*event.GuildID(), event.Member().Member, query, func(embed discord.Embed) {
+	b.playOrQueue(*event.GuildID(), event.Member().Member, query, false, func(embed discord.Embed) {
+		_, err = event.Client().Rest().UpdateInteractionResponse(event.ApplicationID(), event.Token(), discord.NewMessageUpdateBuilder().SetEmbeds(embed).Build())
+		b.updatePlayerMessage(*event.GuildID())
+	})
+	return err
+}
+
+func (b *Bot) playNext(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	query := data.String("query")
+
+	var err error
+	b.playOrQueue(*event.GuildID(), event.Member().Member, query, true, func(embed discord.Embed) {
 		_, err = event.Client().Rest().UpdateInteractionResponse(event.ApplicationID(), event.Token(), discord.NewMessageUpdateBuilder().SetEmbeds(embed).Build())
 		b.updatePlayerMessage(*event.GuildID())
 	})
@@ -209,9 +214,10 @@ func (b *Bot) play(event *events.ApplicationCommandInteractionCreate, data disco
 
 func (b *Bot) tts(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
 	text := data.String("text")
+	voiceName, _ := data.OptString("voice")
 
 	var err error
-	b.textToSpeech(*event.GuildID(), event.Member().Member, text, 0, func(embed discord.Embed) {
+	b.textToSpeech(*event.GuildID(), event.Member().Member, text, 0, ttsLengthCost(text), voiceName, func(embed discord.Embed) {
 		_, err = event.Client().Rest().UpdateInteractionResponse(event.ApplicationID(), event.Token(), discord.NewMessageUpdateBuilder().SetEmbeds(embed).Build())
 		b.updatePlayerMessage(*event.GuildID())
 	})
@@ -221,9 +227,13 @@ func (b *Bot) tts(event *events.ApplicationCommandInteractionCreate, data discor
 func (b *Bot) bits(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
 	text := data.String("text")
 	amount := data.Int("amount")
+	voiceName, _ := data.OptString("voice")
+
+	// bits are paid with points on top of the length of the message
+	cost := int64(amount) + ttsLengthCost(text)
 
 	var err error
-	b.textToSpeech(*event.GuildID(), event.Member().Member, text, amount, func(embed discord.Embed) {
+	b.textToSpeech(*event.GuildID(), event.Member().Member, text, amount, cost, voiceName, func(embed discord.Embed) {
 		_, err = event.Client().Rest().UpdateInteractionResponse(event.ApplicationID(), event.Token(), discord.NewMessageUpdateBuilder().SetEmbeds(embed).Build())
 		b.updatePlayerMessage(*event.GuildID())
 	})
@@ -236,3 +246,595 @@ func (b *Bot) setup(event *events.ApplicationCommandInteractionCreate, _ discord
 	}
 	return updateInteractionResponse(event, "This channel was already a player!")
 }
+
+func (b *Bot) settings(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	var update SettingsUpdate
+	if idleTimeout, ok := data.OptInt("idle-timeout"); ok {
+		update.IdleTimeout = &idleTimeout
+	}
+	if aloneTimeout, ok := data.OptInt("alone-timeout"); ok {
+		update.AloneTimeout = &aloneTimeout
+	}
+	if ttsProvider, ok := data.OptString("tts-provider"); ok {
+		update.TTSProvider = &ttsProvider
+	}
+	guildSettings, err := b.saveSettings(context.TODO(), *event.GuildID(), update)
+	var settingsErr *SettingsError
+	if errors.As(err, &settingsErr) {
+		return updateInteractionResponse(event, settingsErr.Message)
+	}
+	if err != nil {
+		return updateInteractionResponse(event, fmt.Sprintf("Error while saving settings: `%s`", err))
+	}
+
+	return updateInteractionResponse(event, fmt.Sprintf("Idle timeout: `%d` minutes\nAlone timeout: `%d` minutes\nTTS provider: `%s`", int(guildSettings.IdleTimeout.Minutes()), int(guildSettings.AloneTimeout.Minutes()), guildSettings.TTSProvider))
+}
+
+func (b *Bot) alwaysOn(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	guildID := *event.GuildID()
+	guild := b.Guilds.Get(guildID)
+
+	if !data.Bool("enabled") {
+		if _, err := b.EntClient.Guild.UpdateOneID(guildID).SetAlwaysOn(false).Save(context.TODO()); err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while saving settings: `%s`", err))
+		}
+		guild.updateSettings(func(settings *GuildSettings) {
+			settings.AlwaysOn = false
+		})
+		if player := b.Lavalink.ExistingPlayer(guildID); player == nil || player.Track() == nil {
+			b.startIdleTimer(guildID, IdleReasonQueueEnded)
+		}
+		return updateInteractionResponse(event, "24/7 mode disabled")
+	}
+
+	var channelID snowflake.ID
+	if channel, ok := data.OptChannel("channel"); ok {
+		channelID = channel.ID
+	} else if voiceState, ok := b.Client.Caches().VoiceState(guildID, event.User().ID); ok && voiceState.ChannelID != nil {
+		channelID = *voiceState.ChannelID
+	} else {
+		return updateInteractionResponse(event, "Please join a VoiceChannel or choose a channel")
+	}
+
+	update := b.EntClient.Guild.UpdateOneID(guildID).SetAlwaysOn(true).SetAlwaysOnChannelID(channelID)
+	if fallback, ok := data.OptString("fallback"); ok {
+		update.SetFallbackQuery(fallback)
+	}
+	dbGuild, err := update.Save(context.TODO())
+	if err != nil {
+		return updateInteractionResponse(event, fmt.Sprintf("Error while saving settings: `%s`", err))
+	}
+	guild.updateSettings(func(settings *GuildSettings) {
+		settings.AlwaysOn = dbGuild.AlwaysOn
+		settings.AlwaysOnChannelID = dbGuild.AlwaysOnChannelID
+		settings.FallbackQuery = dbGuild.FallbackQuery
+	})
+
+	b.stopIdleTimer(guildID, IdleReasonQueueEnded, IdleReasonAlone)
+	if !b.joinAlwaysOn(guildID) {
+		return updateInteractionResponse(event, "24/7 mode enabled but failed to join the channel")
+	}
+	b.updatePlayerMessage(guildID)
+	return updateInteractionResponse(event, fmt.Sprintf("24/7 mode enabled in <#%s>", channelID))
+}
+
+func (b *Bot) autoplayMode(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	enabled := data.Bool("enabled")
+	guildSettings, err := b.saveSettings(context.TODO(), *event.GuildID(), SettingsUpdate{Autoplay: &enabled})
+	if err != nil {
+		return updateInteractionResponse(event, fmt.Sprintf("Error while saving settings: `%s`", err))
+	}
+
+	status := "disabled"
+	if guildSettings.Autoplay {
+		status = "enabled"
+	}
+	return updateInteractionResponse(event, fmt.Sprintf("Autoplay %s", status))
+}
+
+func (b *Bot) sleep(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	guildID := *event.GuildID()
+
+	switch value := strings.ToLower(strings.TrimSpace(data.String("duration"))); value {
+	case "cancel":
+		if b.Guilds.Get(guildID).sleepTimer() == nil {
+			return updateInteractionResponse(event, "No sleep timer set")
+		}
+		if err := b.clearSleepTimer(guildID); err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while cancelling sleep timer: `%s`", err))
+		}
+		b.updatePlayerMessage(guildID)
+		return updateInteractionResponse(event, "Sleep timer cancelled")
+
+	case "end-of-track":
+		if err := b.setSleepTimer(guildID, newSleepTimer(time.Time{}, true)); err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while setting sleep timer: `%s`", err))
+		}
+		return updateInteractionResponse(event, "Music will stop after the current track")
+
+	default:
+		duration, err := parseSleepDuration(value)
+		if err != nil || duration <= 0 || duration > maxSleepDuration {
+			return updateInteractionResponse(event, "Invalid duration, use something like `45m`, `1h30m`, `end-of-track` or `cancel`")
+		}
+		sleep := newSleepTimer(time.Now().Add(duration), false)
+		if err = b.setSleepTimer(guildID, sleep); err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while setting sleep timer: `%s`", err))
+		}
+		return updateInteractionResponse(event, fmt.Sprintf("Music will stop <t:%d:R>", sleep.Deadline.Unix()))
+	}
+}
+
+func (b *Bot) ttsVoice(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	guildID := *event.GuildID()
+	guildDefault := data.Bool("server-default")
+	if guildDefault && !event.Member().Permissions.Has(discord.PermissionManageGuild) {
+		return updateInteractionResponse(event, "You need the Manage Server permission to change the server default voice")
+	}
+	guildSettings := b.Guilds.Get(guildID).currentSettings()
+
+	if data.Bool("reset") {
+		var err error
+		if guildDefault {
+			var dbGuild *ent.Guild
+			dbGuild, err = b.EntClient.Guild.UpdateOneID(guildID).
+				SetTtsLanguage(guild.DefaultTtsLanguage).
+				SetTtsVoice(guild.DefaultTtsVoice).
+				SetTtsRate(guild.DefaultTtsRate).
+				SetTtsPitch(guild.DefaultTtsPitch).
+				Save(context.TODO())
+			if err == nil {
+				b.Guilds.Get(guildID).updateSettings(func(settings *GuildSettings) {
+					settings.TTSVoice = TTSVoice{LanguageCode: dbGuild.TtsLanguage, Name: dbGuild.TtsVoice, SpeakingRate: dbGuild.TtsRate, Pitch: dbGuild.TtsPitch}
+				})
+			}
+		} else {
+			// the member row also holds the points, bits and reader opt-out, only the voice is reset
+			_, err = b.EntClient.Member.Update().
+				Where(member.GuildID(guildID), member.UserID(event.User().ID)).
+				ClearTtsLanguage().
+				ClearTtsVoice().
+				ClearTtsRate().
+				ClearTtsPitch().
+				Save(context.TODO())
+		}
+		if err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while resetting voice: `%s`", err))
+		}
+		return updateInteractionResponse(event, "Voice reset")
+	}
+
+	voice, err := b.resolveTTSVoice(context.TODO(), guildID, event.User().ID, "")
+	if err != nil {
+		return updateInteractionResponse(event, fmt.Sprintf("Error while loading voice: `%s`", err))
+	}
+	if guildDefault {
+		voice = guildSettings.TTSVoice
+	}
+
+	memberCreate := b.EntClient.Member.Create().SetGuildID(guildID).SetUserID(event.User().ID)
+	changed := false
+	if name, ok := data.OptString("voice"); ok {
+		if voice, err = withVoiceName(b.ttsProvider(guildID), voice, name); err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while choosing voice: %s", err))
+		}
+		memberCreate.SetTtsVoice(voice.Name).SetTtsLanguage(voice.LanguageCode)
+		changed = true
+	}
+	if language, ok := data.OptString("language"); ok {
+		voice.LanguageCode = language
+		memberCreate.SetTtsLanguage(language)
+		changed = true
+	}
+	if rate, ok := data.OptFloat("rate"); ok {
+		voice.SpeakingRate = rate
+		memberCreate.SetTtsRate(rate)
+		changed = true
+	}
+	if pitch, ok := data.OptFloat("pitch"); ok {
+		voice.Pitch = pitch
+		memberCreate.SetTtsPitch(pitch)
+		changed = true
+	}
+	if !changed {
+		return updateInteractionResponse(event, formatTTSVoice(voice))
+	}
+
+	if guildDefault {
+		_, err = b.EntClient.Guild.UpdateOneID(guildID).
+			SetTtsLanguage(voice.LanguageCode).
+			SetTtsVoice(voice.Name).
+			SetTtsRate(voice.SpeakingRate).
+			SetTtsPitch(voice.Pitch).
+			Save(context.TODO())
+		if err == nil {
+			b.Guilds.Get(guildID).updateSettings(func(settings *GuildSettings) {
+				settings.TTSVoice = voice
+			})
+		}
+	} else {
+		// only the options given are stored, the rest keeps following the server default
+		err = memberCreate.
+			OnConflict(
+				sql.ConflictColumns(member.FieldGuildID, member.FieldUserID),
+				sql.ResolveWithNewValues(),
+				sql.ResolveWith(func(u *sql.UpdateSet) {
+					u.SetIgnore(member.FieldCreatedAt)
+					u.SetIgnore(member.FieldTtsReaderOptOut)
+					u.SetIgnore(member.FieldBitsDonated)
+					u.SetIgnore(member.FieldPoints)
+				}),
+			).Exec(context.TODO())
+	}
+	if err != nil {
+		return updateInteractionResponse(event, fmt.Sprintf("Error while saving voice: `%s`", err))
+	}
+	return updateInteractionResponse(event, formatTTSVoice(voice))
+}
+
+func (b *Bot) ttsDictionary(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	guildID := *event.GuildID()
+	if data.SubCommandName == nil {
+		return updateInteractionResponse(event, "Unknown subcommand")
+	}
+	if *data.SubCommandName != "list" && !event.Member().Permissions.Has(discord.PermissionManageGuild) {
+		return updateInteractionResponse(event, "You need the Manage Server permission to change the dictionary")
+	}
+
+	switch *data.SubCommandName {
+	case "add":
+		word := strings.TrimSpace(data.String("word"))
+		replacement := strings.TrimSpace(data.String("replacement"))
+		if word == "" {
+			return updateInteractionResponse(event, "Word can't be empty")
+		}
+		err := b.EntClient.Pronunciation.Create().
+			SetGuildID(guildID).
+			SetWord(word).
+			SetReplacement(replacement).
+			OnConflict(
+				sql.ConflictColumns(pronunciation.FieldGuildID, pronunciation.FieldWord),
+				sql.ResolveWithNewValues(),
+				sql.ResolveWith(func(u *sql.UpdateSet) {
+					u.SetIgnore(pronunciation.FieldCreatedAt)
+				}),
+			).Exec(context.TODO())
+		if err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while saving word: `%s`", err))
+		}
+		b.pronunciations.drop(guildID)
+		return updateInteractionResponse(event, fmt.Sprintf("`%s` will be read as `%s`", word, replacement))
+	case "remove":
+		word := strings.TrimSpace(data.String("word"))
+		deleted, err := b.EntClient.Pronunciation.Delete().Where(pronunciation.GuildID(guildID), pronunciation.Word(word)).Exec(context.TODO())
+		if err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while removing word: `%s`", err))
+		}
+		b.pronunciations.drop(guildID)
+		if deleted == 0 {
+			return updateInteractionResponse(event, fmt.Sprintf("`%s` is not in the dictionary", word))
+		}
+		return updateInteractionResponse(event, fmt.Sprintf("Removed `%s` from the dictionary", word))
+	case "list":
+		entries, err := b.EntClient.Pronunciation.Query().
+			Where(pronunciation.GuildID(guildID)).
+			Order(ent.Asc(pronunciation.FieldWord)).
+			All(context.TODO())
+		if err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while loading dictionary: `%s`", err))
+		}
+		if len(entries) == 0 {
+			return updateInteractionResponse(event, "The dictionary is empty")
+		}
+		content := "Dictionary:\n"
+		for _, entry := range entries {
+			line := fmt.Sprintf("`%s` → `%s`\n", entry.Word, entry.Replacement)
+			if len([]rune(content+line)) > 2000 {
+				break
+			}
+			content += line
+		}
+		return updateInteractionResponse(event, content)
+	default:
+		return updateInteractionResponse(event, "Unknown subcommand")
+	}
+}
+
+// ttsOptOut is a command of its own because /tts-reader is limited to the server managers
+func (b *Bot) ttsOptOut(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	optOut := data.Bool("enabled")
+	err := b.EntClient.Member.Create().
+		SetGuildID(*event.GuildID()).
+		SetUserID(event.User().ID).
+		SetTtsReaderOptOut(optOut).
+		OnConflict(
+			sql.ConflictColumns(member.FieldGuildID, member.FieldUserID),
+			sql.ResolveWith(func(u *sql.UpdateSet) {
+				u.SetExcluded(member.FieldTtsReaderOptOut)
+				u.SetExcluded(member.FieldUpdatedAt)
+			}),
+		).Exec(context.TODO())
+	if err != nil {
+		return updateInteractionResponse(event, fmt.Sprintf("Error while saving settings: `%s`", err))
+	}
+	if optOut {
+		return updateInteractionResponse(event, "Your messages won't be read anymore")
+	}
+	return updateInteractionResponse(event, "Your messages will be read again")
+}
+
+func (b *Bot) ttsReader(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	guildID := *event.GuildID()
+	if !event.Member().Permissions.Has(discord.PermissionManageGuild) {
+		return updateInteractionResponse(event, "You need the Manage Server permission to change the reader channel")
+	}
+	if data.SubCommandName == nil {
+		return updateInteractionResponse(event, "Unknown subcommand")
+	}
+	guild := b.Guilds.Get(guildID)
+
+	switch *data.SubCommandName {
+	case "on":
+		channelID := event.Channel().ID()
+		if channel, ok := data.OptChannel("channel"); ok {
+			channelID = channel.ID
+		}
+		if b.Guilds.GetGuildPlayer(guildID).IsPlayerChannel(channelID) {
+			return updateInteractionResponse(event, "The player channel can't be read")
+		}
+		if _, err := b.EntClient.Guild.UpdateOneID(guildID).SetTtsReaderChannelID(channelID).Save(context.TODO()); err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while saving settings: `%s`", err))
+		}
+		guild.updateSettings(func(settings *GuildSettings) {
+			settings.TTSReaderChannelID = &channelID
+		})
+		return updateInteractionResponse(event, fmt.Sprintf("Reading messages in <#%s>", channelID))
+	case "off":
+		if _, err := b.EntClient.Guild.UpdateOneID(guildID).ClearTtsReaderChannelID().Save(context.TODO()); err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while saving settings: `%s`", err))
+		}
+		guild.updateSettings(func(settings *GuildSettings) {
+			settings.TTSReaderChannelID = nil
+		})
+		return updateInteractionResponse(event, "Stopped reading messages")
+	default:
+		return updateInteractionResponse(event, "Unknown subcommand")
+	}
+}
+
+func (b *Bot) ttsCache(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	if data.Bool("clear") {
+		b.TTSCache.Clear()
+	}
+	stats := b.TTSCache.Stats()
+	return updateInteractionResponse(event, fmt.Sprintf("Cached clips: `%d`\nSize: `%.1f/%.1f MiB`\nHits: `%d`\nMisses: `%d`\nHit rate: `%.1f%%`",
+		stats.Entries, float64(stats.Size)/(1<<20), float64(stats.MaxSize)/(1<<20), stats.Hits, stats.Misses, stats.HitRate()*100))
+}
+
+func (b *Bot) overlay(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	if currentConfig().HTTP.PublicURL == "" {
+		return updateInteractionResponse(event, "The overlay needs http.public_url to be configured")
+	}
+	regenerate := data.Bool("regenerate")
+	overlayURL, err := b.overlayURL(context.TODO(), *event.GuildID(), regenerate)
+	if err != nil {
+		return updateInteractionResponse(event, fmt.Sprintf("Error while loading overlay: `%s`", err))
+	}
+	// anyone with the url can watch the overlay, only show it to the member who asked
+	_, err = event.Client().Rest().CreateFollowupMessage(event.ApplicationID(), event.Token(), discord.NewMessageCreateBuilder().
+		SetContentf("Add this url as a browser source: <%s>", overlayURL).
+		SetEphemeral(true).
+		Build())
+	if err != nil {
+		slog.Error("Failed to send overlay url", "guild_id", *event.GuildID(), "err", err)
+	}
+	if regenerate {
+		return updateInteractionResponse(event, "Generated a new overlay url, the old one stopped working")
+	}
+	return updateInteractionResponse(event, "Sent the overlay url")
+}
+
+func (b *Bot) apiTokenCommand(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	regenerate := data.Bool("regenerate")
+	token, err := b.apiToken(context.TODO(), *event.GuildID(), regenerate)
+	if err != nil {
+		return updateInteractionResponse(event, fmt.Sprintf("Error while loading token: `%s`", err))
+	}
+	// the token controls the player, only show it to the admin who asked
+	_, err = event.Client().Rest().CreateFollowupMessage(event.ApplicationID(), event.Token(), discord.NewMessageCreateBuilder().
+		SetContentf("API token of this server: `%s`\nSend it as `Authorization: Bearer <token>`", token).
+		SetEphemeral(true).
+		Build())
+	if err != nil {
+		slog.Error("Failed to send api token", "guild_id", *event.GuildID(), "err", err)
+	}
+	if regenerate {
+		return updateInteractionResponse(event, "Generated a new API token, the old one stopped working")
+	}
+	return updateInteractionResponse(event, "Sent the API token")
+}
+
+func (b *Bot) cheerSound(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	guildID := *event.GuildID()
+	tier := data.Int("tier")
+
+	ctx, cancel := context.WithTimeout(context.Background(), cheerSoundTimeout)
+	defer cancel()
+
+	attachment, ok := data.OptAttachment("sound")
+	if !ok {
+		_, err := b.EntClient.CheerSound.Delete().Where(cheersound.GuildID(guildID), cheersound.Tier(tier)).Exec(ctx)
+		if err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while removing sound: `%s`", err))
+		}
+		return updateInteractionResponse(event, fmt.Sprintf("%d bits tier uses the default sound", tier))
+	}
+	if currentConfig().HTTP.PublicURL == "" {
+		return updateInteractionResponse(event, "Uploaded sounds need http.public_url to be configured")
+	}
+	if err := b.saveCheerSound(ctx, guildID, tier, attachment); err != nil {
+		return updateInteractionResponse(event, fmt.Sprintf("Error while saving sound: `%s`", err))
+	}
+	return updateInteractionResponse(event, fmt.Sprintf("%d bits tier uses `%s`", tier, attachment.Filename))
+}
+
+func (b *Bot) bitsLeaderboard(event *events.ApplicationCommandInteractionCreate, _ discord.SlashCommandInteractionData) error {
+	content, err := b.formatCheerLeaderboard(context.TODO(), *event.GuildID())
+	if err != nil {
+		return updateInteractionResponse(event, fmt.Sprintf("Error while loading leaderboard: `%s`", err))
+	}
+	return updateInteractionResponse(event, content)
+}
+
+func (b *Bot) balance(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	user := event.User()
+	if optUser, ok := data.OptUser("user"); ok {
+		user = optUser
+	}
+	balance, err := b.pointsBalance(context.TODO(), *event.GuildID(), user.ID)
+	if err != nil {
+		return updateInteractionResponse(event, fmt.Sprintf("Error while loading balance: `%s`", err))
+	}
+	return updateInteractionResponse(event, fmt.Sprintf("%s has `%d` points", user.Mention(), balance))
+}
+
+func (b *Bot) leaderboard(event *events.ApplicationCommandInteractionCreate, _ discord.SlashCommandInteractionData) error {
+	content, err := b.formatPointsLeaderboard(context.TODO(), *event.GuildID())
+	if err != nil {
+		return updateInteractionResponse(event, fmt.Sprintf("Error while loading leaderboard: `%s`", err))
+	}
+	return updateInteractionResponse(event, content)
+}
+
+func (b *Bot) points(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	guildID := *event.GuildID()
+	if data.SubCommandName == nil {
+		return updateInteractionResponse(event, "Unknown subcommand")
+	}
+	if !event.Member().Permissions.Has(discord.PermissionManageGuild) {
+		return updateInteractionResponse(event, "You need the Manage Server permission to change points")
+	}
+	user := data.User("user")
+	amount := int64(data.Int("amount"))
+
+	switch *data.SubCommandName {
+	case "grant":
+		if err := b.addPoints(context.TODO(), guildID, user.ID, amount); err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while granting points: `%s`", err))
+		}
+		return updateInteractionResponse(event, fmt.Sprintf("Granted `%d` points to %s", amount, user.Mention()))
+	case "revoke":
+		revoked, err := b.revokePoints(context.TODO(), guildID, user.ID, amount)
+		if err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while revoking points: `%s`", err))
+		}
+		return updateInteractionResponse(event, fmt.Sprintf("Revoked `%d` points from %s", revoked, user.Mention()))
+	default:
+		return updateInteractionResponse(event, "Unknown subcommand")
+	}
+}
+
+func (b *Bot) webhooks(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error {
+	guildID := *event.GuildID()
+	if data.SubCommandName == nil {
+		return updateInteractionResponse(event, "Unknown subcommand")
+	}
+
+	switch *data.SubCommandName {
+	case "add":
+		webhookURL, err := validateWebhookURL(data.String("url"))
+		if err != nil {
+			return updateInteractionResponse(event, "Please give a public https url")
+		}
+		webhookEvents, err := parseWebhookEvents(data.String("events"))
+		if err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while parsing events: %s", err))
+		}
+		secret := newWebhookSecret()
+		subscription, err := b.EntClient.WebhookSubscription.Create().
+			SetGuildID(guildID).
+			SetURL(webhookURL.String()).
+			SetSecret(secret).
+			SetEvents(webhookEvents).
+			Save(context.TODO())
+		if err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while saving subscription: `%s`", err))
+		}
+		b.Webhooks.Reload(guildID)
+		// the secret is only shown to the admin who created the subscription
+		_, err = event.Client().Rest().CreateFollowupMessage(event.ApplicationID(), event.Token(), discord.NewMessageCreateBuilder().
+			SetContentf("Signing secret of subscription `%d`: `%s`", subscription.ID, secret).
+			SetEphemeral(true).
+			Build())
+		if err != nil {
+			slog.Error("Failed to send webhook secret", "guild_id", *event.GuildID(), "subscription", subscription.ID, "err", err)
+		}
+		return updateInteractionResponse(event, fmt.Sprintf("Subscription `%d` sends %s to <%s>", subscription.ID, formatWebhookEvents(webhookEvents), subscription.URL))
+	case "list":
+		subscriptions, err := b.EntClient.WebhookSubscription.Query().
+			Where(webhooksubscription.GuildID(guildID)).
+			Order(ent.Asc(webhooksubscription.FieldID)).
+			All(context.TODO())
+		if err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while loading subscriptions: `%s`", err))
+		}
+		if len(subscriptions) == 0 {
+			return updateInteractionResponse(event, "No subscriptions")
+		}
+		content := "Subscriptions:\n"
+		for _, subscription := range subscriptions {
+			content += fmt.Sprintf("`%d` <%s> %s\n", subscription.ID, subscription.URL, formatWebhookEvents(subscription.Events))
+		}
+		return updateInteractionResponse(event, content)
+	}
+
+	subscription, err := b.EntClient.WebhookSubscription.Query().
+		Where(webhooksubscription.ID(data.Int("id")), webhooksubscription.GuildID(guildID)).
+		Only(context.TODO())
+	if ent.IsNotFound(err) {
+		return updateInteractionResponse(event, "Subscription not found")
+	}
+	if err != nil {
+		return updateInteractionResponse(event, fmt.Sprintf("Error while loading subscription: `%s`", err))
+	}
+
+	switch *data.SubCommandName {
+	case "remove":
+		if err = b.EntClient.WebhookSubscription.DeleteOne(subscription).Exec(context.TODO()); err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while removing subscription: `%s`", err))
+		}
+		b.Webhooks.Reload(guildID)
+		return updateInteractionResponse(event, fmt.Sprintf("Removed subscription `%d`", subscription.ID))
+	case "test":
+		delivery, err := b.Webhooks.Send(subscription, WebhookEventTest, map[string]string{"message": "Test event from /webhooks"})
+		if err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while sending test event: `%s`", err))
+		}
+		if !delivery.Delivered {
+			return updateInteractionResponse(event, fmt.Sprintf("Test event failed: `%s`", delivery.Error))
+		}
+		return updateInteractionResponse(event, fmt.Sprintf("Test event delivered with status `%d`", delivery.StatusCode))
+	case "deliveries":
+		deliveries, err := subscription.QueryDeliveries().
+			Order(ent.Desc(webhookdelivery.FieldCreatedAt)).
+			Limit(10).
+			All(context.TODO())
+		if err != nil {
+			return updateInteractionResponse(event, fmt.Sprintf("Error while loading deliveries: `%s`", err))
+		}
+		if len(deliveries) == 0 {
+			return updateInteractionResponse(event, "No deliveries yet")
+		}
+		content := fmt.Sprintf("Latest deliveries of subscription `%d`:\n", subscription.ID)
+		for _, delivery := range deliveries {
+			status := "✅"
+			if !delivery.Delivered {
+				status = "❌ " + delivery.Error
+			}
+			content += fmt.Sprintf("<t:%d:R> `%s` attempts `%d` status `%d` %s\n", delivery.CreatedAt.Unix(), delivery.Event, delivery.Attempts, delivery.StatusCode, status)
+		}
+		return updateInteractionResponse(event, content)
+	default:
+		return updateInteractionResponse(event, "Unknown subcommand")
+	}
+}
@@ -1,19 +1,153 @@
 package main
 
 import (
+	"log/slog"
+	"time"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgolink/v3/lavalink"
 	"github.com/disgoorg/json"
-	"github.com/disgoorg/log"
 )
 
+const (
+	commandRegisterRetryDelay    = 5 * time.Second
+	commandRegisterMaxRetryDelay = 5 * time.Minute
+)
+
+var pointsCommandOptions = []discord.ApplicationCommandOption{
+	discord.ApplicationCommandOptionUser{
+		Name:        "user",
+		Description: "Member",
+		Required:    true,
+	},
+	discord.ApplicationCommandOptionInt{
+		Name:        "amount",
+		Description: "Amount of points",
+		Required:    true,
+		MinValue:    json.Ptr(1),
+	},
+}
+
+var webhookIDOptions = []discord.ApplicationCommandOption{
+	discord.ApplicationCommandOptionInt{
+		Name:        "id",
+		Description: "Id of the subscription",
+		Required:    true,
+	},
+}
+
 var commands = []discord.ApplicationCommandCreate{
 	discord.SlashCommandCreate{
 		Name:                     "setup",
 		Description:              "Setup dedicated music player channel",
 		DefaultMemberPermissions: json.NewNullablePtr(discord.PermissionAdministrator),
 	},
+	discord.SlashCommandCreate{
+		Name:                     "settings",
+		Description:              "Show or change the player settings of this server",
+		DefaultMemberPermissions: json.NewNullablePtr(discord.PermissionAdministrator),
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionInt{
+				Name:        "idle-timeout",
+				Description: "Minutes to stay in the voice channel after the queue ended",
+				Required:    false,
+				MinValue:    json.Ptr(0),
+				MaxValue:    json.Ptr(60),
+			},
+			discord.ApplicationCommandOptionInt{
+				Name:        "alone-timeout",
+				Description: "Minutes to stay paused after everyone left the voice channel",
+				Required:    false,
+				MinValue:    json.Ptr(0),
+				MaxValue:    json.Ptr(60),
+			},
+			discord.ApplicationCommandOptionString{
+				Name:        "tts-provider",
+				Description: "Engine used to synthesize /tts and /bits messages",
+				Required:    false,
+				Choices: []discord.ApplicationCommandOptionChoiceString{
+					{
+						Name:  "Lavalink TTS plugin",
+						Value: TTSProviderLavalink,
+					},
+					{
+						Name:  "HTTP synthesizer",
+						Value: TTSProviderHTTP,
+					},
+					{
+						Name:  "Local engine",
+						Value: TTSProviderLocal,
+					},
+				},
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:                     "24-7",
+		Description:              "Keep the bot in a voice channel permanently",
+		DefaultMemberPermissions: json.NewNullablePtr(discord.PermissionAdministrator),
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionBool{
+				Name:        "enabled",
+				Description: "Enable or disable 24/7 mode",
+				Required:    true,
+			},
+			discord.ApplicationCommandOptionChannel{
+				Name:         "channel",
+				Description:  "Voice channel to stay in, defaults to your current voice channel",
+				Required:     false,
+				ChannelTypes: []discord.ChannelType{discord.ChannelTypeGuildVoice, discord.ChannelTypeGuildStageVoice},
+			},
+			discord.ApplicationCommandOptionString{
+				Name:        "fallback",
+				Description: "Playlist or stream to play when the queue runs out",
+				Required:    false,
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:                     "webhooks",
+		Description:              "Send player events to other services",
+		DefaultMemberPermissions: json.NewNullablePtr(discord.PermissionAdministrator),
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionSubCommand{
+				Name:        "add",
+				Description: "Subscribe an url to player events",
+				Options: []discord.ApplicationCommandOption{
+					discord.ApplicationCommandOptionString{
+						Name:        "url",
+						Description: "Public https url receiving the events",
+						Required:    true,
+					},
+					discord.ApplicationCommandOptionString{
+						Name:        "events",
+						Description: "Comma separated events (track.start, track.end, track.exception, track.stuck, queue.update), defaults to all",
+						Required:    false,
+					},
+				},
+			},
+			discord.ApplicationCommandOptionSubCommand{
+				Name:        "remove",
+				Description: "Remove a subscription",
+				Options:     webhookIDOptions,
+			},
+			discord.ApplicationCommandOptionSubCommand{
+				Name:        "list",
+				Description: "Show the subscriptions of this server",
+			},
+			discord.ApplicationCommandOptionSubCommand{
+				Name:        "test",
+				Description: "Send a test event to a subscription",
+				Options:     webhookIDOptions,
+			},
+			discord.ApplicationCommandOptionSubCommand{
+				Name:        "deliveries",
+				Description: "Show the latest deliveries of a subscription",
+				Options:     webhookIDOptions,
+			},
+		},
+	},
 	discord.SlashCommandCreate{
 		Name:        "play",
 		Description: "Queue tracks",
@@ -25,6 +159,49 @@ var commands = []discord.ApplicationCommandCreate{
 			},
 		},
 	},
+	discord.SlashCommandCreate{
+		Name:        "play-next",
+		Description: "Put tracks in front of the queue for points",
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionString{
+				Name:        "query",
+				Description: "Search query or links (Youtube, Spotify, etc.)",
+				Required:    true,
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:        "balance",
+		Description: "Show the points earned by listening",
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionUser{
+				Name:        "user",
+				Description: "Member to show the balance of, defaults to you",
+				Required:    false,
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:        "leaderboard",
+		Description: "Show who has the most points",
+	},
+	discord.SlashCommandCreate{
+		Name:                     "points",
+		Description:              "Manage the points of members",
+		DefaultMemberPermissions: json.NewNullablePtr(discord.PermissionManageGuild),
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionSubCommand{
+				Name:        "grant",
+				Description: "Give points to a member",
+				Options:     pointsCommandOptions,
+			},
+			discord.ApplicationCommandOptionSubCommand{
+				Name:        "revoke",
+				Description: "Take points from a member",
+				Options:     pointsCommandOptions,
+			},
+		},
+	},
 	discord.SlashCommandCreate{
 		Name:        "pause",
 		Description: "Pauses the current song",
@@ -35,27 +212,234 @@ var commands = []discord.ApplicationCommandCreate{
 		Options: []discord.ApplicationCommandOption{
 			discord.ApplicationCommandOptionString{
 				Name:        "text",
-				Description: "Text for TTS to speak",
+				Description: "Text for TTS to speak, characters above 130 cost points",
 				Required:    true,
-				MaxLength:   json.Ptr(130),
+				MaxLength:   json.Ptr(ttsMaxLength),
+			},
+			discord.ApplicationCommandOptionString{
+				Name:         "voice",
+				Description:  "Voice to use for this message",
+				Required:     false,
+				Autocomplete: true,
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:        "tts-voice",
+		Description: "Choose your TTS voice",
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionString{
+				Name:         "voice",
+				Description:  "Voice from the catalog of the TTS provider",
+				Required:     false,
+				Autocomplete: true,
+			},
+			discord.ApplicationCommandOptionString{
+				Name:        "language",
+				Description: "Language code (e.g. th-TH, en-US)",
+				Required:    false,
+			},
+			discord.ApplicationCommandOptionFloat{
+				Name:        "rate",
+				Description: "Speaking rate",
+				Required:    false,
+				MinValue:    json.Ptr(0.25),
+				MaxValue:    json.Ptr(4.0),
+			},
+			discord.ApplicationCommandOptionFloat{
+				Name:        "pitch",
+				Description: "Pitch in semitones",
+				Required:    false,
+				MinValue:    json.Ptr(-20.0),
+				MaxValue:    json.Ptr(20.0),
+			},
+			discord.ApplicationCommandOptionBool{
+				Name:        "reset",
+				Description: "Go back to the default voice",
+				Required:    false,
+			},
+			discord.ApplicationCommandOptionBool{
+				Name:        "server-default",
+				Description: "Change the default voice of the server instead of yours",
+				Required:    false,
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:                     "tts-dict",
+		Description:              "Teach the TTS how to read words",
+		DefaultMemberPermissions: json.NewNullablePtr(discord.PermissionManageGuild),
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionSubCommand{
+				Name:        "add",
+				Description: "Add or change a word in the dictionary",
+				Options: []discord.ApplicationCommandOption{
+					discord.ApplicationCommandOptionString{
+						Name:        "word",
+						Description: "Word as it is written",
+						Required:    true,
+						MaxLength:   json.Ptr(100),
+					},
+					discord.ApplicationCommandOptionString{
+						Name:        "replacement",
+						Description: "How the word should be read",
+						Required:    true,
+						MaxLength:   json.Ptr(200),
+					},
+				},
+			},
+			discord.ApplicationCommandOptionSubCommand{
+				Name:        "remove",
+				Description: "Remove a word from the dictionary",
+				Options: []discord.ApplicationCommandOption{
+					discord.ApplicationCommandOptionString{
+						Name:        "word",
+						Description: "Word to remove",
+						Required:    true,
+					},
+				},
+			},
+			discord.ApplicationCommandOptionSubCommand{
+				Name:        "list",
+				Description: "Show the dictionary of this server",
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:                     "tts-reader",
+		Description:              "Read the messages of a channel aloud",
+		DefaultMemberPermissions: json.NewNullablePtr(discord.PermissionManageGuild),
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionSubCommand{
+				Name:        "on",
+				Description: "Start reading a channel",
+				Options: []discord.ApplicationCommandOption{
+					discord.ApplicationCommandOptionChannel{
+						Name:         "channel",
+						Description:  "Text channel or voice channel chat to read, defaults to this channel",
+						Required:     false,
+						ChannelTypes: []discord.ChannelType{discord.ChannelTypeGuildText, discord.ChannelTypeGuildVoice, discord.ChannelTypeGuildStageVoice},
+					},
+				},
+			},
+			discord.ApplicationCommandOptionSubCommand{
+				Name:        "off",
+				Description: "Stop reading messages",
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:        "tts-opt-out",
+		Description: "Choose whether the channel reader reads your messages",
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionBool{
+				Name:        "enabled",
+				Description: "Don't read my messages",
+				Required:    true,
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:                     "tts-cache",
+		Description:              "Show the TTS clip cache statistics",
+		DefaultMemberPermissions: json.NewNullablePtr(discord.PermissionAdministrator),
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionBool{
+				Name:        "clear",
+				Description: "Remove every cached clip",
+				Required:    false,
 			},
 		},
 	},
 	discord.SlashCommandCreate{
 		Name:        "bits",
-		Description: "Donate fake bits to streameringzation",
+		Description: "Donate fake bits to streameringzation, paid with your points",
 		Options: []discord.ApplicationCommandOption{
 			discord.ApplicationCommandOptionInt{
 				Name:        "amount",
-				Description: "Amount of fake bits",
+				Description: "Amount of fake bits, each costs a point",
 				Required:    true,
 				MinValue:    json.Ptr(1),
 			},
 			discord.ApplicationCommandOptionString{
 				Name:        "text",
-				Description: "Donate message",
+				Description: "Donate message, characters above 130 cost points",
+				Required:    true,
+				MaxLength:   json.Ptr(ttsMaxLength),
+			},
+			discord.ApplicationCommandOptionString{
+				Name:         "voice",
+				Description:  "Voice to use for this message",
+				Required:     false,
+				Autocomplete: true,
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:                     "cheer-sound",
+		Description:              "Change the sound played for a cheer tier",
+		DefaultMemberPermissions: json.NewNullablePtr(discord.PermissionManageGuild),
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionInt{
+				Name:        "tier",
+				Description: "Cheer tier",
+				Required:    true,
+				Choices:     cheerTierChoices(),
+			},
+			discord.ApplicationCommandOptionAttachment{
+				Name:        "sound",
+				Description: "Audio file of at most 2 MiB, leave empty to go back to the default sound",
+				Required:    false,
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:        "bits-leaderboard",
+		Description: "Show who donated the most fake bits",
+	},
+	discord.SlashCommandCreate{
+		Name:                     "api-token",
+		Description:              "Get the token of the REST API",
+		DefaultMemberPermissions: json.NewNullablePtr(discord.PermissionAdministrator),
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionBool{
+				Name:        "regenerate",
+				Description: "Generate a new token, the old one stops working",
+				Required:    false,
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:                     "overlay",
+		Description:              "Get the now playing overlay url for OBS browser sources",
+		DefaultMemberPermissions: json.NewNullablePtr(discord.PermissionManageGuild),
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionBool{
+				Name:        "regenerate",
+				Description: "Generate a new url, the old one stops working",
+				Required:    false,
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:        "autoplay",
+		Description: "Play related tracks when the queue runs out",
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionBool{
+				Name:        "enabled",
+				Description: "Enable or disable autoplay",
+				Required:    true,
+			},
+		},
+	},
+	discord.SlashCommandCreate{
+		Name:        "sleep",
+		Description: "Stop the music after a while",
+		Options: []discord.ApplicationCommandOption{
+			discord.ApplicationCommandOptionString{
+				Name:        "duration",
+				Description: "Time until the music stops (e.g. 45m, 1h30m), end-of-track or cancel",
 				Required:    true,
-				MaxLength:   json.Ptr(130),
 			},
 		},
 	},
@@ -179,14 +563,29 @@ var commands = []discord.ApplicationCommandCreate{
 	},
 }
 
-func registerCommands(client bot.Client) {
-	if Debug {
-		if _, err := client.Rest().SetGuildCommands(client.ApplicationID(), GuildId, commands); err != nil {
-			log.Warn(err)
-		}
+func registerCommands(client bot.Client) error {
+	var err error
+	if cfg := currentConfig(); cfg.Debug {
+		_, err = client.Rest().SetGuildCommands(client.ApplicationID(), cfg.Discord.guildID, commands)
 	} else {
-		if _, err := client.Rest().SetGlobalCommands(client.ApplicationID(), commands); err != nil {
-			log.Error(err)
+		_, err = client.Rest().SetGlobalCommands(client.ApplicationID(), commands)
+	}
+	return err
+}
+
+// retryRegisterCommands registers the commands, failed attempts are retried with exponential backoff starting at delay.
+// The readiness probe reports ready once they're registered.
+func (b *Bot) retryRegisterCommands(client bot.Client, delay time.Duration) {
+	for {
+		err := registerCommands(client)
+		if err == nil {
+			b.commandsRegistered.Store(true)
+			return
+		}
+		slog.Error("Failed to register commands", "retry_in", delay, "err", err)
+		time.Sleep(delay)
+		if delay *= 2; delay > commandRegisterMaxRetryDelay {
+			delay = commandRegisterMaxRetryDelay
 		}
 	}
 }
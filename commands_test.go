@@ -0,0 +1,22 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryRegisterCommands(t *testing.T) {
+	b, discordClient, _ := newTestBot(t)
+	discordClient.rest.commandFailures = 3
+	if check := b.checkCommands(); check.Status != HealthStatusUnavailable {
+		t.Fatalf("commands check = %+v before registering, want unavailable", check)
+	}
+
+	b.retryRegisterCommands(discordClient, time.Millisecond)
+	if discordClient.rest.commandRequests != 4 {
+		t.Errorf("command requests = %d, want the 3 failures retried once more", discordClient.rest.commandRequests)
+	}
+	if check := b.checkCommands(); check.Status != HealthStatusOK {
+		t.Errorf("commands check = %+v after registering, want ok", check)
+	}
+}
@@ -0,0 +1,601 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/BurntSushi/toml"
+	"github.com/disgoorg/disgolink/v3/disgolink"
+	"github.com/disgoorg/snowflake/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is read from the yaml or toml file of CONFIG_FILE, the env vars override it
+type Config struct {
+	Debug    bool           `yaml:"debug" toml:"debug"`
+	Discord  DiscordConfig  `yaml:"discord" toml:"discord"`
+	Nodes    []NodeConfig   `yaml:"nodes" toml:"nodes"`
+	Database DatabaseConfig `yaml:"database" toml:"database"`
+	Sentry   SentryConfig   `yaml:"sentry" toml:"sentry"`
+	HTTP     HTTPConfig     `yaml:"http" toml:"http"`
+	Log      LogConfig      `yaml:"log" toml:"log"`
+	TTS      TTSConfig      `yaml:"tts" toml:"tts"`
+	Cheer    CheerConfig    `yaml:"cheer" toml:"cheer"`
+	Points   PointsConfig   `yaml:"points" toml:"points"`
+	Donation DonationConfig `yaml:"donation" toml:"donation"`
+	Defaults GuildDefaults  `yaml:"defaults" toml:"defaults"`
+	Features FeatureToggles `yaml:"features" toml:"features"`
+}
+
+type DiscordConfig struct {
+	Token string `yaml:"token" toml:"token"`
+	// GuildID is where the commands are registered in debug mode
+	GuildID string `yaml:"guild_id" toml:"guild_id"`
+	// ClientSecret enables the dashboard login, AuthorizeURL and APIURL can point it to a mock provider
+	ClientSecret string `yaml:"client_secret" toml:"client_secret"`
+	AuthorizeURL string `yaml:"authorize_url" toml:"authorize_url"`
+	APIURL       string `yaml:"api_url" toml:"api_url"`
+
+	guildID snowflake.ID
+}
+
+type NodeConfig struct {
+	Name     string `yaml:"name" toml:"name"`
+	Address  string `yaml:"address" toml:"address"`
+	Password string `yaml:"password" toml:"password"`
+	Secure   bool   `yaml:"secure" toml:"secure"`
+}
+
+type DatabaseConfig struct {
+	URL string `yaml:"url" toml:"url"`
+}
+
+type SentryConfig struct {
+	DSN        string  `yaml:"dsn" toml:"dsn"`
+	SampleRate float64 `yaml:"sample_rate" toml:"sample_rate"`
+}
+
+type HTTPConfig struct {
+	Address   string `yaml:"address" toml:"address"`
+	PublicURL string `yaml:"public_url" toml:"public_url"`
+	// HealthAddress serves /healthz, /readyz and /metrics apart from Address, the probes are served on Address when it's unset
+	// and the metrics aren't served at all
+	HealthAddress        string `yaml:"health_address" toml:"health_address"`
+	DashboardRedirectURL string `yaml:"dashboard_redirect_url" toml:"dashboard_redirect_url"`
+}
+
+type LogConfig struct {
+	Format string `yaml:"format" toml:"format"`
+	Level  string `yaml:"level" toml:"level"`
+	// Levels overrides Level per subsystem
+	Levels map[string]string `yaml:"levels" toml:"levels"`
+}
+
+type TTSConfig struct {
+	HTTPURL        string `yaml:"http_url" toml:"http_url"`
+	HTTPVoices     string `yaml:"http_voices" toml:"http_voices"`
+	LocalCommand   string `yaml:"local_command" toml:"local_command"`
+	LocalDirectory string `yaml:"local_directory" toml:"local_directory"`
+	LocalVoices    string `yaml:"local_voices" toml:"local_voices"`
+	CacheSize      int64  `yaml:"cache_size" toml:"cache_size"`
+}
+
+type CheerConfig struct {
+	SoundURL string `yaml:"sound_url" toml:"sound_url"`
+}
+
+type PointsConfig struct {
+	PerMinute    int64 `yaml:"per_minute" toml:"per_minute"`
+	PlayNextCost int64 `yaml:"play_next_cost" toml:"play_next_cost"`
+}
+
+type DonationConfig struct {
+	// WebhookSecrets maps the donation sources to the secrets their webhooks are signed with
+	WebhookSecrets map[string]string `yaml:"webhook_secrets" toml:"webhook_secrets"`
+}
+
+// GuildDefaults are the settings of the guilds the bot joins
+type GuildDefaults struct {
+	IdleTimeout  int    `yaml:"idle_timeout" toml:"idle_timeout"`
+	AloneTimeout int    `yaml:"alone_timeout" toml:"alone_timeout"`
+	Autoplay     bool   `yaml:"autoplay" toml:"autoplay"`
+	Volume       int    `yaml:"volume" toml:"volume"`
+	TTSProvider  string `yaml:"tts_provider" toml:"tts_provider"`
+	TTSLanguage  string `yaml:"tts_language" toml:"tts_language"`
+	TTSVoice     string `yaml:"tts_voice" toml:"tts_voice"`
+}
+
+type FeatureToggles struct {
+	API              bool `yaml:"api" toml:"api"`
+	Dashboard        bool `yaml:"dashboard" toml:"dashboard"`
+	Overlay          bool `yaml:"overlay" toml:"overlay"`
+	Metrics          bool `yaml:"metrics" toml:"metrics"`
+	DonationWebhooks bool `yaml:"donation_webhooks" toml:"donation_webhooks"`
+}
+
+var activeConfig atomic.Pointer[Config]
+
+// currentConfig is the config loaded last, it's replaced on SIGHUP so don't keep it around
+func currentConfig() *Config {
+	return activeConfig.Load()
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		TTS: TTSConfig{
+			LocalDirectory: filepath.Join(os.TempDir(), "probably-a-music-bot-tts"),
+			CacheSize:      defaultTTSCacheSize,
+		},
+		Points: PointsConfig{
+			PerMinute:    1,
+			PlayNextCost: 50,
+		},
+		Defaults: GuildDefaults{
+			IdleTimeout:  5,
+			AloneTimeout: 2,
+			Volume:       40,
+			TTSProvider:  TTSProviderLavalink,
+			TTSLanguage:  "th-TH",
+			TTSVoice:     "th-TH-Neural2-C",
+		},
+		Features: FeatureToggles{
+			API:              true,
+			Dashboard:        true,
+			Overlay:          true,
+			Metrics:          true,
+			DonationWebhooks: true,
+		},
+	}
+}
+
+// loadConfig reads the file at path if it's set, applies the env overrides and validates the result
+func loadConfig(path string) (*Config, error) {
+	cfg := defaultConfig()
+	if path != "" {
+		if err := cfg.readFile(path); err != nil {
+			return nil, err
+		}
+	}
+	if err := cfg.applyEnv(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (c *Config) readFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("can't read config file: %w", err)
+	}
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err = decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("can't parse %s: %w", path, err)
+		}
+	case ".toml":
+		metadata, err := toml.Decode(string(data), c)
+		if err != nil {
+			return fmt.Errorf("can't parse %s: %w", path, err)
+		}
+		if undecoded := metadata.Undecoded(); len(undecoded) > 0 {
+			return fmt.Errorf("can't parse %s: unknown key %s", path, undecoded[0])
+		}
+	default:
+		return fmt.Errorf("unknown config file extension %q, expected .yaml, .yml or .toml", ext)
+	}
+	return nil
+}
+
+// envParser collects the env vars that don't parse instead of falling back silently
+type envParser struct {
+	errs []error
+}
+
+func (p *envParser) string(key string, value *string) {
+	if env, ok := os.LookupEnv(key); ok {
+		*value = env
+	}
+}
+
+func (p *envParser) bool(key string, value *bool) {
+	env, ok := os.LookupEnv(key)
+	if !ok || env == "" {
+		return
+	}
+	parsed, err := strconv.ParseBool(env)
+	if err != nil {
+		p.errs = append(p.errs, fmt.Errorf("%s: %q is not a boolean", key, env))
+		return
+	}
+	*value = parsed
+}
+
+func (p *envParser) int64(key string, value *int64) {
+	env, ok := os.LookupEnv(key)
+	if !ok || env == "" {
+		return
+	}
+	parsed, err := strconv.ParseInt(env, 10, 64)
+	if err != nil {
+		p.errs = append(p.errs, fmt.Errorf("%s: %q is not an integer", key, env))
+		return
+	}
+	*value = parsed
+}
+
+func (p *envParser) int(key string, value *int) {
+	parsed := int64(*value)
+	p.int64(key, &parsed)
+	*value = int(parsed)
+}
+
+func (p *envParser) float64(key string, value *float64) {
+	env, ok := os.LookupEnv(key)
+	if !ok || env == "" {
+		return
+	}
+	parsed, err := strconv.ParseFloat(env, 64)
+	if err != nil {
+		p.errs = append(p.errs, fmt.Errorf("%s: %q is not a number", key, env))
+		return
+	}
+	*value = parsed
+}
+
+// applyEnv overrides the config with the env vars the bot was configured with before the config file
+func (c *Config) applyEnv() error {
+	p := new(envParser)
+	p.bool("DEBUG", &c.Debug)
+
+	p.string("TOKEN", &c.Discord.Token)
+	p.string("GUILD_ID", &c.Discord.GuildID)
+	p.string("DISCORD_CLIENT_SECRET", &c.Discord.ClientSecret)
+	p.string("DISCORD_AUTHORIZE_URL", &c.Discord.AuthorizeURL)
+	p.string("DISCORD_API_URL", &c.Discord.APIURL)
+
+	// the env vars only describe one node, it replaces the nodes of the file
+	if os.Getenv("NODE_ADDRESS") != "" {
+		var node NodeConfig
+		if len(c.Nodes) > 0 {
+			node = c.Nodes[0]
+		}
+		p.string("NODE_NAME", &node.Name)
+		p.string("NODE_ADDRESS", &node.Address)
+		p.string("NODE_PASSWORD", &node.Password)
+		p.bool("NODE_SECURE", &node.Secure)
+		c.Nodes = []NodeConfig{node}
+	}
+
+	p.string("DATABASE_URL", &c.Database.URL)
+
+	p.string("SENTRY_DSN", &c.Sentry.DSN)
+	p.float64("SENTRY_SAMPLE_RATE", &c.Sentry.SampleRate)
+
+	p.string("HTTP_ADDRESS", &c.HTTP.Address)
+	p.string("HTTP_PUBLIC_URL", &c.HTTP.PublicURL)
+	p.string("HEALTH_ADDRESS", &c.HTTP.HealthAddress)
+	p.string("DASHBOARD_REDIRECT_URL", &c.HTTP.DashboardRedirectURL)
+
+	p.string("LOG_FORMAT", &c.Log.Format)
+	p.string("LOG_LEVEL", &c.Log.Level)
+	// e.g. "db=debug,gateway=warn"
+	if levels := os.Getenv("LOG_LEVELS"); levels != "" {
+		c.Log.Levels = make(map[string]string)
+		for _, entry := range strings.Split(levels, ",") {
+			if strings.TrimSpace(entry) == "" {
+				continue
+			}
+			subsystem, level, _ := strings.Cut(strings.TrimSpace(entry), "=")
+			c.Log.Levels[subsystem] = level
+		}
+	}
+
+	p.string("TTS_HTTP_URL", &c.TTS.HTTPURL)
+	p.string("TTS_HTTP_VOICES", &c.TTS.HTTPVoices)
+	p.string("TTS_LOCAL_COMMAND", &c.TTS.LocalCommand)
+	p.string("TTS_LOCAL_DIRECTORY", &c.TTS.LocalDirectory)
+	p.string("TTS_LOCAL_VOICES", &c.TTS.LocalVoices)
+	p.int64("TTS_CACHE_SIZE", &c.TTS.CacheSize)
+
+	p.string("CHEER_SOUND_URL", &c.Cheer.SoundURL)
+
+	p.int64("POINTS_PER_MINUTE", &c.Points.PerMinute)
+	p.int64("PLAY_NEXT_COST", &c.Points.PlayNextCost)
+
+	if secrets := os.Getenv("DONATION_WEBHOOK_SECRETS"); secrets != "" {
+		c.Donation.WebhookSecrets = make(map[string]string)
+		for source, secret := range parseWebhookSecrets(secrets) {
+			c.Donation.WebhookSecrets[source] = string(secret)
+		}
+	}
+
+	p.int("DEFAULT_IDLE_TIMEOUT", &c.Defaults.IdleTimeout)
+	p.int("DEFAULT_ALONE_TIMEOUT", &c.Defaults.AloneTimeout)
+	p.bool("DEFAULT_AUTOPLAY", &c.Defaults.Autoplay)
+	p.int("DEFAULT_VOLUME", &c.Defaults.Volume)
+	p.string("DEFAULT_TTS_PROVIDER", &c.Defaults.TTSProvider)
+	p.string("DEFAULT_TTS_LANGUAGE", &c.Defaults.TTSLanguage)
+	p.string("DEFAULT_TTS_VOICE", &c.Defaults.TTSVoice)
+
+	p.bool("FEATURE_API", &c.Features.API)
+	p.bool("FEATURE_DASHBOARD", &c.Features.Dashboard)
+	p.bool("FEATURE_OVERLAY", &c.Features.Overlay)
+	p.bool("FEATURE_METRICS", &c.Features.Metrics)
+	p.bool("FEATURE_DONATION_WEBHOOKS", &c.Features.DonationWebhooks)
+
+	if len(p.errs) > 0 {
+		return fmt.Errorf("invalid env vars:\n%w", errors.Join(p.errs...))
+	}
+	return nil
+}
+
+// Validate reports every invalid setting at once, named by its key in the config file
+func (c *Config) Validate() error {
+	var errs []error
+	invalid := func(key string, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+	}
+
+	if c.Discord.Token == "" {
+		invalid("discord.token", "is required")
+	}
+	if c.Discord.GuildID != "" {
+		guildID, err := snowflake.Parse(c.Discord.GuildID)
+		if err != nil {
+			invalid("discord.guild_id", "%q is not a snowflake", c.Discord.GuildID)
+		}
+		c.Discord.guildID = guildID
+	} else if c.Debug {
+		invalid("discord.guild_id", "is required in debug mode, the commands are registered to it")
+	}
+	for key, value := range map[string]string{"discord.authorize_url": c.Discord.AuthorizeURL, "discord.api_url": c.Discord.APIURL} {
+		if value != "" && !isHTTPURL(value) {
+			invalid(key, "%q is not a http(s) url", value)
+		}
+	}
+
+	if len(c.Nodes) == 0 {
+		invalid("nodes", "at least one lavalink node is required")
+	}
+	names := make(map[string]bool, len(c.Nodes))
+	for i, node := range c.Nodes {
+		key := fmt.Sprintf("nodes[%d]", i)
+		if node.Name == "" {
+			invalid(key+".name", "is required")
+		} else if names[node.Name] {
+			invalid(key+".name", "%q is used by another node", node.Name)
+		}
+		names[node.Name] = true
+		if _, _, err := net.SplitHostPort(node.Address); err != nil {
+			invalid(key+".address", "%q is not a host:port", node.Address)
+		}
+	}
+
+	if c.Database.URL == "" {
+		invalid("database.url", "is required")
+	}
+
+	if c.Sentry.SampleRate < 0 || c.Sentry.SampleRate > 1 {
+		invalid("sentry.sample_rate", "%v is not between 0 and 1", c.Sentry.SampleRate)
+	}
+
+	if c.HTTP.PublicURL != "" && !isHTTPURL(c.HTTP.PublicURL) {
+		invalid("http.public_url", "%q is not a http(s) url", c.HTTP.PublicURL)
+	}
+	if c.HTTP.DashboardRedirectURL != "" && !isHTTPURL(c.HTTP.DashboardRedirectURL) {
+		invalid("http.dashboard_redirect_url", "%q is not a http(s) url", c.HTTP.DashboardRedirectURL)
+	}
+	if c.TTS.LocalCommand != "" && c.HTTP.PublicURL == "" {
+		invalid("http.public_url", "is required by tts.local_command, lavalink loads the clips from it")
+	}
+	if c.dashboardEnabled() && c.HTTP.DashboardRedirectURL == "" && c.HTTP.PublicURL == "" {
+		invalid("http.public_url", "is required by the dashboard unless http.dashboard_redirect_url is set")
+	}
+
+	switch c.Log.Format {
+	case "", "text", "json":
+	default:
+		invalid("log.format", "%q is not text or json", c.Log.Format)
+	}
+	if _, err := parseLogLevels(c.Log.Level, c.Log.Levels); err != nil {
+		invalid("log", "%s", err)
+	}
+
+	if c.TTS.HTTPURL != "" && !isHTTPURL(c.TTS.HTTPURL) {
+		invalid("tts.http_url", "%q is not a http(s) url", c.TTS.HTTPURL)
+	}
+	if c.TTS.CacheSize <= 0 {
+		invalid("tts.cache_size", "must be positive")
+	}
+
+	if c.Points.PerMinute < 0 {
+		invalid("points.per_minute", "must not be negative")
+	}
+	if c.Points.PlayNextCost < 0 {
+		invalid("points.play_next_cost", "must not be negative")
+	}
+
+	if c.Defaults.IdleTimeout < 0 {
+		invalid("defaults.idle_timeout", "must not be negative")
+	}
+	if c.Defaults.AloneTimeout < 0 {
+		invalid("defaults.alone_timeout", "must not be negative")
+	}
+	if c.Defaults.Volume < 0 || c.Defaults.Volume > 100 {
+		invalid("defaults.volume", "%d is not between 0 and 100", c.Defaults.Volume)
+	}
+	switch c.Defaults.TTSProvider {
+	case TTSProviderLavalink:
+	case TTSProviderHTTP:
+		if c.TTS.HTTPURL == "" {
+			invalid("defaults.tts_provider", "%q needs tts.http_url", c.Defaults.TTSProvider)
+		}
+	case TTSProviderLocal:
+		if c.TTS.LocalCommand == "" {
+			invalid("defaults.tts_provider", "%q needs tts.local_command", c.Defaults.TTSProvider)
+		}
+	default:
+		invalid("defaults.tts_provider", "unknown provider %q", c.Defaults.TTSProvider)
+	}
+	if c.Defaults.TTSLanguage == "" {
+		invalid("defaults.tts_language", "is required")
+	}
+	if c.Defaults.TTSVoice == "" {
+		invalid("defaults.tts_voice", "is required")
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func isHTTPURL(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// logLevel is Log.Level, DEBUG still turns on the debug logs when it's unset
+func (c *Config) logLevel() string {
+	if c.Log.Level == "" && c.Debug {
+		return "debug"
+	}
+	return c.Log.Level
+}
+
+func (c *Config) dashboardEnabled() bool {
+	return c.Features.Dashboard && c.Discord.ClientSecret != ""
+}
+
+func (c *Config) dashboardRedirectURL() string {
+	if c.HTTP.DashboardRedirectURL != "" {
+		return c.HTTP.DashboardRedirectURL
+	}
+	return strings.TrimSuffix(c.HTTP.PublicURL, "/") + "/dashboard/callback"
+}
+
+func (c *Config) donationWebhookSecrets() map[string][]byte {
+	secrets := make(map[string][]byte, len(c.Donation.WebhookSecrets))
+	for source, secret := range c.Donation.WebhookSecrets {
+		if source != "" && secret != "" {
+			secrets[source] = []byte(secret)
+		}
+	}
+	return secrets
+}
+
+func (n NodeConfig) disgolinkConfig() disgolink.NodeConfig {
+	return disgolink.NodeConfig{
+		Name:     n.Name,
+		Address:  n.Address,
+		Password: n.Password,
+		Secure:   n.Secure,
+	}
+}
+
+// reloadConfig applies the settings that are safe to change while running: the log levels, the lavalink nodes,
+// the guild defaults and the points. A config that doesn't validate is ignored and the old one stays.
+func (b *Bot) reloadConfig(ctx context.Context, path string) {
+	cfg, err := loadConfig(path)
+	if err != nil {
+		slog.Error("Failed to reload config, keeping the current one", "err", err)
+		return
+	}
+	old := currentConfig()
+	if sections := restartRequired(old, cfg); len(sections) > 0 {
+		slog.Warn("Config changes need a restart to apply", "sections", sections)
+	}
+
+	if err = setLogLevels(cfg.logLevel(), cfg.Log.Levels); err != nil {
+		// Validate parsed them already
+		slog.Error("Failed to set log levels", "err", err)
+	}
+	b.syncNodes(ctx, cfg.Nodes)
+
+	// keep what isn't reloaded, the rest of the bot reads the config as it was at startup
+	reloaded := *old
+	reloaded.Log.Level = cfg.Log.Level
+	reloaded.Log.Levels = cfg.Log.Levels
+	reloaded.Nodes = cfg.Nodes
+	reloaded.Defaults = cfg.Defaults
+	reloaded.Points = cfg.Points
+	activeConfig.Store(&reloaded)
+	slog.Info("Config reloaded", "path", path)
+}
+
+// restartRequired names the sections that changed but aren't reloaded
+func restartRequired(old *Config, cfg *Config) []string {
+	compared := *cfg
+	compared.Log.Level = old.Log.Level
+	compared.Log.Levels = old.Log.Levels
+	compared.Nodes = old.Nodes
+	compared.Defaults = old.Defaults
+	compared.Points = old.Points
+
+	var sections []string
+	oldValue, newValue := reflect.ValueOf(*old), reflect.ValueOf(compared)
+	for i := 0; i < oldValue.NumField(); i++ {
+		if !reflect.DeepEqual(oldValue.Field(i).Interface(), newValue.Field(i).Interface()) {
+			sections = append(sections, oldValue.Type().Field(i).Tag.Get("yaml"))
+		}
+	}
+	return sections
+}
+
+// syncNodes connects the new nodes and removes the ones that are gone or changed.
+// A node that still has players is kept until they're gone, removing it would cut their playback.
+func (b *Bot) syncNodes(ctx context.Context, nodes []NodeConfig) {
+	wanted := make(map[string]disgolink.NodeConfig, len(nodes))
+	for _, node := range nodes {
+		wanted[node.Name] = node.disgolinkConfig()
+	}
+
+	players := make(map[string]int)
+	b.Lavalink.ForPlayers(func(player disgolink.Player) {
+		players[player.Node().Config().Name]++
+	})
+	var stale []disgolink.Node
+	b.Lavalink.ForNodes(func(node disgolink.Node) {
+		if config, ok := wanted[node.Config().Name]; ok && config == node.Config() {
+			delete(wanted, node.Config().Name)
+			return
+		}
+		stale = append(stale, node)
+	})
+	for _, node := range stale {
+		name := node.Config().Name
+		if players[name] > 0 {
+			lavalinkLog.Warn("Node has players, keeping it until the next restart", "node", name, "players", players[name])
+			delete(wanted, name)
+			continue
+		}
+		b.Lavalink.RemoveNode(name)
+		lavalinkLog.Info("Node removed", "node", name)
+	}
+
+	for _, config := range wanted {
+		node, err := b.Lavalink.AddNode(ctx, config)
+		if err != nil {
+			lavalinkLog.Error("Failed to add node", "node", config.Name, "err", err)
+			continue
+		}
+		lavalinkLog.Info("Node added", "node", node.Config().Name)
+	}
+}
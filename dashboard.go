@@ -0,0 +1,511 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/oauth2"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	dashboardSessionCookie   = "dashboard_session"
+	dashboardSessionLifetime = 24 * time.Hour
+	// dashboardRequestHeader has to be set on requests changing something, browsers don't send it cross-site without a preflight
+	dashboardRequestHeader = "X-Requested-With"
+
+	dashboardHistorySize = 50
+	dashboardSearchSize  = 10
+
+	defaultDiscordAuthorizeURL = "https://discord.com/oauth2/authorize"
+)
+
+//go:embed dashboard
+var dashboardFiles embed.FS
+
+type dashboardSessionKey struct{}
+
+type DashboardGuild struct {
+	ID      snowflake.ID `json:"id"`
+	Name    string       `json:"name"`
+	IconURL string       `json:"icon_url,omitempty"`
+}
+
+// DashboardSession is a logged in admin, guilds holds the guilds they can manage
+type DashboardSession struct {
+	UserID    snowflake.ID                    `json:"user_id"`
+	Username  string                          `json:"username"`
+	AvatarURL string                          `json:"avatar_url"`
+	Guilds    map[snowflake.ID]DashboardGuild `json:"-"`
+	ExpiresAt time.Time                       `json:"expires_at"`
+}
+
+// Dashboard serves the web ui for guild admins, they log in with Discord OAuth2
+type Dashboard struct {
+	bot          *Bot
+	oauth        oauth2.Client
+	authorizeURL string
+	redirectURL  string
+	secure       bool
+
+	mu       sync.Mutex
+	sessions map[string]*DashboardSession
+}
+
+// NewDashboard creates the dashboard, apiURL and authorizeURL can point to a mock provider for local testing
+func NewDashboard(bot *Bot, clientSecret string, redirectURL string, authorizeURL string, apiURL string) *Dashboard {
+	var opts []oauth2.ConfigOpt
+	if apiURL != "" {
+		opts = append(opts, oauth2.WithRestClientConfigOpts(rest.WithURL(apiURL)))
+	}
+	if authorizeURL == "" {
+		authorizeURL = defaultDiscordAuthorizeURL
+	}
+	return &Dashboard{
+		bot:          bot,
+		oauth:        oauth2.New(bot.Client.ApplicationID(), clientSecret, opts...),
+		authorizeURL: authorizeURL,
+		redirectURL:  redirectURL,
+		secure:       strings.HasPrefix(redirectURL, "https://"),
+		sessions:     make(map[string]*DashboardSession),
+	}
+}
+
+func newDashboardSessionID() string {
+	id := make([]byte, 32)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// Register adds the routes of the dashboard, the guild routes reuse the handlers of the REST API
+func (d *Dashboard) Register(mux *http.ServeMux) {
+	files, _ := fs.Sub(dashboardFiles, "dashboard")
+	mux.Handle("GET /dashboard/", http.StripPrefix("/dashboard/", http.FileServerFS(files)))
+	mux.HandleFunc("GET /dashboard/login", d.login)
+	mux.HandleFunc("GET /dashboard/callback", d.callback)
+	mux.HandleFunc("POST /dashboard/logout", d.logout)
+	mux.HandleFunc("GET /dashboard/api/me", d.me)
+
+	b := d.bot
+	routes := map[string]apiGuildHandler{
+		"GET /player":               b.apiPlayer,
+		"GET /events":               b.Stream.ServeStream,
+		"POST /player/pause":        b.apiPause(true),
+		"POST /player/resume":       b.apiPause(false),
+		"POST /player/skip":         b.apiSkip,
+		"POST /player/seek":         b.apiSeek,
+		"PUT /player/volume":        b.apiVolume,
+		"POST /queue":               d.play,
+		"POST /queue/move":          b.apiMoveQueue,
+		"DELETE /queue/{position}":  b.apiRemoveQueue,
+		"GET /search":               d.search,
+		"GET /settings":             d.settings,
+		"PUT /settings":             d.saveSettings,
+		"GET /history":              d.history,
+		"GET /stats":                d.stats,
+		"GET /playlists":            d.playlists,
+		"POST /playlists":           d.savePlaylist,
+		"POST /playlists/{id}/load": d.loadPlaylist,
+		"DELETE /playlists/{id}":    d.deletePlaylist,
+	}
+	for route, handler := range routes {
+		method, path, _ := strings.Cut(route, " ")
+		mux.HandleFunc(method+" /dashboard/api/guilds/{guild}"+path, d.auth(handler))
+	}
+}
+
+func (d *Dashboard) session(r *http.Request) *DashboardSession {
+	cookie, err := r.Cookie(dashboardSessionCookie)
+	if err != nil {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	session, ok := d.sessions[cookie.Value]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(session.ExpiresAt) {
+		delete(d.sessions, cookie.Value)
+		return nil
+	}
+	return session
+}
+
+func dashboardSession(r *http.Request) *DashboardSession {
+	session, _ := r.Context().Value(dashboardSessionKey{}).(*DashboardSession)
+	return session
+}
+
+// sameOrigin reports whether the request comes from a page served by the bot
+func sameOrigin(r *http.Request) bool {
+	origin, err := url.Parse(r.Header.Get("Origin"))
+	return err == nil && origin.Host == r.Host
+}
+
+// auth checks that the session may manage the guild of the path and the bot is still in it
+func (d *Dashboard) auth(handler apiGuildHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session := d.session(r)
+		if session == nil {
+			writeAPIMessage(w, http.StatusUnauthorized, "not logged in")
+			return
+		}
+		// the session cookie is sent along cross-site, only accept requests a foreign page can't forge
+		if websocket.IsWebSocketUpgrade(r) {
+			if !sameOrigin(r) {
+				writeAPIMessage(w, http.StatusForbidden, "cross-origin request")
+				return
+			}
+		} else if r.Method != http.MethodGet && r.Header.Get(dashboardRequestHeader) == "" {
+			writeAPIMessage(w, http.StatusForbidden, "missing "+dashboardRequestHeader+" header")
+			return
+		}
+
+		guildID, err := snowflake.Parse(r.PathValue("guild"))
+		if err != nil {
+			writeAPIMessage(w, http.StatusNotFound, "unknown guild")
+			return
+		}
+		if _, ok := session.Guilds[guildID]; !ok {
+			writeAPIMessage(w, http.StatusForbidden, "you can't manage this guild")
+			return
+		}
+		if _, ok := d.bot.Client.Caches().Guild(guildID); !ok {
+			writeAPIMessage(w, http.StatusNotFound, "the bot is not in this guild")
+			return
+		}
+		handler(w, r.WithContext(context.WithValue(r.Context(), dashboardSessionKey{}, session)), guildID)
+	}
+}
+
+func (d *Dashboard) login(w http.ResponseWriter, r *http.Request) {
+	state := d.oauth.StateController().NewState(d.redirectURL)
+	query := url.Values{
+		"client_id":     {d.oauth.ID().String()},
+		"redirect_uri":  {d.redirectURL},
+		"response_type": {"code"},
+		"scope":         {discord.JoinScopes([]discord.OAuth2Scope{discord.OAuth2ScopeIdentify, discord.OAuth2ScopeGuilds})},
+		"state":         {state},
+		"prompt":        {"none"},
+	}
+	http.Redirect(w, r, d.authorizeURL+"?"+query.Encode(), http.StatusFound)
+}
+
+// canManage reports whether the member may change the settings of the guild, like the admin slash commands
+func canManage(guild discord.OAuth2Guild) bool {
+	return guild.Owner || guild.Permissions.Has(discord.PermissionAdministrator) || guild.Permissions.Has(discord.PermissionManageGuild)
+}
+
+func (d *Dashboard) callback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	oauthSession, _, err := d.oauth.StartSession(query.Get("code"), query.Get("state"))
+	if errors.Is(err, oauth2.ErrStateNotFound) {
+		http.Error(w, "login expired, please try again", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		slog.Error("Failed to start dashboard session", "err", err)
+		http.Error(w, "login failed", http.StatusBadGateway)
+		return
+	}
+	user, err := d.oauth.GetUser(oauthSession)
+	if err != nil {
+		slog.Error("Failed to get dashboard user", "err", err)
+		http.Error(w, "login failed", http.StatusBadGateway)
+		return
+	}
+	guilds, err := d.oauth.GetGuilds(oauthSession)
+	if err != nil {
+		slog.Error("Failed to get dashboard guilds", "user_id", user.ID, "err", err)
+		http.Error(w, "login failed", http.StatusBadGateway)
+		return
+	}
+
+	session := &DashboardSession{
+		UserID:    user.ID,
+		Username:  user.EffectiveName(),
+		AvatarURL: user.EffectiveAvatarURL(),
+		Guilds:    make(map[snowflake.ID]DashboardGuild),
+		ExpiresAt: time.Now().Add(dashboardSessionLifetime),
+	}
+	for _, guild := range guilds {
+		if !canManage(guild) {
+			continue
+		}
+		dashboardGuild := DashboardGuild{ID: guild.ID, Name: guild.Name}
+		if iconURL := guild.IconURL(); iconURL != nil {
+			dashboardGuild.IconURL = *iconURL
+		}
+		session.Guilds[guild.ID] = dashboardGuild
+	}
+
+	sessionID := newDashboardSessionID()
+	d.mu.Lock()
+	for id, expired := range d.sessions {
+		if time.Now().After(expired.ExpiresAt) {
+			delete(d.sessions, id)
+		}
+	}
+	d.sessions[sessionID] = session
+	d.mu.Unlock()
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     dashboardSessionCookie,
+		Value:    sessionID,
+		Path:     "/dashboard",
+		Expires:  session.ExpiresAt,
+		HttpOnly: true,
+		Secure:   d.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, "/dashboard/", http.StatusFound)
+}
+
+func (d *Dashboard) logout(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get(dashboardRequestHeader) == "" {
+		writeAPIMessage(w, http.StatusForbidden, "missing "+dashboardRequestHeader+" header")
+		return
+	}
+	if cookie, err := r.Cookie(dashboardSessionCookie); err == nil {
+		d.mu.Lock()
+		delete(d.sessions, cookie.Value)
+		d.mu.Unlock()
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     dashboardSessionCookie,
+		Path:     "/dashboard",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   d.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// me returns the logged in user and the guilds shared with the bot they can manage
+func (d *Dashboard) me(w http.ResponseWriter, r *http.Request) {
+	session := d.session(r)
+	if session == nil {
+		writeAPIMessage(w, http.StatusUnauthorized, "not logged in")
+		return
+	}
+	guilds := make([]DashboardGuild, 0, len(session.Guilds))
+	for guildID, guild := range session.Guilds {
+		if _, ok := d.bot.Client.Caches().Guild(guildID); ok {
+			guilds = append(guilds, guild)
+		}
+	}
+	writeAPIJSON(w, http.StatusOK, map[string]any{
+		"user":   session,
+		"guilds": guilds,
+	})
+}
+
+func (d *Dashboard) play(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	var body struct {
+		Query string `json:"query"`
+		Next  bool   `json:"next"`
+	}
+	if !readAPIBody(w, r, &body) {
+		return
+	}
+	if strings.TrimSpace(body.Query) == "" {
+		writeAPIMessage(w, http.StatusBadRequest, "query is required")
+		return
+	}
+	d.bot.playForMember(w, guildID, dashboardSession(r).UserID, body.Query, body.Next)
+}
+
+// search returns the tracks found for the query, they're added with their uri through play
+func (d *Dashboard) search(w http.ResponseWriter, r *http.Request, _ snowflake.ID) {
+	query := strings.TrimSpace(r.URL.Query().Get("query"))
+	if query == "" {
+		writeAPIMessage(w, http.StatusBadRequest, "query is required")
+		return
+	}
+	if !urlPattern.MatchString(query) {
+		query = lavalink.SearchTypeYouTube.Apply(query)
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+	result, err := loadTracks(ctx, d.bot.Lavalink.BestNode(), query)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+
+	tracks := make([]WebhookTrack, 0, dashboardSearchSize)
+	switch data := result.Data.(type) {
+	case lavalink.Search:
+		for _, track := range data {
+			if len(tracks) == dashboardSearchSize {
+				break
+			}
+			tracks = append(tracks, newWebhookTrack(track))
+		}
+	case lavalink.Track:
+		tracks = append(tracks, newWebhookTrack(data))
+	case lavalink.Playlist:
+		for _, track := range data.Tracks {
+			if len(tracks) == dashboardSearchSize {
+				break
+			}
+			tracks = append(tracks, newWebhookTrack(track))
+		}
+	}
+	writeAPIJSON(w, http.StatusOK, tracks)
+}
+
+type dashboardSettings struct {
+	IdleTimeout  int      `json:"idle_timeout"`
+	AloneTimeout int      `json:"alone_timeout"`
+	TTSProvider  string   `json:"tts_provider"`
+	TTSProviders []string `json:"tts_providers"`
+	Autoplay     bool     `json:"autoplay"`
+}
+
+func (d *Dashboard) writeSettings(w http.ResponseWriter, guildSettings *GuildSettings) {
+	settings := dashboardSettings{
+		IdleTimeout:  int(guildSettings.IdleTimeout.Minutes()),
+		AloneTimeout: int(guildSettings.AloneTimeout.Minutes()),
+		TTSProvider:  guildSettings.TTSProvider,
+		TTSProviders: make([]string, 0, len(d.bot.TTSProviders)),
+		Autoplay:     guildSettings.Autoplay,
+	}
+	for name := range d.bot.TTSProviders {
+		settings.TTSProviders = append(settings.TTSProviders, name)
+	}
+	writeAPIJSON(w, http.StatusOK, settings)
+}
+
+func (d *Dashboard) settings(w http.ResponseWriter, _ *http.Request, guildID snowflake.ID) {
+	d.writeSettings(w, d.bot.Guilds.Get(guildID).currentSettings())
+}
+
+func (d *Dashboard) saveSettings(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	var update SettingsUpdate
+	if !readAPIBody(w, r, &update) {
+		return
+	}
+	guildSettings, err := d.bot.saveSettings(r.Context(), guildID, update)
+	var settingsErr *SettingsError
+	if errors.As(err, &settingsErr) {
+		writeAPIMessage(w, http.StatusBadRequest, settingsErr.Message)
+		return
+	}
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	d.writeSettings(w, guildSettings)
+}
+
+func (d *Dashboard) history(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	history, err := d.bot.playHistory(r.Context(), guildID, dashboardHistorySize)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, history)
+}
+
+func (d *Dashboard) stats(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	stats, err := d.bot.playStats(r.Context(), guildID)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, stats)
+}
+
+func (d *Dashboard) playlists(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	playlists, err := d.bot.playlists(r.Context(), guildID)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, playlists)
+}
+
+func (d *Dashboard) savePlaylist(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	var body struct {
+		Name string `json:"name"`
+	}
+	if !readAPIBody(w, r, &body) {
+		return
+	}
+	if name := strings.TrimSpace(body.Name); name == "" || len(name) > 100 {
+		writeAPIMessage(w, http.StatusBadRequest, "name must be between 1 and 100 characters")
+		return
+	}
+	saved, err := d.bot.saveQueueAsPlaylist(r.Context(), guildID, body.Name)
+	if errors.Is(err, errEmptyPlaylist) {
+		writeAPIMessage(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, saved)
+}
+
+func (d *Dashboard) loadPlaylist(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeAPIMessage(w, http.StatusNotFound, errPlaylistNotFound.Error())
+		return
+	}
+	member, err := d.bot.member(guildID, dashboardSession(r).UserID)
+	if err != nil {
+		writeAPIMessage(w, http.StatusNotFound, "unknown member")
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+	loaded, err := d.bot.loadPlaylist(ctx, guildID, id, member)
+	switch {
+	case errors.Is(err, errPlaylistNotFound):
+		writeAPIMessage(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, errNotInVoice), errors.Is(err, errOtherChannel):
+		writeAPIMessage(w, http.StatusConflict, err.Error())
+	case err != nil:
+		writeAPIError(w, err)
+	default:
+		writeAPIJSON(w, http.StatusOK, loaded)
+	}
+}
+
+func (d *Dashboard) deletePlaylist(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeAPIMessage(w, http.StatusNotFound, errPlaylistNotFound.Error())
+		return
+	}
+	err = d.bot.deletePlaylist(r.Context(), guildID, id)
+	if errors.Is(err, errPlaylistNotFound) {
+		writeAPIMessage(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
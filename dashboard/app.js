@@ -0,0 +1,397 @@
+"use strict";
+
+const $ = (id) => document.getElementById(id);
+
+let guildID = null;
+let player = null;
+let socket = null;
+
+async function request(method, path, body) {
+	const options = {method, headers: {}};
+	if (method !== "GET") {
+		options.headers["X-Requested-With"] = "dashboard";
+	}
+	if (body !== undefined) {
+		options.headers["Content-Type"] = "application/json";
+		options.body = JSON.stringify(body);
+	}
+	const response = await fetch(path, options);
+	const data = await response.json().catch(() => null);
+	if (!response.ok) {
+		throw new Error(data && data.message ? data.message : response.statusText);
+	}
+	return data;
+}
+
+function guildRequest(method, path, body) {
+	return request(method, `api/guilds/${guildID}${path}`, body);
+}
+
+function showError(err) {
+	$("error").textContent = err.message;
+	$("error").classList.remove("hidden");
+	setTimeout(() => $("error").classList.add("hidden"), 5000);
+}
+
+// action runs fn and shows its error instead of throwing
+function action(fn) {
+	return (...args) => fn(...args).catch(showError);
+}
+
+function formatDuration(ms) {
+	const seconds = Math.floor(ms / 1000);
+	const h = Math.floor(seconds / 3600);
+	const m = Math.floor(seconds / 60) % 60;
+	const s = String(seconds % 60).padStart(2, "0");
+	return h > 0 ? `${h}:${String(m).padStart(2, "0")}:${s}` : `${m}:${s}`;
+}
+
+function trackLabel(track) {
+	return `${track.title} - ${track.author}`;
+}
+
+function trackElement(track) {
+	const title = document.createElement("span");
+	title.className = "title";
+	if (track.uri) {
+		const link = document.createElement("a");
+		link.href = track.uri;
+		link.target = "_blank";
+		link.rel = "noopener";
+		link.textContent = trackLabel(track);
+		title.append(link);
+	} else {
+		title.textContent = trackLabel(track);
+	}
+	return title;
+}
+
+function button(text, onClick, secondary) {
+	const element = document.createElement("button");
+	element.textContent = text;
+	if (secondary) {
+		element.className = "secondary";
+	}
+	element.addEventListener("click", action(onClick));
+	return element;
+}
+
+function renderPosition() {
+	if (!player || !player.track) {
+		$("progress").value = 0;
+		$("time").textContent = "";
+		return;
+	}
+	const length = player.track.is_stream ? 0 : player.track.length_ms;
+	$("progress").max = Math.max(length, 1);
+	$("progress").value = Math.min(player.position_ms, length);
+	$("time").textContent = player.track.is_stream
+		? "Live"
+		: `${formatDuration(player.position_ms)} / ${formatDuration(length)}`;
+}
+
+function renderPlayer() {
+	if (!player) {
+		$("track").textContent = "Nothing is playing";
+		$("toggle-pause").disabled = true;
+		$("skip").disabled = true;
+		$("volume").disabled = true;
+		$("repeat-mode").textContent = "";
+		$("queue-length").textContent = "";
+		$("queue").replaceChildren();
+		renderPosition();
+		return;
+	}
+	$("track").replaceChildren(player.track ? trackElement(player.track) : "Nothing is playing");
+	if (player.track && player.track.requester) {
+		$("track").append(` (requested by ${player.track.requester})`);
+	}
+	$("toggle-pause").disabled = false;
+	$("toggle-pause").textContent = player.paused ? "Resume" : "Pause";
+	$("skip").disabled = !player.track;
+	$("volume").disabled = false;
+	$("volume").value = player.volume;
+	$("repeat-mode").textContent = player.repeat_mode.replace("_", " ");
+	renderPosition();
+	renderQueue();
+}
+
+function renderQueue() {
+	$("queue-length").textContent = player.queue.length > 0
+		? `(${player.queue.length} tracks, ${formatDuration(player.queue_length_ms)})`
+		: "";
+	const items = player.queue.map((track, index) => {
+		const item = document.createElement("li");
+		item.draggable = true;
+		item.dataset.position = index + 1;
+		item.append(
+			trackElement(track),
+			document.createTextNode(track.is_stream ? "Live" : formatDuration(track.length_ms)),
+			button("Remove", () => removeTrack(index + 1), true),
+		);
+		return item;
+	});
+	$("queue").replaceChildren(...items);
+}
+
+async function loadPlayer() {
+	try {
+		player = await guildRequest("GET", "/player");
+	} catch (err) {
+		player = null;
+	}
+	renderPlayer();
+}
+
+function removeTrack(position) {
+	return guildRequest("DELETE", `/queue/${position}`);
+}
+
+// the queue is reordered with drag and drop, positions are 1-based like the api
+let dragged = null;
+
+$("queue").addEventListener("dragstart", (event) => {
+	dragged = event.target.closest("li");
+	dragged.classList.add("dragging");
+	event.dataTransfer.effectAllowed = "move";
+});
+
+$("queue").addEventListener("dragend", () => {
+	dragged.classList.remove("dragging");
+	dragged = null;
+	document.querySelectorAll(".drop-target").forEach((item) => item.classList.remove("drop-target"));
+});
+
+$("queue").addEventListener("dragover", (event) => {
+	const target = event.target.closest("li");
+	if (!dragged || !target) {
+		return;
+	}
+	event.preventDefault();
+	document.querySelectorAll(".drop-target").forEach((item) => item.classList.remove("drop-target"));
+	target.classList.add("drop-target");
+});
+
+$("queue").addEventListener("drop", action(async (event) => {
+	const target = event.target.closest("li");
+	if (!dragged || !target || target === dragged) {
+		return;
+	}
+	event.preventDefault();
+	const from = Number(dragged.dataset.position);
+	const to = Number(target.dataset.position);
+	target.before(dragged);
+	await guildRequest("POST", "/queue/move", {from, to});
+}));
+
+$("toggle-pause").addEventListener("click", action(() =>
+	guildRequest("POST", player.paused ? "/player/resume" : "/player/pause")));
+
+$("skip").addEventListener("click", action(() => guildRequest("POST", "/player/skip")));
+
+$("volume").addEventListener("change", action(() =>
+	guildRequest("PUT", "/player/volume", {volume: Number($("volume").value)})));
+
+$("progress").addEventListener("click", action(async (event) => {
+	if (!player || !player.track || player.track.is_stream) {
+		return;
+	}
+	const ratio = event.offsetX / $("progress").clientWidth;
+	await guildRequest("POST", "/player/seek", {position_ms: Math.floor(ratio * player.track.length_ms)});
+}));
+
+$("search-form").addEventListener("submit", action(async (event) => {
+	event.preventDefault();
+	const query = encodeURIComponent($("search-query").value);
+	const tracks = await guildRequest("GET", `/search?query=${query}`);
+	$("search-results").replaceChildren(...tracks.map((track) => {
+		const item = document.createElement("li");
+		item.append(
+			trackElement(track),
+			button("Add", () => guildRequest("POST", "/queue", {query: track.uri})),
+			button("Play next", () => guildRequest("POST", "/queue", {query: track.uri, next: true}), true),
+		);
+		return item;
+	}));
+}));
+
+async function loadSettings() {
+	const settings = await guildRequest("GET", "/settings");
+	$("idle-timeout").value = settings.idle_timeout;
+	$("alone-timeout").value = settings.alone_timeout;
+	$("tts-provider").replaceChildren(...settings.tts_providers.map((provider) => new Option(provider, provider)));
+	$("tts-provider").value = settings.tts_provider;
+	$("autoplay").checked = settings.autoplay;
+}
+
+$("settings-form").addEventListener("submit", action(async (event) => {
+	event.preventDefault();
+	await guildRequest("PUT", "/settings", {
+		idle_timeout: Number($("idle-timeout").value),
+		alone_timeout: Number($("alone-timeout").value),
+		tts_provider: $("tts-provider").value,
+		autoplay: $("autoplay").checked,
+	});
+	await loadSettings();
+}));
+
+async function loadPlaylists() {
+	const playlists = await guildRequest("GET", "/playlists");
+	$("playlists").replaceChildren(...playlists.map((playlist) => {
+		const item = document.createElement("li");
+		const title = document.createElement("span");
+		title.className = "title";
+		title.textContent = `${playlist.name} (${playlist.tracks} tracks)`;
+		item.append(
+			title,
+			button("Load", () => guildRequest("POST", `/playlists/${playlist.id}/load`)),
+			button("Delete", async () => {
+				if (confirm(`Delete the playlist ${playlist.name}?`)) {
+					await guildRequest("DELETE", `/playlists/${playlist.id}`);
+					await loadPlaylists();
+				}
+			}, true),
+		);
+		return item;
+	}));
+}
+
+$("playlist-form").addEventListener("submit", action(async (event) => {
+	event.preventDefault();
+	await guildRequest("POST", "/playlists", {name: $("playlist-name").value});
+	$("playlist-name").value = "";
+	await loadPlaylists();
+}));
+
+async function loadHistory() {
+	const history = await guildRequest("GET", "/history");
+	$("history").replaceChildren(...history.map((entry) => {
+		const row = document.createElement("tr");
+		const played = document.createElement("td");
+		played.textContent = new Date(entry.played_at).toLocaleString();
+		const track = document.createElement("td");
+		track.append(trackElement(entry.track));
+		if (entry.autoplay) {
+			track.append(" (autoplay)");
+		}
+		const requester = document.createElement("td");
+		requester.textContent = entry.track.requester || "";
+		row.append(played, track, requester);
+		return row;
+	}));
+}
+
+async function loadStats() {
+	const stats = await guildRequest("GET", "/stats");
+	const summary = document.createElement("p");
+	summary.textContent = `${stats.plays} plays of ${stats.unique_tracks} tracks, ` +
+		`${formatDuration(stats.length_ms)} listened since ${new Date(stats.since).toLocaleDateString()}`;
+
+	const topTracks = document.createElement("ol");
+	topTracks.append(...stats.top_tracks.map((track) => {
+		const item = document.createElement("li");
+		item.textContent = `${trackLabel(track)} (${track.count})`;
+		return item;
+	}));
+	const topRequesters = document.createElement("ol");
+	topRequesters.append(...stats.top_requesters.map((requester) => {
+		const item = document.createElement("li");
+		item.textContent = `${requester.requester} (${requester.count})`;
+		return item;
+	}));
+	const tracksTitle = document.createElement("h3");
+	tracksTitle.textContent = "Top tracks";
+	const requestersTitle = document.createElement("h3");
+	requestersTitle.textContent = "Top requesters";
+	$("stats").replaceChildren(summary, tracksTitle, topTracks, requestersTitle, topRequesters);
+}
+
+function connect() {
+	if (socket) {
+		socket.onclose = null;
+		socket.close();
+	}
+	const protocol = location.protocol === "https:" ? "wss:" : "ws:";
+	const base = location.pathname.replace(/[^/]*$/, "");
+	socket = new WebSocket(`${protocol}//${location.host}${base}api/guilds/${guildID}/events`);
+	const connectedGuild = guildID;
+	socket.onmessage = (message) => {
+		const payload = JSON.parse(message.data);
+		switch (payload.event) {
+			case "snapshot":
+				player = payload.data;
+				renderPlayer();
+				break;
+			case "player.update":
+				if (player) {
+					player.position_ms = payload.data.position_ms;
+					renderPosition();
+				}
+				break;
+			case "track.start":
+				loadPlayer();
+				loadHistory().catch(showError);
+				break;
+			default:
+				loadPlayer();
+		}
+	};
+	socket.onclose = () => {
+		if (connectedGuild === guildID) {
+			setTimeout(connect, 5000);
+		}
+	};
+}
+
+function selectGuild(id) {
+	guildID = id;
+	localStorage.setItem("guild", id);
+	connect();
+	loadPlayer();
+	Promise.all([loadSettings(), loadPlaylists(), loadHistory(), loadStats()]).catch(showError);
+}
+
+$("guild").addEventListener("change", () => selectGuild($("guild").value));
+
+$("logout").addEventListener("click", action(async () => {
+	await request("POST", "logout");
+	location.reload();
+}));
+
+// the position is advanced locally between the player updates of lavalink
+setInterval(() => {
+	if (player && player.track && !player.paused) {
+		player.position_ms += 1000;
+		renderPosition();
+	}
+}, 1000);
+
+async function init() {
+	let me;
+	try {
+		me = await request("GET", "api/me");
+	} catch (err) {
+		$("login").classList.remove("hidden");
+		return;
+	}
+	$("username").textContent = me.user.username;
+	$("avatar").src = me.user.avatar_url;
+	$("user").classList.remove("hidden");
+	if (me.guilds.length === 0) {
+		$("empty").classList.remove("hidden");
+		return;
+	}
+
+	me.guilds.sort((a, b) => a.name.localeCompare(b.name));
+	$("guild").replaceChildren(...me.guilds.map((guild) => new Option(guild.name, guild.id)));
+	$("guild").classList.remove("hidden");
+	$("app").classList.remove("hidden");
+
+	const saved = localStorage.getItem("guild");
+	if (me.guilds.some((guild) => guild.id === saved)) {
+		$("guild").value = saved;
+	}
+	selectGuild($("guild").value);
+}
+
+init();
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/cheersound"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+)
+
+// CheerSound is the model entity for the CheerSound schema.
+type CheerSound struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID snowflake.ID `json:"guild_id,omitempty"`
+	// Minimum amount of bits of the tier
+	Tier int `json:"tier,omitempty"`
+	// FileName holds the value of the "file_name" field.
+	FileName string `json:"file_name,omitempty"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
+	// Data holds the value of the "data" field.
+	Data []byte `json:"data,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CheerSoundQuery when eager-loading is set.
+	Edges        CheerSoundEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CheerSoundEdges holds the relations/edges for other nodes in the graph.
+type CheerSoundEdges struct {
+	// Guild holds the value of the guild edge.
+	Guild *Guild `json:"guild,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GuildOrErr returns the Guild value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CheerSoundEdges) GuildOrErr() (*Guild, error) {
+	if e.Guild != nil {
+		return e.Guild, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: guild.Label}
+	}
+	return nil, &NotLoadedError{edge: "guild"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CheerSound) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cheersound.FieldData:
+			values[i] = new([]byte)
+		case cheersound.FieldID, cheersound.FieldGuildID, cheersound.FieldTier:
+			values[i] = new(sql.NullInt64)
+		case cheersound.FieldFileName, cheersound.FieldContentType:
+			values[i] = new(sql.NullString)
+		case cheersound.FieldCreatedAt, cheersound.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CheerSound fields.
+func (cs *CheerSound) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cheersound.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cs.ID = int(value.Int64)
+		case cheersound.FieldGuildID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				cs.GuildID = snowflake.ID(value.Int64)
+			}
+		case cheersound.FieldTier:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tier", values[i])
+			} else if value.Valid {
+				cs.Tier = int(value.Int64)
+			}
+		case cheersound.FieldFileName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_name", values[i])
+			} else if value.Valid {
+				cs.FileName = value.String
+			}
+		case cheersound.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				cs.ContentType = value.String
+			}
+		case cheersound.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil {
+				cs.Data = *value
+			}
+		case cheersound.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cs.CreatedAt = value.Time
+			}
+		case cheersound.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cs.UpdatedAt = value.Time
+			}
+		default:
+			cs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CheerSound.
+// This includes values selected through modifiers, order, etc.
+func (cs *CheerSound) Value(name string) (ent.Value, error) {
+	return cs.selectValues.Get(name)
+}
+
+// QueryGuild queries the "guild" edge of the CheerSound entity.
+func (cs *CheerSound) QueryGuild() *GuildQuery {
+	return NewCheerSoundClient(cs.config).QueryGuild(cs)
+}
+
+// Update returns a builder for updating this CheerSound.
+// Note that you need to call CheerSound.Unwrap() before calling this method if this CheerSound
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cs *CheerSound) Update() *CheerSoundUpdateOne {
+	return NewCheerSoundClient(cs.config).UpdateOne(cs)
+}
+
+// Unwrap unwraps the CheerSound entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cs *CheerSound) Unwrap() *CheerSound {
+	_tx, ok := cs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CheerSound is not a transactional entity")
+	}
+	cs.config.driver = _tx.drv
+	return cs
+}
+
+// String implements the fmt.Stringer.
+func (cs *CheerSound) String() string {
+	var builder strings.Builder
+	builder.WriteString("CheerSound(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cs.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(fmt.Sprintf("%v", cs.GuildID))
+	builder.WriteString(", ")
+	builder.WriteString("tier=")
+	builder.WriteString(fmt.Sprintf("%v", cs.Tier))
+	builder.WriteString(", ")
+	builder.WriteString("file_name=")
+	builder.WriteString(cs.FileName)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(cs.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(fmt.Sprintf("%v", cs.Data))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cs.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CheerSounds is a parsable slice of CheerSound.
+type CheerSounds []*CheerSound
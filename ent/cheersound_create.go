@@ -0,0 +1,890 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/cheersound"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+)
+
+// CheerSoundCreate is the builder for creating a CheerSound entity.
+type CheerSoundCreate struct {
+	config
+	mutation *CheerSoundMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGuildID sets the "guild_id" field.
+func (csc *CheerSoundCreate) SetGuildID(s snowflake.ID) *CheerSoundCreate {
+	csc.mutation.SetGuildID(s)
+	return csc
+}
+
+// SetTier sets the "tier" field.
+func (csc *CheerSoundCreate) SetTier(i int) *CheerSoundCreate {
+	csc.mutation.SetTier(i)
+	return csc
+}
+
+// SetFileName sets the "file_name" field.
+func (csc *CheerSoundCreate) SetFileName(s string) *CheerSoundCreate {
+	csc.mutation.SetFileName(s)
+	return csc
+}
+
+// SetContentType sets the "content_type" field.
+func (csc *CheerSoundCreate) SetContentType(s string) *CheerSoundCreate {
+	csc.mutation.SetContentType(s)
+	return csc
+}
+
+// SetData sets the "data" field.
+func (csc *CheerSoundCreate) SetData(b []byte) *CheerSoundCreate {
+	csc.mutation.SetData(b)
+	return csc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csc *CheerSoundCreate) SetCreatedAt(t time.Time) *CheerSoundCreate {
+	csc.mutation.SetCreatedAt(t)
+	return csc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csc *CheerSoundCreate) SetNillableCreatedAt(t *time.Time) *CheerSoundCreate {
+	if t != nil {
+		csc.SetCreatedAt(*t)
+	}
+	return csc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (csc *CheerSoundCreate) SetUpdatedAt(t time.Time) *CheerSoundCreate {
+	csc.mutation.SetUpdatedAt(t)
+	return csc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (csc *CheerSoundCreate) SetNillableUpdatedAt(t *time.Time) *CheerSoundCreate {
+	if t != nil {
+		csc.SetUpdatedAt(*t)
+	}
+	return csc
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (csc *CheerSoundCreate) SetGuild(g *Guild) *CheerSoundCreate {
+	return csc.SetGuildID(g.ID)
+}
+
+// Mutation returns the CheerSoundMutation object of the builder.
+func (csc *CheerSoundCreate) Mutation() *CheerSoundMutation {
+	return csc.mutation
+}
+
+// Save creates the CheerSound in the database.
+func (csc *CheerSoundCreate) Save(ctx context.Context) (*CheerSound, error) {
+	csc.defaults()
+	return withHooks(ctx, csc.sqlSave, csc.mutation, csc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (csc *CheerSoundCreate) SaveX(ctx context.Context) *CheerSound {
+	v, err := csc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (csc *CheerSoundCreate) Exec(ctx context.Context) error {
+	_, err := csc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csc *CheerSoundCreate) ExecX(ctx context.Context) {
+	if err := csc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csc *CheerSoundCreate) defaults() {
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		v := cheersound.DefaultCreatedAt()
+		csc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := csc.mutation.UpdatedAt(); !ok {
+		v := cheersound.DefaultUpdatedAt()
+		csc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csc *CheerSoundCreate) check() error {
+	if _, ok := csc.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "CheerSound.guild_id"`)}
+	}
+	if _, ok := csc.mutation.Tier(); !ok {
+		return &ValidationError{Name: "tier", err: errors.New(`ent: missing required field "CheerSound.tier"`)}
+	}
+	if v, ok := csc.mutation.Tier(); ok {
+		if err := cheersound.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`ent: validator failed for field "CheerSound.tier": %w`, err)}
+		}
+	}
+	if _, ok := csc.mutation.FileName(); !ok {
+		return &ValidationError{Name: "file_name", err: errors.New(`ent: missing required field "CheerSound.file_name"`)}
+	}
+	if _, ok := csc.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "CheerSound.content_type"`)}
+	}
+	if _, ok := csc.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`ent: missing required field "CheerSound.data"`)}
+	}
+	if v, ok := csc.mutation.Data(); ok {
+		if err := cheersound.DataValidator(v); err != nil {
+			return &ValidationError{Name: "data", err: fmt.Errorf(`ent: validator failed for field "CheerSound.data": %w`, err)}
+		}
+	}
+	if len(csc.mutation.GuildIDs()) == 0 {
+		return &ValidationError{Name: "guild", err: errors.New(`ent: missing required edge "CheerSound.guild"`)}
+	}
+	return nil
+}
+
+func (csc *CheerSoundCreate) sqlSave(ctx context.Context) (*CheerSound, error) {
+	if err := csc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := csc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, csc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	csc.mutation.id = &_node.ID
+	csc.mutation.done = true
+	return _node, nil
+}
+
+func (csc *CheerSoundCreate) createSpec() (*CheerSound, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CheerSound{config: csc.config}
+		_spec = sqlgraph.NewCreateSpec(cheersound.Table, sqlgraph.NewFieldSpec(cheersound.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = csc.conflict
+	if value, ok := csc.mutation.Tier(); ok {
+		_spec.SetField(cheersound.FieldTier, field.TypeInt, value)
+		_node.Tier = value
+	}
+	if value, ok := csc.mutation.FileName(); ok {
+		_spec.SetField(cheersound.FieldFileName, field.TypeString, value)
+		_node.FileName = value
+	}
+	if value, ok := csc.mutation.ContentType(); ok {
+		_spec.SetField(cheersound.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := csc.mutation.Data(); ok {
+		_spec.SetField(cheersound.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
+	if value, ok := csc.mutation.CreatedAt(); ok {
+		_spec.SetField(cheersound.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := csc.mutation.UpdatedAt(); ok {
+		_spec.SetField(cheersound.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := csc.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cheersound.GuildTable,
+			Columns: []string{cheersound.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GuildID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CheerSound.Create().
+//		SetGuildID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CheerSoundUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (csc *CheerSoundCreate) OnConflict(opts ...sql.ConflictOption) *CheerSoundUpsertOne {
+	csc.conflict = opts
+	return &CheerSoundUpsertOne{
+		create: csc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CheerSound.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (csc *CheerSoundCreate) OnConflictColumns(columns ...string) *CheerSoundUpsertOne {
+	csc.conflict = append(csc.conflict, sql.ConflictColumns(columns...))
+	return &CheerSoundUpsertOne{
+		create: csc,
+	}
+}
+
+type (
+	// CheerSoundUpsertOne is the builder for "upsert"-ing
+	//  one CheerSound node.
+	CheerSoundUpsertOne struct {
+		create *CheerSoundCreate
+	}
+
+	// CheerSoundUpsert is the "OnConflict" setter.
+	CheerSoundUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGuildID sets the "guild_id" field.
+func (u *CheerSoundUpsert) SetGuildID(v snowflake.ID) *CheerSoundUpsert {
+	u.Set(cheersound.FieldGuildID, v)
+	return u
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *CheerSoundUpsert) UpdateGuildID() *CheerSoundUpsert {
+	u.SetExcluded(cheersound.FieldGuildID)
+	return u
+}
+
+// SetTier sets the "tier" field.
+func (u *CheerSoundUpsert) SetTier(v int) *CheerSoundUpsert {
+	u.Set(cheersound.FieldTier, v)
+	return u
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *CheerSoundUpsert) UpdateTier() *CheerSoundUpsert {
+	u.SetExcluded(cheersound.FieldTier)
+	return u
+}
+
+// AddTier adds v to the "tier" field.
+func (u *CheerSoundUpsert) AddTier(v int) *CheerSoundUpsert {
+	u.Add(cheersound.FieldTier, v)
+	return u
+}
+
+// SetFileName sets the "file_name" field.
+func (u *CheerSoundUpsert) SetFileName(v string) *CheerSoundUpsert {
+	u.Set(cheersound.FieldFileName, v)
+	return u
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *CheerSoundUpsert) UpdateFileName() *CheerSoundUpsert {
+	u.SetExcluded(cheersound.FieldFileName)
+	return u
+}
+
+// SetContentType sets the "content_type" field.
+func (u *CheerSoundUpsert) SetContentType(v string) *CheerSoundUpsert {
+	u.Set(cheersound.FieldContentType, v)
+	return u
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *CheerSoundUpsert) UpdateContentType() *CheerSoundUpsert {
+	u.SetExcluded(cheersound.FieldContentType)
+	return u
+}
+
+// SetData sets the "data" field.
+func (u *CheerSoundUpsert) SetData(v []byte) *CheerSoundUpsert {
+	u.Set(cheersound.FieldData, v)
+	return u
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *CheerSoundUpsert) UpdateData() *CheerSoundUpsert {
+	u.SetExcluded(cheersound.FieldData)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CheerSoundUpsert) SetCreatedAt(v time.Time) *CheerSoundUpsert {
+	u.Set(cheersound.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CheerSoundUpsert) UpdateCreatedAt() *CheerSoundUpsert {
+	u.SetExcluded(cheersound.FieldCreatedAt)
+	return u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *CheerSoundUpsert) ClearCreatedAt() *CheerSoundUpsert {
+	u.SetNull(cheersound.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CheerSoundUpsert) SetUpdatedAt(v time.Time) *CheerSoundUpsert {
+	u.Set(cheersound.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CheerSoundUpsert) UpdateUpdatedAt() *CheerSoundUpsert {
+	u.SetExcluded(cheersound.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *CheerSoundUpsert) ClearUpdatedAt() *CheerSoundUpsert {
+	u.SetNull(cheersound.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.CheerSound.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CheerSoundUpsertOne) UpdateNewValues() *CheerSoundUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CheerSound.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CheerSoundUpsertOne) Ignore() *CheerSoundUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CheerSoundUpsertOne) DoNothing() *CheerSoundUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CheerSoundCreate.OnConflict
+// documentation for more info.
+func (u *CheerSoundUpsertOne) Update(set func(*CheerSoundUpsert)) *CheerSoundUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CheerSoundUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGuildID sets the "guild_id" field.
+func (u *CheerSoundUpsertOne) SetGuildID(v snowflake.ID) *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetGuildID(v)
+	})
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *CheerSoundUpsertOne) UpdateGuildID() *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateGuildID()
+	})
+}
+
+// SetTier sets the "tier" field.
+func (u *CheerSoundUpsertOne) SetTier(v int) *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetTier(v)
+	})
+}
+
+// AddTier adds v to the "tier" field.
+func (u *CheerSoundUpsertOne) AddTier(v int) *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.AddTier(v)
+	})
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *CheerSoundUpsertOne) UpdateTier() *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateTier()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *CheerSoundUpsertOne) SetFileName(v string) *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *CheerSoundUpsertOne) UpdateFileName() *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// SetContentType sets the "content_type" field.
+func (u *CheerSoundUpsertOne) SetContentType(v string) *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *CheerSoundUpsertOne) UpdateContentType() *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *CheerSoundUpsertOne) SetData(v []byte) *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *CheerSoundUpsertOne) UpdateData() *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateData()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CheerSoundUpsertOne) SetCreatedAt(v time.Time) *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CheerSoundUpsertOne) UpdateCreatedAt() *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *CheerSoundUpsertOne) ClearCreatedAt() *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CheerSoundUpsertOne) SetUpdatedAt(v time.Time) *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CheerSoundUpsertOne) UpdateUpdatedAt() *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *CheerSoundUpsertOne) ClearUpdatedAt() *CheerSoundUpsertOne {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CheerSoundUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CheerSoundCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CheerSoundUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CheerSoundUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CheerSoundUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CheerSoundCreateBulk is the builder for creating many CheerSound entities in bulk.
+type CheerSoundCreateBulk struct {
+	config
+	err      error
+	builders []*CheerSoundCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CheerSound entities in the database.
+func (cscb *CheerSoundCreateBulk) Save(ctx context.Context) ([]*CheerSound, error) {
+	if cscb.err != nil {
+		return nil, cscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cscb.builders))
+	nodes := make([]*CheerSound, len(cscb.builders))
+	mutators := make([]Mutator, len(cscb.builders))
+	for i := range cscb.builders {
+		func(i int, root context.Context) {
+			builder := cscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CheerSoundMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cscb *CheerSoundCreateBulk) SaveX(ctx context.Context) []*CheerSound {
+	v, err := cscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cscb *CheerSoundCreateBulk) Exec(ctx context.Context) error {
+	_, err := cscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cscb *CheerSoundCreateBulk) ExecX(ctx context.Context) {
+	if err := cscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CheerSound.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CheerSoundUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (cscb *CheerSoundCreateBulk) OnConflict(opts ...sql.ConflictOption) *CheerSoundUpsertBulk {
+	cscb.conflict = opts
+	return &CheerSoundUpsertBulk{
+		create: cscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CheerSound.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cscb *CheerSoundCreateBulk) OnConflictColumns(columns ...string) *CheerSoundUpsertBulk {
+	cscb.conflict = append(cscb.conflict, sql.ConflictColumns(columns...))
+	return &CheerSoundUpsertBulk{
+		create: cscb,
+	}
+}
+
+// CheerSoundUpsertBulk is the builder for "upsert"-ing
+// a bulk of CheerSound nodes.
+type CheerSoundUpsertBulk struct {
+	create *CheerSoundCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CheerSound.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CheerSoundUpsertBulk) UpdateNewValues() *CheerSoundUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CheerSound.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CheerSoundUpsertBulk) Ignore() *CheerSoundUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CheerSoundUpsertBulk) DoNothing() *CheerSoundUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CheerSoundCreateBulk.OnConflict
+// documentation for more info.
+func (u *CheerSoundUpsertBulk) Update(set func(*CheerSoundUpsert)) *CheerSoundUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CheerSoundUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGuildID sets the "guild_id" field.
+func (u *CheerSoundUpsertBulk) SetGuildID(v snowflake.ID) *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetGuildID(v)
+	})
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *CheerSoundUpsertBulk) UpdateGuildID() *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateGuildID()
+	})
+}
+
+// SetTier sets the "tier" field.
+func (u *CheerSoundUpsertBulk) SetTier(v int) *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetTier(v)
+	})
+}
+
+// AddTier adds v to the "tier" field.
+func (u *CheerSoundUpsertBulk) AddTier(v int) *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.AddTier(v)
+	})
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *CheerSoundUpsertBulk) UpdateTier() *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateTier()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *CheerSoundUpsertBulk) SetFileName(v string) *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *CheerSoundUpsertBulk) UpdateFileName() *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// SetContentType sets the "content_type" field.
+func (u *CheerSoundUpsertBulk) SetContentType(v string) *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *CheerSoundUpsertBulk) UpdateContentType() *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *CheerSoundUpsertBulk) SetData(v []byte) *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *CheerSoundUpsertBulk) UpdateData() *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateData()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CheerSoundUpsertBulk) SetCreatedAt(v time.Time) *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CheerSoundUpsertBulk) UpdateCreatedAt() *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *CheerSoundUpsertBulk) ClearCreatedAt() *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CheerSoundUpsertBulk) SetUpdatedAt(v time.Time) *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CheerSoundUpsertBulk) UpdateUpdatedAt() *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *CheerSoundUpsertBulk) ClearUpdatedAt() *CheerSoundUpsertBulk {
+	return u.Update(func(s *CheerSoundUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CheerSoundUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CheerSoundCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CheerSoundCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CheerSoundUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
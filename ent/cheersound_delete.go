@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/loukhin/probably-a-music-bot/ent/cheersound"
+	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+)
+
+// CheerSoundDelete is the builder for deleting a CheerSound entity.
+type CheerSoundDelete struct {
+	config
+	hooks    []Hook
+	mutation *CheerSoundMutation
+}
+
+// Where appends a list predicates to the CheerSoundDelete builder.
+func (csd *CheerSoundDelete) Where(ps ...predicate.CheerSound) *CheerSoundDelete {
+	csd.mutation.Where(ps...)
+	return csd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (csd *CheerSoundDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, csd.sqlExec, csd.mutation, csd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csd *CheerSoundDelete) ExecX(ctx context.Context) int {
+	n, err := csd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (csd *CheerSoundDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(cheersound.Table, sqlgraph.NewFieldSpec(cheersound.FieldID, field.TypeInt))
+	if ps := csd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, csd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	csd.mutation.done = true
+	return affected, err
+}
+
+// CheerSoundDeleteOne is the builder for deleting a single CheerSound entity.
+type CheerSoundDeleteOne struct {
+	csd *CheerSoundDelete
+}
+
+// Where appends a list predicates to the CheerSoundDelete builder.
+func (csdo *CheerSoundDeleteOne) Where(ps ...predicate.CheerSound) *CheerSoundDeleteOne {
+	csdo.csd.mutation.Where(ps...)
+	return csdo
+}
+
+// Exec executes the deletion query.
+func (csdo *CheerSoundDeleteOne) Exec(ctx context.Context) error {
+	n, err := csdo.csd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{cheersound.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csdo *CheerSoundDeleteOne) ExecX(ctx context.Context) {
+	if err := csdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,607 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/cheersound"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+)
+
+// CheerSoundQuery is the builder for querying CheerSound entities.
+type CheerSoundQuery struct {
+	config
+	ctx        *QueryContext
+	order      []cheersound.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CheerSound
+	withGuild  *GuildQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CheerSoundQuery builder.
+func (csq *CheerSoundQuery) Where(ps ...predicate.CheerSound) *CheerSoundQuery {
+	csq.predicates = append(csq.predicates, ps...)
+	return csq
+}
+
+// Limit the number of records to be returned by this query.
+func (csq *CheerSoundQuery) Limit(limit int) *CheerSoundQuery {
+	csq.ctx.Limit = &limit
+	return csq
+}
+
+// Offset to start from.
+func (csq *CheerSoundQuery) Offset(offset int) *CheerSoundQuery {
+	csq.ctx.Offset = &offset
+	return csq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (csq *CheerSoundQuery) Unique(unique bool) *CheerSoundQuery {
+	csq.ctx.Unique = &unique
+	return csq
+}
+
+// Order specifies how the records should be ordered.
+func (csq *CheerSoundQuery) Order(o ...cheersound.OrderOption) *CheerSoundQuery {
+	csq.order = append(csq.order, o...)
+	return csq
+}
+
+// QueryGuild chains the current query on the "guild" edge.
+func (csq *CheerSoundQuery) QueryGuild() *GuildQuery {
+	query := (&GuildClient{config: csq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := csq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := csq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(cheersound.Table, cheersound.FieldID, selector),
+			sqlgraph.To(guild.Table, guild.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, cheersound.GuildTable, cheersound.GuildColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(csq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CheerSound entity from the query.
+// Returns a *NotFoundError when no CheerSound was found.
+func (csq *CheerSoundQuery) First(ctx context.Context) (*CheerSound, error) {
+	nodes, err := csq.Limit(1).All(setContextOp(ctx, csq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{cheersound.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (csq *CheerSoundQuery) FirstX(ctx context.Context) *CheerSound {
+	node, err := csq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CheerSound ID from the query.
+// Returns a *NotFoundError when no CheerSound ID was found.
+func (csq *CheerSoundQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = csq.Limit(1).IDs(setContextOp(ctx, csq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{cheersound.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (csq *CheerSoundQuery) FirstIDX(ctx context.Context) int {
+	id, err := csq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CheerSound entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CheerSound entity is found.
+// Returns a *NotFoundError when no CheerSound entities are found.
+func (csq *CheerSoundQuery) Only(ctx context.Context) (*CheerSound, error) {
+	nodes, err := csq.Limit(2).All(setContextOp(ctx, csq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{cheersound.Label}
+	default:
+		return nil, &NotSingularError{cheersound.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (csq *CheerSoundQuery) OnlyX(ctx context.Context) *CheerSound {
+	node, err := csq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CheerSound ID in the query.
+// Returns a *NotSingularError when more than one CheerSound ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (csq *CheerSoundQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = csq.Limit(2).IDs(setContextOp(ctx, csq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{cheersound.Label}
+	default:
+		err = &NotSingularError{cheersound.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (csq *CheerSoundQuery) OnlyIDX(ctx context.Context) int {
+	id, err := csq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CheerSounds.
+func (csq *CheerSoundQuery) All(ctx context.Context) ([]*CheerSound, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryAll)
+	if err := csq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CheerSound, *CheerSoundQuery]()
+	return withInterceptors[[]*CheerSound](ctx, csq, qr, csq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (csq *CheerSoundQuery) AllX(ctx context.Context) []*CheerSound {
+	nodes, err := csq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CheerSound IDs.
+func (csq *CheerSoundQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if csq.ctx.Unique == nil && csq.path != nil {
+		csq.Unique(true)
+	}
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryIDs)
+	if err = csq.Select(cheersound.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (csq *CheerSoundQuery) IDsX(ctx context.Context) []int {
+	ids, err := csq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (csq *CheerSoundQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryCount)
+	if err := csq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, csq, querierCount[*CheerSoundQuery](), csq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (csq *CheerSoundQuery) CountX(ctx context.Context) int {
+	count, err := csq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (csq *CheerSoundQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryExist)
+	switch _, err := csq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (csq *CheerSoundQuery) ExistX(ctx context.Context) bool {
+	exist, err := csq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CheerSoundQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (csq *CheerSoundQuery) Clone() *CheerSoundQuery {
+	if csq == nil {
+		return nil
+	}
+	return &CheerSoundQuery{
+		config:     csq.config,
+		ctx:        csq.ctx.Clone(),
+		order:      append([]cheersound.OrderOption{}, csq.order...),
+		inters:     append([]Interceptor{}, csq.inters...),
+		predicates: append([]predicate.CheerSound{}, csq.predicates...),
+		withGuild:  csq.withGuild.Clone(),
+		// clone intermediate query.
+		sql:  csq.sql.Clone(),
+		path: csq.path,
+	}
+}
+
+// WithGuild tells the query-builder to eager-load the nodes that are connected to
+// the "guild" edge. The optional arguments are used to configure the query builder of the edge.
+func (csq *CheerSoundQuery) WithGuild(opts ...func(*GuildQuery)) *CheerSoundQuery {
+	query := (&GuildClient{config: csq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	csq.withGuild = query
+	return csq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID snowflake.ID `json:"guild_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CheerSound.Query().
+//		GroupBy(cheersound.FieldGuildID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (csq *CheerSoundQuery) GroupBy(field string, fields ...string) *CheerSoundGroupBy {
+	csq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CheerSoundGroupBy{build: csq}
+	grbuild.flds = &csq.ctx.Fields
+	grbuild.label = cheersound.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID snowflake.ID `json:"guild_id,omitempty"`
+//	}
+//
+//	client.CheerSound.Query().
+//		Select(cheersound.FieldGuildID).
+//		Scan(ctx, &v)
+func (csq *CheerSoundQuery) Select(fields ...string) *CheerSoundSelect {
+	csq.ctx.Fields = append(csq.ctx.Fields, fields...)
+	sbuild := &CheerSoundSelect{CheerSoundQuery: csq}
+	sbuild.label = cheersound.Label
+	sbuild.flds, sbuild.scan = &csq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CheerSoundSelect configured with the given aggregations.
+func (csq *CheerSoundQuery) Aggregate(fns ...AggregateFunc) *CheerSoundSelect {
+	return csq.Select().Aggregate(fns...)
+}
+
+func (csq *CheerSoundQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range csq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, csq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range csq.ctx.Fields {
+		if !cheersound.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if csq.path != nil {
+		prev, err := csq.path(ctx)
+		if err != nil {
+			return err
+		}
+		csq.sql = prev
+	}
+	return nil
+}
+
+func (csq *CheerSoundQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CheerSound, error) {
+	var (
+		nodes       = []*CheerSound{}
+		_spec       = csq.querySpec()
+		loadedTypes = [1]bool{
+			csq.withGuild != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CheerSound).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CheerSound{config: csq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, csq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := csq.withGuild; query != nil {
+		if err := csq.loadGuild(ctx, query, nodes, nil,
+			func(n *CheerSound, e *Guild) { n.Edges.Guild = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (csq *CheerSoundQuery) loadGuild(ctx context.Context, query *GuildQuery, nodes []*CheerSound, init func(*CheerSound), assign func(*CheerSound, *Guild)) error {
+	ids := make([]snowflake.ID, 0, len(nodes))
+	nodeids := make(map[snowflake.ID][]*CheerSound)
+	for i := range nodes {
+		fk := nodes[i].GuildID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(guild.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "guild_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (csq *CheerSoundQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := csq.querySpec()
+	_spec.Node.Columns = csq.ctx.Fields
+	if len(csq.ctx.Fields) > 0 {
+		_spec.Unique = csq.ctx.Unique != nil && *csq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, csq.driver, _spec)
+}
+
+func (csq *CheerSoundQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(cheersound.Table, cheersound.Columns, sqlgraph.NewFieldSpec(cheersound.FieldID, field.TypeInt))
+	_spec.From = csq.sql
+	if unique := csq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if csq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := csq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cheersound.FieldID)
+		for i := range fields {
+			if fields[i] != cheersound.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if csq.withGuild != nil {
+			_spec.Node.AddColumnOnce(cheersound.FieldGuildID)
+		}
+	}
+	if ps := csq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := csq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (csq *CheerSoundQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(csq.driver.Dialect())
+	t1 := builder.Table(cheersound.Table)
+	columns := csq.ctx.Fields
+	if len(columns) == 0 {
+		columns = cheersound.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if csq.sql != nil {
+		selector = csq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if csq.ctx.Unique != nil && *csq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range csq.predicates {
+		p(selector)
+	}
+	for _, p := range csq.order {
+		p(selector)
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CheerSoundGroupBy is the group-by builder for CheerSound entities.
+type CheerSoundGroupBy struct {
+	selector
+	build *CheerSoundQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (csgb *CheerSoundGroupBy) Aggregate(fns ...AggregateFunc) *CheerSoundGroupBy {
+	csgb.fns = append(csgb.fns, fns...)
+	return csgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (csgb *CheerSoundGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, csgb.build.ctx, ent.OpQueryGroupBy)
+	if err := csgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CheerSoundQuery, *CheerSoundGroupBy](ctx, csgb.build, csgb, csgb.build.inters, v)
+}
+
+func (csgb *CheerSoundGroupBy) sqlScan(ctx context.Context, root *CheerSoundQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(csgb.fns))
+	for _, fn := range csgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*csgb.flds)+len(csgb.fns))
+		for _, f := range *csgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*csgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := csgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CheerSoundSelect is the builder for selecting fields of CheerSound entities.
+type CheerSoundSelect struct {
+	*CheerSoundQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (css *CheerSoundSelect) Aggregate(fns ...AggregateFunc) *CheerSoundSelect {
+	css.fns = append(css.fns, fns...)
+	return css
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (css *CheerSoundSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, css.ctx, ent.OpQuerySelect)
+	if err := css.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CheerSoundQuery, *CheerSoundSelect](ctx, css.CheerSoundQuery, css, css.inters, v)
+}
+
+func (css *CheerSoundSelect) sqlScan(ctx context.Context, root *CheerSoundQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(css.fns))
+	for _, fn := range css.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*css.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := css.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
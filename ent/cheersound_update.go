@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/cheersound"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+)
+
+// CheerSoundUpdate is the builder for updating CheerSound entities.
+type CheerSoundUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CheerSoundMutation
+}
+
+// Where appends a list predicates to the CheerSoundUpdate builder.
+func (csu *CheerSoundUpdate) Where(ps ...predicate.CheerSound) *CheerSoundUpdate {
+	csu.mutation.Where(ps...)
+	return csu
+}
+
+// SetGuildID sets the "guild_id" field.
+func (csu *CheerSoundUpdate) SetGuildID(s snowflake.ID) *CheerSoundUpdate {
+	csu.mutation.SetGuildID(s)
+	return csu
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (csu *CheerSoundUpdate) SetNillableGuildID(s *snowflake.ID) *CheerSoundUpdate {
+	if s != nil {
+		csu.SetGuildID(*s)
+	}
+	return csu
+}
+
+// SetTier sets the "tier" field.
+func (csu *CheerSoundUpdate) SetTier(i int) *CheerSoundUpdate {
+	csu.mutation.ResetTier()
+	csu.mutation.SetTier(i)
+	return csu
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (csu *CheerSoundUpdate) SetNillableTier(i *int) *CheerSoundUpdate {
+	if i != nil {
+		csu.SetTier(*i)
+	}
+	return csu
+}
+
+// AddTier adds i to the "tier" field.
+func (csu *CheerSoundUpdate) AddTier(i int) *CheerSoundUpdate {
+	csu.mutation.AddTier(i)
+	return csu
+}
+
+// SetFileName sets the "file_name" field.
+func (csu *CheerSoundUpdate) SetFileName(s string) *CheerSoundUpdate {
+	csu.mutation.SetFileName(s)
+	return csu
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (csu *CheerSoundUpdate) SetNillableFileName(s *string) *CheerSoundUpdate {
+	if s != nil {
+		csu.SetFileName(*s)
+	}
+	return csu
+}
+
+// SetContentType sets the "content_type" field.
+func (csu *CheerSoundUpdate) SetContentType(s string) *CheerSoundUpdate {
+	csu.mutation.SetContentType(s)
+	return csu
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (csu *CheerSoundUpdate) SetNillableContentType(s *string) *CheerSoundUpdate {
+	if s != nil {
+		csu.SetContentType(*s)
+	}
+	return csu
+}
+
+// SetData sets the "data" field.
+func (csu *CheerSoundUpdate) SetData(b []byte) *CheerSoundUpdate {
+	csu.mutation.SetData(b)
+	return csu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csu *CheerSoundUpdate) SetCreatedAt(t time.Time) *CheerSoundUpdate {
+	csu.mutation.SetCreatedAt(t)
+	return csu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csu *CheerSoundUpdate) SetNillableCreatedAt(t *time.Time) *CheerSoundUpdate {
+	if t != nil {
+		csu.SetCreatedAt(*t)
+	}
+	return csu
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (csu *CheerSoundUpdate) ClearCreatedAt() *CheerSoundUpdate {
+	csu.mutation.ClearCreatedAt()
+	return csu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (csu *CheerSoundUpdate) SetUpdatedAt(t time.Time) *CheerSoundUpdate {
+	csu.mutation.SetUpdatedAt(t)
+	return csu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (csu *CheerSoundUpdate) ClearUpdatedAt() *CheerSoundUpdate {
+	csu.mutation.ClearUpdatedAt()
+	return csu
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (csu *CheerSoundUpdate) SetGuild(g *Guild) *CheerSoundUpdate {
+	return csu.SetGuildID(g.ID)
+}
+
+// Mutation returns the CheerSoundMutation object of the builder.
+func (csu *CheerSoundUpdate) Mutation() *CheerSoundMutation {
+	return csu.mutation
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (csu *CheerSoundUpdate) ClearGuild() *CheerSoundUpdate {
+	csu.mutation.ClearGuild()
+	return csu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (csu *CheerSoundUpdate) Save(ctx context.Context) (int, error) {
+	csu.defaults()
+	return withHooks(ctx, csu.sqlSave, csu.mutation, csu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csu *CheerSoundUpdate) SaveX(ctx context.Context) int {
+	affected, err := csu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (csu *CheerSoundUpdate) Exec(ctx context.Context) error {
+	_, err := csu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csu *CheerSoundUpdate) ExecX(ctx context.Context) {
+	if err := csu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csu *CheerSoundUpdate) defaults() {
+	if _, ok := csu.mutation.UpdatedAt(); !ok && !csu.mutation.UpdatedAtCleared() {
+		v := cheersound.UpdateDefaultUpdatedAt()
+		csu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csu *CheerSoundUpdate) check() error {
+	if v, ok := csu.mutation.Tier(); ok {
+		if err := cheersound.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`ent: validator failed for field "CheerSound.tier": %w`, err)}
+		}
+	}
+	if v, ok := csu.mutation.Data(); ok {
+		if err := cheersound.DataValidator(v); err != nil {
+			return &ValidationError{Name: "data", err: fmt.Errorf(`ent: validator failed for field "CheerSound.data": %w`, err)}
+		}
+	}
+	if csu.mutation.GuildCleared() && len(csu.mutation.GuildIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CheerSound.guild"`)
+	}
+	return nil
+}
+
+func (csu *CheerSoundUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := csu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cheersound.Table, cheersound.Columns, sqlgraph.NewFieldSpec(cheersound.FieldID, field.TypeInt))
+	if ps := csu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csu.mutation.Tier(); ok {
+		_spec.SetField(cheersound.FieldTier, field.TypeInt, value)
+	}
+	if value, ok := csu.mutation.AddedTier(); ok {
+		_spec.AddField(cheersound.FieldTier, field.TypeInt, value)
+	}
+	if value, ok := csu.mutation.FileName(); ok {
+		_spec.SetField(cheersound.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := csu.mutation.ContentType(); ok {
+		_spec.SetField(cheersound.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := csu.mutation.Data(); ok {
+		_spec.SetField(cheersound.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := csu.mutation.CreatedAt(); ok {
+		_spec.SetField(cheersound.FieldCreatedAt, field.TypeTime, value)
+	}
+	if csu.mutation.CreatedAtCleared() {
+		_spec.ClearField(cheersound.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := csu.mutation.UpdatedAt(); ok {
+		_spec.SetField(cheersound.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if csu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(cheersound.FieldUpdatedAt, field.TypeTime)
+	}
+	if csu.mutation.GuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cheersound.GuildTable,
+			Columns: []string{cheersound.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csu.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cheersound.GuildTable,
+			Columns: []string{cheersound.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, csu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cheersound.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	csu.mutation.done = true
+	return n, nil
+}
+
+// CheerSoundUpdateOne is the builder for updating a single CheerSound entity.
+type CheerSoundUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CheerSoundMutation
+}
+
+// SetGuildID sets the "guild_id" field.
+func (csuo *CheerSoundUpdateOne) SetGuildID(s snowflake.ID) *CheerSoundUpdateOne {
+	csuo.mutation.SetGuildID(s)
+	return csuo
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (csuo *CheerSoundUpdateOne) SetNillableGuildID(s *snowflake.ID) *CheerSoundUpdateOne {
+	if s != nil {
+		csuo.SetGuildID(*s)
+	}
+	return csuo
+}
+
+// SetTier sets the "tier" field.
+func (csuo *CheerSoundUpdateOne) SetTier(i int) *CheerSoundUpdateOne {
+	csuo.mutation.ResetTier()
+	csuo.mutation.SetTier(i)
+	return csuo
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (csuo *CheerSoundUpdateOne) SetNillableTier(i *int) *CheerSoundUpdateOne {
+	if i != nil {
+		csuo.SetTier(*i)
+	}
+	return csuo
+}
+
+// AddTier adds i to the "tier" field.
+func (csuo *CheerSoundUpdateOne) AddTier(i int) *CheerSoundUpdateOne {
+	csuo.mutation.AddTier(i)
+	return csuo
+}
+
+// SetFileName sets the "file_name" field.
+func (csuo *CheerSoundUpdateOne) SetFileName(s string) *CheerSoundUpdateOne {
+	csuo.mutation.SetFileName(s)
+	return csuo
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (csuo *CheerSoundUpdateOne) SetNillableFileName(s *string) *CheerSoundUpdateOne {
+	if s != nil {
+		csuo.SetFileName(*s)
+	}
+	return csuo
+}
+
+// SetContentType sets the "content_type" field.
+func (csuo *CheerSoundUpdateOne) SetContentType(s string) *CheerSoundUpdateOne {
+	csuo.mutation.SetContentType(s)
+	return csuo
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (csuo *CheerSoundUpdateOne) SetNillableContentType(s *string) *CheerSoundUpdateOne {
+	if s != nil {
+		csuo.SetContentType(*s)
+	}
+	return csuo
+}
+
+// SetData sets the "data" field.
+func (csuo *CheerSoundUpdateOne) SetData(b []byte) *CheerSoundUpdateOne {
+	csuo.mutation.SetData(b)
+	return csuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csuo *CheerSoundUpdateOne) SetCreatedAt(t time.Time) *CheerSoundUpdateOne {
+	csuo.mutation.SetCreatedAt(t)
+	return csuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csuo *CheerSoundUpdateOne) SetNillableCreatedAt(t *time.Time) *CheerSoundUpdateOne {
+	if t != nil {
+		csuo.SetCreatedAt(*t)
+	}
+	return csuo
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (csuo *CheerSoundUpdateOne) ClearCreatedAt() *CheerSoundUpdateOne {
+	csuo.mutation.ClearCreatedAt()
+	return csuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (csuo *CheerSoundUpdateOne) SetUpdatedAt(t time.Time) *CheerSoundUpdateOne {
+	csuo.mutation.SetUpdatedAt(t)
+	return csuo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (csuo *CheerSoundUpdateOne) ClearUpdatedAt() *CheerSoundUpdateOne {
+	csuo.mutation.ClearUpdatedAt()
+	return csuo
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (csuo *CheerSoundUpdateOne) SetGuild(g *Guild) *CheerSoundUpdateOne {
+	return csuo.SetGuildID(g.ID)
+}
+
+// Mutation returns the CheerSoundMutation object of the builder.
+func (csuo *CheerSoundUpdateOne) Mutation() *CheerSoundMutation {
+	return csuo.mutation
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (csuo *CheerSoundUpdateOne) ClearGuild() *CheerSoundUpdateOne {
+	csuo.mutation.ClearGuild()
+	return csuo
+}
+
+// Where appends a list predicates to the CheerSoundUpdate builder.
+func (csuo *CheerSoundUpdateOne) Where(ps ...predicate.CheerSound) *CheerSoundUpdateOne {
+	csuo.mutation.Where(ps...)
+	return csuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (csuo *CheerSoundUpdateOne) Select(field string, fields ...string) *CheerSoundUpdateOne {
+	csuo.fields = append([]string{field}, fields...)
+	return csuo
+}
+
+// Save executes the query and returns the updated CheerSound entity.
+func (csuo *CheerSoundUpdateOne) Save(ctx context.Context) (*CheerSound, error) {
+	csuo.defaults()
+	return withHooks(ctx, csuo.sqlSave, csuo.mutation, csuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csuo *CheerSoundUpdateOne) SaveX(ctx context.Context) *CheerSound {
+	node, err := csuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (csuo *CheerSoundUpdateOne) Exec(ctx context.Context) error {
+	_, err := csuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csuo *CheerSoundUpdateOne) ExecX(ctx context.Context) {
+	if err := csuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csuo *CheerSoundUpdateOne) defaults() {
+	if _, ok := csuo.mutation.UpdatedAt(); !ok && !csuo.mutation.UpdatedAtCleared() {
+		v := cheersound.UpdateDefaultUpdatedAt()
+		csuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csuo *CheerSoundUpdateOne) check() error {
+	if v, ok := csuo.mutation.Tier(); ok {
+		if err := cheersound.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`ent: validator failed for field "CheerSound.tier": %w`, err)}
+		}
+	}
+	if v, ok := csuo.mutation.Data(); ok {
+		if err := cheersound.DataValidator(v); err != nil {
+			return &ValidationError{Name: "data", err: fmt.Errorf(`ent: validator failed for field "CheerSound.data": %w`, err)}
+		}
+	}
+	if csuo.mutation.GuildCleared() && len(csuo.mutation.GuildIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CheerSound.guild"`)
+	}
+	return nil
+}
+
+func (csuo *CheerSoundUpdateOne) sqlSave(ctx context.Context) (_node *CheerSound, err error) {
+	if err := csuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cheersound.Table, cheersound.Columns, sqlgraph.NewFieldSpec(cheersound.FieldID, field.TypeInt))
+	id, ok := csuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CheerSound.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := csuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cheersound.FieldID)
+		for _, f := range fields {
+			if !cheersound.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cheersound.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := csuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csuo.mutation.Tier(); ok {
+		_spec.SetField(cheersound.FieldTier, field.TypeInt, value)
+	}
+	if value, ok := csuo.mutation.AddedTier(); ok {
+		_spec.AddField(cheersound.FieldTier, field.TypeInt, value)
+	}
+	if value, ok := csuo.mutation.FileName(); ok {
+		_spec.SetField(cheersound.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := csuo.mutation.ContentType(); ok {
+		_spec.SetField(cheersound.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := csuo.mutation.Data(); ok {
+		_spec.SetField(cheersound.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := csuo.mutation.CreatedAt(); ok {
+		_spec.SetField(cheersound.FieldCreatedAt, field.TypeTime, value)
+	}
+	if csuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(cheersound.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := csuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(cheersound.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if csuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(cheersound.FieldUpdatedAt, field.TypeTime)
+	}
+	if csuo.mutation.GuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cheersound.GuildTable,
+			Columns: []string{cheersound.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csuo.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cheersound.GuildTable,
+			Columns: []string{cheersound.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CheerSound{config: csuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, csuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cheersound.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	csuo.mutation.done = true
+	return _node, nil
+}
@@ -1,8 +1,16 @@
 package ent
 
-import "entgo.io/ent/dialect/sql"
+import (
+	"context"
+	stdsql "database/sql"
+)
 
 func (c *Client) Ping() error {
-	db := c.driver.(*sql.Driver).DB()
-	return db.Ping()
+	return c.PingContext(context.Background())
+}
+
+func (c *Client) PingContext(ctx context.Context) error {
+	// the driver may be wrapped, e.g. to time the queries
+	db := c.driver.(interface{ DB() *stdsql.DB }).DB()
+	return db.PingContext(ctx)
 }
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	snowflake "github.com/disgoorg/snowflake/v2"
 	"github.com/loukhin/probably-a-music-bot/ent/guild"
@@ -23,10 +24,119 @@ type Guild struct {
 	PlayerChannelID *snowflake.ID `json:"player_channel_id,omitempty"`
 	// PlayerMessageID holds the value of the "player_message_id" field.
 	PlayerMessageID *snowflake.ID `json:"player_message_id,omitempty"`
+	// Minutes to stay connected after the queue ended
+	IdleTimeout int `json:"idle_timeout,omitempty"`
+	// Minutes to stay paused when everyone left the voice channel
+	AloneTimeout int `json:"alone_timeout,omitempty"`
+	// Autoplay holds the value of the "autoplay" field.
+	Autoplay bool `json:"autoplay,omitempty"`
+	// AlwaysOn holds the value of the "always_on" field.
+	AlwaysOn bool `json:"always_on,omitempty"`
+	// AlwaysOnChannelID holds the value of the "always_on_channel_id" field.
+	AlwaysOnChannelID *snowflake.ID `json:"always_on_channel_id,omitempty"`
+	// FallbackQuery holds the value of the "fallback_query" field.
+	FallbackQuery string `json:"fallback_query,omitempty"`
+	// TtsProvider holds the value of the "tts_provider" field.
+	TtsProvider string `json:"tts_provider,omitempty"`
+	// TtsLanguage holds the value of the "tts_language" field.
+	TtsLanguage string `json:"tts_language,omitempty"`
+	// TtsVoice holds the value of the "tts_voice" field.
+	TtsVoice string `json:"tts_voice,omitempty"`
+	// TtsRate holds the value of the "tts_rate" field.
+	TtsRate float64 `json:"tts_rate,omitempty"`
+	// TtsPitch holds the value of the "tts_pitch" field.
+	TtsPitch float64 `json:"tts_pitch,omitempty"`
+	// Channel whose messages are read aloud
+	TtsReaderChannelID *snowflake.ID `json:"tts_reader_channel_id,omitempty"`
+	// OverlayToken holds the value of the "overlay_token" field.
+	OverlayToken *string `json:"-"`
+	// APIToken holds the value of the "api_token" field.
+	APIToken *string `json:"-"`
+	// SleepAt holds the value of the "sleep_at" field.
+	SleepAt *time.Time `json:"sleep_at,omitempty"`
+	// SleepEndOfTrack holds the value of the "sleep_end_of_track" field.
+	SleepEndOfTrack bool `json:"sleep_end_of_track,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GuildQuery when eager-loading is set.
+	Edges        GuildEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GuildEdges holds the relations/edges for other nodes in the graph.
+type GuildEdges struct {
+	// Members holds the value of the members edge.
+	Members []*Member `json:"members,omitempty"`
+	// Pronunciations holds the value of the pronunciations edge.
+	Pronunciations []*Pronunciation `json:"pronunciations,omitempty"`
+	// CheerSounds holds the value of the cheer_sounds edge.
+	CheerSounds []*CheerSound `json:"cheer_sounds,omitempty"`
+	// WebhookSubscriptions holds the value of the webhook_subscriptions edge.
+	WebhookSubscriptions []*WebhookSubscription `json:"webhook_subscriptions,omitempty"`
+	// TrackPlays holds the value of the track_plays edge.
+	TrackPlays []*TrackPlay `json:"track_plays,omitempty"`
+	// Playlists holds the value of the playlists edge.
+	Playlists []*Playlist `json:"playlists,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [6]bool
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e GuildEdges) MembersOrErr() ([]*Member, error) {
+	if e.loadedTypes[0] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// PronunciationsOrErr returns the Pronunciations value or an error if the edge
+// was not loaded in eager-loading.
+func (e GuildEdges) PronunciationsOrErr() ([]*Pronunciation, error) {
+	if e.loadedTypes[1] {
+		return e.Pronunciations, nil
+	}
+	return nil, &NotLoadedError{edge: "pronunciations"}
+}
+
+// CheerSoundsOrErr returns the CheerSounds value or an error if the edge
+// was not loaded in eager-loading.
+func (e GuildEdges) CheerSoundsOrErr() ([]*CheerSound, error) {
+	if e.loadedTypes[2] {
+		return e.CheerSounds, nil
+	}
+	return nil, &NotLoadedError{edge: "cheer_sounds"}
+}
+
+// WebhookSubscriptionsOrErr returns the WebhookSubscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e GuildEdges) WebhookSubscriptionsOrErr() ([]*WebhookSubscription, error) {
+	if e.loadedTypes[3] {
+		return e.WebhookSubscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook_subscriptions"}
+}
+
+// TrackPlaysOrErr returns the TrackPlays value or an error if the edge
+// was not loaded in eager-loading.
+func (e GuildEdges) TrackPlaysOrErr() ([]*TrackPlay, error) {
+	if e.loadedTypes[4] {
+		return e.TrackPlays, nil
+	}
+	return nil, &NotLoadedError{edge: "track_plays"}
+}
+
+// PlaylistsOrErr returns the Playlists value or an error if the edge
+// was not loaded in eager-loading.
+func (e GuildEdges) PlaylistsOrErr() ([]*Playlist, error) {
+	if e.loadedTypes[5] {
+		return e.Playlists, nil
+	}
+	return nil, &NotLoadedError{edge: "playlists"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -34,14 +144,18 @@ func (*Guild) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case guild.FieldID, guild.FieldPlayerChannelID, guild.FieldPlayerMessageID:
+		case guild.FieldAutoplay, guild.FieldAlwaysOn, guild.FieldSleepEndOfTrack:
+			values[i] = new(sql.NullBool)
+		case guild.FieldTtsRate, guild.FieldTtsPitch:
+			values[i] = new(sql.NullFloat64)
+		case guild.FieldID, guild.FieldPlayerChannelID, guild.FieldPlayerMessageID, guild.FieldIdleTimeout, guild.FieldAloneTimeout, guild.FieldAlwaysOnChannelID, guild.FieldTtsReaderChannelID:
 			values[i] = new(sql.NullInt64)
-		case guild.FieldName:
+		case guild.FieldName, guild.FieldFallbackQuery, guild.FieldTtsProvider, guild.FieldTtsLanguage, guild.FieldTtsVoice, guild.FieldOverlayToken, guild.FieldAPIToken:
 			values[i] = new(sql.NullString)
-		case guild.FieldCreatedAt, guild.FieldUpdatedAt:
+		case guild.FieldSleepAt, guild.FieldCreatedAt, guild.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
-			return nil, fmt.Errorf("unexpected column %q for type Guild", columns[i])
+			values[i] = new(sql.UnknownType)
 		}
 	}
 	return values, nil
@@ -81,6 +195,107 @@ func (gu *Guild) assignValues(columns []string, values []any) error {
 				gu.PlayerMessageID = new(snowflake.ID)
 				*gu.PlayerMessageID = snowflake.ID(value.Int64)
 			}
+		case guild.FieldIdleTimeout:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field idle_timeout", values[i])
+			} else if value.Valid {
+				gu.IdleTimeout = int(value.Int64)
+			}
+		case guild.FieldAloneTimeout:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field alone_timeout", values[i])
+			} else if value.Valid {
+				gu.AloneTimeout = int(value.Int64)
+			}
+		case guild.FieldAutoplay:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field autoplay", values[i])
+			} else if value.Valid {
+				gu.Autoplay = value.Bool
+			}
+		case guild.FieldAlwaysOn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field always_on", values[i])
+			} else if value.Valid {
+				gu.AlwaysOn = value.Bool
+			}
+		case guild.FieldAlwaysOnChannelID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field always_on_channel_id", values[i])
+			} else if value.Valid {
+				gu.AlwaysOnChannelID = new(snowflake.ID)
+				*gu.AlwaysOnChannelID = snowflake.ID(value.Int64)
+			}
+		case guild.FieldFallbackQuery:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fallback_query", values[i])
+			} else if value.Valid {
+				gu.FallbackQuery = value.String
+			}
+		case guild.FieldTtsProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tts_provider", values[i])
+			} else if value.Valid {
+				gu.TtsProvider = value.String
+			}
+		case guild.FieldTtsLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tts_language", values[i])
+			} else if value.Valid {
+				gu.TtsLanguage = value.String
+			}
+		case guild.FieldTtsVoice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tts_voice", values[i])
+			} else if value.Valid {
+				gu.TtsVoice = value.String
+			}
+		case guild.FieldTtsRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tts_rate", values[i])
+			} else if value.Valid {
+				gu.TtsRate = value.Float64
+			}
+		case guild.FieldTtsPitch:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tts_pitch", values[i])
+			} else if value.Valid {
+				gu.TtsPitch = value.Float64
+			}
+		case guild.FieldTtsReaderChannelID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tts_reader_channel_id", values[i])
+			} else if value.Valid {
+				gu.TtsReaderChannelID = new(snowflake.ID)
+				*gu.TtsReaderChannelID = snowflake.ID(value.Int64)
+			}
+		case guild.FieldOverlayToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field overlay_token", values[i])
+			} else if value.Valid {
+				gu.OverlayToken = new(string)
+				*gu.OverlayToken = value.String
+			}
+		case guild.FieldAPIToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_token", values[i])
+			} else if value.Valid {
+				gu.APIToken = new(string)
+				*gu.APIToken = value.String
+			}
+		case guild.FieldSleepAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sleep_at", values[i])
+			} else if value.Valid {
+				gu.SleepAt = new(time.Time)
+				*gu.SleepAt = value.Time
+			}
+		case guild.FieldSleepEndOfTrack:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sleep_end_of_track", values[i])
+			} else if value.Valid {
+				gu.SleepEndOfTrack = value.Bool
+			}
 		case guild.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -93,11 +308,49 @@ func (gu *Guild) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				gu.UpdatedAt = value.Time
 			}
+		default:
+			gu.selectValues.Set(columns[i], values[i])
 		}
 	}
 	return nil
 }
 
+// Value returns the ent.Value that was dynamically selected and assigned to the Guild.
+// This includes values selected through modifiers, order, etc.
+func (gu *Guild) Value(name string) (ent.Value, error) {
+	return gu.selectValues.Get(name)
+}
+
+// QueryMembers queries the "members" edge of the Guild entity.
+func (gu *Guild) QueryMembers() *MemberQuery {
+	return NewGuildClient(gu.config).QueryMembers(gu)
+}
+
+// QueryPronunciations queries the "pronunciations" edge of the Guild entity.
+func (gu *Guild) QueryPronunciations() *PronunciationQuery {
+	return NewGuildClient(gu.config).QueryPronunciations(gu)
+}
+
+// QueryCheerSounds queries the "cheer_sounds" edge of the Guild entity.
+func (gu *Guild) QueryCheerSounds() *CheerSoundQuery {
+	return NewGuildClient(gu.config).QueryCheerSounds(gu)
+}
+
+// QueryWebhookSubscriptions queries the "webhook_subscriptions" edge of the Guild entity.
+func (gu *Guild) QueryWebhookSubscriptions() *WebhookSubscriptionQuery {
+	return NewGuildClient(gu.config).QueryWebhookSubscriptions(gu)
+}
+
+// QueryTrackPlays queries the "track_plays" edge of the Guild entity.
+func (gu *Guild) QueryTrackPlays() *TrackPlayQuery {
+	return NewGuildClient(gu.config).QueryTrackPlays(gu)
+}
+
+// QueryPlaylists queries the "playlists" edge of the Guild entity.
+func (gu *Guild) QueryPlaylists() *PlaylistQuery {
+	return NewGuildClient(gu.config).QueryPlaylists(gu)
+}
+
 // Update returns a builder for updating this Guild.
 // Note that you need to call Guild.Unwrap() before calling this method if this Guild
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -134,6 +387,58 @@ func (gu *Guild) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("idle_timeout=")
+	builder.WriteString(fmt.Sprintf("%v", gu.IdleTimeout))
+	builder.WriteString(", ")
+	builder.WriteString("alone_timeout=")
+	builder.WriteString(fmt.Sprintf("%v", gu.AloneTimeout))
+	builder.WriteString(", ")
+	builder.WriteString("autoplay=")
+	builder.WriteString(fmt.Sprintf("%v", gu.Autoplay))
+	builder.WriteString(", ")
+	builder.WriteString("always_on=")
+	builder.WriteString(fmt.Sprintf("%v", gu.AlwaysOn))
+	builder.WriteString(", ")
+	if v := gu.AlwaysOnChannelID; v != nil {
+		builder.WriteString("always_on_channel_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("fallback_query=")
+	builder.WriteString(gu.FallbackQuery)
+	builder.WriteString(", ")
+	builder.WriteString("tts_provider=")
+	builder.WriteString(gu.TtsProvider)
+	builder.WriteString(", ")
+	builder.WriteString("tts_language=")
+	builder.WriteString(gu.TtsLanguage)
+	builder.WriteString(", ")
+	builder.WriteString("tts_voice=")
+	builder.WriteString(gu.TtsVoice)
+	builder.WriteString(", ")
+	builder.WriteString("tts_rate=")
+	builder.WriteString(fmt.Sprintf("%v", gu.TtsRate))
+	builder.WriteString(", ")
+	builder.WriteString("tts_pitch=")
+	builder.WriteString(fmt.Sprintf("%v", gu.TtsPitch))
+	builder.WriteString(", ")
+	if v := gu.TtsReaderChannelID; v != nil {
+		builder.WriteString("tts_reader_channel_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("overlay_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("api_token=<sensitive>")
+	builder.WriteString(", ")
+	if v := gu.SleepAt; v != nil {
+		builder.WriteString("sleep_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sleep_end_of_track=")
+	builder.WriteString(fmt.Sprintf("%v", gu.SleepEndOfTrack))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(gu.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -4,6 +4,9 @@ package guild
 
 import (
 	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -17,12 +20,98 @@ const (
 	FieldPlayerChannelID = "player_channel_id"
 	// FieldPlayerMessageID holds the string denoting the player_message_id field in the database.
 	FieldPlayerMessageID = "player_message_id"
+	// FieldIdleTimeout holds the string denoting the idle_timeout field in the database.
+	FieldIdleTimeout = "idle_timeout"
+	// FieldAloneTimeout holds the string denoting the alone_timeout field in the database.
+	FieldAloneTimeout = "alone_timeout"
+	// FieldAutoplay holds the string denoting the autoplay field in the database.
+	FieldAutoplay = "autoplay"
+	// FieldAlwaysOn holds the string denoting the always_on field in the database.
+	FieldAlwaysOn = "always_on"
+	// FieldAlwaysOnChannelID holds the string denoting the always_on_channel_id field in the database.
+	FieldAlwaysOnChannelID = "always_on_channel_id"
+	// FieldFallbackQuery holds the string denoting the fallback_query field in the database.
+	FieldFallbackQuery = "fallback_query"
+	// FieldTtsProvider holds the string denoting the tts_provider field in the database.
+	FieldTtsProvider = "tts_provider"
+	// FieldTtsLanguage holds the string denoting the tts_language field in the database.
+	FieldTtsLanguage = "tts_language"
+	// FieldTtsVoice holds the string denoting the tts_voice field in the database.
+	FieldTtsVoice = "tts_voice"
+	// FieldTtsRate holds the string denoting the tts_rate field in the database.
+	FieldTtsRate = "tts_rate"
+	// FieldTtsPitch holds the string denoting the tts_pitch field in the database.
+	FieldTtsPitch = "tts_pitch"
+	// FieldTtsReaderChannelID holds the string denoting the tts_reader_channel_id field in the database.
+	FieldTtsReaderChannelID = "tts_reader_channel_id"
+	// FieldOverlayToken holds the string denoting the overlay_token field in the database.
+	FieldOverlayToken = "overlay_token"
+	// FieldAPIToken holds the string denoting the api_token field in the database.
+	FieldAPIToken = "api_token"
+	// FieldSleepAt holds the string denoting the sleep_at field in the database.
+	FieldSleepAt = "sleep_at"
+	// FieldSleepEndOfTrack holds the string denoting the sleep_end_of_track field in the database.
+	FieldSleepEndOfTrack = "sleep_end_of_track"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+	// EdgePronunciations holds the string denoting the pronunciations edge name in mutations.
+	EdgePronunciations = "pronunciations"
+	// EdgeCheerSounds holds the string denoting the cheer_sounds edge name in mutations.
+	EdgeCheerSounds = "cheer_sounds"
+	// EdgeWebhookSubscriptions holds the string denoting the webhook_subscriptions edge name in mutations.
+	EdgeWebhookSubscriptions = "webhook_subscriptions"
+	// EdgeTrackPlays holds the string denoting the track_plays edge name in mutations.
+	EdgeTrackPlays = "track_plays"
+	// EdgePlaylists holds the string denoting the playlists edge name in mutations.
+	EdgePlaylists = "playlists"
 	// Table holds the table name of the guild in the database.
 	Table = "guilds"
+	// MembersTable is the table that holds the members relation/edge.
+	MembersTable = "members"
+	// MembersInverseTable is the table name for the Member entity.
+	// It exists in this package in order to avoid circular dependency with the "member" package.
+	MembersInverseTable = "members"
+	// MembersColumn is the table column denoting the members relation/edge.
+	MembersColumn = "guild_id"
+	// PronunciationsTable is the table that holds the pronunciations relation/edge.
+	PronunciationsTable = "pronunciations"
+	// PronunciationsInverseTable is the table name for the Pronunciation entity.
+	// It exists in this package in order to avoid circular dependency with the "pronunciation" package.
+	PronunciationsInverseTable = "pronunciations"
+	// PronunciationsColumn is the table column denoting the pronunciations relation/edge.
+	PronunciationsColumn = "guild_id"
+	// CheerSoundsTable is the table that holds the cheer_sounds relation/edge.
+	CheerSoundsTable = "cheer_sounds"
+	// CheerSoundsInverseTable is the table name for the CheerSound entity.
+	// It exists in this package in order to avoid circular dependency with the "cheersound" package.
+	CheerSoundsInverseTable = "cheer_sounds"
+	// CheerSoundsColumn is the table column denoting the cheer_sounds relation/edge.
+	CheerSoundsColumn = "guild_id"
+	// WebhookSubscriptionsTable is the table that holds the webhook_subscriptions relation/edge.
+	WebhookSubscriptionsTable = "webhook_subscriptions"
+	// WebhookSubscriptionsInverseTable is the table name for the WebhookSubscription entity.
+	// It exists in this package in order to avoid circular dependency with the "webhooksubscription" package.
+	WebhookSubscriptionsInverseTable = "webhook_subscriptions"
+	// WebhookSubscriptionsColumn is the table column denoting the webhook_subscriptions relation/edge.
+	WebhookSubscriptionsColumn = "guild_id"
+	// TrackPlaysTable is the table that holds the track_plays relation/edge.
+	TrackPlaysTable = "track_plays"
+	// TrackPlaysInverseTable is the table name for the TrackPlay entity.
+	// It exists in this package in order to avoid circular dependency with the "trackplay" package.
+	TrackPlaysInverseTable = "track_plays"
+	// TrackPlaysColumn is the table column denoting the track_plays relation/edge.
+	TrackPlaysColumn = "guild_id"
+	// PlaylistsTable is the table that holds the playlists relation/edge.
+	PlaylistsTable = "playlists"
+	// PlaylistsInverseTable is the table name for the Playlist entity.
+	// It exists in this package in order to avoid circular dependency with the "playlist" package.
+	PlaylistsInverseTable = "playlists"
+	// PlaylistsColumn is the table column denoting the playlists relation/edge.
+	PlaylistsColumn = "guild_id"
 )
 
 // Columns holds all SQL columns for guild fields.
@@ -31,6 +120,22 @@ var Columns = []string{
 	FieldName,
 	FieldPlayerChannelID,
 	FieldPlayerMessageID,
+	FieldIdleTimeout,
+	FieldAloneTimeout,
+	FieldAutoplay,
+	FieldAlwaysOn,
+	FieldAlwaysOnChannelID,
+	FieldFallbackQuery,
+	FieldTtsProvider,
+	FieldTtsLanguage,
+	FieldTtsVoice,
+	FieldTtsRate,
+	FieldTtsPitch,
+	FieldTtsReaderChannelID,
+	FieldOverlayToken,
+	FieldAPIToken,
+	FieldSleepAt,
+	FieldSleepEndOfTrack,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -46,6 +151,34 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultIdleTimeout holds the default value on creation for the "idle_timeout" field.
+	DefaultIdleTimeout int
+	// IdleTimeoutValidator is a validator for the "idle_timeout" field. It is called by the builders before save.
+	IdleTimeoutValidator func(int) error
+	// DefaultAloneTimeout holds the default value on creation for the "alone_timeout" field.
+	DefaultAloneTimeout int
+	// AloneTimeoutValidator is a validator for the "alone_timeout" field. It is called by the builders before save.
+	AloneTimeoutValidator func(int) error
+	// DefaultAutoplay holds the default value on creation for the "autoplay" field.
+	DefaultAutoplay bool
+	// DefaultAlwaysOn holds the default value on creation for the "always_on" field.
+	DefaultAlwaysOn bool
+	// DefaultTtsProvider holds the default value on creation for the "tts_provider" field.
+	DefaultTtsProvider string
+	// DefaultTtsLanguage holds the default value on creation for the "tts_language" field.
+	DefaultTtsLanguage string
+	// DefaultTtsVoice holds the default value on creation for the "tts_voice" field.
+	DefaultTtsVoice string
+	// DefaultTtsRate holds the default value on creation for the "tts_rate" field.
+	DefaultTtsRate float64
+	// TtsRateValidator is a validator for the "tts_rate" field. It is called by the builders before save.
+	TtsRateValidator func(float64) error
+	// DefaultTtsPitch holds the default value on creation for the "tts_pitch" field.
+	DefaultTtsPitch float64
+	// TtsPitchValidator is a validator for the "tts_pitch" field. It is called by the builders before save.
+	TtsPitchValidator func(float64) error
+	// DefaultSleepEndOfTrack holds the default value on creation for the "sleep_end_of_track" field.
+	DefaultSleepEndOfTrack bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -53,3 +186,242 @@ var (
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
 )
+
+// OrderOption defines the ordering options for the Guild queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPlayerChannelID orders the results by the player_channel_id field.
+func ByPlayerChannelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayerChannelID, opts...).ToFunc()
+}
+
+// ByPlayerMessageID orders the results by the player_message_id field.
+func ByPlayerMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayerMessageID, opts...).ToFunc()
+}
+
+// ByIdleTimeout orders the results by the idle_timeout field.
+func ByIdleTimeout(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdleTimeout, opts...).ToFunc()
+}
+
+// ByAloneTimeout orders the results by the alone_timeout field.
+func ByAloneTimeout(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAloneTimeout, opts...).ToFunc()
+}
+
+// ByAutoplay orders the results by the autoplay field.
+func ByAutoplay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoplay, opts...).ToFunc()
+}
+
+// ByAlwaysOn orders the results by the always_on field.
+func ByAlwaysOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlwaysOn, opts...).ToFunc()
+}
+
+// ByAlwaysOnChannelID orders the results by the always_on_channel_id field.
+func ByAlwaysOnChannelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlwaysOnChannelID, opts...).ToFunc()
+}
+
+// ByFallbackQuery orders the results by the fallback_query field.
+func ByFallbackQuery(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFallbackQuery, opts...).ToFunc()
+}
+
+// ByTtsProvider orders the results by the tts_provider field.
+func ByTtsProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTtsProvider, opts...).ToFunc()
+}
+
+// ByTtsLanguage orders the results by the tts_language field.
+func ByTtsLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTtsLanguage, opts...).ToFunc()
+}
+
+// ByTtsVoice orders the results by the tts_voice field.
+func ByTtsVoice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTtsVoice, opts...).ToFunc()
+}
+
+// ByTtsRate orders the results by the tts_rate field.
+func ByTtsRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTtsRate, opts...).ToFunc()
+}
+
+// ByTtsPitch orders the results by the tts_pitch field.
+func ByTtsPitch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTtsPitch, opts...).ToFunc()
+}
+
+// ByTtsReaderChannelID orders the results by the tts_reader_channel_id field.
+func ByTtsReaderChannelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTtsReaderChannelID, opts...).ToFunc()
+}
+
+// ByOverlayToken orders the results by the overlay_token field.
+func ByOverlayToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverlayToken, opts...).ToFunc()
+}
+
+// ByAPIToken orders the results by the api_token field.
+func ByAPIToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIToken, opts...).ToFunc()
+}
+
+// BySleepAt orders the results by the sleep_at field.
+func BySleepAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSleepAt, opts...).ToFunc()
+}
+
+// BySleepEndOfTrack orders the results by the sleep_end_of_track field.
+func BySleepEndOfTrack(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSleepEndOfTrack, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMembersCount orders the results by members count.
+func ByMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembersStep(), opts...)
+	}
+}
+
+// ByMembers orders the results by members terms.
+func ByMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPronunciationsCount orders the results by pronunciations count.
+func ByPronunciationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPronunciationsStep(), opts...)
+	}
+}
+
+// ByPronunciations orders the results by pronunciations terms.
+func ByPronunciations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPronunciationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCheerSoundsCount orders the results by cheer_sounds count.
+func ByCheerSoundsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCheerSoundsStep(), opts...)
+	}
+}
+
+// ByCheerSounds orders the results by cheer_sounds terms.
+func ByCheerSounds(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCheerSoundsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhookSubscriptionsCount orders the results by webhook_subscriptions count.
+func ByWebhookSubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhookSubscriptionsStep(), opts...)
+	}
+}
+
+// ByWebhookSubscriptions orders the results by webhook_subscriptions terms.
+func ByWebhookSubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhookSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTrackPlaysCount orders the results by track_plays count.
+func ByTrackPlaysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTrackPlaysStep(), opts...)
+	}
+}
+
+// ByTrackPlays orders the results by track_plays terms.
+func ByTrackPlays(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTrackPlaysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPlaylistsCount orders the results by playlists count.
+func ByPlaylistsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPlaylistsStep(), opts...)
+	}
+}
+
+// ByPlaylists orders the results by playlists terms.
+func ByPlaylists(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlaylistsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+	)
+}
+func newPronunciationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PronunciationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PronunciationsTable, PronunciationsColumn),
+	)
+}
+func newCheerSoundsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CheerSoundsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CheerSoundsTable, CheerSoundsColumn),
+	)
+}
+func newWebhookSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhookSubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhookSubscriptionsTable, WebhookSubscriptionsColumn),
+	)
+}
+func newTrackPlaysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TrackPlaysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TrackPlaysTable, TrackPlaysColumn),
+	)
+}
+func newPlaylistsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlaylistsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PlaylistsTable, PlaylistsColumn),
+	)
+}
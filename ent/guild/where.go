@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	snowflake "github.com/disgoorg/snowflake/v2"
 	"github.com/loukhin/probably-a-music-bot/ent/predicate"
 )
@@ -72,6 +73,88 @@ func PlayerMessageID(v snowflake.ID) predicate.Guild {
 	return predicate.Guild(sql.FieldEQ(FieldPlayerMessageID, vc))
 }
 
+// IdleTimeout applies equality check predicate on the "idle_timeout" field. It's identical to IdleTimeoutEQ.
+func IdleTimeout(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldIdleTimeout, v))
+}
+
+// AloneTimeout applies equality check predicate on the "alone_timeout" field. It's identical to AloneTimeoutEQ.
+func AloneTimeout(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldAloneTimeout, v))
+}
+
+// Autoplay applies equality check predicate on the "autoplay" field. It's identical to AutoplayEQ.
+func Autoplay(v bool) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldAutoplay, v))
+}
+
+// AlwaysOn applies equality check predicate on the "always_on" field. It's identical to AlwaysOnEQ.
+func AlwaysOn(v bool) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldAlwaysOn, v))
+}
+
+// AlwaysOnChannelID applies equality check predicate on the "always_on_channel_id" field. It's identical to AlwaysOnChannelIDEQ.
+func AlwaysOnChannelID(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldEQ(FieldAlwaysOnChannelID, vc))
+}
+
+// FallbackQuery applies equality check predicate on the "fallback_query" field. It's identical to FallbackQueryEQ.
+func FallbackQuery(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldFallbackQuery, v))
+}
+
+// TtsProvider applies equality check predicate on the "tts_provider" field. It's identical to TtsProviderEQ.
+func TtsProvider(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldTtsProvider, v))
+}
+
+// TtsLanguage applies equality check predicate on the "tts_language" field. It's identical to TtsLanguageEQ.
+func TtsLanguage(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldTtsLanguage, v))
+}
+
+// TtsVoice applies equality check predicate on the "tts_voice" field. It's identical to TtsVoiceEQ.
+func TtsVoice(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldTtsVoice, v))
+}
+
+// TtsRate applies equality check predicate on the "tts_rate" field. It's identical to TtsRateEQ.
+func TtsRate(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldTtsRate, v))
+}
+
+// TtsPitch applies equality check predicate on the "tts_pitch" field. It's identical to TtsPitchEQ.
+func TtsPitch(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldTtsPitch, v))
+}
+
+// TtsReaderChannelID applies equality check predicate on the "tts_reader_channel_id" field. It's identical to TtsReaderChannelIDEQ.
+func TtsReaderChannelID(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldEQ(FieldTtsReaderChannelID, vc))
+}
+
+// OverlayToken applies equality check predicate on the "overlay_token" field. It's identical to OverlayTokenEQ.
+func OverlayToken(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldOverlayToken, v))
+}
+
+// APIToken applies equality check predicate on the "api_token" field. It's identical to APITokenEQ.
+func APIToken(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldAPIToken, v))
+}
+
+// SleepAt applies equality check predicate on the "sleep_at" field. It's identical to SleepAtEQ.
+func SleepAt(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldSleepAt, v))
+}
+
+// SleepEndOfTrack applies equality check predicate on the "sleep_end_of_track" field. It's identical to SleepEndOfTrackEQ.
+func SleepEndOfTrack(v bool) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldSleepEndOfTrack, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Guild {
 	return predicate.Guild(sql.FieldEQ(FieldCreatedAt, v))
@@ -275,6 +358,794 @@ func PlayerMessageIDNotNil() predicate.Guild {
 	return predicate.Guild(sql.FieldNotNull(FieldPlayerMessageID))
 }
 
+// IdleTimeoutEQ applies the EQ predicate on the "idle_timeout" field.
+func IdleTimeoutEQ(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutNEQ applies the NEQ predicate on the "idle_timeout" field.
+func IdleTimeoutNEQ(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutIn applies the In predicate on the "idle_timeout" field.
+func IdleTimeoutIn(vs ...int) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldIdleTimeout, vs...))
+}
+
+// IdleTimeoutNotIn applies the NotIn predicate on the "idle_timeout" field.
+func IdleTimeoutNotIn(vs ...int) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldIdleTimeout, vs...))
+}
+
+// IdleTimeoutGT applies the GT predicate on the "idle_timeout" field.
+func IdleTimeoutGT(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutGTE applies the GTE predicate on the "idle_timeout" field.
+func IdleTimeoutGTE(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutLT applies the LT predicate on the "idle_timeout" field.
+func IdleTimeoutLT(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutLTE applies the LTE predicate on the "idle_timeout" field.
+func IdleTimeoutLTE(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldIdleTimeout, v))
+}
+
+// AloneTimeoutEQ applies the EQ predicate on the "alone_timeout" field.
+func AloneTimeoutEQ(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldAloneTimeout, v))
+}
+
+// AloneTimeoutNEQ applies the NEQ predicate on the "alone_timeout" field.
+func AloneTimeoutNEQ(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldAloneTimeout, v))
+}
+
+// AloneTimeoutIn applies the In predicate on the "alone_timeout" field.
+func AloneTimeoutIn(vs ...int) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldAloneTimeout, vs...))
+}
+
+// AloneTimeoutNotIn applies the NotIn predicate on the "alone_timeout" field.
+func AloneTimeoutNotIn(vs ...int) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldAloneTimeout, vs...))
+}
+
+// AloneTimeoutGT applies the GT predicate on the "alone_timeout" field.
+func AloneTimeoutGT(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldAloneTimeout, v))
+}
+
+// AloneTimeoutGTE applies the GTE predicate on the "alone_timeout" field.
+func AloneTimeoutGTE(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldAloneTimeout, v))
+}
+
+// AloneTimeoutLT applies the LT predicate on the "alone_timeout" field.
+func AloneTimeoutLT(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldAloneTimeout, v))
+}
+
+// AloneTimeoutLTE applies the LTE predicate on the "alone_timeout" field.
+func AloneTimeoutLTE(v int) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldAloneTimeout, v))
+}
+
+// AutoplayEQ applies the EQ predicate on the "autoplay" field.
+func AutoplayEQ(v bool) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldAutoplay, v))
+}
+
+// AutoplayNEQ applies the NEQ predicate on the "autoplay" field.
+func AutoplayNEQ(v bool) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldAutoplay, v))
+}
+
+// AlwaysOnEQ applies the EQ predicate on the "always_on" field.
+func AlwaysOnEQ(v bool) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldAlwaysOn, v))
+}
+
+// AlwaysOnNEQ applies the NEQ predicate on the "always_on" field.
+func AlwaysOnNEQ(v bool) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldAlwaysOn, v))
+}
+
+// AlwaysOnChannelIDEQ applies the EQ predicate on the "always_on_channel_id" field.
+func AlwaysOnChannelIDEQ(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldEQ(FieldAlwaysOnChannelID, vc))
+}
+
+// AlwaysOnChannelIDNEQ applies the NEQ predicate on the "always_on_channel_id" field.
+func AlwaysOnChannelIDNEQ(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldNEQ(FieldAlwaysOnChannelID, vc))
+}
+
+// AlwaysOnChannelIDIn applies the In predicate on the "always_on_channel_id" field.
+func AlwaysOnChannelIDIn(vs ...snowflake.ID) predicate.Guild {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint64(vs[i])
+	}
+	return predicate.Guild(sql.FieldIn(FieldAlwaysOnChannelID, v...))
+}
+
+// AlwaysOnChannelIDNotIn applies the NotIn predicate on the "always_on_channel_id" field.
+func AlwaysOnChannelIDNotIn(vs ...snowflake.ID) predicate.Guild {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint64(vs[i])
+	}
+	return predicate.Guild(sql.FieldNotIn(FieldAlwaysOnChannelID, v...))
+}
+
+// AlwaysOnChannelIDGT applies the GT predicate on the "always_on_channel_id" field.
+func AlwaysOnChannelIDGT(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldGT(FieldAlwaysOnChannelID, vc))
+}
+
+// AlwaysOnChannelIDGTE applies the GTE predicate on the "always_on_channel_id" field.
+func AlwaysOnChannelIDGTE(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldGTE(FieldAlwaysOnChannelID, vc))
+}
+
+// AlwaysOnChannelIDLT applies the LT predicate on the "always_on_channel_id" field.
+func AlwaysOnChannelIDLT(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldLT(FieldAlwaysOnChannelID, vc))
+}
+
+// AlwaysOnChannelIDLTE applies the LTE predicate on the "always_on_channel_id" field.
+func AlwaysOnChannelIDLTE(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldLTE(FieldAlwaysOnChannelID, vc))
+}
+
+// AlwaysOnChannelIDIsNil applies the IsNil predicate on the "always_on_channel_id" field.
+func AlwaysOnChannelIDIsNil() predicate.Guild {
+	return predicate.Guild(sql.FieldIsNull(FieldAlwaysOnChannelID))
+}
+
+// AlwaysOnChannelIDNotNil applies the NotNil predicate on the "always_on_channel_id" field.
+func AlwaysOnChannelIDNotNil() predicate.Guild {
+	return predicate.Guild(sql.FieldNotNull(FieldAlwaysOnChannelID))
+}
+
+// FallbackQueryEQ applies the EQ predicate on the "fallback_query" field.
+func FallbackQueryEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldFallbackQuery, v))
+}
+
+// FallbackQueryNEQ applies the NEQ predicate on the "fallback_query" field.
+func FallbackQueryNEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldFallbackQuery, v))
+}
+
+// FallbackQueryIn applies the In predicate on the "fallback_query" field.
+func FallbackQueryIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldFallbackQuery, vs...))
+}
+
+// FallbackQueryNotIn applies the NotIn predicate on the "fallback_query" field.
+func FallbackQueryNotIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldFallbackQuery, vs...))
+}
+
+// FallbackQueryGT applies the GT predicate on the "fallback_query" field.
+func FallbackQueryGT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldFallbackQuery, v))
+}
+
+// FallbackQueryGTE applies the GTE predicate on the "fallback_query" field.
+func FallbackQueryGTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldFallbackQuery, v))
+}
+
+// FallbackQueryLT applies the LT predicate on the "fallback_query" field.
+func FallbackQueryLT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldFallbackQuery, v))
+}
+
+// FallbackQueryLTE applies the LTE predicate on the "fallback_query" field.
+func FallbackQueryLTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldFallbackQuery, v))
+}
+
+// FallbackQueryContains applies the Contains predicate on the "fallback_query" field.
+func FallbackQueryContains(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContains(FieldFallbackQuery, v))
+}
+
+// FallbackQueryHasPrefix applies the HasPrefix predicate on the "fallback_query" field.
+func FallbackQueryHasPrefix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasPrefix(FieldFallbackQuery, v))
+}
+
+// FallbackQueryHasSuffix applies the HasSuffix predicate on the "fallback_query" field.
+func FallbackQueryHasSuffix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasSuffix(FieldFallbackQuery, v))
+}
+
+// FallbackQueryIsNil applies the IsNil predicate on the "fallback_query" field.
+func FallbackQueryIsNil() predicate.Guild {
+	return predicate.Guild(sql.FieldIsNull(FieldFallbackQuery))
+}
+
+// FallbackQueryNotNil applies the NotNil predicate on the "fallback_query" field.
+func FallbackQueryNotNil() predicate.Guild {
+	return predicate.Guild(sql.FieldNotNull(FieldFallbackQuery))
+}
+
+// FallbackQueryEqualFold applies the EqualFold predicate on the "fallback_query" field.
+func FallbackQueryEqualFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEqualFold(FieldFallbackQuery, v))
+}
+
+// FallbackQueryContainsFold applies the ContainsFold predicate on the "fallback_query" field.
+func FallbackQueryContainsFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContainsFold(FieldFallbackQuery, v))
+}
+
+// TtsProviderEQ applies the EQ predicate on the "tts_provider" field.
+func TtsProviderEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldTtsProvider, v))
+}
+
+// TtsProviderNEQ applies the NEQ predicate on the "tts_provider" field.
+func TtsProviderNEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldTtsProvider, v))
+}
+
+// TtsProviderIn applies the In predicate on the "tts_provider" field.
+func TtsProviderIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldTtsProvider, vs...))
+}
+
+// TtsProviderNotIn applies the NotIn predicate on the "tts_provider" field.
+func TtsProviderNotIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldTtsProvider, vs...))
+}
+
+// TtsProviderGT applies the GT predicate on the "tts_provider" field.
+func TtsProviderGT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldTtsProvider, v))
+}
+
+// TtsProviderGTE applies the GTE predicate on the "tts_provider" field.
+func TtsProviderGTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldTtsProvider, v))
+}
+
+// TtsProviderLT applies the LT predicate on the "tts_provider" field.
+func TtsProviderLT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldTtsProvider, v))
+}
+
+// TtsProviderLTE applies the LTE predicate on the "tts_provider" field.
+func TtsProviderLTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldTtsProvider, v))
+}
+
+// TtsProviderContains applies the Contains predicate on the "tts_provider" field.
+func TtsProviderContains(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContains(FieldTtsProvider, v))
+}
+
+// TtsProviderHasPrefix applies the HasPrefix predicate on the "tts_provider" field.
+func TtsProviderHasPrefix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasPrefix(FieldTtsProvider, v))
+}
+
+// TtsProviderHasSuffix applies the HasSuffix predicate on the "tts_provider" field.
+func TtsProviderHasSuffix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasSuffix(FieldTtsProvider, v))
+}
+
+// TtsProviderEqualFold applies the EqualFold predicate on the "tts_provider" field.
+func TtsProviderEqualFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEqualFold(FieldTtsProvider, v))
+}
+
+// TtsProviderContainsFold applies the ContainsFold predicate on the "tts_provider" field.
+func TtsProviderContainsFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContainsFold(FieldTtsProvider, v))
+}
+
+// TtsLanguageEQ applies the EQ predicate on the "tts_language" field.
+func TtsLanguageEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldTtsLanguage, v))
+}
+
+// TtsLanguageNEQ applies the NEQ predicate on the "tts_language" field.
+func TtsLanguageNEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldTtsLanguage, v))
+}
+
+// TtsLanguageIn applies the In predicate on the "tts_language" field.
+func TtsLanguageIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldTtsLanguage, vs...))
+}
+
+// TtsLanguageNotIn applies the NotIn predicate on the "tts_language" field.
+func TtsLanguageNotIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldTtsLanguage, vs...))
+}
+
+// TtsLanguageGT applies the GT predicate on the "tts_language" field.
+func TtsLanguageGT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldTtsLanguage, v))
+}
+
+// TtsLanguageGTE applies the GTE predicate on the "tts_language" field.
+func TtsLanguageGTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldTtsLanguage, v))
+}
+
+// TtsLanguageLT applies the LT predicate on the "tts_language" field.
+func TtsLanguageLT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldTtsLanguage, v))
+}
+
+// TtsLanguageLTE applies the LTE predicate on the "tts_language" field.
+func TtsLanguageLTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldTtsLanguage, v))
+}
+
+// TtsLanguageContains applies the Contains predicate on the "tts_language" field.
+func TtsLanguageContains(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContains(FieldTtsLanguage, v))
+}
+
+// TtsLanguageHasPrefix applies the HasPrefix predicate on the "tts_language" field.
+func TtsLanguageHasPrefix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasPrefix(FieldTtsLanguage, v))
+}
+
+// TtsLanguageHasSuffix applies the HasSuffix predicate on the "tts_language" field.
+func TtsLanguageHasSuffix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasSuffix(FieldTtsLanguage, v))
+}
+
+// TtsLanguageEqualFold applies the EqualFold predicate on the "tts_language" field.
+func TtsLanguageEqualFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEqualFold(FieldTtsLanguage, v))
+}
+
+// TtsLanguageContainsFold applies the ContainsFold predicate on the "tts_language" field.
+func TtsLanguageContainsFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContainsFold(FieldTtsLanguage, v))
+}
+
+// TtsVoiceEQ applies the EQ predicate on the "tts_voice" field.
+func TtsVoiceEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldTtsVoice, v))
+}
+
+// TtsVoiceNEQ applies the NEQ predicate on the "tts_voice" field.
+func TtsVoiceNEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldTtsVoice, v))
+}
+
+// TtsVoiceIn applies the In predicate on the "tts_voice" field.
+func TtsVoiceIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldTtsVoice, vs...))
+}
+
+// TtsVoiceNotIn applies the NotIn predicate on the "tts_voice" field.
+func TtsVoiceNotIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldTtsVoice, vs...))
+}
+
+// TtsVoiceGT applies the GT predicate on the "tts_voice" field.
+func TtsVoiceGT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldTtsVoice, v))
+}
+
+// TtsVoiceGTE applies the GTE predicate on the "tts_voice" field.
+func TtsVoiceGTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldTtsVoice, v))
+}
+
+// TtsVoiceLT applies the LT predicate on the "tts_voice" field.
+func TtsVoiceLT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldTtsVoice, v))
+}
+
+// TtsVoiceLTE applies the LTE predicate on the "tts_voice" field.
+func TtsVoiceLTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldTtsVoice, v))
+}
+
+// TtsVoiceContains applies the Contains predicate on the "tts_voice" field.
+func TtsVoiceContains(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContains(FieldTtsVoice, v))
+}
+
+// TtsVoiceHasPrefix applies the HasPrefix predicate on the "tts_voice" field.
+func TtsVoiceHasPrefix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasPrefix(FieldTtsVoice, v))
+}
+
+// TtsVoiceHasSuffix applies the HasSuffix predicate on the "tts_voice" field.
+func TtsVoiceHasSuffix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasSuffix(FieldTtsVoice, v))
+}
+
+// TtsVoiceEqualFold applies the EqualFold predicate on the "tts_voice" field.
+func TtsVoiceEqualFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEqualFold(FieldTtsVoice, v))
+}
+
+// TtsVoiceContainsFold applies the ContainsFold predicate on the "tts_voice" field.
+func TtsVoiceContainsFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContainsFold(FieldTtsVoice, v))
+}
+
+// TtsRateEQ applies the EQ predicate on the "tts_rate" field.
+func TtsRateEQ(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldTtsRate, v))
+}
+
+// TtsRateNEQ applies the NEQ predicate on the "tts_rate" field.
+func TtsRateNEQ(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldTtsRate, v))
+}
+
+// TtsRateIn applies the In predicate on the "tts_rate" field.
+func TtsRateIn(vs ...float64) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldTtsRate, vs...))
+}
+
+// TtsRateNotIn applies the NotIn predicate on the "tts_rate" field.
+func TtsRateNotIn(vs ...float64) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldTtsRate, vs...))
+}
+
+// TtsRateGT applies the GT predicate on the "tts_rate" field.
+func TtsRateGT(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldTtsRate, v))
+}
+
+// TtsRateGTE applies the GTE predicate on the "tts_rate" field.
+func TtsRateGTE(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldTtsRate, v))
+}
+
+// TtsRateLT applies the LT predicate on the "tts_rate" field.
+func TtsRateLT(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldTtsRate, v))
+}
+
+// TtsRateLTE applies the LTE predicate on the "tts_rate" field.
+func TtsRateLTE(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldTtsRate, v))
+}
+
+// TtsPitchEQ applies the EQ predicate on the "tts_pitch" field.
+func TtsPitchEQ(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldTtsPitch, v))
+}
+
+// TtsPitchNEQ applies the NEQ predicate on the "tts_pitch" field.
+func TtsPitchNEQ(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldTtsPitch, v))
+}
+
+// TtsPitchIn applies the In predicate on the "tts_pitch" field.
+func TtsPitchIn(vs ...float64) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldTtsPitch, vs...))
+}
+
+// TtsPitchNotIn applies the NotIn predicate on the "tts_pitch" field.
+func TtsPitchNotIn(vs ...float64) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldTtsPitch, vs...))
+}
+
+// TtsPitchGT applies the GT predicate on the "tts_pitch" field.
+func TtsPitchGT(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldTtsPitch, v))
+}
+
+// TtsPitchGTE applies the GTE predicate on the "tts_pitch" field.
+func TtsPitchGTE(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldTtsPitch, v))
+}
+
+// TtsPitchLT applies the LT predicate on the "tts_pitch" field.
+func TtsPitchLT(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldTtsPitch, v))
+}
+
+// TtsPitchLTE applies the LTE predicate on the "tts_pitch" field.
+func TtsPitchLTE(v float64) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldTtsPitch, v))
+}
+
+// TtsReaderChannelIDEQ applies the EQ predicate on the "tts_reader_channel_id" field.
+func TtsReaderChannelIDEQ(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldEQ(FieldTtsReaderChannelID, vc))
+}
+
+// TtsReaderChannelIDNEQ applies the NEQ predicate on the "tts_reader_channel_id" field.
+func TtsReaderChannelIDNEQ(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldNEQ(FieldTtsReaderChannelID, vc))
+}
+
+// TtsReaderChannelIDIn applies the In predicate on the "tts_reader_channel_id" field.
+func TtsReaderChannelIDIn(vs ...snowflake.ID) predicate.Guild {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint64(vs[i])
+	}
+	return predicate.Guild(sql.FieldIn(FieldTtsReaderChannelID, v...))
+}
+
+// TtsReaderChannelIDNotIn applies the NotIn predicate on the "tts_reader_channel_id" field.
+func TtsReaderChannelIDNotIn(vs ...snowflake.ID) predicate.Guild {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint64(vs[i])
+	}
+	return predicate.Guild(sql.FieldNotIn(FieldTtsReaderChannelID, v...))
+}
+
+// TtsReaderChannelIDGT applies the GT predicate on the "tts_reader_channel_id" field.
+func TtsReaderChannelIDGT(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldGT(FieldTtsReaderChannelID, vc))
+}
+
+// TtsReaderChannelIDGTE applies the GTE predicate on the "tts_reader_channel_id" field.
+func TtsReaderChannelIDGTE(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldGTE(FieldTtsReaderChannelID, vc))
+}
+
+// TtsReaderChannelIDLT applies the LT predicate on the "tts_reader_channel_id" field.
+func TtsReaderChannelIDLT(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldLT(FieldTtsReaderChannelID, vc))
+}
+
+// TtsReaderChannelIDLTE applies the LTE predicate on the "tts_reader_channel_id" field.
+func TtsReaderChannelIDLTE(v snowflake.ID) predicate.Guild {
+	vc := uint64(v)
+	return predicate.Guild(sql.FieldLTE(FieldTtsReaderChannelID, vc))
+}
+
+// TtsReaderChannelIDIsNil applies the IsNil predicate on the "tts_reader_channel_id" field.
+func TtsReaderChannelIDIsNil() predicate.Guild {
+	return predicate.Guild(sql.FieldIsNull(FieldTtsReaderChannelID))
+}
+
+// TtsReaderChannelIDNotNil applies the NotNil predicate on the "tts_reader_channel_id" field.
+func TtsReaderChannelIDNotNil() predicate.Guild {
+	return predicate.Guild(sql.FieldNotNull(FieldTtsReaderChannelID))
+}
+
+// OverlayTokenEQ applies the EQ predicate on the "overlay_token" field.
+func OverlayTokenEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldOverlayToken, v))
+}
+
+// OverlayTokenNEQ applies the NEQ predicate on the "overlay_token" field.
+func OverlayTokenNEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldOverlayToken, v))
+}
+
+// OverlayTokenIn applies the In predicate on the "overlay_token" field.
+func OverlayTokenIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldOverlayToken, vs...))
+}
+
+// OverlayTokenNotIn applies the NotIn predicate on the "overlay_token" field.
+func OverlayTokenNotIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldOverlayToken, vs...))
+}
+
+// OverlayTokenGT applies the GT predicate on the "overlay_token" field.
+func OverlayTokenGT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldOverlayToken, v))
+}
+
+// OverlayTokenGTE applies the GTE predicate on the "overlay_token" field.
+func OverlayTokenGTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldOverlayToken, v))
+}
+
+// OverlayTokenLT applies the LT predicate on the "overlay_token" field.
+func OverlayTokenLT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldOverlayToken, v))
+}
+
+// OverlayTokenLTE applies the LTE predicate on the "overlay_token" field.
+func OverlayTokenLTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldOverlayToken, v))
+}
+
+// OverlayTokenContains applies the Contains predicate on the "overlay_token" field.
+func OverlayTokenContains(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContains(FieldOverlayToken, v))
+}
+
+// OverlayTokenHasPrefix applies the HasPrefix predicate on the "overlay_token" field.
+func OverlayTokenHasPrefix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasPrefix(FieldOverlayToken, v))
+}
+
+// OverlayTokenHasSuffix applies the HasSuffix predicate on the "overlay_token" field.
+func OverlayTokenHasSuffix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasSuffix(FieldOverlayToken, v))
+}
+
+// OverlayTokenIsNil applies the IsNil predicate on the "overlay_token" field.
+func OverlayTokenIsNil() predicate.Guild {
+	return predicate.Guild(sql.FieldIsNull(FieldOverlayToken))
+}
+
+// OverlayTokenNotNil applies the NotNil predicate on the "overlay_token" field.
+func OverlayTokenNotNil() predicate.Guild {
+	return predicate.Guild(sql.FieldNotNull(FieldOverlayToken))
+}
+
+// OverlayTokenEqualFold applies the EqualFold predicate on the "overlay_token" field.
+func OverlayTokenEqualFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEqualFold(FieldOverlayToken, v))
+}
+
+// OverlayTokenContainsFold applies the ContainsFold predicate on the "overlay_token" field.
+func OverlayTokenContainsFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContainsFold(FieldOverlayToken, v))
+}
+
+// APITokenEQ applies the EQ predicate on the "api_token" field.
+func APITokenEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldAPIToken, v))
+}
+
+// APITokenNEQ applies the NEQ predicate on the "api_token" field.
+func APITokenNEQ(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldAPIToken, v))
+}
+
+// APITokenIn applies the In predicate on the "api_token" field.
+func APITokenIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldAPIToken, vs...))
+}
+
+// APITokenNotIn applies the NotIn predicate on the "api_token" field.
+func APITokenNotIn(vs ...string) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldAPIToken, vs...))
+}
+
+// APITokenGT applies the GT predicate on the "api_token" field.
+func APITokenGT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldAPIToken, v))
+}
+
+// APITokenGTE applies the GTE predicate on the "api_token" field.
+func APITokenGTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldAPIToken, v))
+}
+
+// APITokenLT applies the LT predicate on the "api_token" field.
+func APITokenLT(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldAPIToken, v))
+}
+
+// APITokenLTE applies the LTE predicate on the "api_token" field.
+func APITokenLTE(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldAPIToken, v))
+}
+
+// APITokenContains applies the Contains predicate on the "api_token" field.
+func APITokenContains(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContains(FieldAPIToken, v))
+}
+
+// APITokenHasPrefix applies the HasPrefix predicate on the "api_token" field.
+func APITokenHasPrefix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasPrefix(FieldAPIToken, v))
+}
+
+// APITokenHasSuffix applies the HasSuffix predicate on the "api_token" field.
+func APITokenHasSuffix(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldHasSuffix(FieldAPIToken, v))
+}
+
+// APITokenIsNil applies the IsNil predicate on the "api_token" field.
+func APITokenIsNil() predicate.Guild {
+	return predicate.Guild(sql.FieldIsNull(FieldAPIToken))
+}
+
+// APITokenNotNil applies the NotNil predicate on the "api_token" field.
+func APITokenNotNil() predicate.Guild {
+	return predicate.Guild(sql.FieldNotNull(FieldAPIToken))
+}
+
+// APITokenEqualFold applies the EqualFold predicate on the "api_token" field.
+func APITokenEqualFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldEqualFold(FieldAPIToken, v))
+}
+
+// APITokenContainsFold applies the ContainsFold predicate on the "api_token" field.
+func APITokenContainsFold(v string) predicate.Guild {
+	return predicate.Guild(sql.FieldContainsFold(FieldAPIToken, v))
+}
+
+// SleepAtEQ applies the EQ predicate on the "sleep_at" field.
+func SleepAtEQ(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldSleepAt, v))
+}
+
+// SleepAtNEQ applies the NEQ predicate on the "sleep_at" field.
+func SleepAtNEQ(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldSleepAt, v))
+}
+
+// SleepAtIn applies the In predicate on the "sleep_at" field.
+func SleepAtIn(vs ...time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldIn(FieldSleepAt, vs...))
+}
+
+// SleepAtNotIn applies the NotIn predicate on the "sleep_at" field.
+func SleepAtNotIn(vs ...time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldNotIn(FieldSleepAt, vs...))
+}
+
+// SleepAtGT applies the GT predicate on the "sleep_at" field.
+func SleepAtGT(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldGT(FieldSleepAt, v))
+}
+
+// SleepAtGTE applies the GTE predicate on the "sleep_at" field.
+func SleepAtGTE(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldGTE(FieldSleepAt, v))
+}
+
+// SleepAtLT applies the LT predicate on the "sleep_at" field.
+func SleepAtLT(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldLT(FieldSleepAt, v))
+}
+
+// SleepAtLTE applies the LTE predicate on the "sleep_at" field.
+func SleepAtLTE(v time.Time) predicate.Guild {
+	return predicate.Guild(sql.FieldLTE(FieldSleepAt, v))
+}
+
+// SleepAtIsNil applies the IsNil predicate on the "sleep_at" field.
+func SleepAtIsNil() predicate.Guild {
+	return predicate.Guild(sql.FieldIsNull(FieldSleepAt))
+}
+
+// SleepAtNotNil applies the NotNil predicate on the "sleep_at" field.
+func SleepAtNotNil() predicate.Guild {
+	return predicate.Guild(sql.FieldNotNull(FieldSleepAt))
+}
+
+// SleepEndOfTrackEQ applies the EQ predicate on the "sleep_end_of_track" field.
+func SleepEndOfTrackEQ(v bool) predicate.Guild {
+	return predicate.Guild(sql.FieldEQ(FieldSleepEndOfTrack, v))
+}
+
+// SleepEndOfTrackNEQ applies the NEQ predicate on the "sleep_end_of_track" field.
+func SleepEndOfTrackNEQ(v bool) predicate.Guild {
+	return predicate.Guild(sql.FieldNEQ(FieldSleepEndOfTrack, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Guild {
 	return predicate.Guild(sql.FieldEQ(FieldCreatedAt, v))
@@ -315,6 +1186,16 @@ func CreatedAtLTE(v time.Time) predicate.Guild {
 	return predicate.Guild(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.Guild {
+	return predicate.Guild(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.Guild {
+	return predicate.Guild(sql.FieldNotNull(FieldCreatedAt))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.Guild {
 	return predicate.Guild(sql.FieldEQ(FieldUpdatedAt, v))
@@ -355,34 +1236,165 @@ func UpdatedAtLTE(v time.Time) predicate.Guild {
 	return predicate.Guild(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
-// And groups predicates with the AND operator between them.
-func And(predicates ...predicate.Guild) predicate.Guild {
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.Guild {
+	return predicate.Guild(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.Guild {
+	return predicate.Guild(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// HasMembers applies the HasEdge predicate on the "members" edge.
+func HasMembers() predicate.Guild {
 	return predicate.Guild(func(s *sql.Selector) {
-		s1 := s.Clone().SetP(nil)
-		for _, p := range predicates {
-			p(s1)
-		}
-		s.Where(s1.P())
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// Or groups predicates with the OR operator between them.
-func Or(predicates ...predicate.Guild) predicate.Guild {
+// HasMembersWith applies the HasEdge predicate on the "members" edge with a given conditions (other predicates).
+func HasMembersWith(preds ...predicate.Member) predicate.Guild {
 	return predicate.Guild(func(s *sql.Selector) {
-		s1 := s.Clone().SetP(nil)
-		for i, p := range predicates {
-			if i > 0 {
-				s1.Or()
+		step := newMembersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
 			}
-			p(s1)
-		}
-		s.Where(s1.P())
+		})
 	})
 }
 
-// Not applies the not operator on the given predicate.
-func Not(p predicate.Guild) predicate.Guild {
+// HasPronunciations applies the HasEdge predicate on the "pronunciations" edge.
+func HasPronunciations() predicate.Guild {
+	return predicate.Guild(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PronunciationsTable, PronunciationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPronunciationsWith applies the HasEdge predicate on the "pronunciations" edge with a given conditions (other predicates).
+func HasPronunciationsWith(preds ...predicate.Pronunciation) predicate.Guild {
+	return predicate.Guild(func(s *sql.Selector) {
+		step := newPronunciationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCheerSounds applies the HasEdge predicate on the "cheer_sounds" edge.
+func HasCheerSounds() predicate.Guild {
+	return predicate.Guild(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CheerSoundsTable, CheerSoundsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCheerSoundsWith applies the HasEdge predicate on the "cheer_sounds" edge with a given conditions (other predicates).
+func HasCheerSoundsWith(preds ...predicate.CheerSound) predicate.Guild {
+	return predicate.Guild(func(s *sql.Selector) {
+		step := newCheerSoundsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhookSubscriptions applies the HasEdge predicate on the "webhook_subscriptions" edge.
+func HasWebhookSubscriptions() predicate.Guild {
+	return predicate.Guild(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhookSubscriptionsTable, WebhookSubscriptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhookSubscriptionsWith applies the HasEdge predicate on the "webhook_subscriptions" edge with a given conditions (other predicates).
+func HasWebhookSubscriptionsWith(preds ...predicate.WebhookSubscription) predicate.Guild {
+	return predicate.Guild(func(s *sql.Selector) {
+		step := newWebhookSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTrackPlays applies the HasEdge predicate on the "track_plays" edge.
+func HasTrackPlays() predicate.Guild {
+	return predicate.Guild(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TrackPlaysTable, TrackPlaysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTrackPlaysWith applies the HasEdge predicate on the "track_plays" edge with a given conditions (other predicates).
+func HasTrackPlaysWith(preds ...predicate.TrackPlay) predicate.Guild {
+	return predicate.Guild(func(s *sql.Selector) {
+		step := newTrackPlaysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPlaylists applies the HasEdge predicate on the "playlists" edge.
+func HasPlaylists() predicate.Guild {
 	return predicate.Guild(func(s *sql.Selector) {
-		p(s.Not())
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PlaylistsTable, PlaylistsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
 	})
 }
+
+// HasPlaylistsWith applies the HasEdge predicate on the "playlists" edge with a given conditions (other predicates).
+func HasPlaylistsWith(preds ...predicate.Playlist) predicate.Guild {
+	return predicate.Guild(func(s *sql.Selector) {
+		step := newPlaylistsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Guild) predicate.Guild {
+	return predicate.Guild(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Guild) predicate.Guild {
+	return predicate.Guild(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Guild) predicate.Guild {
+	return predicate.Guild(sql.NotPredicates(p))
+}
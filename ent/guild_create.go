@@ -12,7 +12,13 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/cheersound"
 	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/member"
+	"github.com/loukhin/probably-a-music-bot/ent/playlist"
+	"github.com/loukhin/probably-a-music-bot/ent/pronunciation"
+	"github.com/loukhin/probably-a-music-bot/ent/trackplay"
+	"github.com/loukhin/probably-a-music-bot/ent/webhooksubscription"
 )
 
 // GuildCreate is the builder for creating a Guild entity.
@@ -57,6 +63,230 @@ func (gc *GuildCreate) SetNillablePlayerMessageID(s *snowflake.ID) *GuildCreate
 	return gc
 }
 
+// SetIdleTimeout sets the "idle_timeout" field.
+func (gc *GuildCreate) SetIdleTimeout(i int) *GuildCreate {
+	gc.mutation.SetIdleTimeout(i)
+	return gc
+}
+
+// SetNillableIdleTimeout sets the "idle_timeout" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableIdleTimeout(i *int) *GuildCreate {
+	if i != nil {
+		gc.SetIdleTimeout(*i)
+	}
+	return gc
+}
+
+// SetAloneTimeout sets the "alone_timeout" field.
+func (gc *GuildCreate) SetAloneTimeout(i int) *GuildCreate {
+	gc.mutation.SetAloneTimeout(i)
+	return gc
+}
+
+// SetNillableAloneTimeout sets the "alone_timeout" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableAloneTimeout(i *int) *GuildCreate {
+	if i != nil {
+		gc.SetAloneTimeout(*i)
+	}
+	return gc
+}
+
+// SetAutoplay sets the "autoplay" field.
+func (gc *GuildCreate) SetAutoplay(b bool) *GuildCreate {
+	gc.mutation.SetAutoplay(b)
+	return gc
+}
+
+// SetNillableAutoplay sets the "autoplay" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableAutoplay(b *bool) *GuildCreate {
+	if b != nil {
+		gc.SetAutoplay(*b)
+	}
+	return gc
+}
+
+// SetAlwaysOn sets the "always_on" field.
+func (gc *GuildCreate) SetAlwaysOn(b bool) *GuildCreate {
+	gc.mutation.SetAlwaysOn(b)
+	return gc
+}
+
+// SetNillableAlwaysOn sets the "always_on" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableAlwaysOn(b *bool) *GuildCreate {
+	if b != nil {
+		gc.SetAlwaysOn(*b)
+	}
+	return gc
+}
+
+// SetAlwaysOnChannelID sets the "always_on_channel_id" field.
+func (gc *GuildCreate) SetAlwaysOnChannelID(s snowflake.ID) *GuildCreate {
+	gc.mutation.SetAlwaysOnChannelID(s)
+	return gc
+}
+
+// SetNillableAlwaysOnChannelID sets the "always_on_channel_id" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableAlwaysOnChannelID(s *snowflake.ID) *GuildCreate {
+	if s != nil {
+		gc.SetAlwaysOnChannelID(*s)
+	}
+	return gc
+}
+
+// SetFallbackQuery sets the "fallback_query" field.
+func (gc *GuildCreate) SetFallbackQuery(s string) *GuildCreate {
+	gc.mutation.SetFallbackQuery(s)
+	return gc
+}
+
+// SetNillableFallbackQuery sets the "fallback_query" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableFallbackQuery(s *string) *GuildCreate {
+	if s != nil {
+		gc.SetFallbackQuery(*s)
+	}
+	return gc
+}
+
+// SetTtsProvider sets the "tts_provider" field.
+func (gc *GuildCreate) SetTtsProvider(s string) *GuildCreate {
+	gc.mutation.SetTtsProvider(s)
+	return gc
+}
+
+// SetNillableTtsProvider sets the "tts_provider" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableTtsProvider(s *string) *GuildCreate {
+	if s != nil {
+		gc.SetTtsProvider(*s)
+	}
+	return gc
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (gc *GuildCreate) SetTtsLanguage(s string) *GuildCreate {
+	gc.mutation.SetTtsLanguage(s)
+	return gc
+}
+
+// SetNillableTtsLanguage sets the "tts_language" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableTtsLanguage(s *string) *GuildCreate {
+	if s != nil {
+		gc.SetTtsLanguage(*s)
+	}
+	return gc
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (gc *GuildCreate) SetTtsVoice(s string) *GuildCreate {
+	gc.mutation.SetTtsVoice(s)
+	return gc
+}
+
+// SetNillableTtsVoice sets the "tts_voice" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableTtsVoice(s *string) *GuildCreate {
+	if s != nil {
+		gc.SetTtsVoice(*s)
+	}
+	return gc
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (gc *GuildCreate) SetTtsRate(f float64) *GuildCreate {
+	gc.mutation.SetTtsRate(f)
+	return gc
+}
+
+// SetNillableTtsRate sets the "tts_rate" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableTtsRate(f *float64) *GuildCreate {
+	if f != nil {
+		gc.SetTtsRate(*f)
+	}
+	return gc
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (gc *GuildCreate) SetTtsPitch(f float64) *GuildCreate {
+	gc.mutation.SetTtsPitch(f)
+	return gc
+}
+
+// SetNillableTtsPitch sets the "tts_pitch" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableTtsPitch(f *float64) *GuildCreate {
+	if f != nil {
+		gc.SetTtsPitch(*f)
+	}
+	return gc
+}
+
+// SetTtsReaderChannelID sets the "tts_reader_channel_id" field.
+func (gc *GuildCreate) SetTtsReaderChannelID(s snowflake.ID) *GuildCreate {
+	gc.mutation.SetTtsReaderChannelID(s)
+	return gc
+}
+
+// SetNillableTtsReaderChannelID sets the "tts_reader_channel_id" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableTtsReaderChannelID(s *snowflake.ID) *GuildCreate {
+	if s != nil {
+		gc.SetTtsReaderChannelID(*s)
+	}
+	return gc
+}
+
+// SetOverlayToken sets the "overlay_token" field.
+func (gc *GuildCreate) SetOverlayToken(s string) *GuildCreate {
+	gc.mutation.SetOverlayToken(s)
+	return gc
+}
+
+// SetNillableOverlayToken sets the "overlay_token" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableOverlayToken(s *string) *GuildCreate {
+	if s != nil {
+		gc.SetOverlayToken(*s)
+	}
+	return gc
+}
+
+// SetAPIToken sets the "api_token" field.
+func (gc *GuildCreate) SetAPIToken(s string) *GuildCreate {
+	gc.mutation.SetAPIToken(s)
+	return gc
+}
+
+// SetNillableAPIToken sets the "api_token" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableAPIToken(s *string) *GuildCreate {
+	if s != nil {
+		gc.SetAPIToken(*s)
+	}
+	return gc
+}
+
+// SetSleepAt sets the "sleep_at" field.
+func (gc *GuildCreate) SetSleepAt(t time.Time) *GuildCreate {
+	gc.mutation.SetSleepAt(t)
+	return gc
+}
+
+// SetNillableSleepAt sets the "sleep_at" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableSleepAt(t *time.Time) *GuildCreate {
+	if t != nil {
+		gc.SetSleepAt(*t)
+	}
+	return gc
+}
+
+// SetSleepEndOfTrack sets the "sleep_end_of_track" field.
+func (gc *GuildCreate) SetSleepEndOfTrack(b bool) *GuildCreate {
+	gc.mutation.SetSleepEndOfTrack(b)
+	return gc
+}
+
+// SetNillableSleepEndOfTrack sets the "sleep_end_of_track" field if the given value is not nil.
+func (gc *GuildCreate) SetNillableSleepEndOfTrack(b *bool) *GuildCreate {
+	if b != nil {
+		gc.SetSleepEndOfTrack(*b)
+	}
+	return gc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (gc *GuildCreate) SetCreatedAt(t time.Time) *GuildCreate {
 	gc.mutation.SetCreatedAt(t)
@@ -91,6 +321,96 @@ func (gc *GuildCreate) SetID(s snowflake.ID) *GuildCreate {
 	return gc
 }
 
+// AddMemberIDs adds the "members" edge to the Member entity by IDs.
+func (gc *GuildCreate) AddMemberIDs(ids ...int) *GuildCreate {
+	gc.mutation.AddMemberIDs(ids...)
+	return gc
+}
+
+// AddMembers adds the "members" edges to the Member entity.
+func (gc *GuildCreate) AddMembers(m ...*Member) *GuildCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return gc.AddMemberIDs(ids...)
+}
+
+// AddPronunciationIDs adds the "pronunciations" edge to the Pronunciation entity by IDs.
+func (gc *GuildCreate) AddPronunciationIDs(ids ...int) *GuildCreate {
+	gc.mutation.AddPronunciationIDs(ids...)
+	return gc
+}
+
+// AddPronunciations adds the "pronunciations" edges to the Pronunciation entity.
+func (gc *GuildCreate) AddPronunciations(p ...*Pronunciation) *GuildCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return gc.AddPronunciationIDs(ids...)
+}
+
+// AddCheerSoundIDs adds the "cheer_sounds" edge to the CheerSound entity by IDs.
+func (gc *GuildCreate) AddCheerSoundIDs(ids ...int) *GuildCreate {
+	gc.mutation.AddCheerSoundIDs(ids...)
+	return gc
+}
+
+// AddCheerSounds adds the "cheer_sounds" edges to the CheerSound entity.
+func (gc *GuildCreate) AddCheerSounds(c ...*CheerSound) *GuildCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return gc.AddCheerSoundIDs(ids...)
+}
+
+// AddWebhookSubscriptionIDs adds the "webhook_subscriptions" edge to the WebhookSubscription entity by IDs.
+func (gc *GuildCreate) AddWebhookSubscriptionIDs(ids ...int) *GuildCreate {
+	gc.mutation.AddWebhookSubscriptionIDs(ids...)
+	return gc
+}
+
+// AddWebhookSubscriptions adds the "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (gc *GuildCreate) AddWebhookSubscriptions(w ...*WebhookSubscription) *GuildCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return gc.AddWebhookSubscriptionIDs(ids...)
+}
+
+// AddTrackPlayIDs adds the "track_plays" edge to the TrackPlay entity by IDs.
+func (gc *GuildCreate) AddTrackPlayIDs(ids ...int) *GuildCreate {
+	gc.mutation.AddTrackPlayIDs(ids...)
+	return gc
+}
+
+// AddTrackPlays adds the "track_plays" edges to the TrackPlay entity.
+func (gc *GuildCreate) AddTrackPlays(t ...*TrackPlay) *GuildCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gc.AddTrackPlayIDs(ids...)
+}
+
+// AddPlaylistIDs adds the "playlists" edge to the Playlist entity by IDs.
+func (gc *GuildCreate) AddPlaylistIDs(ids ...int) *GuildCreate {
+	gc.mutation.AddPlaylistIDs(ids...)
+	return gc
+}
+
+// AddPlaylists adds the "playlists" edges to the Playlist entity.
+func (gc *GuildCreate) AddPlaylists(p ...*Playlist) *GuildCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return gc.AddPlaylistIDs(ids...)
+}
+
 // Mutation returns the GuildMutation object of the builder.
 func (gc *GuildCreate) Mutation() *GuildMutation {
 	return gc.mutation
@@ -99,7 +419,7 @@ func (gc *GuildCreate) Mutation() *GuildMutation {
 // Save creates the Guild in the database.
 func (gc *GuildCreate) Save(ctx context.Context) (*Guild, error) {
 	gc.defaults()
-	return withHooks[*Guild, GuildMutation](ctx, gc.sqlSave, gc.mutation, gc.hooks)
+	return withHooks(ctx, gc.sqlSave, gc.mutation, gc.hooks)
 }
 
 // SaveX calls Save and panics if Save returns an error.
@@ -126,6 +446,46 @@ func (gc *GuildCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (gc *GuildCreate) defaults() {
+	if _, ok := gc.mutation.IdleTimeout(); !ok {
+		v := guild.DefaultIdleTimeout
+		gc.mutation.SetIdleTimeout(v)
+	}
+	if _, ok := gc.mutation.AloneTimeout(); !ok {
+		v := guild.DefaultAloneTimeout
+		gc.mutation.SetAloneTimeout(v)
+	}
+	if _, ok := gc.mutation.Autoplay(); !ok {
+		v := guild.DefaultAutoplay
+		gc.mutation.SetAutoplay(v)
+	}
+	if _, ok := gc.mutation.AlwaysOn(); !ok {
+		v := guild.DefaultAlwaysOn
+		gc.mutation.SetAlwaysOn(v)
+	}
+	if _, ok := gc.mutation.TtsProvider(); !ok {
+		v := guild.DefaultTtsProvider
+		gc.mutation.SetTtsProvider(v)
+	}
+	if _, ok := gc.mutation.TtsLanguage(); !ok {
+		v := guild.DefaultTtsLanguage
+		gc.mutation.SetTtsLanguage(v)
+	}
+	if _, ok := gc.mutation.TtsVoice(); !ok {
+		v := guild.DefaultTtsVoice
+		gc.mutation.SetTtsVoice(v)
+	}
+	if _, ok := gc.mutation.TtsRate(); !ok {
+		v := guild.DefaultTtsRate
+		gc.mutation.SetTtsRate(v)
+	}
+	if _, ok := gc.mutation.TtsPitch(); !ok {
+		v := guild.DefaultTtsPitch
+		gc.mutation.SetTtsPitch(v)
+	}
+	if _, ok := gc.mutation.SleepEndOfTrack(); !ok {
+		v := guild.DefaultSleepEndOfTrack
+		gc.mutation.SetSleepEndOfTrack(v)
+	}
 	if _, ok := gc.mutation.CreatedAt(); !ok {
 		v := guild.DefaultCreatedAt()
 		gc.mutation.SetCreatedAt(v)
@@ -141,11 +501,55 @@ func (gc *GuildCreate) check() error {
 	if _, ok := gc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Guild.name"`)}
 	}
-	if _, ok := gc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Guild.created_at"`)}
+	if _, ok := gc.mutation.IdleTimeout(); !ok {
+		return &ValidationError{Name: "idle_timeout", err: errors.New(`ent: missing required field "Guild.idle_timeout"`)}
 	}
-	if _, ok := gc.mutation.UpdatedAt(); !ok {
-		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Guild.updated_at"`)}
+	if v, ok := gc.mutation.IdleTimeout(); ok {
+		if err := guild.IdleTimeoutValidator(v); err != nil {
+			return &ValidationError{Name: "idle_timeout", err: fmt.Errorf(`ent: validator failed for field "Guild.idle_timeout": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.AloneTimeout(); !ok {
+		return &ValidationError{Name: "alone_timeout", err: errors.New(`ent: missing required field "Guild.alone_timeout"`)}
+	}
+	if v, ok := gc.mutation.AloneTimeout(); ok {
+		if err := guild.AloneTimeoutValidator(v); err != nil {
+			return &ValidationError{Name: "alone_timeout", err: fmt.Errorf(`ent: validator failed for field "Guild.alone_timeout": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.Autoplay(); !ok {
+		return &ValidationError{Name: "autoplay", err: errors.New(`ent: missing required field "Guild.autoplay"`)}
+	}
+	if _, ok := gc.mutation.AlwaysOn(); !ok {
+		return &ValidationError{Name: "always_on", err: errors.New(`ent: missing required field "Guild.always_on"`)}
+	}
+	if _, ok := gc.mutation.TtsProvider(); !ok {
+		return &ValidationError{Name: "tts_provider", err: errors.New(`ent: missing required field "Guild.tts_provider"`)}
+	}
+	if _, ok := gc.mutation.TtsLanguage(); !ok {
+		return &ValidationError{Name: "tts_language", err: errors.New(`ent: missing required field "Guild.tts_language"`)}
+	}
+	if _, ok := gc.mutation.TtsVoice(); !ok {
+		return &ValidationError{Name: "tts_voice", err: errors.New(`ent: missing required field "Guild.tts_voice"`)}
+	}
+	if _, ok := gc.mutation.TtsRate(); !ok {
+		return &ValidationError{Name: "tts_rate", err: errors.New(`ent: missing required field "Guild.tts_rate"`)}
+	}
+	if v, ok := gc.mutation.TtsRate(); ok {
+		if err := guild.TtsRateValidator(v); err != nil {
+			return &ValidationError{Name: "tts_rate", err: fmt.Errorf(`ent: validator failed for field "Guild.tts_rate": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.TtsPitch(); !ok {
+		return &ValidationError{Name: "tts_pitch", err: errors.New(`ent: missing required field "Guild.tts_pitch"`)}
+	}
+	if v, ok := gc.mutation.TtsPitch(); ok {
+		if err := guild.TtsPitchValidator(v); err != nil {
+			return &ValidationError{Name: "tts_pitch", err: fmt.Errorf(`ent: validator failed for field "Guild.tts_pitch": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.SleepEndOfTrack(); !ok {
+		return &ValidationError{Name: "sleep_end_of_track", err: errors.New(`ent: missing required field "Guild.sleep_end_of_track"`)}
 	}
 	return nil
 }
@@ -192,6 +596,70 @@ func (gc *GuildCreate) createSpec() (*Guild, *sqlgraph.CreateSpec) {
 		_spec.SetField(guild.FieldPlayerMessageID, field.TypeUint64, value)
 		_node.PlayerMessageID = &value
 	}
+	if value, ok := gc.mutation.IdleTimeout(); ok {
+		_spec.SetField(guild.FieldIdleTimeout, field.TypeInt, value)
+		_node.IdleTimeout = value
+	}
+	if value, ok := gc.mutation.AloneTimeout(); ok {
+		_spec.SetField(guild.FieldAloneTimeout, field.TypeInt, value)
+		_node.AloneTimeout = value
+	}
+	if value, ok := gc.mutation.Autoplay(); ok {
+		_spec.SetField(guild.FieldAutoplay, field.TypeBool, value)
+		_node.Autoplay = value
+	}
+	if value, ok := gc.mutation.AlwaysOn(); ok {
+		_spec.SetField(guild.FieldAlwaysOn, field.TypeBool, value)
+		_node.AlwaysOn = value
+	}
+	if value, ok := gc.mutation.AlwaysOnChannelID(); ok {
+		_spec.SetField(guild.FieldAlwaysOnChannelID, field.TypeUint64, value)
+		_node.AlwaysOnChannelID = &value
+	}
+	if value, ok := gc.mutation.FallbackQuery(); ok {
+		_spec.SetField(guild.FieldFallbackQuery, field.TypeString, value)
+		_node.FallbackQuery = value
+	}
+	if value, ok := gc.mutation.TtsProvider(); ok {
+		_spec.SetField(guild.FieldTtsProvider, field.TypeString, value)
+		_node.TtsProvider = value
+	}
+	if value, ok := gc.mutation.TtsLanguage(); ok {
+		_spec.SetField(guild.FieldTtsLanguage, field.TypeString, value)
+		_node.TtsLanguage = value
+	}
+	if value, ok := gc.mutation.TtsVoice(); ok {
+		_spec.SetField(guild.FieldTtsVoice, field.TypeString, value)
+		_node.TtsVoice = value
+	}
+	if value, ok := gc.mutation.TtsRate(); ok {
+		_spec.SetField(guild.FieldTtsRate, field.TypeFloat64, value)
+		_node.TtsRate = value
+	}
+	if value, ok := gc.mutation.TtsPitch(); ok {
+		_spec.SetField(guild.FieldTtsPitch, field.TypeFloat64, value)
+		_node.TtsPitch = value
+	}
+	if value, ok := gc.mutation.TtsReaderChannelID(); ok {
+		_spec.SetField(guild.FieldTtsReaderChannelID, field.TypeUint64, value)
+		_node.TtsReaderChannelID = &value
+	}
+	if value, ok := gc.mutation.OverlayToken(); ok {
+		_spec.SetField(guild.FieldOverlayToken, field.TypeString, value)
+		_node.OverlayToken = &value
+	}
+	if value, ok := gc.mutation.APIToken(); ok {
+		_spec.SetField(guild.FieldAPIToken, field.TypeString, value)
+		_node.APIToken = &value
+	}
+	if value, ok := gc.mutation.SleepAt(); ok {
+		_spec.SetField(guild.FieldSleepAt, field.TypeTime, value)
+		_node.SleepAt = &value
+	}
+	if value, ok := gc.mutation.SleepEndOfTrack(); ok {
+		_spec.SetField(guild.FieldSleepEndOfTrack, field.TypeBool, value)
+		_node.SleepEndOfTrack = value
+	}
 	if value, ok := gc.mutation.CreatedAt(); ok {
 		_spec.SetField(guild.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -200,6 +668,102 @@ func (gc *GuildCreate) createSpec() (*Guild, *sqlgraph.CreateSpec) {
 		_spec.SetField(guild.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if nodes := gc.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.PronunciationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PronunciationsTable,
+			Columns: []string{guild.PronunciationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pronunciation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.CheerSoundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.CheerSoundsTable,
+			Columns: []string{guild.CheerSoundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cheersound.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.WebhookSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.WebhookSubscriptionsTable,
+			Columns: []string{guild.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.TrackPlaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.TrackPlaysTable,
+			Columns: []string{guild.TrackPlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trackplay.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.PlaylistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PlaylistsTable,
+			Columns: []string{guild.PlaylistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -312,145 +876,729 @@ func (u *GuildUpsert) ClearPlayerMessageID() *GuildUpsert {
 	return u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (u *GuildUpsert) SetCreatedAt(v time.Time) *GuildUpsert {
-	u.Set(guild.FieldCreatedAt, v)
+// SetIdleTimeout sets the "idle_timeout" field.
+func (u *GuildUpsert) SetIdleTimeout(v int) *GuildUpsert {
+	u.Set(guild.FieldIdleTimeout, v)
 	return u
 }
 
-// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
-func (u *GuildUpsert) UpdateCreatedAt() *GuildUpsert {
-	u.SetExcluded(guild.FieldCreatedAt)
+// UpdateIdleTimeout sets the "idle_timeout" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateIdleTimeout() *GuildUpsert {
+	u.SetExcluded(guild.FieldIdleTimeout)
 	return u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (u *GuildUpsert) SetUpdatedAt(v time.Time) *GuildUpsert {
-	u.Set(guild.FieldUpdatedAt, v)
+// AddIdleTimeout adds v to the "idle_timeout" field.
+func (u *GuildUpsert) AddIdleTimeout(v int) *GuildUpsert {
+	u.Add(guild.FieldIdleTimeout, v)
 	return u
 }
 
-// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
-func (u *GuildUpsert) UpdateUpdatedAt() *GuildUpsert {
-	u.SetExcluded(guild.FieldUpdatedAt)
+// SetAloneTimeout sets the "alone_timeout" field.
+func (u *GuildUpsert) SetAloneTimeout(v int) *GuildUpsert {
+	u.Set(guild.FieldAloneTimeout, v)
 	return u
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
-// Using this option is equivalent to using:
-//
-//	client.Guild.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//			sql.ResolveWith(func(u *sql.UpdateSet) {
-//				u.SetIgnore(guild.FieldID)
-//			}),
-//		).
-//		Exec(ctx)
-func (u *GuildUpsertOne) UpdateNewValues() *GuildUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		if _, exists := u.create.mutation.ID(); exists {
-			s.SetIgnore(guild.FieldID)
-		}
-	}))
+// UpdateAloneTimeout sets the "alone_timeout" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateAloneTimeout() *GuildUpsert {
+	u.SetExcluded(guild.FieldAloneTimeout)
+	return u
+}
+
+// AddAloneTimeout adds v to the "alone_timeout" field.
+func (u *GuildUpsert) AddAloneTimeout(v int) *GuildUpsert {
+	u.Add(guild.FieldAloneTimeout, v)
 	return u
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.Guild.Create().
-//	    OnConflict(sql.ResolveWithIgnore()).
-//	    Exec(ctx)
-func (u *GuildUpsertOne) Ignore() *GuildUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
-	return u
+// SetAutoplay sets the "autoplay" field.
+func (u *GuildUpsert) SetAutoplay(v bool) *GuildUpsert {
+	u.Set(guild.FieldAutoplay, v)
+	return u
+}
+
+// UpdateAutoplay sets the "autoplay" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateAutoplay() *GuildUpsert {
+	u.SetExcluded(guild.FieldAutoplay)
+	return u
+}
+
+// SetAlwaysOn sets the "always_on" field.
+func (u *GuildUpsert) SetAlwaysOn(v bool) *GuildUpsert {
+	u.Set(guild.FieldAlwaysOn, v)
+	return u
+}
+
+// UpdateAlwaysOn sets the "always_on" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateAlwaysOn() *GuildUpsert {
+	u.SetExcluded(guild.FieldAlwaysOn)
+	return u
+}
+
+// SetAlwaysOnChannelID sets the "always_on_channel_id" field.
+func (u *GuildUpsert) SetAlwaysOnChannelID(v snowflake.ID) *GuildUpsert {
+	u.Set(guild.FieldAlwaysOnChannelID, v)
+	return u
+}
+
+// UpdateAlwaysOnChannelID sets the "always_on_channel_id" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateAlwaysOnChannelID() *GuildUpsert {
+	u.SetExcluded(guild.FieldAlwaysOnChannelID)
+	return u
+}
+
+// AddAlwaysOnChannelID adds v to the "always_on_channel_id" field.
+func (u *GuildUpsert) AddAlwaysOnChannelID(v snowflake.ID) *GuildUpsert {
+	u.Add(guild.FieldAlwaysOnChannelID, v)
+	return u
+}
+
+// ClearAlwaysOnChannelID clears the value of the "always_on_channel_id" field.
+func (u *GuildUpsert) ClearAlwaysOnChannelID() *GuildUpsert {
+	u.SetNull(guild.FieldAlwaysOnChannelID)
+	return u
+}
+
+// SetFallbackQuery sets the "fallback_query" field.
+func (u *GuildUpsert) SetFallbackQuery(v string) *GuildUpsert {
+	u.Set(guild.FieldFallbackQuery, v)
+	return u
+}
+
+// UpdateFallbackQuery sets the "fallback_query" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateFallbackQuery() *GuildUpsert {
+	u.SetExcluded(guild.FieldFallbackQuery)
+	return u
+}
+
+// ClearFallbackQuery clears the value of the "fallback_query" field.
+func (u *GuildUpsert) ClearFallbackQuery() *GuildUpsert {
+	u.SetNull(guild.FieldFallbackQuery)
+	return u
+}
+
+// SetTtsProvider sets the "tts_provider" field.
+func (u *GuildUpsert) SetTtsProvider(v string) *GuildUpsert {
+	u.Set(guild.FieldTtsProvider, v)
+	return u
+}
+
+// UpdateTtsProvider sets the "tts_provider" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateTtsProvider() *GuildUpsert {
+	u.SetExcluded(guild.FieldTtsProvider)
+	return u
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (u *GuildUpsert) SetTtsLanguage(v string) *GuildUpsert {
+	u.Set(guild.FieldTtsLanguage, v)
+	return u
+}
+
+// UpdateTtsLanguage sets the "tts_language" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateTtsLanguage() *GuildUpsert {
+	u.SetExcluded(guild.FieldTtsLanguage)
+	return u
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (u *GuildUpsert) SetTtsVoice(v string) *GuildUpsert {
+	u.Set(guild.FieldTtsVoice, v)
+	return u
+}
+
+// UpdateTtsVoice sets the "tts_voice" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateTtsVoice() *GuildUpsert {
+	u.SetExcluded(guild.FieldTtsVoice)
+	return u
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (u *GuildUpsert) SetTtsRate(v float64) *GuildUpsert {
+	u.Set(guild.FieldTtsRate, v)
+	return u
+}
+
+// UpdateTtsRate sets the "tts_rate" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateTtsRate() *GuildUpsert {
+	u.SetExcluded(guild.FieldTtsRate)
+	return u
+}
+
+// AddTtsRate adds v to the "tts_rate" field.
+func (u *GuildUpsert) AddTtsRate(v float64) *GuildUpsert {
+	u.Add(guild.FieldTtsRate, v)
+	return u
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (u *GuildUpsert) SetTtsPitch(v float64) *GuildUpsert {
+	u.Set(guild.FieldTtsPitch, v)
+	return u
+}
+
+// UpdateTtsPitch sets the "tts_pitch" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateTtsPitch() *GuildUpsert {
+	u.SetExcluded(guild.FieldTtsPitch)
+	return u
+}
+
+// AddTtsPitch adds v to the "tts_pitch" field.
+func (u *GuildUpsert) AddTtsPitch(v float64) *GuildUpsert {
+	u.Add(guild.FieldTtsPitch, v)
+	return u
+}
+
+// SetTtsReaderChannelID sets the "tts_reader_channel_id" field.
+func (u *GuildUpsert) SetTtsReaderChannelID(v snowflake.ID) *GuildUpsert {
+	u.Set(guild.FieldTtsReaderChannelID, v)
+	return u
+}
+
+// UpdateTtsReaderChannelID sets the "tts_reader_channel_id" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateTtsReaderChannelID() *GuildUpsert {
+	u.SetExcluded(guild.FieldTtsReaderChannelID)
+	return u
+}
+
+// AddTtsReaderChannelID adds v to the "tts_reader_channel_id" field.
+func (u *GuildUpsert) AddTtsReaderChannelID(v snowflake.ID) *GuildUpsert {
+	u.Add(guild.FieldTtsReaderChannelID, v)
+	return u
+}
+
+// ClearTtsReaderChannelID clears the value of the "tts_reader_channel_id" field.
+func (u *GuildUpsert) ClearTtsReaderChannelID() *GuildUpsert {
+	u.SetNull(guild.FieldTtsReaderChannelID)
+	return u
+}
+
+// SetOverlayToken sets the "overlay_token" field.
+func (u *GuildUpsert) SetOverlayToken(v string) *GuildUpsert {
+	u.Set(guild.FieldOverlayToken, v)
+	return u
+}
+
+// UpdateOverlayToken sets the "overlay_token" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateOverlayToken() *GuildUpsert {
+	u.SetExcluded(guild.FieldOverlayToken)
+	return u
+}
+
+// ClearOverlayToken clears the value of the "overlay_token" field.
+func (u *GuildUpsert) ClearOverlayToken() *GuildUpsert {
+	u.SetNull(guild.FieldOverlayToken)
+	return u
+}
+
+// SetAPIToken sets the "api_token" field.
+func (u *GuildUpsert) SetAPIToken(v string) *GuildUpsert {
+	u.Set(guild.FieldAPIToken, v)
+	return u
+}
+
+// UpdateAPIToken sets the "api_token" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateAPIToken() *GuildUpsert {
+	u.SetExcluded(guild.FieldAPIToken)
+	return u
+}
+
+// ClearAPIToken clears the value of the "api_token" field.
+func (u *GuildUpsert) ClearAPIToken() *GuildUpsert {
+	u.SetNull(guild.FieldAPIToken)
+	return u
+}
+
+// SetSleepAt sets the "sleep_at" field.
+func (u *GuildUpsert) SetSleepAt(v time.Time) *GuildUpsert {
+	u.Set(guild.FieldSleepAt, v)
+	return u
+}
+
+// UpdateSleepAt sets the "sleep_at" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateSleepAt() *GuildUpsert {
+	u.SetExcluded(guild.FieldSleepAt)
+	return u
+}
+
+// ClearSleepAt clears the value of the "sleep_at" field.
+func (u *GuildUpsert) ClearSleepAt() *GuildUpsert {
+	u.SetNull(guild.FieldSleepAt)
+	return u
+}
+
+// SetSleepEndOfTrack sets the "sleep_end_of_track" field.
+func (u *GuildUpsert) SetSleepEndOfTrack(v bool) *GuildUpsert {
+	u.Set(guild.FieldSleepEndOfTrack, v)
+	return u
+}
+
+// UpdateSleepEndOfTrack sets the "sleep_end_of_track" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateSleepEndOfTrack() *GuildUpsert {
+	u.SetExcluded(guild.FieldSleepEndOfTrack)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *GuildUpsert) SetCreatedAt(v time.Time) *GuildUpsert {
+	u.Set(guild.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateCreatedAt() *GuildUpsert {
+	u.SetExcluded(guild.FieldCreatedAt)
+	return u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *GuildUpsert) ClearCreatedAt() *GuildUpsert {
+	u.SetNull(guild.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GuildUpsert) SetUpdatedAt(v time.Time) *GuildUpsert {
+	u.Set(guild.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GuildUpsert) UpdateUpdatedAt() *GuildUpsert {
+	u.SetExcluded(guild.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *GuildUpsert) ClearUpdatedAt() *GuildUpsert {
+	u.SetNull(guild.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Guild.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(guild.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GuildUpsertOne) UpdateNewValues() *GuildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(guild.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Guild.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GuildUpsertOne) Ignore() *GuildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GuildUpsertOne) DoNothing() *GuildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GuildCreate.OnConflict
+// documentation for more info.
+func (u *GuildUpsertOne) Update(set func(*GuildUpsert)) *GuildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GuildUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *GuildUpsertOne) SetName(v string) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateName() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPlayerChannelID sets the "player_channel_id" field.
+func (u *GuildUpsertOne) SetPlayerChannelID(v snowflake.ID) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetPlayerChannelID(v)
+	})
+}
+
+// AddPlayerChannelID adds v to the "player_channel_id" field.
+func (u *GuildUpsertOne) AddPlayerChannelID(v snowflake.ID) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddPlayerChannelID(v)
+	})
+}
+
+// UpdatePlayerChannelID sets the "player_channel_id" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdatePlayerChannelID() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdatePlayerChannelID()
+	})
+}
+
+// ClearPlayerChannelID clears the value of the "player_channel_id" field.
+func (u *GuildUpsertOne) ClearPlayerChannelID() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearPlayerChannelID()
+	})
+}
+
+// SetPlayerMessageID sets the "player_message_id" field.
+func (u *GuildUpsertOne) SetPlayerMessageID(v snowflake.ID) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetPlayerMessageID(v)
+	})
+}
+
+// AddPlayerMessageID adds v to the "player_message_id" field.
+func (u *GuildUpsertOne) AddPlayerMessageID(v snowflake.ID) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddPlayerMessageID(v)
+	})
+}
+
+// UpdatePlayerMessageID sets the "player_message_id" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdatePlayerMessageID() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdatePlayerMessageID()
+	})
+}
+
+// ClearPlayerMessageID clears the value of the "player_message_id" field.
+func (u *GuildUpsertOne) ClearPlayerMessageID() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearPlayerMessageID()
+	})
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (u *GuildUpsertOne) SetIdleTimeout(v int) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetIdleTimeout(v)
+	})
+}
+
+// AddIdleTimeout adds v to the "idle_timeout" field.
+func (u *GuildUpsertOne) AddIdleTimeout(v int) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddIdleTimeout(v)
+	})
+}
+
+// UpdateIdleTimeout sets the "idle_timeout" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateIdleTimeout() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateIdleTimeout()
+	})
+}
+
+// SetAloneTimeout sets the "alone_timeout" field.
+func (u *GuildUpsertOne) SetAloneTimeout(v int) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetAloneTimeout(v)
+	})
+}
+
+// AddAloneTimeout adds v to the "alone_timeout" field.
+func (u *GuildUpsertOne) AddAloneTimeout(v int) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddAloneTimeout(v)
+	})
+}
+
+// UpdateAloneTimeout sets the "alone_timeout" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateAloneTimeout() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateAloneTimeout()
+	})
+}
+
+// SetAutoplay sets the "autoplay" field.
+func (u *GuildUpsertOne) SetAutoplay(v bool) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetAutoplay(v)
+	})
+}
+
+// UpdateAutoplay sets the "autoplay" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateAutoplay() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateAutoplay()
+	})
+}
+
+// SetAlwaysOn sets the "always_on" field.
+func (u *GuildUpsertOne) SetAlwaysOn(v bool) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetAlwaysOn(v)
+	})
+}
+
+// UpdateAlwaysOn sets the "always_on" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateAlwaysOn() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateAlwaysOn()
+	})
+}
+
+// SetAlwaysOnChannelID sets the "always_on_channel_id" field.
+func (u *GuildUpsertOne) SetAlwaysOnChannelID(v snowflake.ID) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetAlwaysOnChannelID(v)
+	})
+}
+
+// AddAlwaysOnChannelID adds v to the "always_on_channel_id" field.
+func (u *GuildUpsertOne) AddAlwaysOnChannelID(v snowflake.ID) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddAlwaysOnChannelID(v)
+	})
+}
+
+// UpdateAlwaysOnChannelID sets the "always_on_channel_id" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateAlwaysOnChannelID() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateAlwaysOnChannelID()
+	})
+}
+
+// ClearAlwaysOnChannelID clears the value of the "always_on_channel_id" field.
+func (u *GuildUpsertOne) ClearAlwaysOnChannelID() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearAlwaysOnChannelID()
+	})
+}
+
+// SetFallbackQuery sets the "fallback_query" field.
+func (u *GuildUpsertOne) SetFallbackQuery(v string) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetFallbackQuery(v)
+	})
+}
+
+// UpdateFallbackQuery sets the "fallback_query" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateFallbackQuery() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateFallbackQuery()
+	})
+}
+
+// ClearFallbackQuery clears the value of the "fallback_query" field.
+func (u *GuildUpsertOne) ClearFallbackQuery() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearFallbackQuery()
+	})
+}
+
+// SetTtsProvider sets the "tts_provider" field.
+func (u *GuildUpsertOne) SetTtsProvider(v string) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetTtsProvider(v)
+	})
+}
+
+// UpdateTtsProvider sets the "tts_provider" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateTtsProvider() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateTtsProvider()
+	})
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (u *GuildUpsertOne) SetTtsLanguage(v string) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetTtsLanguage(v)
+	})
+}
+
+// UpdateTtsLanguage sets the "tts_language" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateTtsLanguage() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateTtsLanguage()
+	})
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (u *GuildUpsertOne) SetTtsVoice(v string) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetTtsVoice(v)
+	})
+}
+
+// UpdateTtsVoice sets the "tts_voice" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateTtsVoice() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateTtsVoice()
+	})
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (u *GuildUpsertOne) SetTtsRate(v float64) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetTtsRate(v)
+	})
+}
+
+// AddTtsRate adds v to the "tts_rate" field.
+func (u *GuildUpsertOne) AddTtsRate(v float64) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddTtsRate(v)
+	})
+}
+
+// UpdateTtsRate sets the "tts_rate" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateTtsRate() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateTtsRate()
+	})
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (u *GuildUpsertOne) SetTtsPitch(v float64) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetTtsPitch(v)
+	})
+}
+
+// AddTtsPitch adds v to the "tts_pitch" field.
+func (u *GuildUpsertOne) AddTtsPitch(v float64) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddTtsPitch(v)
+	})
+}
+
+// UpdateTtsPitch sets the "tts_pitch" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateTtsPitch() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateTtsPitch()
+	})
+}
+
+// SetTtsReaderChannelID sets the "tts_reader_channel_id" field.
+func (u *GuildUpsertOne) SetTtsReaderChannelID(v snowflake.ID) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetTtsReaderChannelID(v)
+	})
+}
+
+// AddTtsReaderChannelID adds v to the "tts_reader_channel_id" field.
+func (u *GuildUpsertOne) AddTtsReaderChannelID(v snowflake.ID) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddTtsReaderChannelID(v)
+	})
+}
+
+// UpdateTtsReaderChannelID sets the "tts_reader_channel_id" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateTtsReaderChannelID() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateTtsReaderChannelID()
+	})
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *GuildUpsertOne) DoNothing() *GuildUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
-	return u
+// ClearTtsReaderChannelID clears the value of the "tts_reader_channel_id" field.
+func (u *GuildUpsertOne) ClearTtsReaderChannelID() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearTtsReaderChannelID()
+	})
 }
 
-// Update allows overriding fields `UPDATE` values. See the GuildCreate.OnConflict
-// documentation for more info.
-func (u *GuildUpsertOne) Update(set func(*GuildUpsert)) *GuildUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&GuildUpsert{UpdateSet: update})
-	}))
-	return u
+// SetOverlayToken sets the "overlay_token" field.
+func (u *GuildUpsertOne) SetOverlayToken(v string) *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetOverlayToken(v)
+	})
 }
 
-// SetName sets the "name" field.
-func (u *GuildUpsertOne) SetName(v string) *GuildUpsertOne {
+// UpdateOverlayToken sets the "overlay_token" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateOverlayToken() *GuildUpsertOne {
 	return u.Update(func(s *GuildUpsert) {
-		s.SetName(v)
+		s.UpdateOverlayToken()
 	})
 }
 
-// UpdateName sets the "name" field to the value that was provided on create.
-func (u *GuildUpsertOne) UpdateName() *GuildUpsertOne {
+// ClearOverlayToken clears the value of the "overlay_token" field.
+func (u *GuildUpsertOne) ClearOverlayToken() *GuildUpsertOne {
 	return u.Update(func(s *GuildUpsert) {
-		s.UpdateName()
+		s.ClearOverlayToken()
 	})
 }
 
-// SetPlayerChannelID sets the "player_channel_id" field.
-func (u *GuildUpsertOne) SetPlayerChannelID(v snowflake.ID) *GuildUpsertOne {
+// SetAPIToken sets the "api_token" field.
+func (u *GuildUpsertOne) SetAPIToken(v string) *GuildUpsertOne {
 	return u.Update(func(s *GuildUpsert) {
-		s.SetPlayerChannelID(v)
+		s.SetAPIToken(v)
 	})
 }
 
-// AddPlayerChannelID adds v to the "player_channel_id" field.
-func (u *GuildUpsertOne) AddPlayerChannelID(v snowflake.ID) *GuildUpsertOne {
+// UpdateAPIToken sets the "api_token" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateAPIToken() *GuildUpsertOne {
 	return u.Update(func(s *GuildUpsert) {
-		s.AddPlayerChannelID(v)
+		s.UpdateAPIToken()
 	})
 }
 
-// UpdatePlayerChannelID sets the "player_channel_id" field to the value that was provided on create.
-func (u *GuildUpsertOne) UpdatePlayerChannelID() *GuildUpsertOne {
+// ClearAPIToken clears the value of the "api_token" field.
+func (u *GuildUpsertOne) ClearAPIToken() *GuildUpsertOne {
 	return u.Update(func(s *GuildUpsert) {
-		s.UpdatePlayerChannelID()
+		s.ClearAPIToken()
 	})
 }
 
-// ClearPlayerChannelID clears the value of the "player_channel_id" field.
-func (u *GuildUpsertOne) ClearPlayerChannelID() *GuildUpsertOne {
+// SetSleepAt sets the "sleep_at" field.
+func (u *GuildUpsertOne) SetSleepAt(v time.Time) *GuildUpsertOne {
 	return u.Update(func(s *GuildUpsert) {
-		s.ClearPlayerChannelID()
+		s.SetSleepAt(v)
 	})
 }
 
-// SetPlayerMessageID sets the "player_message_id" field.
-func (u *GuildUpsertOne) SetPlayerMessageID(v snowflake.ID) *GuildUpsertOne {
+// UpdateSleepAt sets the "sleep_at" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateSleepAt() *GuildUpsertOne {
 	return u.Update(func(s *GuildUpsert) {
-		s.SetPlayerMessageID(v)
+		s.UpdateSleepAt()
 	})
 }
 
-// AddPlayerMessageID adds v to the "player_message_id" field.
-func (u *GuildUpsertOne) AddPlayerMessageID(v snowflake.ID) *GuildUpsertOne {
+// ClearSleepAt clears the value of the "sleep_at" field.
+func (u *GuildUpsertOne) ClearSleepAt() *GuildUpsertOne {
 	return u.Update(func(s *GuildUpsert) {
-		s.AddPlayerMessageID(v)
+		s.ClearSleepAt()
 	})
 }
 
-// UpdatePlayerMessageID sets the "player_message_id" field to the value that was provided on create.
-func (u *GuildUpsertOne) UpdatePlayerMessageID() *GuildUpsertOne {
+// SetSleepEndOfTrack sets the "sleep_end_of_track" field.
+func (u *GuildUpsertOne) SetSleepEndOfTrack(v bool) *GuildUpsertOne {
 	return u.Update(func(s *GuildUpsert) {
-		s.UpdatePlayerMessageID()
+		s.SetSleepEndOfTrack(v)
 	})
 }
 
-// ClearPlayerMessageID clears the value of the "player_message_id" field.
-func (u *GuildUpsertOne) ClearPlayerMessageID() *GuildUpsertOne {
+// UpdateSleepEndOfTrack sets the "sleep_end_of_track" field to the value that was provided on create.
+func (u *GuildUpsertOne) UpdateSleepEndOfTrack() *GuildUpsertOne {
 	return u.Update(func(s *GuildUpsert) {
-		s.ClearPlayerMessageID()
+		s.UpdateSleepEndOfTrack()
 	})
 }
 
@@ -468,6 +1616,13 @@ func (u *GuildUpsertOne) UpdateCreatedAt() *GuildUpsertOne {
 	})
 }
 
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *GuildUpsertOne) ClearCreatedAt() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *GuildUpsertOne) SetUpdatedAt(v time.Time) *GuildUpsertOne {
 	return u.Update(func(s *GuildUpsert) {
@@ -482,6 +1637,13 @@ func (u *GuildUpsertOne) UpdateUpdatedAt() *GuildUpsertOne {
 	})
 }
 
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *GuildUpsertOne) ClearUpdatedAt() *GuildUpsertOne {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *GuildUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -518,12 +1680,16 @@ func (u *GuildUpsertOne) IDX(ctx context.Context) snowflake.ID {
 // GuildCreateBulk is the builder for creating many Guild entities in bulk.
 type GuildCreateBulk struct {
 	config
+	err      error
 	builders []*GuildCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Guild entities in the database.
 func (gcb *GuildCreateBulk) Save(ctx context.Context) ([]*Guild, error) {
+	if gcb.err != nil {
+		return nil, gcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(gcb.builders))
 	nodes := make([]*Guild, len(gcb.builders))
 	mutators := make([]Mutator, len(gcb.builders))
@@ -540,8 +1706,8 @@ func (gcb *GuildCreateBulk) Save(ctx context.Context) ([]*Guild, error) {
 					return nil, err
 				}
 				builder.mutation = mutation
-				nodes[i], specs[i] = builder.createSpec()
 				var err error
+				nodes[i], specs[i] = builder.createSpec()
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, gcb.builders[i+1].mutation)
 				} else {
@@ -762,6 +1928,314 @@ func (u *GuildUpsertBulk) ClearPlayerMessageID() *GuildUpsertBulk {
 	})
 }
 
+// SetIdleTimeout sets the "idle_timeout" field.
+func (u *GuildUpsertBulk) SetIdleTimeout(v int) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetIdleTimeout(v)
+	})
+}
+
+// AddIdleTimeout adds v to the "idle_timeout" field.
+func (u *GuildUpsertBulk) AddIdleTimeout(v int) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddIdleTimeout(v)
+	})
+}
+
+// UpdateIdleTimeout sets the "idle_timeout" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateIdleTimeout() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateIdleTimeout()
+	})
+}
+
+// SetAloneTimeout sets the "alone_timeout" field.
+func (u *GuildUpsertBulk) SetAloneTimeout(v int) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetAloneTimeout(v)
+	})
+}
+
+// AddAloneTimeout adds v to the "alone_timeout" field.
+func (u *GuildUpsertBulk) AddAloneTimeout(v int) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddAloneTimeout(v)
+	})
+}
+
+// UpdateAloneTimeout sets the "alone_timeout" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateAloneTimeout() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateAloneTimeout()
+	})
+}
+
+// SetAutoplay sets the "autoplay" field.
+func (u *GuildUpsertBulk) SetAutoplay(v bool) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetAutoplay(v)
+	})
+}
+
+// UpdateAutoplay sets the "autoplay" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateAutoplay() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateAutoplay()
+	})
+}
+
+// SetAlwaysOn sets the "always_on" field.
+func (u *GuildUpsertBulk) SetAlwaysOn(v bool) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetAlwaysOn(v)
+	})
+}
+
+// UpdateAlwaysOn sets the "always_on" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateAlwaysOn() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateAlwaysOn()
+	})
+}
+
+// SetAlwaysOnChannelID sets the "always_on_channel_id" field.
+func (u *GuildUpsertBulk) SetAlwaysOnChannelID(v snowflake.ID) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetAlwaysOnChannelID(v)
+	})
+}
+
+// AddAlwaysOnChannelID adds v to the "always_on_channel_id" field.
+func (u *GuildUpsertBulk) AddAlwaysOnChannelID(v snowflake.ID) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddAlwaysOnChannelID(v)
+	})
+}
+
+// UpdateAlwaysOnChannelID sets the "always_on_channel_id" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateAlwaysOnChannelID() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateAlwaysOnChannelID()
+	})
+}
+
+// ClearAlwaysOnChannelID clears the value of the "always_on_channel_id" field.
+func (u *GuildUpsertBulk) ClearAlwaysOnChannelID() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearAlwaysOnChannelID()
+	})
+}
+
+// SetFallbackQuery sets the "fallback_query" field.
+func (u *GuildUpsertBulk) SetFallbackQuery(v string) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetFallbackQuery(v)
+	})
+}
+
+// UpdateFallbackQuery sets the "fallback_query" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateFallbackQuery() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateFallbackQuery()
+	})
+}
+
+// ClearFallbackQuery clears the value of the "fallback_query" field.
+func (u *GuildUpsertBulk) ClearFallbackQuery() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearFallbackQuery()
+	})
+}
+
+// SetTtsProvider sets the "tts_provider" field.
+func (u *GuildUpsertBulk) SetTtsProvider(v string) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetTtsProvider(v)
+	})
+}
+
+// UpdateTtsProvider sets the "tts_provider" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateTtsProvider() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateTtsProvider()
+	})
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (u *GuildUpsertBulk) SetTtsLanguage(v string) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetTtsLanguage(v)
+	})
+}
+
+// UpdateTtsLanguage sets the "tts_language" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateTtsLanguage() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateTtsLanguage()
+	})
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (u *GuildUpsertBulk) SetTtsVoice(v string) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetTtsVoice(v)
+	})
+}
+
+// UpdateTtsVoice sets the "tts_voice" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateTtsVoice() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateTtsVoice()
+	})
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (u *GuildUpsertBulk) SetTtsRate(v float64) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetTtsRate(v)
+	})
+}
+
+// AddTtsRate adds v to the "tts_rate" field.
+func (u *GuildUpsertBulk) AddTtsRate(v float64) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddTtsRate(v)
+	})
+}
+
+// UpdateTtsRate sets the "tts_rate" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateTtsRate() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateTtsRate()
+	})
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (u *GuildUpsertBulk) SetTtsPitch(v float64) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetTtsPitch(v)
+	})
+}
+
+// AddTtsPitch adds v to the "tts_pitch" field.
+func (u *GuildUpsertBulk) AddTtsPitch(v float64) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddTtsPitch(v)
+	})
+}
+
+// UpdateTtsPitch sets the "tts_pitch" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateTtsPitch() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateTtsPitch()
+	})
+}
+
+// SetTtsReaderChannelID sets the "tts_reader_channel_id" field.
+func (u *GuildUpsertBulk) SetTtsReaderChannelID(v snowflake.ID) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetTtsReaderChannelID(v)
+	})
+}
+
+// AddTtsReaderChannelID adds v to the "tts_reader_channel_id" field.
+func (u *GuildUpsertBulk) AddTtsReaderChannelID(v snowflake.ID) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.AddTtsReaderChannelID(v)
+	})
+}
+
+// UpdateTtsReaderChannelID sets the "tts_reader_channel_id" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateTtsReaderChannelID() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateTtsReaderChannelID()
+	})
+}
+
+// ClearTtsReaderChannelID clears the value of the "tts_reader_channel_id" field.
+func (u *GuildUpsertBulk) ClearTtsReaderChannelID() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearTtsReaderChannelID()
+	})
+}
+
+// SetOverlayToken sets the "overlay_token" field.
+func (u *GuildUpsertBulk) SetOverlayToken(v string) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetOverlayToken(v)
+	})
+}
+
+// UpdateOverlayToken sets the "overlay_token" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateOverlayToken() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateOverlayToken()
+	})
+}
+
+// ClearOverlayToken clears the value of the "overlay_token" field.
+func (u *GuildUpsertBulk) ClearOverlayToken() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearOverlayToken()
+	})
+}
+
+// SetAPIToken sets the "api_token" field.
+func (u *GuildUpsertBulk) SetAPIToken(v string) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetAPIToken(v)
+	})
+}
+
+// UpdateAPIToken sets the "api_token" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateAPIToken() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateAPIToken()
+	})
+}
+
+// ClearAPIToken clears the value of the "api_token" field.
+func (u *GuildUpsertBulk) ClearAPIToken() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearAPIToken()
+	})
+}
+
+// SetSleepAt sets the "sleep_at" field.
+func (u *GuildUpsertBulk) SetSleepAt(v time.Time) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetSleepAt(v)
+	})
+}
+
+// UpdateSleepAt sets the "sleep_at" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateSleepAt() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateSleepAt()
+	})
+}
+
+// ClearSleepAt clears the value of the "sleep_at" field.
+func (u *GuildUpsertBulk) ClearSleepAt() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearSleepAt()
+	})
+}
+
+// SetSleepEndOfTrack sets the "sleep_end_of_track" field.
+func (u *GuildUpsertBulk) SetSleepEndOfTrack(v bool) *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.SetSleepEndOfTrack(v)
+	})
+}
+
+// UpdateSleepEndOfTrack sets the "sleep_end_of_track" field to the value that was provided on create.
+func (u *GuildUpsertBulk) UpdateSleepEndOfTrack() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.UpdateSleepEndOfTrack()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *GuildUpsertBulk) SetCreatedAt(v time.Time) *GuildUpsertBulk {
 	return u.Update(func(s *GuildUpsert) {
@@ -776,6 +2250,13 @@ func (u *GuildUpsertBulk) UpdateCreatedAt() *GuildUpsertBulk {
 	})
 }
 
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *GuildUpsertBulk) ClearCreatedAt() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *GuildUpsertBulk) SetUpdatedAt(v time.Time) *GuildUpsertBulk {
 	return u.Update(func(s *GuildUpsert) {
@@ -790,8 +2271,18 @@ func (u *GuildUpsertBulk) UpdateUpdatedAt() *GuildUpsertBulk {
 	})
 }
 
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *GuildUpsertBulk) ClearUpdatedAt() *GuildUpsertBulk {
+	return u.Update(func(s *GuildUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *GuildUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GuildCreateBulk instead", i)
@@ -4,24 +4,38 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/cheersound"
 	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/member"
+	"github.com/loukhin/probably-a-music-bot/ent/playlist"
 	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+	"github.com/loukhin/probably-a-music-bot/ent/pronunciation"
+	"github.com/loukhin/probably-a-music-bot/ent/trackplay"
+	"github.com/loukhin/probably-a-music-bot/ent/webhooksubscription"
 )
 
 // GuildQuery is the builder for querying Guild entities.
 type GuildQuery struct {
 	config
-	ctx        *QueryContext
-	order      []OrderFunc
-	inters     []Interceptor
-	predicates []predicate.Guild
+	ctx                      *QueryContext
+	order                    []guild.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Guild
+	withMembers              *MemberQuery
+	withPronunciations       *PronunciationQuery
+	withCheerSounds          *CheerSoundQuery
+	withWebhookSubscriptions *WebhookSubscriptionQuery
+	withTrackPlays           *TrackPlayQuery
+	withPlaylists            *PlaylistQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -53,15 +67,147 @@ func (gq *GuildQuery) Unique(unique bool) *GuildQuery {
 }
 
 // Order specifies how the records should be ordered.
-func (gq *GuildQuery) Order(o ...OrderFunc) *GuildQuery {
+func (gq *GuildQuery) Order(o ...guild.OrderOption) *GuildQuery {
 	gq.order = append(gq.order, o...)
 	return gq
 }
 
+// QueryMembers chains the current query on the "members" edge.
+func (gq *GuildQuery) QueryMembers() *MemberQuery {
+	query := (&MemberClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(guild.Table, guild.FieldID, selector),
+			sqlgraph.To(member.Table, member.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, guild.MembersTable, guild.MembersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPronunciations chains the current query on the "pronunciations" edge.
+func (gq *GuildQuery) QueryPronunciations() *PronunciationQuery {
+	query := (&PronunciationClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(guild.Table, guild.FieldID, selector),
+			sqlgraph.To(pronunciation.Table, pronunciation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, guild.PronunciationsTable, guild.PronunciationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCheerSounds chains the current query on the "cheer_sounds" edge.
+func (gq *GuildQuery) QueryCheerSounds() *CheerSoundQuery {
+	query := (&CheerSoundClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(guild.Table, guild.FieldID, selector),
+			sqlgraph.To(cheersound.Table, cheersound.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, guild.CheerSoundsTable, guild.CheerSoundsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhookSubscriptions chains the current query on the "webhook_subscriptions" edge.
+func (gq *GuildQuery) QueryWebhookSubscriptions() *WebhookSubscriptionQuery {
+	query := (&WebhookSubscriptionClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(guild.Table, guild.FieldID, selector),
+			sqlgraph.To(webhooksubscription.Table, webhooksubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, guild.WebhookSubscriptionsTable, guild.WebhookSubscriptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTrackPlays chains the current query on the "track_plays" edge.
+func (gq *GuildQuery) QueryTrackPlays() *TrackPlayQuery {
+	query := (&TrackPlayClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(guild.Table, guild.FieldID, selector),
+			sqlgraph.To(trackplay.Table, trackplay.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, guild.TrackPlaysTable, guild.TrackPlaysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPlaylists chains the current query on the "playlists" edge.
+func (gq *GuildQuery) QueryPlaylists() *PlaylistQuery {
+	query := (&PlaylistClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(guild.Table, guild.FieldID, selector),
+			sqlgraph.To(playlist.Table, playlist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, guild.PlaylistsTable, guild.PlaylistsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Guild entity from the query.
 // Returns a *NotFoundError when no Guild was found.
 func (gq *GuildQuery) First(ctx context.Context) (*Guild, error) {
-	nodes, err := gq.Limit(1).All(setContextOp(ctx, gq.ctx, "First"))
+	nodes, err := gq.Limit(1).All(setContextOp(ctx, gq.ctx, ent.OpQueryFirst))
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +230,7 @@ func (gq *GuildQuery) FirstX(ctx context.Context) *Guild {
 // Returns a *NotFoundError when no Guild ID was found.
 func (gq *GuildQuery) FirstID(ctx context.Context) (id snowflake.ID, err error) {
 	var ids []snowflake.ID
-	if ids, err = gq.Limit(1).IDs(setContextOp(ctx, gq.ctx, "FirstID")); err != nil {
+	if ids, err = gq.Limit(1).IDs(setContextOp(ctx, gq.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -107,7 +253,7 @@ func (gq *GuildQuery) FirstIDX(ctx context.Context) snowflake.ID {
 // Returns a *NotSingularError when more than one Guild entity is found.
 // Returns a *NotFoundError when no Guild entities are found.
 func (gq *GuildQuery) Only(ctx context.Context) (*Guild, error) {
-	nodes, err := gq.Limit(2).All(setContextOp(ctx, gq.ctx, "Only"))
+	nodes, err := gq.Limit(2).All(setContextOp(ctx, gq.ctx, ent.OpQueryOnly))
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +281,7 @@ func (gq *GuildQuery) OnlyX(ctx context.Context) *Guild {
 // Returns a *NotFoundError when no entities are found.
 func (gq *GuildQuery) OnlyID(ctx context.Context) (id snowflake.ID, err error) {
 	var ids []snowflake.ID
-	if ids, err = gq.Limit(2).IDs(setContextOp(ctx, gq.ctx, "OnlyID")); err != nil {
+	if ids, err = gq.Limit(2).IDs(setContextOp(ctx, gq.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -160,7 +306,7 @@ func (gq *GuildQuery) OnlyIDX(ctx context.Context) snowflake.ID {
 
 // All executes the query and returns a list of Guilds.
 func (gq *GuildQuery) All(ctx context.Context) ([]*Guild, error) {
-	ctx = setContextOp(ctx, gq.ctx, "All")
+	ctx = setContextOp(ctx, gq.ctx, ent.OpQueryAll)
 	if err := gq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
@@ -182,7 +328,7 @@ func (gq *GuildQuery) IDs(ctx context.Context) (ids []snowflake.ID, err error) {
 	if gq.ctx.Unique == nil && gq.path != nil {
 		gq.Unique(true)
 	}
-	ctx = setContextOp(ctx, gq.ctx, "IDs")
+	ctx = setContextOp(ctx, gq.ctx, ent.OpQueryIDs)
 	if err = gq.Select(guild.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
@@ -200,7 +346,7 @@ func (gq *GuildQuery) IDsX(ctx context.Context) []snowflake.ID {
 
 // Count returns the count of the given query.
 func (gq *GuildQuery) Count(ctx context.Context) (int, error) {
-	ctx = setContextOp(ctx, gq.ctx, "Count")
+	ctx = setContextOp(ctx, gq.ctx, ent.OpQueryCount)
 	if err := gq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
@@ -218,7 +364,7 @@ func (gq *GuildQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (gq *GuildQuery) Exist(ctx context.Context) (bool, error) {
-	ctx = setContextOp(ctx, gq.ctx, "Exist")
+	ctx = setContextOp(ctx, gq.ctx, ent.OpQueryExist)
 	switch _, err := gq.FirstID(ctx); {
 	case IsNotFound(err):
 		return false, nil
@@ -245,17 +391,89 @@ func (gq *GuildQuery) Clone() *GuildQuery {
 		return nil
 	}
 	return &GuildQuery{
-		config:     gq.config,
-		ctx:        gq.ctx.Clone(),
-		order:      append([]OrderFunc{}, gq.order...),
-		inters:     append([]Interceptor{}, gq.inters...),
-		predicates: append([]predicate.Guild{}, gq.predicates...),
+		config:                   gq.config,
+		ctx:                      gq.ctx.Clone(),
+		order:                    append([]guild.OrderOption{}, gq.order...),
+		inters:                   append([]Interceptor{}, gq.inters...),
+		predicates:               append([]predicate.Guild{}, gq.predicates...),
+		withMembers:              gq.withMembers.Clone(),
+		withPronunciations:       gq.withPronunciations.Clone(),
+		withCheerSounds:          gq.withCheerSounds.Clone(),
+		withWebhookSubscriptions: gq.withWebhookSubscriptions.Clone(),
+		withTrackPlays:           gq.withTrackPlays.Clone(),
+		withPlaylists:            gq.withPlaylists.Clone(),
 		// clone intermediate query.
 		sql:  gq.sql.Clone(),
 		path: gq.path,
 	}
 }
 
+// WithMembers tells the query-builder to eager-load the nodes that are connected to
+// the "members" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GuildQuery) WithMembers(opts ...func(*MemberQuery)) *GuildQuery {
+	query := (&MemberClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withMembers = query
+	return gq
+}
+
+// WithPronunciations tells the query-builder to eager-load the nodes that are connected to
+// the "pronunciations" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GuildQuery) WithPronunciations(opts ...func(*PronunciationQuery)) *GuildQuery {
+	query := (&PronunciationClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withPronunciations = query
+	return gq
+}
+
+// WithCheerSounds tells the query-builder to eager-load the nodes that are connected to
+// the "cheer_sounds" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GuildQuery) WithCheerSounds(opts ...func(*CheerSoundQuery)) *GuildQuery {
+	query := (&CheerSoundClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withCheerSounds = query
+	return gq
+}
+
+// WithWebhookSubscriptions tells the query-builder to eager-load the nodes that are connected to
+// the "webhook_subscriptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GuildQuery) WithWebhookSubscriptions(opts ...func(*WebhookSubscriptionQuery)) *GuildQuery {
+	query := (&WebhookSubscriptionClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withWebhookSubscriptions = query
+	return gq
+}
+
+// WithTrackPlays tells the query-builder to eager-load the nodes that are connected to
+// the "track_plays" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GuildQuery) WithTrackPlays(opts ...func(*TrackPlayQuery)) *GuildQuery {
+	query := (&TrackPlayClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withTrackPlays = query
+	return gq
+}
+
+// WithPlaylists tells the query-builder to eager-load the nodes that are connected to
+// the "playlists" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GuildQuery) WithPlaylists(opts ...func(*PlaylistQuery)) *GuildQuery {
+	query := (&PlaylistClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withPlaylists = query
+	return gq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +550,16 @@ func (gq *GuildQuery) prepareQuery(ctx context.Context) error {
 
 func (gq *GuildQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Guild, error) {
 	var (
-		nodes = []*Guild{}
-		_spec = gq.querySpec()
+		nodes       = []*Guild{}
+		_spec       = gq.querySpec()
+		loadedTypes = [6]bool{
+			gq.withMembers != nil,
+			gq.withPronunciations != nil,
+			gq.withCheerSounds != nil,
+			gq.withWebhookSubscriptions != nil,
+			gq.withTrackPlays != nil,
+			gq.withPlaylists != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Guild).scanValues(nil, columns)
@@ -341,6 +567,7 @@ func (gq *GuildQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Guild,
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Guild{config: gq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +579,234 @@ func (gq *GuildQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Guild,
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := gq.withMembers; query != nil {
+		if err := gq.loadMembers(ctx, query, nodes,
+			func(n *Guild) { n.Edges.Members = []*Member{} },
+			func(n *Guild, e *Member) { n.Edges.Members = append(n.Edges.Members, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withPronunciations; query != nil {
+		if err := gq.loadPronunciations(ctx, query, nodes,
+			func(n *Guild) { n.Edges.Pronunciations = []*Pronunciation{} },
+			func(n *Guild, e *Pronunciation) { n.Edges.Pronunciations = append(n.Edges.Pronunciations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withCheerSounds; query != nil {
+		if err := gq.loadCheerSounds(ctx, query, nodes,
+			func(n *Guild) { n.Edges.CheerSounds = []*CheerSound{} },
+			func(n *Guild, e *CheerSound) { n.Edges.CheerSounds = append(n.Edges.CheerSounds, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withWebhookSubscriptions; query != nil {
+		if err := gq.loadWebhookSubscriptions(ctx, query, nodes,
+			func(n *Guild) { n.Edges.WebhookSubscriptions = []*WebhookSubscription{} },
+			func(n *Guild, e *WebhookSubscription) {
+				n.Edges.WebhookSubscriptions = append(n.Edges.WebhookSubscriptions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withTrackPlays; query != nil {
+		if err := gq.loadTrackPlays(ctx, query, nodes,
+			func(n *Guild) { n.Edges.TrackPlays = []*TrackPlay{} },
+			func(n *Guild, e *TrackPlay) { n.Edges.TrackPlays = append(n.Edges.TrackPlays, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withPlaylists; query != nil {
+		if err := gq.loadPlaylists(ctx, query, nodes,
+			func(n *Guild) { n.Edges.Playlists = []*Playlist{} },
+			func(n *Guild, e *Playlist) { n.Edges.Playlists = append(n.Edges.Playlists, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (gq *GuildQuery) loadMembers(ctx context.Context, query *MemberQuery, nodes []*Guild, init func(*Guild), assign func(*Guild, *Member)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[snowflake.ID]*Guild)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(member.FieldGuildID)
+	}
+	query.Where(predicate.Member(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(guild.MembersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GuildID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "guild_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GuildQuery) loadPronunciations(ctx context.Context, query *PronunciationQuery, nodes []*Guild, init func(*Guild), assign func(*Guild, *Pronunciation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[snowflake.ID]*Guild)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pronunciation.FieldGuildID)
+	}
+	query.Where(predicate.Pronunciation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(guild.PronunciationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GuildID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "guild_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GuildQuery) loadCheerSounds(ctx context.Context, query *CheerSoundQuery, nodes []*Guild, init func(*Guild), assign func(*Guild, *CheerSound)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[snowflake.ID]*Guild)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(cheersound.FieldGuildID)
+	}
+	query.Where(predicate.CheerSound(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(guild.CheerSoundsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GuildID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "guild_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GuildQuery) loadWebhookSubscriptions(ctx context.Context, query *WebhookSubscriptionQuery, nodes []*Guild, init func(*Guild), assign func(*Guild, *WebhookSubscription)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[snowflake.ID]*Guild)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhooksubscription.FieldGuildID)
+	}
+	query.Where(predicate.WebhookSubscription(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(guild.WebhookSubscriptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GuildID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "guild_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GuildQuery) loadTrackPlays(ctx context.Context, query *TrackPlayQuery, nodes []*Guild, init func(*Guild), assign func(*Guild, *TrackPlay)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[snowflake.ID]*Guild)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(trackplay.FieldGuildID)
+	}
+	query.Where(predicate.TrackPlay(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(guild.TrackPlaysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GuildID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "guild_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GuildQuery) loadPlaylists(ctx context.Context, query *PlaylistQuery, nodes []*Guild, init func(*Guild), assign func(*Guild, *Playlist)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[snowflake.ID]*Guild)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(playlist.FieldGuildID)
+	}
+	query.Where(predicate.Playlist(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(guild.PlaylistsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GuildID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "guild_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (gq *GuildQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := gq.querySpec()
 	_spec.Node.Columns = gq.ctx.Fields
@@ -450,7 +902,7 @@ func (ggb *GuildGroupBy) Aggregate(fns ...AggregateFunc) *GuildGroupBy {
 
 // Scan applies the selector query and scans the result into the given value.
 func (ggb *GuildGroupBy) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, ggb.build.ctx, "GroupBy")
+	ctx = setContextOp(ctx, ggb.build.ctx, ent.OpQueryGroupBy)
 	if err := ggb.build.prepareQuery(ctx); err != nil {
 		return err
 	}
@@ -498,7 +950,7 @@ func (gs *GuildSelect) Aggregate(fns ...AggregateFunc) *GuildSelect {
 
 // Scan applies the selector query and scans the result into the given value.
 func (gs *GuildSelect) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, gs.ctx, "Select")
+	ctx = setContextOp(ctx, gs.ctx, ent.OpQuerySelect)
 	if err := gs.prepareQuery(ctx); err != nil {
 		return err
 	}
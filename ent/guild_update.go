@@ -12,8 +12,14 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/cheersound"
 	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/member"
+	"github.com/loukhin/probably-a-music-bot/ent/playlist"
 	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+	"github.com/loukhin/probably-a-music-bot/ent/pronunciation"
+	"github.com/loukhin/probably-a-music-bot/ent/trackplay"
+	"github.com/loukhin/probably-a-music-bot/ent/webhooksubscription"
 )
 
 // GuildUpdate is the builder for updating Guild entities.
@@ -35,6 +41,14 @@ func (gu *GuildUpdate) SetName(s string) *GuildUpdate {
 	return gu
 }
 
+// SetNillableName sets the "name" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableName(s *string) *GuildUpdate {
+	if s != nil {
+		gu.SetName(*s)
+	}
+	return gu
+}
+
 // SetPlayerChannelID sets the "player_channel_id" field.
 func (gu *GuildUpdate) SetPlayerChannelID(s snowflake.ID) *GuildUpdate {
 	gu.mutation.ResetPlayerChannelID()
@@ -89,6 +103,308 @@ func (gu *GuildUpdate) ClearPlayerMessageID() *GuildUpdate {
 	return gu
 }
 
+// SetIdleTimeout sets the "idle_timeout" field.
+func (gu *GuildUpdate) SetIdleTimeout(i int) *GuildUpdate {
+	gu.mutation.ResetIdleTimeout()
+	gu.mutation.SetIdleTimeout(i)
+	return gu
+}
+
+// SetNillableIdleTimeout sets the "idle_timeout" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableIdleTimeout(i *int) *GuildUpdate {
+	if i != nil {
+		gu.SetIdleTimeout(*i)
+	}
+	return gu
+}
+
+// AddIdleTimeout adds i to the "idle_timeout" field.
+func (gu *GuildUpdate) AddIdleTimeout(i int) *GuildUpdate {
+	gu.mutation.AddIdleTimeout(i)
+	return gu
+}
+
+// SetAloneTimeout sets the "alone_timeout" field.
+func (gu *GuildUpdate) SetAloneTimeout(i int) *GuildUpdate {
+	gu.mutation.ResetAloneTimeout()
+	gu.mutation.SetAloneTimeout(i)
+	return gu
+}
+
+// SetNillableAloneTimeout sets the "alone_timeout" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableAloneTimeout(i *int) *GuildUpdate {
+	if i != nil {
+		gu.SetAloneTimeout(*i)
+	}
+	return gu
+}
+
+// AddAloneTimeout adds i to the "alone_timeout" field.
+func (gu *GuildUpdate) AddAloneTimeout(i int) *GuildUpdate {
+	gu.mutation.AddAloneTimeout(i)
+	return gu
+}
+
+// SetAutoplay sets the "autoplay" field.
+func (gu *GuildUpdate) SetAutoplay(b bool) *GuildUpdate {
+	gu.mutation.SetAutoplay(b)
+	return gu
+}
+
+// SetNillableAutoplay sets the "autoplay" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableAutoplay(b *bool) *GuildUpdate {
+	if b != nil {
+		gu.SetAutoplay(*b)
+	}
+	return gu
+}
+
+// SetAlwaysOn sets the "always_on" field.
+func (gu *GuildUpdate) SetAlwaysOn(b bool) *GuildUpdate {
+	gu.mutation.SetAlwaysOn(b)
+	return gu
+}
+
+// SetNillableAlwaysOn sets the "always_on" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableAlwaysOn(b *bool) *GuildUpdate {
+	if b != nil {
+		gu.SetAlwaysOn(*b)
+	}
+	return gu
+}
+
+// SetAlwaysOnChannelID sets the "always_on_channel_id" field.
+func (gu *GuildUpdate) SetAlwaysOnChannelID(s snowflake.ID) *GuildUpdate {
+	gu.mutation.ResetAlwaysOnChannelID()
+	gu.mutation.SetAlwaysOnChannelID(s)
+	return gu
+}
+
+// SetNillableAlwaysOnChannelID sets the "always_on_channel_id" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableAlwaysOnChannelID(s *snowflake.ID) *GuildUpdate {
+	if s != nil {
+		gu.SetAlwaysOnChannelID(*s)
+	}
+	return gu
+}
+
+// AddAlwaysOnChannelID adds s to the "always_on_channel_id" field.
+func (gu *GuildUpdate) AddAlwaysOnChannelID(s snowflake.ID) *GuildUpdate {
+	gu.mutation.AddAlwaysOnChannelID(s)
+	return gu
+}
+
+// ClearAlwaysOnChannelID clears the value of the "always_on_channel_id" field.
+func (gu *GuildUpdate) ClearAlwaysOnChannelID() *GuildUpdate {
+	gu.mutation.ClearAlwaysOnChannelID()
+	return gu
+}
+
+// SetFallbackQuery sets the "fallback_query" field.
+func (gu *GuildUpdate) SetFallbackQuery(s string) *GuildUpdate {
+	gu.mutation.SetFallbackQuery(s)
+	return gu
+}
+
+// SetNillableFallbackQuery sets the "fallback_query" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableFallbackQuery(s *string) *GuildUpdate {
+	if s != nil {
+		gu.SetFallbackQuery(*s)
+	}
+	return gu
+}
+
+// ClearFallbackQuery clears the value of the "fallback_query" field.
+func (gu *GuildUpdate) ClearFallbackQuery() *GuildUpdate {
+	gu.mutation.ClearFallbackQuery()
+	return gu
+}
+
+// SetTtsProvider sets the "tts_provider" field.
+func (gu *GuildUpdate) SetTtsProvider(s string) *GuildUpdate {
+	gu.mutation.SetTtsProvider(s)
+	return gu
+}
+
+// SetNillableTtsProvider sets the "tts_provider" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableTtsProvider(s *string) *GuildUpdate {
+	if s != nil {
+		gu.SetTtsProvider(*s)
+	}
+	return gu
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (gu *GuildUpdate) SetTtsLanguage(s string) *GuildUpdate {
+	gu.mutation.SetTtsLanguage(s)
+	return gu
+}
+
+// SetNillableTtsLanguage sets the "tts_language" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableTtsLanguage(s *string) *GuildUpdate {
+	if s != nil {
+		gu.SetTtsLanguage(*s)
+	}
+	return gu
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (gu *GuildUpdate) SetTtsVoice(s string) *GuildUpdate {
+	gu.mutation.SetTtsVoice(s)
+	return gu
+}
+
+// SetNillableTtsVoice sets the "tts_voice" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableTtsVoice(s *string) *GuildUpdate {
+	if s != nil {
+		gu.SetTtsVoice(*s)
+	}
+	return gu
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (gu *GuildUpdate) SetTtsRate(f float64) *GuildUpdate {
+	gu.mutation.ResetTtsRate()
+	gu.mutation.SetTtsRate(f)
+	return gu
+}
+
+// SetNillableTtsRate sets the "tts_rate" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableTtsRate(f *float64) *GuildUpdate {
+	if f != nil {
+		gu.SetTtsRate(*f)
+	}
+	return gu
+}
+
+// AddTtsRate adds f to the "tts_rate" field.
+func (gu *GuildUpdate) AddTtsRate(f float64) *GuildUpdate {
+	gu.mutation.AddTtsRate(f)
+	return gu
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (gu *GuildUpdate) SetTtsPitch(f float64) *GuildUpdate {
+	gu.mutation.ResetTtsPitch()
+	gu.mutation.SetTtsPitch(f)
+	return gu
+}
+
+// SetNillableTtsPitch sets the "tts_pitch" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableTtsPitch(f *float64) *GuildUpdate {
+	if f != nil {
+		gu.SetTtsPitch(*f)
+	}
+	return gu
+}
+
+// AddTtsPitch adds f to the "tts_pitch" field.
+func (gu *GuildUpdate) AddTtsPitch(f float64) *GuildUpdate {
+	gu.mutation.AddTtsPitch(f)
+	return gu
+}
+
+// SetTtsReaderChannelID sets the "tts_reader_channel_id" field.
+func (gu *GuildUpdate) SetTtsReaderChannelID(s snowflake.ID) *GuildUpdate {
+	gu.mutation.ResetTtsReaderChannelID()
+	gu.mutation.SetTtsReaderChannelID(s)
+	return gu
+}
+
+// SetNillableTtsReaderChannelID sets the "tts_reader_channel_id" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableTtsReaderChannelID(s *snowflake.ID) *GuildUpdate {
+	if s != nil {
+		gu.SetTtsReaderChannelID(*s)
+	}
+	return gu
+}
+
+// AddTtsReaderChannelID adds s to the "tts_reader_channel_id" field.
+func (gu *GuildUpdate) AddTtsReaderChannelID(s snowflake.ID) *GuildUpdate {
+	gu.mutation.AddTtsReaderChannelID(s)
+	return gu
+}
+
+// ClearTtsReaderChannelID clears the value of the "tts_reader_channel_id" field.
+func (gu *GuildUpdate) ClearTtsReaderChannelID() *GuildUpdate {
+	gu.mutation.ClearTtsReaderChannelID()
+	return gu
+}
+
+// SetOverlayToken sets the "overlay_token" field.
+func (gu *GuildUpdate) SetOverlayToken(s string) *GuildUpdate {
+	gu.mutation.SetOverlayToken(s)
+	return gu
+}
+
+// SetNillableOverlayToken sets the "overlay_token" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableOverlayToken(s *string) *GuildUpdate {
+	if s != nil {
+		gu.SetOverlayToken(*s)
+	}
+	return gu
+}
+
+// ClearOverlayToken clears the value of the "overlay_token" field.
+func (gu *GuildUpdate) ClearOverlayToken() *GuildUpdate {
+	gu.mutation.ClearOverlayToken()
+	return gu
+}
+
+// SetAPIToken sets the "api_token" field.
+func (gu *GuildUpdate) SetAPIToken(s string) *GuildUpdate {
+	gu.mutation.SetAPIToken(s)
+	return gu
+}
+
+// SetNillableAPIToken sets the "api_token" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableAPIToken(s *string) *GuildUpdate {
+	if s != nil {
+		gu.SetAPIToken(*s)
+	}
+	return gu
+}
+
+// ClearAPIToken clears the value of the "api_token" field.
+func (gu *GuildUpdate) ClearAPIToken() *GuildUpdate {
+	gu.mutation.ClearAPIToken()
+	return gu
+}
+
+// SetSleepAt sets the "sleep_at" field.
+func (gu *GuildUpdate) SetSleepAt(t time.Time) *GuildUpdate {
+	gu.mutation.SetSleepAt(t)
+	return gu
+}
+
+// SetNillableSleepAt sets the "sleep_at" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableSleepAt(t *time.Time) *GuildUpdate {
+	if t != nil {
+		gu.SetSleepAt(*t)
+	}
+	return gu
+}
+
+// ClearSleepAt clears the value of the "sleep_at" field.
+func (gu *GuildUpdate) ClearSleepAt() *GuildUpdate {
+	gu.mutation.ClearSleepAt()
+	return gu
+}
+
+// SetSleepEndOfTrack sets the "sleep_end_of_track" field.
+func (gu *GuildUpdate) SetSleepEndOfTrack(b bool) *GuildUpdate {
+	gu.mutation.SetSleepEndOfTrack(b)
+	return gu
+}
+
+// SetNillableSleepEndOfTrack sets the "sleep_end_of_track" field if the given value is not nil.
+func (gu *GuildUpdate) SetNillableSleepEndOfTrack(b *bool) *GuildUpdate {
+	if b != nil {
+		gu.SetSleepEndOfTrack(*b)
+	}
+	return gu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (gu *GuildUpdate) SetCreatedAt(t time.Time) *GuildUpdate {
 	gu.mutation.SetCreatedAt(t)
@@ -96,199 +412,1353 @@ func (gu *GuildUpdate) SetCreatedAt(t time.Time) *GuildUpdate {
 }
 
 // SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (gu *GuildUpdate) SetNillableCreatedAt(t *time.Time) *GuildUpdate {
+func (gu *GuildUpdate) SetNillableCreatedAt(t *time.Time) *GuildUpdate {
+	if t != nil {
+		gu.SetCreatedAt(*t)
+	}
+	return gu
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (gu *GuildUpdate) ClearCreatedAt() *GuildUpdate {
+	gu.mutation.ClearCreatedAt()
+	return gu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gu *GuildUpdate) SetUpdatedAt(t time.Time) *GuildUpdate {
+	gu.mutation.SetUpdatedAt(t)
+	return gu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (gu *GuildUpdate) ClearUpdatedAt() *GuildUpdate {
+	gu.mutation.ClearUpdatedAt()
+	return gu
+}
+
+// AddMemberIDs adds the "members" edge to the Member entity by IDs.
+func (gu *GuildUpdate) AddMemberIDs(ids ...int) *GuildUpdate {
+	gu.mutation.AddMemberIDs(ids...)
+	return gu
+}
+
+// AddMembers adds the "members" edges to the Member entity.
+func (gu *GuildUpdate) AddMembers(m ...*Member) *GuildUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return gu.AddMemberIDs(ids...)
+}
+
+// AddPronunciationIDs adds the "pronunciations" edge to the Pronunciation entity by IDs.
+func (gu *GuildUpdate) AddPronunciationIDs(ids ...int) *GuildUpdate {
+	gu.mutation.AddPronunciationIDs(ids...)
+	return gu
+}
+
+// AddPronunciations adds the "pronunciations" edges to the Pronunciation entity.
+func (gu *GuildUpdate) AddPronunciations(p ...*Pronunciation) *GuildUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return gu.AddPronunciationIDs(ids...)
+}
+
+// AddCheerSoundIDs adds the "cheer_sounds" edge to the CheerSound entity by IDs.
+func (gu *GuildUpdate) AddCheerSoundIDs(ids ...int) *GuildUpdate {
+	gu.mutation.AddCheerSoundIDs(ids...)
+	return gu
+}
+
+// AddCheerSounds adds the "cheer_sounds" edges to the CheerSound entity.
+func (gu *GuildUpdate) AddCheerSounds(c ...*CheerSound) *GuildUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return gu.AddCheerSoundIDs(ids...)
+}
+
+// AddWebhookSubscriptionIDs adds the "webhook_subscriptions" edge to the WebhookSubscription entity by IDs.
+func (gu *GuildUpdate) AddWebhookSubscriptionIDs(ids ...int) *GuildUpdate {
+	gu.mutation.AddWebhookSubscriptionIDs(ids...)
+	return gu
+}
+
+// AddWebhookSubscriptions adds the "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (gu *GuildUpdate) AddWebhookSubscriptions(w ...*WebhookSubscription) *GuildUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return gu.AddWebhookSubscriptionIDs(ids...)
+}
+
+// AddTrackPlayIDs adds the "track_plays" edge to the TrackPlay entity by IDs.
+func (gu *GuildUpdate) AddTrackPlayIDs(ids ...int) *GuildUpdate {
+	gu.mutation.AddTrackPlayIDs(ids...)
+	return gu
+}
+
+// AddTrackPlays adds the "track_plays" edges to the TrackPlay entity.
+func (gu *GuildUpdate) AddTrackPlays(t ...*TrackPlay) *GuildUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gu.AddTrackPlayIDs(ids...)
+}
+
+// AddPlaylistIDs adds the "playlists" edge to the Playlist entity by IDs.
+func (gu *GuildUpdate) AddPlaylistIDs(ids ...int) *GuildUpdate {
+	gu.mutation.AddPlaylistIDs(ids...)
+	return gu
+}
+
+// AddPlaylists adds the "playlists" edges to the Playlist entity.
+func (gu *GuildUpdate) AddPlaylists(p ...*Playlist) *GuildUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return gu.AddPlaylistIDs(ids...)
+}
+
+// Mutation returns the GuildMutation object of the builder.
+func (gu *GuildUpdate) Mutation() *GuildMutation {
+	return gu.mutation
+}
+
+// ClearMembers clears all "members" edges to the Member entity.
+func (gu *GuildUpdate) ClearMembers() *GuildUpdate {
+	gu.mutation.ClearMembers()
+	return gu
+}
+
+// RemoveMemberIDs removes the "members" edge to Member entities by IDs.
+func (gu *GuildUpdate) RemoveMemberIDs(ids ...int) *GuildUpdate {
+	gu.mutation.RemoveMemberIDs(ids...)
+	return gu
+}
+
+// RemoveMembers removes "members" edges to Member entities.
+func (gu *GuildUpdate) RemoveMembers(m ...*Member) *GuildUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return gu.RemoveMemberIDs(ids...)
+}
+
+// ClearPronunciations clears all "pronunciations" edges to the Pronunciation entity.
+func (gu *GuildUpdate) ClearPronunciations() *GuildUpdate {
+	gu.mutation.ClearPronunciations()
+	return gu
+}
+
+// RemovePronunciationIDs removes the "pronunciations" edge to Pronunciation entities by IDs.
+func (gu *GuildUpdate) RemovePronunciationIDs(ids ...int) *GuildUpdate {
+	gu.mutation.RemovePronunciationIDs(ids...)
+	return gu
+}
+
+// RemovePronunciations removes "pronunciations" edges to Pronunciation entities.
+func (gu *GuildUpdate) RemovePronunciations(p ...*Pronunciation) *GuildUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return gu.RemovePronunciationIDs(ids...)
+}
+
+// ClearCheerSounds clears all "cheer_sounds" edges to the CheerSound entity.
+func (gu *GuildUpdate) ClearCheerSounds() *GuildUpdate {
+	gu.mutation.ClearCheerSounds()
+	return gu
+}
+
+// RemoveCheerSoundIDs removes the "cheer_sounds" edge to CheerSound entities by IDs.
+func (gu *GuildUpdate) RemoveCheerSoundIDs(ids ...int) *GuildUpdate {
+	gu.mutation.RemoveCheerSoundIDs(ids...)
+	return gu
+}
+
+// RemoveCheerSounds removes "cheer_sounds" edges to CheerSound entities.
+func (gu *GuildUpdate) RemoveCheerSounds(c ...*CheerSound) *GuildUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return gu.RemoveCheerSoundIDs(ids...)
+}
+
+// ClearWebhookSubscriptions clears all "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (gu *GuildUpdate) ClearWebhookSubscriptions() *GuildUpdate {
+	gu.mutation.ClearWebhookSubscriptions()
+	return gu
+}
+
+// RemoveWebhookSubscriptionIDs removes the "webhook_subscriptions" edge to WebhookSubscription entities by IDs.
+func (gu *GuildUpdate) RemoveWebhookSubscriptionIDs(ids ...int) *GuildUpdate {
+	gu.mutation.RemoveWebhookSubscriptionIDs(ids...)
+	return gu
+}
+
+// RemoveWebhookSubscriptions removes "webhook_subscriptions" edges to WebhookSubscription entities.
+func (gu *GuildUpdate) RemoveWebhookSubscriptions(w ...*WebhookSubscription) *GuildUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return gu.RemoveWebhookSubscriptionIDs(ids...)
+}
+
+// ClearTrackPlays clears all "track_plays" edges to the TrackPlay entity.
+func (gu *GuildUpdate) ClearTrackPlays() *GuildUpdate {
+	gu.mutation.ClearTrackPlays()
+	return gu
+}
+
+// RemoveTrackPlayIDs removes the "track_plays" edge to TrackPlay entities by IDs.
+func (gu *GuildUpdate) RemoveTrackPlayIDs(ids ...int) *GuildUpdate {
+	gu.mutation.RemoveTrackPlayIDs(ids...)
+	return gu
+}
+
+// RemoveTrackPlays removes "track_plays" edges to TrackPlay entities.
+func (gu *GuildUpdate) RemoveTrackPlays(t ...*TrackPlay) *GuildUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gu.RemoveTrackPlayIDs(ids...)
+}
+
+// ClearPlaylists clears all "playlists" edges to the Playlist entity.
+func (gu *GuildUpdate) ClearPlaylists() *GuildUpdate {
+	gu.mutation.ClearPlaylists()
+	return gu
+}
+
+// RemovePlaylistIDs removes the "playlists" edge to Playlist entities by IDs.
+func (gu *GuildUpdate) RemovePlaylistIDs(ids ...int) *GuildUpdate {
+	gu.mutation.RemovePlaylistIDs(ids...)
+	return gu
+}
+
+// RemovePlaylists removes "playlists" edges to Playlist entities.
+func (gu *GuildUpdate) RemovePlaylists(p ...*Playlist) *GuildUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return gu.RemovePlaylistIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gu *GuildUpdate) Save(ctx context.Context) (int, error) {
+	gu.defaults()
+	return withHooks(ctx, gu.sqlSave, gu.mutation, gu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gu *GuildUpdate) SaveX(ctx context.Context) int {
+	affected, err := gu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gu *GuildUpdate) Exec(ctx context.Context) error {
+	_, err := gu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gu *GuildUpdate) ExecX(ctx context.Context) {
+	if err := gu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gu *GuildUpdate) defaults() {
+	if _, ok := gu.mutation.UpdatedAt(); !ok && !gu.mutation.UpdatedAtCleared() {
+		v := guild.UpdateDefaultUpdatedAt()
+		gu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gu *GuildUpdate) check() error {
+	if v, ok := gu.mutation.IdleTimeout(); ok {
+		if err := guild.IdleTimeoutValidator(v); err != nil {
+			return &ValidationError{Name: "idle_timeout", err: fmt.Errorf(`ent: validator failed for field "Guild.idle_timeout": %w`, err)}
+		}
+	}
+	if v, ok := gu.mutation.AloneTimeout(); ok {
+		if err := guild.AloneTimeoutValidator(v); err != nil {
+			return &ValidationError{Name: "alone_timeout", err: fmt.Errorf(`ent: validator failed for field "Guild.alone_timeout": %w`, err)}
+		}
+	}
+	if v, ok := gu.mutation.TtsRate(); ok {
+		if err := guild.TtsRateValidator(v); err != nil {
+			return &ValidationError{Name: "tts_rate", err: fmt.Errorf(`ent: validator failed for field "Guild.tts_rate": %w`, err)}
+		}
+	}
+	if v, ok := gu.mutation.TtsPitch(); ok {
+		if err := guild.TtsPitchValidator(v); err != nil {
+			return &ValidationError{Name: "tts_pitch", err: fmt.Errorf(`ent: validator failed for field "Guild.tts_pitch": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gu *GuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guild.Table, guild.Columns, sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64))
+	if ps := gu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gu.mutation.Name(); ok {
+		_spec.SetField(guild.FieldName, field.TypeString, value)
+	}
+	if value, ok := gu.mutation.PlayerChannelID(); ok {
+		_spec.SetField(guild.FieldPlayerChannelID, field.TypeUint64, value)
+	}
+	if value, ok := gu.mutation.AddedPlayerChannelID(); ok {
+		_spec.AddField(guild.FieldPlayerChannelID, field.TypeUint64, value)
+	}
+	if gu.mutation.PlayerChannelIDCleared() {
+		_spec.ClearField(guild.FieldPlayerChannelID, field.TypeUint64)
+	}
+	if value, ok := gu.mutation.PlayerMessageID(); ok {
+		_spec.SetField(guild.FieldPlayerMessageID, field.TypeUint64, value)
+	}
+	if value, ok := gu.mutation.AddedPlayerMessageID(); ok {
+		_spec.AddField(guild.FieldPlayerMessageID, field.TypeUint64, value)
+	}
+	if gu.mutation.PlayerMessageIDCleared() {
+		_spec.ClearField(guild.FieldPlayerMessageID, field.TypeUint64)
+	}
+	if value, ok := gu.mutation.IdleTimeout(); ok {
+		_spec.SetField(guild.FieldIdleTimeout, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.AddedIdleTimeout(); ok {
+		_spec.AddField(guild.FieldIdleTimeout, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.AloneTimeout(); ok {
+		_spec.SetField(guild.FieldAloneTimeout, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.AddedAloneTimeout(); ok {
+		_spec.AddField(guild.FieldAloneTimeout, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.Autoplay(); ok {
+		_spec.SetField(guild.FieldAutoplay, field.TypeBool, value)
+	}
+	if value, ok := gu.mutation.AlwaysOn(); ok {
+		_spec.SetField(guild.FieldAlwaysOn, field.TypeBool, value)
+	}
+	if value, ok := gu.mutation.AlwaysOnChannelID(); ok {
+		_spec.SetField(guild.FieldAlwaysOnChannelID, field.TypeUint64, value)
+	}
+	if value, ok := gu.mutation.AddedAlwaysOnChannelID(); ok {
+		_spec.AddField(guild.FieldAlwaysOnChannelID, field.TypeUint64, value)
+	}
+	if gu.mutation.AlwaysOnChannelIDCleared() {
+		_spec.ClearField(guild.FieldAlwaysOnChannelID, field.TypeUint64)
+	}
+	if value, ok := gu.mutation.FallbackQuery(); ok {
+		_spec.SetField(guild.FieldFallbackQuery, field.TypeString, value)
+	}
+	if gu.mutation.FallbackQueryCleared() {
+		_spec.ClearField(guild.FieldFallbackQuery, field.TypeString)
+	}
+	if value, ok := gu.mutation.TtsProvider(); ok {
+		_spec.SetField(guild.FieldTtsProvider, field.TypeString, value)
+	}
+	if value, ok := gu.mutation.TtsLanguage(); ok {
+		_spec.SetField(guild.FieldTtsLanguage, field.TypeString, value)
+	}
+	if value, ok := gu.mutation.TtsVoice(); ok {
+		_spec.SetField(guild.FieldTtsVoice, field.TypeString, value)
+	}
+	if value, ok := gu.mutation.TtsRate(); ok {
+		_spec.SetField(guild.FieldTtsRate, field.TypeFloat64, value)
+	}
+	if value, ok := gu.mutation.AddedTtsRate(); ok {
+		_spec.AddField(guild.FieldTtsRate, field.TypeFloat64, value)
+	}
+	if value, ok := gu.mutation.TtsPitch(); ok {
+		_spec.SetField(guild.FieldTtsPitch, field.TypeFloat64, value)
+	}
+	if value, ok := gu.mutation.AddedTtsPitch(); ok {
+		_spec.AddField(guild.FieldTtsPitch, field.TypeFloat64, value)
+	}
+	if value, ok := gu.mutation.TtsReaderChannelID(); ok {
+		_spec.SetField(guild.FieldTtsReaderChannelID, field.TypeUint64, value)
+	}
+	if value, ok := gu.mutation.AddedTtsReaderChannelID(); ok {
+		_spec.AddField(guild.FieldTtsReaderChannelID, field.TypeUint64, value)
+	}
+	if gu.mutation.TtsReaderChannelIDCleared() {
+		_spec.ClearField(guild.FieldTtsReaderChannelID, field.TypeUint64)
+	}
+	if value, ok := gu.mutation.OverlayToken(); ok {
+		_spec.SetField(guild.FieldOverlayToken, field.TypeString, value)
+	}
+	if gu.mutation.OverlayTokenCleared() {
+		_spec.ClearField(guild.FieldOverlayToken, field.TypeString)
+	}
+	if value, ok := gu.mutation.APIToken(); ok {
+		_spec.SetField(guild.FieldAPIToken, field.TypeString, value)
+	}
+	if gu.mutation.APITokenCleared() {
+		_spec.ClearField(guild.FieldAPIToken, field.TypeString)
+	}
+	if value, ok := gu.mutation.SleepAt(); ok {
+		_spec.SetField(guild.FieldSleepAt, field.TypeTime, value)
+	}
+	if gu.mutation.SleepAtCleared() {
+		_spec.ClearField(guild.FieldSleepAt, field.TypeTime)
+	}
+	if value, ok := gu.mutation.SleepEndOfTrack(); ok {
+		_spec.SetField(guild.FieldSleepEndOfTrack, field.TypeBool, value)
+	}
+	if value, ok := gu.mutation.CreatedAt(); ok {
+		_spec.SetField(guild.FieldCreatedAt, field.TypeTime, value)
+	}
+	if gu.mutation.CreatedAtCleared() {
+		_spec.ClearField(guild.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := gu.mutation.UpdatedAt(); ok {
+		_spec.SetField(guild.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if gu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(guild.FieldUpdatedAt, field.TypeTime)
+	}
+	if gu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedMembersIDs(); len(nodes) > 0 && !gu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.PronunciationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PronunciationsTable,
+			Columns: []string{guild.PronunciationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pronunciation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedPronunciationsIDs(); len(nodes) > 0 && !gu.mutation.PronunciationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PronunciationsTable,
+			Columns: []string{guild.PronunciationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pronunciation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.PronunciationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PronunciationsTable,
+			Columns: []string{guild.PronunciationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pronunciation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.CheerSoundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.CheerSoundsTable,
+			Columns: []string{guild.CheerSoundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cheersound.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedCheerSoundsIDs(); len(nodes) > 0 && !gu.mutation.CheerSoundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.CheerSoundsTable,
+			Columns: []string{guild.CheerSoundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cheersound.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.CheerSoundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.CheerSoundsTable,
+			Columns: []string{guild.CheerSoundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cheersound.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.WebhookSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.WebhookSubscriptionsTable,
+			Columns: []string{guild.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedWebhookSubscriptionsIDs(); len(nodes) > 0 && !gu.mutation.WebhookSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.WebhookSubscriptionsTable,
+			Columns: []string{guild.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.WebhookSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.WebhookSubscriptionsTable,
+			Columns: []string{guild.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.TrackPlaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.TrackPlaysTable,
+			Columns: []string{guild.TrackPlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trackplay.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedTrackPlaysIDs(); len(nodes) > 0 && !gu.mutation.TrackPlaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.TrackPlaysTable,
+			Columns: []string{guild.TrackPlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trackplay.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.TrackPlaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.TrackPlaysTable,
+			Columns: []string{guild.TrackPlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trackplay.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.PlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PlaylistsTable,
+			Columns: []string{guild.PlaylistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedPlaylistsIDs(); len(nodes) > 0 && !gu.mutation.PlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PlaylistsTable,
+			Columns: []string{guild.PlaylistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.PlaylistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PlaylistsTable,
+			Columns: []string{guild.PlaylistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guild.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gu.mutation.done = true
+	return n, nil
+}
+
+// GuildUpdateOne is the builder for updating a single Guild entity.
+type GuildUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GuildMutation
+}
+
+// SetName sets the "name" field.
+func (guo *GuildUpdateOne) SetName(s string) *GuildUpdateOne {
+	guo.mutation.SetName(s)
+	return guo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableName(s *string) *GuildUpdateOne {
+	if s != nil {
+		guo.SetName(*s)
+	}
+	return guo
+}
+
+// SetPlayerChannelID sets the "player_channel_id" field.
+func (guo *GuildUpdateOne) SetPlayerChannelID(s snowflake.ID) *GuildUpdateOne {
+	guo.mutation.ResetPlayerChannelID()
+	guo.mutation.SetPlayerChannelID(s)
+	return guo
+}
+
+// SetNillablePlayerChannelID sets the "player_channel_id" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillablePlayerChannelID(s *snowflake.ID) *GuildUpdateOne {
+	if s != nil {
+		guo.SetPlayerChannelID(*s)
+	}
+	return guo
+}
+
+// AddPlayerChannelID adds s to the "player_channel_id" field.
+func (guo *GuildUpdateOne) AddPlayerChannelID(s snowflake.ID) *GuildUpdateOne {
+	guo.mutation.AddPlayerChannelID(s)
+	return guo
+}
+
+// ClearPlayerChannelID clears the value of the "player_channel_id" field.
+func (guo *GuildUpdateOne) ClearPlayerChannelID() *GuildUpdateOne {
+	guo.mutation.ClearPlayerChannelID()
+	return guo
+}
+
+// SetPlayerMessageID sets the "player_message_id" field.
+func (guo *GuildUpdateOne) SetPlayerMessageID(s snowflake.ID) *GuildUpdateOne {
+	guo.mutation.ResetPlayerMessageID()
+	guo.mutation.SetPlayerMessageID(s)
+	return guo
+}
+
+// SetNillablePlayerMessageID sets the "player_message_id" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillablePlayerMessageID(s *snowflake.ID) *GuildUpdateOne {
+	if s != nil {
+		guo.SetPlayerMessageID(*s)
+	}
+	return guo
+}
+
+// AddPlayerMessageID adds s to the "player_message_id" field.
+func (guo *GuildUpdateOne) AddPlayerMessageID(s snowflake.ID) *GuildUpdateOne {
+	guo.mutation.AddPlayerMessageID(s)
+	return guo
+}
+
+// ClearPlayerMessageID clears the value of the "player_message_id" field.
+func (guo *GuildUpdateOne) ClearPlayerMessageID() *GuildUpdateOne {
+	guo.mutation.ClearPlayerMessageID()
+	return guo
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (guo *GuildUpdateOne) SetIdleTimeout(i int) *GuildUpdateOne {
+	guo.mutation.ResetIdleTimeout()
+	guo.mutation.SetIdleTimeout(i)
+	return guo
+}
+
+// SetNillableIdleTimeout sets the "idle_timeout" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableIdleTimeout(i *int) *GuildUpdateOne {
+	if i != nil {
+		guo.SetIdleTimeout(*i)
+	}
+	return guo
+}
+
+// AddIdleTimeout adds i to the "idle_timeout" field.
+func (guo *GuildUpdateOne) AddIdleTimeout(i int) *GuildUpdateOne {
+	guo.mutation.AddIdleTimeout(i)
+	return guo
+}
+
+// SetAloneTimeout sets the "alone_timeout" field.
+func (guo *GuildUpdateOne) SetAloneTimeout(i int) *GuildUpdateOne {
+	guo.mutation.ResetAloneTimeout()
+	guo.mutation.SetAloneTimeout(i)
+	return guo
+}
+
+// SetNillableAloneTimeout sets the "alone_timeout" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableAloneTimeout(i *int) *GuildUpdateOne {
+	if i != nil {
+		guo.SetAloneTimeout(*i)
+	}
+	return guo
+}
+
+// AddAloneTimeout adds i to the "alone_timeout" field.
+func (guo *GuildUpdateOne) AddAloneTimeout(i int) *GuildUpdateOne {
+	guo.mutation.AddAloneTimeout(i)
+	return guo
+}
+
+// SetAutoplay sets the "autoplay" field.
+func (guo *GuildUpdateOne) SetAutoplay(b bool) *GuildUpdateOne {
+	guo.mutation.SetAutoplay(b)
+	return guo
+}
+
+// SetNillableAutoplay sets the "autoplay" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableAutoplay(b *bool) *GuildUpdateOne {
+	if b != nil {
+		guo.SetAutoplay(*b)
+	}
+	return guo
+}
+
+// SetAlwaysOn sets the "always_on" field.
+func (guo *GuildUpdateOne) SetAlwaysOn(b bool) *GuildUpdateOne {
+	guo.mutation.SetAlwaysOn(b)
+	return guo
+}
+
+// SetNillableAlwaysOn sets the "always_on" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableAlwaysOn(b *bool) *GuildUpdateOne {
+	if b != nil {
+		guo.SetAlwaysOn(*b)
+	}
+	return guo
+}
+
+// SetAlwaysOnChannelID sets the "always_on_channel_id" field.
+func (guo *GuildUpdateOne) SetAlwaysOnChannelID(s snowflake.ID) *GuildUpdateOne {
+	guo.mutation.ResetAlwaysOnChannelID()
+	guo.mutation.SetAlwaysOnChannelID(s)
+	return guo
+}
+
+// SetNillableAlwaysOnChannelID sets the "always_on_channel_id" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableAlwaysOnChannelID(s *snowflake.ID) *GuildUpdateOne {
+	if s != nil {
+		guo.SetAlwaysOnChannelID(*s)
+	}
+	return guo
+}
+
+// AddAlwaysOnChannelID adds s to the "always_on_channel_id" field.
+func (guo *GuildUpdateOne) AddAlwaysOnChannelID(s snowflake.ID) *GuildUpdateOne {
+	guo.mutation.AddAlwaysOnChannelID(s)
+	return guo
+}
+
+// ClearAlwaysOnChannelID clears the value of the "always_on_channel_id" field.
+func (guo *GuildUpdateOne) ClearAlwaysOnChannelID() *GuildUpdateOne {
+	guo.mutation.ClearAlwaysOnChannelID()
+	return guo
+}
+
+// SetFallbackQuery sets the "fallback_query" field.
+func (guo *GuildUpdateOne) SetFallbackQuery(s string) *GuildUpdateOne {
+	guo.mutation.SetFallbackQuery(s)
+	return guo
+}
+
+// SetNillableFallbackQuery sets the "fallback_query" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableFallbackQuery(s *string) *GuildUpdateOne {
+	if s != nil {
+		guo.SetFallbackQuery(*s)
+	}
+	return guo
+}
+
+// ClearFallbackQuery clears the value of the "fallback_query" field.
+func (guo *GuildUpdateOne) ClearFallbackQuery() *GuildUpdateOne {
+	guo.mutation.ClearFallbackQuery()
+	return guo
+}
+
+// SetTtsProvider sets the "tts_provider" field.
+func (guo *GuildUpdateOne) SetTtsProvider(s string) *GuildUpdateOne {
+	guo.mutation.SetTtsProvider(s)
+	return guo
+}
+
+// SetNillableTtsProvider sets the "tts_provider" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableTtsProvider(s *string) *GuildUpdateOne {
+	if s != nil {
+		guo.SetTtsProvider(*s)
+	}
+	return guo
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (guo *GuildUpdateOne) SetTtsLanguage(s string) *GuildUpdateOne {
+	guo.mutation.SetTtsLanguage(s)
+	return guo
+}
+
+// SetNillableTtsLanguage sets the "tts_language" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableTtsLanguage(s *string) *GuildUpdateOne {
+	if s != nil {
+		guo.SetTtsLanguage(*s)
+	}
+	return guo
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (guo *GuildUpdateOne) SetTtsVoice(s string) *GuildUpdateOne {
+	guo.mutation.SetTtsVoice(s)
+	return guo
+}
+
+// SetNillableTtsVoice sets the "tts_voice" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableTtsVoice(s *string) *GuildUpdateOne {
+	if s != nil {
+		guo.SetTtsVoice(*s)
+	}
+	return guo
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (guo *GuildUpdateOne) SetTtsRate(f float64) *GuildUpdateOne {
+	guo.mutation.ResetTtsRate()
+	guo.mutation.SetTtsRate(f)
+	return guo
+}
+
+// SetNillableTtsRate sets the "tts_rate" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableTtsRate(f *float64) *GuildUpdateOne {
+	if f != nil {
+		guo.SetTtsRate(*f)
+	}
+	return guo
+}
+
+// AddTtsRate adds f to the "tts_rate" field.
+func (guo *GuildUpdateOne) AddTtsRate(f float64) *GuildUpdateOne {
+	guo.mutation.AddTtsRate(f)
+	return guo
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (guo *GuildUpdateOne) SetTtsPitch(f float64) *GuildUpdateOne {
+	guo.mutation.ResetTtsPitch()
+	guo.mutation.SetTtsPitch(f)
+	return guo
+}
+
+// SetNillableTtsPitch sets the "tts_pitch" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableTtsPitch(f *float64) *GuildUpdateOne {
+	if f != nil {
+		guo.SetTtsPitch(*f)
+	}
+	return guo
+}
+
+// AddTtsPitch adds f to the "tts_pitch" field.
+func (guo *GuildUpdateOne) AddTtsPitch(f float64) *GuildUpdateOne {
+	guo.mutation.AddTtsPitch(f)
+	return guo
+}
+
+// SetTtsReaderChannelID sets the "tts_reader_channel_id" field.
+func (guo *GuildUpdateOne) SetTtsReaderChannelID(s snowflake.ID) *GuildUpdateOne {
+	guo.mutation.ResetTtsReaderChannelID()
+	guo.mutation.SetTtsReaderChannelID(s)
+	return guo
+}
+
+// SetNillableTtsReaderChannelID sets the "tts_reader_channel_id" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableTtsReaderChannelID(s *snowflake.ID) *GuildUpdateOne {
+	if s != nil {
+		guo.SetTtsReaderChannelID(*s)
+	}
+	return guo
+}
+
+// AddTtsReaderChannelID adds s to the "tts_reader_channel_id" field.
+func (guo *GuildUpdateOne) AddTtsReaderChannelID(s snowflake.ID) *GuildUpdateOne {
+	guo.mutation.AddTtsReaderChannelID(s)
+	return guo
+}
+
+// ClearTtsReaderChannelID clears the value of the "tts_reader_channel_id" field.
+func (guo *GuildUpdateOne) ClearTtsReaderChannelID() *GuildUpdateOne {
+	guo.mutation.ClearTtsReaderChannelID()
+	return guo
+}
+
+// SetOverlayToken sets the "overlay_token" field.
+func (guo *GuildUpdateOne) SetOverlayToken(s string) *GuildUpdateOne {
+	guo.mutation.SetOverlayToken(s)
+	return guo
+}
+
+// SetNillableOverlayToken sets the "overlay_token" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableOverlayToken(s *string) *GuildUpdateOne {
+	if s != nil {
+		guo.SetOverlayToken(*s)
+	}
+	return guo
+}
+
+// ClearOverlayToken clears the value of the "overlay_token" field.
+func (guo *GuildUpdateOne) ClearOverlayToken() *GuildUpdateOne {
+	guo.mutation.ClearOverlayToken()
+	return guo
+}
+
+// SetAPIToken sets the "api_token" field.
+func (guo *GuildUpdateOne) SetAPIToken(s string) *GuildUpdateOne {
+	guo.mutation.SetAPIToken(s)
+	return guo
+}
+
+// SetNillableAPIToken sets the "api_token" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableAPIToken(s *string) *GuildUpdateOne {
+	if s != nil {
+		guo.SetAPIToken(*s)
+	}
+	return guo
+}
+
+// ClearAPIToken clears the value of the "api_token" field.
+func (guo *GuildUpdateOne) ClearAPIToken() *GuildUpdateOne {
+	guo.mutation.ClearAPIToken()
+	return guo
+}
+
+// SetSleepAt sets the "sleep_at" field.
+func (guo *GuildUpdateOne) SetSleepAt(t time.Time) *GuildUpdateOne {
+	guo.mutation.SetSleepAt(t)
+	return guo
+}
+
+// SetNillableSleepAt sets the "sleep_at" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableSleepAt(t *time.Time) *GuildUpdateOne {
+	if t != nil {
+		guo.SetSleepAt(*t)
+	}
+	return guo
+}
+
+// ClearSleepAt clears the value of the "sleep_at" field.
+func (guo *GuildUpdateOne) ClearSleepAt() *GuildUpdateOne {
+	guo.mutation.ClearSleepAt()
+	return guo
+}
+
+// SetSleepEndOfTrack sets the "sleep_end_of_track" field.
+func (guo *GuildUpdateOne) SetSleepEndOfTrack(b bool) *GuildUpdateOne {
+	guo.mutation.SetSleepEndOfTrack(b)
+	return guo
+}
+
+// SetNillableSleepEndOfTrack sets the "sleep_end_of_track" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableSleepEndOfTrack(b *bool) *GuildUpdateOne {
+	if b != nil {
+		guo.SetSleepEndOfTrack(*b)
+	}
+	return guo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (guo *GuildUpdateOne) SetCreatedAt(t time.Time) *GuildUpdateOne {
+	guo.mutation.SetCreatedAt(t)
+	return guo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (guo *GuildUpdateOne) SetNillableCreatedAt(t *time.Time) *GuildUpdateOne {
 	if t != nil {
-		gu.SetCreatedAt(*t)
+		guo.SetCreatedAt(*t)
 	}
-	return gu
+	return guo
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (guo *GuildUpdateOne) ClearCreatedAt() *GuildUpdateOne {
+	guo.mutation.ClearCreatedAt()
+	return guo
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (gu *GuildUpdate) SetUpdatedAt(t time.Time) *GuildUpdate {
-	gu.mutation.SetUpdatedAt(t)
-	return gu
+func (guo *GuildUpdateOne) SetUpdatedAt(t time.Time) *GuildUpdateOne {
+	guo.mutation.SetUpdatedAt(t)
+	return guo
 }
 
-// Mutation returns the GuildMutation object of the builder.
-func (gu *GuildUpdate) Mutation() *GuildMutation {
-	return gu.mutation
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (guo *GuildUpdateOne) ClearUpdatedAt() *GuildUpdateOne {
+	guo.mutation.ClearUpdatedAt()
+	return guo
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (gu *GuildUpdate) Save(ctx context.Context) (int, error) {
-	gu.defaults()
-	return withHooks[int, GuildMutation](ctx, gu.sqlSave, gu.mutation, gu.hooks)
+// AddMemberIDs adds the "members" edge to the Member entity by IDs.
+func (guo *GuildUpdateOne) AddMemberIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.AddMemberIDs(ids...)
+	return guo
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (gu *GuildUpdate) SaveX(ctx context.Context) int {
-	affected, err := gu.Save(ctx)
-	if err != nil {
-		panic(err)
+// AddMembers adds the "members" edges to the Member entity.
+func (guo *GuildUpdateOne) AddMembers(m ...*Member) *GuildUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return affected
+	return guo.AddMemberIDs(ids...)
 }
 
-// Exec executes the query.
-func (gu *GuildUpdate) Exec(ctx context.Context) error {
-	_, err := gu.Save(ctx)
-	return err
+// AddPronunciationIDs adds the "pronunciations" edge to the Pronunciation entity by IDs.
+func (guo *GuildUpdateOne) AddPronunciationIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.AddPronunciationIDs(ids...)
+	return guo
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (gu *GuildUpdate) ExecX(ctx context.Context) {
-	if err := gu.Exec(ctx); err != nil {
-		panic(err)
+// AddPronunciations adds the "pronunciations" edges to the Pronunciation entity.
+func (guo *GuildUpdateOne) AddPronunciations(p ...*Pronunciation) *GuildUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
+	return guo.AddPronunciationIDs(ids...)
 }
 
-// defaults sets the default values of the builder before save.
-func (gu *GuildUpdate) defaults() {
-	if _, ok := gu.mutation.UpdatedAt(); !ok {
-		v := guild.UpdateDefaultUpdatedAt()
-		gu.mutation.SetUpdatedAt(v)
-	}
+// AddCheerSoundIDs adds the "cheer_sounds" edge to the CheerSound entity by IDs.
+func (guo *GuildUpdateOne) AddCheerSoundIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.AddCheerSoundIDs(ids...)
+	return guo
 }
 
-func (gu *GuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(guild.Table, guild.Columns, sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64))
-	if ps := gu.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := gu.mutation.Name(); ok {
-		_spec.SetField(guild.FieldName, field.TypeString, value)
-	}
-	if value, ok := gu.mutation.PlayerChannelID(); ok {
-		_spec.SetField(guild.FieldPlayerChannelID, field.TypeUint64, value)
-	}
-	if value, ok := gu.mutation.AddedPlayerChannelID(); ok {
-		_spec.AddField(guild.FieldPlayerChannelID, field.TypeUint64, value)
-	}
-	if gu.mutation.PlayerChannelIDCleared() {
-		_spec.ClearField(guild.FieldPlayerChannelID, field.TypeUint64)
+// AddCheerSounds adds the "cheer_sounds" edges to the CheerSound entity.
+func (guo *GuildUpdateOne) AddCheerSounds(c ...*CheerSound) *GuildUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
-	if value, ok := gu.mutation.PlayerMessageID(); ok {
-		_spec.SetField(guild.FieldPlayerMessageID, field.TypeUint64, value)
-	}
-	if value, ok := gu.mutation.AddedPlayerMessageID(); ok {
-		_spec.AddField(guild.FieldPlayerMessageID, field.TypeUint64, value)
-	}
-	if gu.mutation.PlayerMessageIDCleared() {
-		_spec.ClearField(guild.FieldPlayerMessageID, field.TypeUint64)
-	}
-	if value, ok := gu.mutation.CreatedAt(); ok {
-		_spec.SetField(guild.FieldCreatedAt, field.TypeTime, value)
+	return guo.AddCheerSoundIDs(ids...)
+}
+
+// AddWebhookSubscriptionIDs adds the "webhook_subscriptions" edge to the WebhookSubscription entity by IDs.
+func (guo *GuildUpdateOne) AddWebhookSubscriptionIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.AddWebhookSubscriptionIDs(ids...)
+	return guo
+}
+
+// AddWebhookSubscriptions adds the "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (guo *GuildUpdateOne) AddWebhookSubscriptions(w ...*WebhookSubscription) *GuildUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
 	}
-	if value, ok := gu.mutation.UpdatedAt(); ok {
-		_spec.SetField(guild.FieldUpdatedAt, field.TypeTime, value)
+	return guo.AddWebhookSubscriptionIDs(ids...)
+}
+
+// AddTrackPlayIDs adds the "track_plays" edge to the TrackPlay entity by IDs.
+func (guo *GuildUpdateOne) AddTrackPlayIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.AddTrackPlayIDs(ids...)
+	return guo
+}
+
+// AddTrackPlays adds the "track_plays" edges to the TrackPlay entity.
+func (guo *GuildUpdateOne) AddTrackPlays(t ...*TrackPlay) *GuildUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{guild.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return guo.AddTrackPlayIDs(ids...)
+}
+
+// AddPlaylistIDs adds the "playlists" edge to the Playlist entity by IDs.
+func (guo *GuildUpdateOne) AddPlaylistIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.AddPlaylistIDs(ids...)
+	return guo
+}
+
+// AddPlaylists adds the "playlists" edges to the Playlist entity.
+func (guo *GuildUpdateOne) AddPlaylists(p ...*Playlist) *GuildUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	gu.mutation.done = true
-	return n, nil
+	return guo.AddPlaylistIDs(ids...)
 }
 
-// GuildUpdateOne is the builder for updating a single Guild entity.
-type GuildUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *GuildMutation
+// Mutation returns the GuildMutation object of the builder.
+func (guo *GuildUpdateOne) Mutation() *GuildMutation {
+	return guo.mutation
 }
 
-// SetName sets the "name" field.
-func (guo *GuildUpdateOne) SetName(s string) *GuildUpdateOne {
-	guo.mutation.SetName(s)
+// ClearMembers clears all "members" edges to the Member entity.
+func (guo *GuildUpdateOne) ClearMembers() *GuildUpdateOne {
+	guo.mutation.ClearMembers()
 	return guo
 }
 
-// SetPlayerChannelID sets the "player_channel_id" field.
-func (guo *GuildUpdateOne) SetPlayerChannelID(s snowflake.ID) *GuildUpdateOne {
-	guo.mutation.ResetPlayerChannelID()
-	guo.mutation.SetPlayerChannelID(s)
+// RemoveMemberIDs removes the "members" edge to Member entities by IDs.
+func (guo *GuildUpdateOne) RemoveMemberIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.RemoveMemberIDs(ids...)
 	return guo
 }
 
-// SetNillablePlayerChannelID sets the "player_channel_id" field if the given value is not nil.
-func (guo *GuildUpdateOne) SetNillablePlayerChannelID(s *snowflake.ID) *GuildUpdateOne {
-	if s != nil {
-		guo.SetPlayerChannelID(*s)
+// RemoveMembers removes "members" edges to Member entities.
+func (guo *GuildUpdateOne) RemoveMembers(m ...*Member) *GuildUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
+	return guo.RemoveMemberIDs(ids...)
+}
+
+// ClearPronunciations clears all "pronunciations" edges to the Pronunciation entity.
+func (guo *GuildUpdateOne) ClearPronunciations() *GuildUpdateOne {
+	guo.mutation.ClearPronunciations()
 	return guo
 }
 
-// AddPlayerChannelID adds s to the "player_channel_id" field.
-func (guo *GuildUpdateOne) AddPlayerChannelID(s snowflake.ID) *GuildUpdateOne {
-	guo.mutation.AddPlayerChannelID(s)
+// RemovePronunciationIDs removes the "pronunciations" edge to Pronunciation entities by IDs.
+func (guo *GuildUpdateOne) RemovePronunciationIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.RemovePronunciationIDs(ids...)
 	return guo
 }
 
-// ClearPlayerChannelID clears the value of the "player_channel_id" field.
-func (guo *GuildUpdateOne) ClearPlayerChannelID() *GuildUpdateOne {
-	guo.mutation.ClearPlayerChannelID()
+// RemovePronunciations removes "pronunciations" edges to Pronunciation entities.
+func (guo *GuildUpdateOne) RemovePronunciations(p ...*Pronunciation) *GuildUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return guo.RemovePronunciationIDs(ids...)
+}
+
+// ClearCheerSounds clears all "cheer_sounds" edges to the CheerSound entity.
+func (guo *GuildUpdateOne) ClearCheerSounds() *GuildUpdateOne {
+	guo.mutation.ClearCheerSounds()
 	return guo
 }
 
-// SetPlayerMessageID sets the "player_message_id" field.
-func (guo *GuildUpdateOne) SetPlayerMessageID(s snowflake.ID) *GuildUpdateOne {
-	guo.mutation.ResetPlayerMessageID()
-	guo.mutation.SetPlayerMessageID(s)
+// RemoveCheerSoundIDs removes the "cheer_sounds" edge to CheerSound entities by IDs.
+func (guo *GuildUpdateOne) RemoveCheerSoundIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.RemoveCheerSoundIDs(ids...)
 	return guo
 }
 
-// SetNillablePlayerMessageID sets the "player_message_id" field if the given value is not nil.
-func (guo *GuildUpdateOne) SetNillablePlayerMessageID(s *snowflake.ID) *GuildUpdateOne {
-	if s != nil {
-		guo.SetPlayerMessageID(*s)
+// RemoveCheerSounds removes "cheer_sounds" edges to CheerSound entities.
+func (guo *GuildUpdateOne) RemoveCheerSounds(c ...*CheerSound) *GuildUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
+	return guo.RemoveCheerSoundIDs(ids...)
+}
+
+// ClearWebhookSubscriptions clears all "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (guo *GuildUpdateOne) ClearWebhookSubscriptions() *GuildUpdateOne {
+	guo.mutation.ClearWebhookSubscriptions()
 	return guo
 }
 
-// AddPlayerMessageID adds s to the "player_message_id" field.
-func (guo *GuildUpdateOne) AddPlayerMessageID(s snowflake.ID) *GuildUpdateOne {
-	guo.mutation.AddPlayerMessageID(s)
+// RemoveWebhookSubscriptionIDs removes the "webhook_subscriptions" edge to WebhookSubscription entities by IDs.
+func (guo *GuildUpdateOne) RemoveWebhookSubscriptionIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.RemoveWebhookSubscriptionIDs(ids...)
 	return guo
 }
 
-// ClearPlayerMessageID clears the value of the "player_message_id" field.
-func (guo *GuildUpdateOne) ClearPlayerMessageID() *GuildUpdateOne {
-	guo.mutation.ClearPlayerMessageID()
+// RemoveWebhookSubscriptions removes "webhook_subscriptions" edges to WebhookSubscription entities.
+func (guo *GuildUpdateOne) RemoveWebhookSubscriptions(w ...*WebhookSubscription) *GuildUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return guo.RemoveWebhookSubscriptionIDs(ids...)
+}
+
+// ClearTrackPlays clears all "track_plays" edges to the TrackPlay entity.
+func (guo *GuildUpdateOne) ClearTrackPlays() *GuildUpdateOne {
+	guo.mutation.ClearTrackPlays()
 	return guo
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (guo *GuildUpdateOne) SetCreatedAt(t time.Time) *GuildUpdateOne {
-	guo.mutation.SetCreatedAt(t)
+// RemoveTrackPlayIDs removes the "track_plays" edge to TrackPlay entities by IDs.
+func (guo *GuildUpdateOne) RemoveTrackPlayIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.RemoveTrackPlayIDs(ids...)
 	return guo
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (guo *GuildUpdateOne) SetNillableCreatedAt(t *time.Time) *GuildUpdateOne {
-	if t != nil {
-		guo.SetCreatedAt(*t)
+// RemoveTrackPlays removes "track_plays" edges to TrackPlay entities.
+func (guo *GuildUpdateOne) RemoveTrackPlays(t ...*TrackPlay) *GuildUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return guo.RemoveTrackPlayIDs(ids...)
+}
+
+// ClearPlaylists clears all "playlists" edges to the Playlist entity.
+func (guo *GuildUpdateOne) ClearPlaylists() *GuildUpdateOne {
+	guo.mutation.ClearPlaylists()
 	return guo
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (guo *GuildUpdateOne) SetUpdatedAt(t time.Time) *GuildUpdateOne {
-	guo.mutation.SetUpdatedAt(t)
+// RemovePlaylistIDs removes the "playlists" edge to Playlist entities by IDs.
+func (guo *GuildUpdateOne) RemovePlaylistIDs(ids ...int) *GuildUpdateOne {
+	guo.mutation.RemovePlaylistIDs(ids...)
 	return guo
 }
 
-// Mutation returns the GuildMutation object of the builder.
-func (guo *GuildUpdateOne) Mutation() *GuildMutation {
-	return guo.mutation
+// RemovePlaylists removes "playlists" edges to Playlist entities.
+func (guo *GuildUpdateOne) RemovePlaylists(p ...*Playlist) *GuildUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return guo.RemovePlaylistIDs(ids...)
 }
 
 // Where appends a list predicates to the GuildUpdate builder.
@@ -307,7 +1777,7 @@ func (guo *GuildUpdateOne) Select(field string, fields ...string) *GuildUpdateOn
 // Save executes the query and returns the updated Guild entity.
 func (guo *GuildUpdateOne) Save(ctx context.Context) (*Guild, error) {
 	guo.defaults()
-	return withHooks[*Guild, GuildMutation](ctx, guo.sqlSave, guo.mutation, guo.hooks)
+	return withHooks(ctx, guo.sqlSave, guo.mutation, guo.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
@@ -334,13 +1804,41 @@ func (guo *GuildUpdateOne) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (guo *GuildUpdateOne) defaults() {
-	if _, ok := guo.mutation.UpdatedAt(); !ok {
+	if _, ok := guo.mutation.UpdatedAt(); !ok && !guo.mutation.UpdatedAtCleared() {
 		v := guild.UpdateDefaultUpdatedAt()
 		guo.mutation.SetUpdatedAt(v)
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (guo *GuildUpdateOne) check() error {
+	if v, ok := guo.mutation.IdleTimeout(); ok {
+		if err := guild.IdleTimeoutValidator(v); err != nil {
+			return &ValidationError{Name: "idle_timeout", err: fmt.Errorf(`ent: validator failed for field "Guild.idle_timeout": %w`, err)}
+		}
+	}
+	if v, ok := guo.mutation.AloneTimeout(); ok {
+		if err := guild.AloneTimeoutValidator(v); err != nil {
+			return &ValidationError{Name: "alone_timeout", err: fmt.Errorf(`ent: validator failed for field "Guild.alone_timeout": %w`, err)}
+		}
+	}
+	if v, ok := guo.mutation.TtsRate(); ok {
+		if err := guild.TtsRateValidator(v); err != nil {
+			return &ValidationError{Name: "tts_rate", err: fmt.Errorf(`ent: validator failed for field "Guild.tts_rate": %w`, err)}
+		}
+	}
+	if v, ok := guo.mutation.TtsPitch(); ok {
+		if err := guild.TtsPitchValidator(v); err != nil {
+			return &ValidationError{Name: "tts_pitch", err: fmt.Errorf(`ent: validator failed for field "Guild.tts_pitch": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (guo *GuildUpdateOne) sqlSave(ctx context.Context) (_node *Guild, err error) {
+	if err := guo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(guild.Table, guild.Columns, sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64))
 	id, ok := guo.mutation.ID()
 	if !ok {
@@ -387,12 +1885,372 @@ func (guo *GuildUpdateOne) sqlSave(ctx context.Context) (_node *Guild, err error
 	if guo.mutation.PlayerMessageIDCleared() {
 		_spec.ClearField(guild.FieldPlayerMessageID, field.TypeUint64)
 	}
+	if value, ok := guo.mutation.IdleTimeout(); ok {
+		_spec.SetField(guild.FieldIdleTimeout, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.AddedIdleTimeout(); ok {
+		_spec.AddField(guild.FieldIdleTimeout, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.AloneTimeout(); ok {
+		_spec.SetField(guild.FieldAloneTimeout, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.AddedAloneTimeout(); ok {
+		_spec.AddField(guild.FieldAloneTimeout, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.Autoplay(); ok {
+		_spec.SetField(guild.FieldAutoplay, field.TypeBool, value)
+	}
+	if value, ok := guo.mutation.AlwaysOn(); ok {
+		_spec.SetField(guild.FieldAlwaysOn, field.TypeBool, value)
+	}
+	if value, ok := guo.mutation.AlwaysOnChannelID(); ok {
+		_spec.SetField(guild.FieldAlwaysOnChannelID, field.TypeUint64, value)
+	}
+	if value, ok := guo.mutation.AddedAlwaysOnChannelID(); ok {
+		_spec.AddField(guild.FieldAlwaysOnChannelID, field.TypeUint64, value)
+	}
+	if guo.mutation.AlwaysOnChannelIDCleared() {
+		_spec.ClearField(guild.FieldAlwaysOnChannelID, field.TypeUint64)
+	}
+	if value, ok := guo.mutation.FallbackQuery(); ok {
+		_spec.SetField(guild.FieldFallbackQuery, field.TypeString, value)
+	}
+	if guo.mutation.FallbackQueryCleared() {
+		_spec.ClearField(guild.FieldFallbackQuery, field.TypeString)
+	}
+	if value, ok := guo.mutation.TtsProvider(); ok {
+		_spec.SetField(guild.FieldTtsProvider, field.TypeString, value)
+	}
+	if value, ok := guo.mutation.TtsLanguage(); ok {
+		_spec.SetField(guild.FieldTtsLanguage, field.TypeString, value)
+	}
+	if value, ok := guo.mutation.TtsVoice(); ok {
+		_spec.SetField(guild.FieldTtsVoice, field.TypeString, value)
+	}
+	if value, ok := guo.mutation.TtsRate(); ok {
+		_spec.SetField(guild.FieldTtsRate, field.TypeFloat64, value)
+	}
+	if value, ok := guo.mutation.AddedTtsRate(); ok {
+		_spec.AddField(guild.FieldTtsRate, field.TypeFloat64, value)
+	}
+	if value, ok := guo.mutation.TtsPitch(); ok {
+		_spec.SetField(guild.FieldTtsPitch, field.TypeFloat64, value)
+	}
+	if value, ok := guo.mutation.AddedTtsPitch(); ok {
+		_spec.AddField(guild.FieldTtsPitch, field.TypeFloat64, value)
+	}
+	if value, ok := guo.mutation.TtsReaderChannelID(); ok {
+		_spec.SetField(guild.FieldTtsReaderChannelID, field.TypeUint64, value)
+	}
+	if value, ok := guo.mutation.AddedTtsReaderChannelID(); ok {
+		_spec.AddField(guild.FieldTtsReaderChannelID, field.TypeUint64, value)
+	}
+	if guo.mutation.TtsReaderChannelIDCleared() {
+		_spec.ClearField(guild.FieldTtsReaderChannelID, field.TypeUint64)
+	}
+	if value, ok := guo.mutation.OverlayToken(); ok {
+		_spec.SetField(guild.FieldOverlayToken, field.TypeString, value)
+	}
+	if guo.mutation.OverlayTokenCleared() {
+		_spec.ClearField(guild.FieldOverlayToken, field.TypeString)
+	}
+	if value, ok := guo.mutation.APIToken(); ok {
+		_spec.SetField(guild.FieldAPIToken, field.TypeString, value)
+	}
+	if guo.mutation.APITokenCleared() {
+		_spec.ClearField(guild.FieldAPIToken, field.TypeString)
+	}
+	if value, ok := guo.mutation.SleepAt(); ok {
+		_spec.SetField(guild.FieldSleepAt, field.TypeTime, value)
+	}
+	if guo.mutation.SleepAtCleared() {
+		_spec.ClearField(guild.FieldSleepAt, field.TypeTime)
+	}
+	if value, ok := guo.mutation.SleepEndOfTrack(); ok {
+		_spec.SetField(guild.FieldSleepEndOfTrack, field.TypeBool, value)
+	}
 	if value, ok := guo.mutation.CreatedAt(); ok {
 		_spec.SetField(guild.FieldCreatedAt, field.TypeTime, value)
 	}
+	if guo.mutation.CreatedAtCleared() {
+		_spec.ClearField(guild.FieldCreatedAt, field.TypeTime)
+	}
 	if value, ok := guo.mutation.UpdatedAt(); ok {
 		_spec.SetField(guild.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if guo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(guild.FieldUpdatedAt, field.TypeTime)
+	}
+	if guo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !guo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.MembersTable,
+			Columns: []string{guild.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.PronunciationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PronunciationsTable,
+			Columns: []string{guild.PronunciationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pronunciation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedPronunciationsIDs(); len(nodes) > 0 && !guo.mutation.PronunciationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PronunciationsTable,
+			Columns: []string{guild.PronunciationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pronunciation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.PronunciationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PronunciationsTable,
+			Columns: []string{guild.PronunciationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pronunciation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.CheerSoundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.CheerSoundsTable,
+			Columns: []string{guild.CheerSoundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cheersound.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedCheerSoundsIDs(); len(nodes) > 0 && !guo.mutation.CheerSoundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.CheerSoundsTable,
+			Columns: []string{guild.CheerSoundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cheersound.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.CheerSoundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.CheerSoundsTable,
+			Columns: []string{guild.CheerSoundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cheersound.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.WebhookSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.WebhookSubscriptionsTable,
+			Columns: []string{guild.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedWebhookSubscriptionsIDs(); len(nodes) > 0 && !guo.mutation.WebhookSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.WebhookSubscriptionsTable,
+			Columns: []string{guild.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.WebhookSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.WebhookSubscriptionsTable,
+			Columns: []string{guild.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.TrackPlaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.TrackPlaysTable,
+			Columns: []string{guild.TrackPlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trackplay.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedTrackPlaysIDs(); len(nodes) > 0 && !guo.mutation.TrackPlaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.TrackPlaysTable,
+			Columns: []string{guild.TrackPlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trackplay.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.TrackPlaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.TrackPlaysTable,
+			Columns: []string{guild.TrackPlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trackplay.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.PlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PlaylistsTable,
+			Columns: []string{guild.PlaylistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedPlaylistsIDs(); len(nodes) > 0 && !guo.mutation.PlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PlaylistsTable,
+			Columns: []string{guild.PlaylistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.PlaylistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   guild.PlaylistsTable,
+			Columns: []string{guild.PlaylistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Guild{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/member"
+)
+
+// Member is the model entity for the Member schema.
+type Member struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID snowflake.ID `json:"guild_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID snowflake.ID `json:"user_id,omitempty"`
+	// TtsLanguage holds the value of the "tts_language" field.
+	TtsLanguage *string `json:"tts_language,omitempty"`
+	// TtsVoice holds the value of the "tts_voice" field.
+	TtsVoice *string `json:"tts_voice,omitempty"`
+	// TtsRate holds the value of the "tts_rate" field.
+	TtsRate *float64 `json:"tts_rate,omitempty"`
+	// TtsPitch holds the value of the "tts_pitch" field.
+	TtsPitch *float64 `json:"tts_pitch,omitempty"`
+	// TtsReaderOptOut holds the value of the "tts_reader_opt_out" field.
+	TtsReaderOptOut bool `json:"tts_reader_opt_out,omitempty"`
+	// BitsDonated holds the value of the "bits_donated" field.
+	BitsDonated int64 `json:"bits_donated,omitempty"`
+	// Points holds the value of the "points" field.
+	Points int64 `json:"points,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MemberQuery when eager-loading is set.
+	Edges        MemberEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MemberEdges holds the relations/edges for other nodes in the graph.
+type MemberEdges struct {
+	// Guild holds the value of the guild edge.
+	Guild *Guild `json:"guild,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GuildOrErr returns the Guild value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MemberEdges) GuildOrErr() (*Guild, error) {
+	if e.Guild != nil {
+		return e.Guild, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: guild.Label}
+	}
+	return nil, &NotLoadedError{edge: "guild"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Member) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case member.FieldTtsReaderOptOut:
+			values[i] = new(sql.NullBool)
+		case member.FieldTtsRate, member.FieldTtsPitch:
+			values[i] = new(sql.NullFloat64)
+		case member.FieldID, member.FieldGuildID, member.FieldUserID, member.FieldBitsDonated, member.FieldPoints:
+			values[i] = new(sql.NullInt64)
+		case member.FieldTtsLanguage, member.FieldTtsVoice:
+			values[i] = new(sql.NullString)
+		case member.FieldCreatedAt, member.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Member fields.
+func (m *Member) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case member.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			m.ID = int(value.Int64)
+		case member.FieldGuildID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				m.GuildID = snowflake.ID(value.Int64)
+			}
+		case member.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				m.UserID = snowflake.ID(value.Int64)
+			}
+		case member.FieldTtsLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tts_language", values[i])
+			} else if value.Valid {
+				m.TtsLanguage = new(string)
+				*m.TtsLanguage = value.String
+			}
+		case member.FieldTtsVoice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tts_voice", values[i])
+			} else if value.Valid {
+				m.TtsVoice = new(string)
+				*m.TtsVoice = value.String
+			}
+		case member.FieldTtsRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tts_rate", values[i])
+			} else if value.Valid {
+				m.TtsRate = new(float64)
+				*m.TtsRate = value.Float64
+			}
+		case member.FieldTtsPitch:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tts_pitch", values[i])
+			} else if value.Valid {
+				m.TtsPitch = new(float64)
+				*m.TtsPitch = value.Float64
+			}
+		case member.FieldTtsReaderOptOut:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field tts_reader_opt_out", values[i])
+			} else if value.Valid {
+				m.TtsReaderOptOut = value.Bool
+			}
+		case member.FieldBitsDonated:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bits_donated", values[i])
+			} else if value.Valid {
+				m.BitsDonated = value.Int64
+			}
+		case member.FieldPoints:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field points", values[i])
+			} else if value.Valid {
+				m.Points = value.Int64
+			}
+		case member.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		case member.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				m.UpdatedAt = value.Time
+			}
+		default:
+			m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Member.
+// This includes values selected through modifiers, order, etc.
+func (m *Member) Value(name string) (ent.Value, error) {
+	return m.selectValues.Get(name)
+}
+
+// QueryGuild queries the "guild" edge of the Member entity.
+func (m *Member) QueryGuild() *GuildQuery {
+	return NewMemberClient(m.config).QueryGuild(m)
+}
+
+// Update returns a builder for updating this Member.
+// Note that you need to call Member.Unwrap() before calling this method if this Member
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Member) Update() *MemberUpdateOne {
+	return NewMemberClient(m.config).UpdateOne(m)
+}
+
+// Unwrap unwraps the Member entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Member) Unwrap() *Member {
+	_tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Member is not a transactional entity")
+	}
+	m.config.driver = _tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Member) String() string {
+	var builder strings.Builder
+	builder.WriteString("Member(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(fmt.Sprintf("%v", m.GuildID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", m.UserID))
+	builder.WriteString(", ")
+	if v := m.TtsLanguage; v != nil {
+		builder.WriteString("tts_language=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := m.TtsVoice; v != nil {
+		builder.WriteString("tts_voice=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := m.TtsRate; v != nil {
+		builder.WriteString("tts_rate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.TtsPitch; v != nil {
+		builder.WriteString("tts_pitch=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tts_reader_opt_out=")
+	builder.WriteString(fmt.Sprintf("%v", m.TtsReaderOptOut))
+	builder.WriteString(", ")
+	builder.WriteString("bits_donated=")
+	builder.WriteString(fmt.Sprintf("%v", m.BitsDonated))
+	builder.WriteString(", ")
+	builder.WriteString("points=")
+	builder.WriteString(fmt.Sprintf("%v", m.Points))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Members is a parsable slice of Member.
+type Members []*Member
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package member
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the member type in the database.
+	Label = "member"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGuildID holds the string denoting the guild_id field in the database.
+	FieldGuildID = "guild_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldTtsLanguage holds the string denoting the tts_language field in the database.
+	FieldTtsLanguage = "tts_language"
+	// FieldTtsVoice holds the string denoting the tts_voice field in the database.
+	FieldTtsVoice = "tts_voice"
+	// FieldTtsRate holds the string denoting the tts_rate field in the database.
+	FieldTtsRate = "tts_rate"
+	// FieldTtsPitch holds the string denoting the tts_pitch field in the database.
+	FieldTtsPitch = "tts_pitch"
+	// FieldTtsReaderOptOut holds the string denoting the tts_reader_opt_out field in the database.
+	FieldTtsReaderOptOut = "tts_reader_opt_out"
+	// FieldBitsDonated holds the string denoting the bits_donated field in the database.
+	FieldBitsDonated = "bits_donated"
+	// FieldPoints holds the string denoting the points field in the database.
+	FieldPoints = "points"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeGuild holds the string denoting the guild edge name in mutations.
+	EdgeGuild = "guild"
+	// Table holds the table name of the member in the database.
+	Table = "members"
+	// GuildTable is the table that holds the guild relation/edge.
+	GuildTable = "members"
+	// GuildInverseTable is the table name for the Guild entity.
+	// It exists in this package in order to avoid circular dependency with the "guild" package.
+	GuildInverseTable = "guilds"
+	// GuildColumn is the table column denoting the guild relation/edge.
+	GuildColumn = "guild_id"
+)
+
+// Columns holds all SQL columns for member fields.
+var Columns = []string{
+	FieldID,
+	FieldGuildID,
+	FieldUserID,
+	FieldTtsLanguage,
+	FieldTtsVoice,
+	FieldTtsRate,
+	FieldTtsPitch,
+	FieldTtsReaderOptOut,
+	FieldBitsDonated,
+	FieldPoints,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTtsReaderOptOut holds the default value on creation for the "tts_reader_opt_out" field.
+	DefaultTtsReaderOptOut bool
+	// DefaultBitsDonated holds the default value on creation for the "bits_donated" field.
+	DefaultBitsDonated int64
+	// BitsDonatedValidator is a validator for the "bits_donated" field. It is called by the builders before save.
+	BitsDonatedValidator func(int64) error
+	// DefaultPoints holds the default value on creation for the "points" field.
+	DefaultPoints int64
+	// PointsValidator is a validator for the "points" field. It is called by the builders before save.
+	PointsValidator func(int64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Member queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGuildID orders the results by the guild_id field.
+func ByGuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuildID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByTtsLanguage orders the results by the tts_language field.
+func ByTtsLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTtsLanguage, opts...).ToFunc()
+}
+
+// ByTtsVoice orders the results by the tts_voice field.
+func ByTtsVoice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTtsVoice, opts...).ToFunc()
+}
+
+// ByTtsRate orders the results by the tts_rate field.
+func ByTtsRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTtsRate, opts...).ToFunc()
+}
+
+// ByTtsPitch orders the results by the tts_pitch field.
+func ByTtsPitch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTtsPitch, opts...).ToFunc()
+}
+
+// ByTtsReaderOptOut orders the results by the tts_reader_opt_out field.
+func ByTtsReaderOptOut(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTtsReaderOptOut, opts...).ToFunc()
+}
+
+// ByBitsDonated orders the results by the bits_donated field.
+func ByBitsDonated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBitsDonated, opts...).ToFunc()
+}
+
+// ByPoints orders the results by the points field.
+func ByPoints(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPoints, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByGuildField orders the results by guild field.
+func ByGuildField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGuildStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newGuildStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GuildInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GuildTable, GuildColumn),
+	)
+}
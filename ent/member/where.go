@@ -0,0 +1,676 @@
+// Code generated by ent, DO NOT EDIT.
+
+package member
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldID, id))
+}
+
+// GuildID applies equality check predicate on the "guild_id" field. It's identical to GuildIDEQ.
+func GuildID(v snowflake.ID) predicate.Member {
+	vc := uint64(v)
+	return predicate.Member(sql.FieldEQ(FieldGuildID, vc))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v snowflake.ID) predicate.Member {
+	vc := uint64(v)
+	return predicate.Member(sql.FieldEQ(FieldUserID, vc))
+}
+
+// TtsLanguage applies equality check predicate on the "tts_language" field. It's identical to TtsLanguageEQ.
+func TtsLanguage(v string) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldTtsLanguage, v))
+}
+
+// TtsVoice applies equality check predicate on the "tts_voice" field. It's identical to TtsVoiceEQ.
+func TtsVoice(v string) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldTtsVoice, v))
+}
+
+// TtsRate applies equality check predicate on the "tts_rate" field. It's identical to TtsRateEQ.
+func TtsRate(v float64) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldTtsRate, v))
+}
+
+// TtsPitch applies equality check predicate on the "tts_pitch" field. It's identical to TtsPitchEQ.
+func TtsPitch(v float64) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldTtsPitch, v))
+}
+
+// TtsReaderOptOut applies equality check predicate on the "tts_reader_opt_out" field. It's identical to TtsReaderOptOutEQ.
+func TtsReaderOptOut(v bool) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldTtsReaderOptOut, v))
+}
+
+// BitsDonated applies equality check predicate on the "bits_donated" field. It's identical to BitsDonatedEQ.
+func BitsDonated(v int64) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldBitsDonated, v))
+}
+
+// Points applies equality check predicate on the "points" field. It's identical to PointsEQ.
+func Points(v int64) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldPoints, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// GuildIDEQ applies the EQ predicate on the "guild_id" field.
+func GuildIDEQ(v snowflake.ID) predicate.Member {
+	vc := uint64(v)
+	return predicate.Member(sql.FieldEQ(FieldGuildID, vc))
+}
+
+// GuildIDNEQ applies the NEQ predicate on the "guild_id" field.
+func GuildIDNEQ(v snowflake.ID) predicate.Member {
+	vc := uint64(v)
+	return predicate.Member(sql.FieldNEQ(FieldGuildID, vc))
+}
+
+// GuildIDIn applies the In predicate on the "guild_id" field.
+func GuildIDIn(vs ...snowflake.ID) predicate.Member {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint64(vs[i])
+	}
+	return predicate.Member(sql.FieldIn(FieldGuildID, v...))
+}
+
+// GuildIDNotIn applies the NotIn predicate on the "guild_id" field.
+func GuildIDNotIn(vs ...snowflake.ID) predicate.Member {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint64(vs[i])
+	}
+	return predicate.Member(sql.FieldNotIn(FieldGuildID, v...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v snowflake.ID) predicate.Member {
+	vc := uint64(v)
+	return predicate.Member(sql.FieldEQ(FieldUserID, vc))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v snowflake.ID) predicate.Member {
+	vc := uint64(v)
+	return predicate.Member(sql.FieldNEQ(FieldUserID, vc))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...snowflake.ID) predicate.Member {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint64(vs[i])
+	}
+	return predicate.Member(sql.FieldIn(FieldUserID, v...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...snowflake.ID) predicate.Member {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint64(vs[i])
+	}
+	return predicate.Member(sql.FieldNotIn(FieldUserID, v...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v snowflake.ID) predicate.Member {
+	vc := uint64(v)
+	return predicate.Member(sql.FieldGT(FieldUserID, vc))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v snowflake.ID) predicate.Member {
+	vc := uint64(v)
+	return predicate.Member(sql.FieldGTE(FieldUserID, vc))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v snowflake.ID) predicate.Member {
+	vc := uint64(v)
+	return predicate.Member(sql.FieldLT(FieldUserID, vc))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v snowflake.ID) predicate.Member {
+	vc := uint64(v)
+	return predicate.Member(sql.FieldLTE(FieldUserID, vc))
+}
+
+// TtsLanguageEQ applies the EQ predicate on the "tts_language" field.
+func TtsLanguageEQ(v string) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldTtsLanguage, v))
+}
+
+// TtsLanguageNEQ applies the NEQ predicate on the "tts_language" field.
+func TtsLanguageNEQ(v string) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldTtsLanguage, v))
+}
+
+// TtsLanguageIn applies the In predicate on the "tts_language" field.
+func TtsLanguageIn(vs ...string) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldTtsLanguage, vs...))
+}
+
+// TtsLanguageNotIn applies the NotIn predicate on the "tts_language" field.
+func TtsLanguageNotIn(vs ...string) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldTtsLanguage, vs...))
+}
+
+// TtsLanguageGT applies the GT predicate on the "tts_language" field.
+func TtsLanguageGT(v string) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldTtsLanguage, v))
+}
+
+// TtsLanguageGTE applies the GTE predicate on the "tts_language" field.
+func TtsLanguageGTE(v string) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldTtsLanguage, v))
+}
+
+// TtsLanguageLT applies the LT predicate on the "tts_language" field.
+func TtsLanguageLT(v string) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldTtsLanguage, v))
+}
+
+// TtsLanguageLTE applies the LTE predicate on the "tts_language" field.
+func TtsLanguageLTE(v string) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldTtsLanguage, v))
+}
+
+// TtsLanguageContains applies the Contains predicate on the "tts_language" field.
+func TtsLanguageContains(v string) predicate.Member {
+	return predicate.Member(sql.FieldContains(FieldTtsLanguage, v))
+}
+
+// TtsLanguageHasPrefix applies the HasPrefix predicate on the "tts_language" field.
+func TtsLanguageHasPrefix(v string) predicate.Member {
+	return predicate.Member(sql.FieldHasPrefix(FieldTtsLanguage, v))
+}
+
+// TtsLanguageHasSuffix applies the HasSuffix predicate on the "tts_language" field.
+func TtsLanguageHasSuffix(v string) predicate.Member {
+	return predicate.Member(sql.FieldHasSuffix(FieldTtsLanguage, v))
+}
+
+// TtsLanguageIsNil applies the IsNil predicate on the "tts_language" field.
+func TtsLanguageIsNil() predicate.Member {
+	return predicate.Member(sql.FieldIsNull(FieldTtsLanguage))
+}
+
+// TtsLanguageNotNil applies the NotNil predicate on the "tts_language" field.
+func TtsLanguageNotNil() predicate.Member {
+	return predicate.Member(sql.FieldNotNull(FieldTtsLanguage))
+}
+
+// TtsLanguageEqualFold applies the EqualFold predicate on the "tts_language" field.
+func TtsLanguageEqualFold(v string) predicate.Member {
+	return predicate.Member(sql.FieldEqualFold(FieldTtsLanguage, v))
+}
+
+// TtsLanguageContainsFold applies the ContainsFold predicate on the "tts_language" field.
+func TtsLanguageContainsFold(v string) predicate.Member {
+	return predicate.Member(sql.FieldContainsFold(FieldTtsLanguage, v))
+}
+
+// TtsVoiceEQ applies the EQ predicate on the "tts_voice" field.
+func TtsVoiceEQ(v string) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldTtsVoice, v))
+}
+
+// TtsVoiceNEQ applies the NEQ predicate on the "tts_voice" field.
+func TtsVoiceNEQ(v string) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldTtsVoice, v))
+}
+
+// TtsVoiceIn applies the In predicate on the "tts_voice" field.
+func TtsVoiceIn(vs ...string) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldTtsVoice, vs...))
+}
+
+// TtsVoiceNotIn applies the NotIn predicate on the "tts_voice" field.
+func TtsVoiceNotIn(vs ...string) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldTtsVoice, vs...))
+}
+
+// TtsVoiceGT applies the GT predicate on the "tts_voice" field.
+func TtsVoiceGT(v string) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldTtsVoice, v))
+}
+
+// TtsVoiceGTE applies the GTE predicate on the "tts_voice" field.
+func TtsVoiceGTE(v string) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldTtsVoice, v))
+}
+
+// TtsVoiceLT applies the LT predicate on the "tts_voice" field.
+func TtsVoiceLT(v string) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldTtsVoice, v))
+}
+
+// TtsVoiceLTE applies the LTE predicate on the "tts_voice" field.
+func TtsVoiceLTE(v string) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldTtsVoice, v))
+}
+
+// TtsVoiceContains applies the Contains predicate on the "tts_voice" field.
+func TtsVoiceContains(v string) predicate.Member {
+	return predicate.Member(sql.FieldContains(FieldTtsVoice, v))
+}
+
+// TtsVoiceHasPrefix applies the HasPrefix predicate on the "tts_voice" field.
+func TtsVoiceHasPrefix(v string) predicate.Member {
+	return predicate.Member(sql.FieldHasPrefix(FieldTtsVoice, v))
+}
+
+// TtsVoiceHasSuffix applies the HasSuffix predicate on the "tts_voice" field.
+func TtsVoiceHasSuffix(v string) predicate.Member {
+	return predicate.Member(sql.FieldHasSuffix(FieldTtsVoice, v))
+}
+
+// TtsVoiceIsNil applies the IsNil predicate on the "tts_voice" field.
+func TtsVoiceIsNil() predicate.Member {
+	return predicate.Member(sql.FieldIsNull(FieldTtsVoice))
+}
+
+// TtsVoiceNotNil applies the NotNil predicate on the "tts_voice" field.
+func TtsVoiceNotNil() predicate.Member {
+	return predicate.Member(sql.FieldNotNull(FieldTtsVoice))
+}
+
+// TtsVoiceEqualFold applies the EqualFold predicate on the "tts_voice" field.
+func TtsVoiceEqualFold(v string) predicate.Member {
+	return predicate.Member(sql.FieldEqualFold(FieldTtsVoice, v))
+}
+
+// TtsVoiceContainsFold applies the ContainsFold predicate on the "tts_voice" field.
+func TtsVoiceContainsFold(v string) predicate.Member {
+	return predicate.Member(sql.FieldContainsFold(FieldTtsVoice, v))
+}
+
+// TtsRateEQ applies the EQ predicate on the "tts_rate" field.
+func TtsRateEQ(v float64) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldTtsRate, v))
+}
+
+// TtsRateNEQ applies the NEQ predicate on the "tts_rate" field.
+func TtsRateNEQ(v float64) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldTtsRate, v))
+}
+
+// TtsRateIn applies the In predicate on the "tts_rate" field.
+func TtsRateIn(vs ...float64) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldTtsRate, vs...))
+}
+
+// TtsRateNotIn applies the NotIn predicate on the "tts_rate" field.
+func TtsRateNotIn(vs ...float64) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldTtsRate, vs...))
+}
+
+// TtsRateGT applies the GT predicate on the "tts_rate" field.
+func TtsRateGT(v float64) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldTtsRate, v))
+}
+
+// TtsRateGTE applies the GTE predicate on the "tts_rate" field.
+func TtsRateGTE(v float64) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldTtsRate, v))
+}
+
+// TtsRateLT applies the LT predicate on the "tts_rate" field.
+func TtsRateLT(v float64) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldTtsRate, v))
+}
+
+// TtsRateLTE applies the LTE predicate on the "tts_rate" field.
+func TtsRateLTE(v float64) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldTtsRate, v))
+}
+
+// TtsRateIsNil applies the IsNil predicate on the "tts_rate" field.
+func TtsRateIsNil() predicate.Member {
+	return predicate.Member(sql.FieldIsNull(FieldTtsRate))
+}
+
+// TtsRateNotNil applies the NotNil predicate on the "tts_rate" field.
+func TtsRateNotNil() predicate.Member {
+	return predicate.Member(sql.FieldNotNull(FieldTtsRate))
+}
+
+// TtsPitchEQ applies the EQ predicate on the "tts_pitch" field.
+func TtsPitchEQ(v float64) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldTtsPitch, v))
+}
+
+// TtsPitchNEQ applies the NEQ predicate on the "tts_pitch" field.
+func TtsPitchNEQ(v float64) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldTtsPitch, v))
+}
+
+// TtsPitchIn applies the In predicate on the "tts_pitch" field.
+func TtsPitchIn(vs ...float64) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldTtsPitch, vs...))
+}
+
+// TtsPitchNotIn applies the NotIn predicate on the "tts_pitch" field.
+func TtsPitchNotIn(vs ...float64) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldTtsPitch, vs...))
+}
+
+// TtsPitchGT applies the GT predicate on the "tts_pitch" field.
+func TtsPitchGT(v float64) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldTtsPitch, v))
+}
+
+// TtsPitchGTE applies the GTE predicate on the "tts_pitch" field.
+func TtsPitchGTE(v float64) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldTtsPitch, v))
+}
+
+// TtsPitchLT applies the LT predicate on the "tts_pitch" field.
+func TtsPitchLT(v float64) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldTtsPitch, v))
+}
+
+// TtsPitchLTE applies the LTE predicate on the "tts_pitch" field.
+func TtsPitchLTE(v float64) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldTtsPitch, v))
+}
+
+// TtsPitchIsNil applies the IsNil predicate on the "tts_pitch" field.
+func TtsPitchIsNil() predicate.Member {
+	return predicate.Member(sql.FieldIsNull(FieldTtsPitch))
+}
+
+// TtsPitchNotNil applies the NotNil predicate on the "tts_pitch" field.
+func TtsPitchNotNil() predicate.Member {
+	return predicate.Member(sql.FieldNotNull(FieldTtsPitch))
+}
+
+// TtsReaderOptOutEQ applies the EQ predicate on the "tts_reader_opt_out" field.
+func TtsReaderOptOutEQ(v bool) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldTtsReaderOptOut, v))
+}
+
+// TtsReaderOptOutNEQ applies the NEQ predicate on the "tts_reader_opt_out" field.
+func TtsReaderOptOutNEQ(v bool) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldTtsReaderOptOut, v))
+}
+
+// BitsDonatedEQ applies the EQ predicate on the "bits_donated" field.
+func BitsDonatedEQ(v int64) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldBitsDonated, v))
+}
+
+// BitsDonatedNEQ applies the NEQ predicate on the "bits_donated" field.
+func BitsDonatedNEQ(v int64) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldBitsDonated, v))
+}
+
+// BitsDonatedIn applies the In predicate on the "bits_donated" field.
+func BitsDonatedIn(vs ...int64) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldBitsDonated, vs...))
+}
+
+// BitsDonatedNotIn applies the NotIn predicate on the "bits_donated" field.
+func BitsDonatedNotIn(vs ...int64) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldBitsDonated, vs...))
+}
+
+// BitsDonatedGT applies the GT predicate on the "bits_donated" field.
+func BitsDonatedGT(v int64) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldBitsDonated, v))
+}
+
+// BitsDonatedGTE applies the GTE predicate on the "bits_donated" field.
+func BitsDonatedGTE(v int64) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldBitsDonated, v))
+}
+
+// BitsDonatedLT applies the LT predicate on the "bits_donated" field.
+func BitsDonatedLT(v int64) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldBitsDonated, v))
+}
+
+// BitsDonatedLTE applies the LTE predicate on the "bits_donated" field.
+func BitsDonatedLTE(v int64) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldBitsDonated, v))
+}
+
+// PointsEQ applies the EQ predicate on the "points" field.
+func PointsEQ(v int64) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldPoints, v))
+}
+
+// PointsNEQ applies the NEQ predicate on the "points" field.
+func PointsNEQ(v int64) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldPoints, v))
+}
+
+// PointsIn applies the In predicate on the "points" field.
+func PointsIn(vs ...int64) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldPoints, vs...))
+}
+
+// PointsNotIn applies the NotIn predicate on the "points" field.
+func PointsNotIn(vs ...int64) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldPoints, vs...))
+}
+
+// PointsGT applies the GT predicate on the "points" field.
+func PointsGT(v int64) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldPoints, v))
+}
+
+// PointsGTE applies the GTE predicate on the "points" field.
+func PointsGTE(v int64) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldPoints, v))
+}
+
+// PointsLT applies the LT predicate on the "points" field.
+func PointsLT(v int64) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldPoints, v))
+}
+
+// PointsLTE applies the LTE predicate on the "points" field.
+func PointsLTE(v int64) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldPoints, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.Member {
+	return predicate.Member(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.Member {
+	return predicate.Member(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.Member {
+	return predicate.Member(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.Member {
+	return predicate.Member(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// HasGuild applies the HasEdge predicate on the "guild" edge.
+func HasGuild() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GuildTable, GuildColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGuildWith applies the HasEdge predicate on the "guild" edge with a given conditions (other predicates).
+func HasGuildWith(preds ...predicate.Guild) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newGuildStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Member) predicate.Member {
+	return predicate.Member(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Member) predicate.Member {
+	return predicate.Member(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Member) predicate.Member {
+	return predicate.Member(sql.NotPredicates(p))
+}
@@ -0,0 +1,1318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/member"
+)
+
+// MemberCreate is the builder for creating a Member entity.
+type MemberCreate struct {
+	config
+	mutation *MemberMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGuildID sets the "guild_id" field.
+func (mc *MemberCreate) SetGuildID(s snowflake.ID) *MemberCreate {
+	mc.mutation.SetGuildID(s)
+	return mc
+}
+
+// SetUserID sets the "user_id" field.
+func (mc *MemberCreate) SetUserID(s snowflake.ID) *MemberCreate {
+	mc.mutation.SetUserID(s)
+	return mc
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (mc *MemberCreate) SetTtsLanguage(s string) *MemberCreate {
+	mc.mutation.SetTtsLanguage(s)
+	return mc
+}
+
+// SetNillableTtsLanguage sets the "tts_language" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableTtsLanguage(s *string) *MemberCreate {
+	if s != nil {
+		mc.SetTtsLanguage(*s)
+	}
+	return mc
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (mc *MemberCreate) SetTtsVoice(s string) *MemberCreate {
+	mc.mutation.SetTtsVoice(s)
+	return mc
+}
+
+// SetNillableTtsVoice sets the "tts_voice" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableTtsVoice(s *string) *MemberCreate {
+	if s != nil {
+		mc.SetTtsVoice(*s)
+	}
+	return mc
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (mc *MemberCreate) SetTtsRate(f float64) *MemberCreate {
+	mc.mutation.SetTtsRate(f)
+	return mc
+}
+
+// SetNillableTtsRate sets the "tts_rate" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableTtsRate(f *float64) *MemberCreate {
+	if f != nil {
+		mc.SetTtsRate(*f)
+	}
+	return mc
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (mc *MemberCreate) SetTtsPitch(f float64) *MemberCreate {
+	mc.mutation.SetTtsPitch(f)
+	return mc
+}
+
+// SetNillableTtsPitch sets the "tts_pitch" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableTtsPitch(f *float64) *MemberCreate {
+	if f != nil {
+		mc.SetTtsPitch(*f)
+	}
+	return mc
+}
+
+// SetTtsReaderOptOut sets the "tts_reader_opt_out" field.
+func (mc *MemberCreate) SetTtsReaderOptOut(b bool) *MemberCreate {
+	mc.mutation.SetTtsReaderOptOut(b)
+	return mc
+}
+
+// SetNillableTtsReaderOptOut sets the "tts_reader_opt_out" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableTtsReaderOptOut(b *bool) *MemberCreate {
+	if b != nil {
+		mc.SetTtsReaderOptOut(*b)
+	}
+	return mc
+}
+
+// SetBitsDonated sets the "bits_donated" field.
+func (mc *MemberCreate) SetBitsDonated(i int64) *MemberCreate {
+	mc.mutation.SetBitsDonated(i)
+	return mc
+}
+
+// SetNillableBitsDonated sets the "bits_donated" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableBitsDonated(i *int64) *MemberCreate {
+	if i != nil {
+		mc.SetBitsDonated(*i)
+	}
+	return mc
+}
+
+// SetPoints sets the "points" field.
+func (mc *MemberCreate) SetPoints(i int64) *MemberCreate {
+	mc.mutation.SetPoints(i)
+	return mc
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (mc *MemberCreate) SetNillablePoints(i *int64) *MemberCreate {
+	if i != nil {
+		mc.SetPoints(*i)
+	}
+	return mc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mc *MemberCreate) SetCreatedAt(t time.Time) *MemberCreate {
+	mc.mutation.SetCreatedAt(t)
+	return mc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableCreatedAt(t *time.Time) *MemberCreate {
+	if t != nil {
+		mc.SetCreatedAt(*t)
+	}
+	return mc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mc *MemberCreate) SetUpdatedAt(t time.Time) *MemberCreate {
+	mc.mutation.SetUpdatedAt(t)
+	return mc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableUpdatedAt(t *time.Time) *MemberCreate {
+	if t != nil {
+		mc.SetUpdatedAt(*t)
+	}
+	return mc
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (mc *MemberCreate) SetGuild(g *Guild) *MemberCreate {
+	return mc.SetGuildID(g.ID)
+}
+
+// Mutation returns the MemberMutation object of the builder.
+func (mc *MemberCreate) Mutation() *MemberMutation {
+	return mc.mutation
+}
+
+// Save creates the Member in the database.
+func (mc *MemberCreate) Save(ctx context.Context) (*Member, error) {
+	mc.defaults()
+	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *MemberCreate) SaveX(ctx context.Context) *Member {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *MemberCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *MemberCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *MemberCreate) defaults() {
+	if _, ok := mc.mutation.TtsReaderOptOut(); !ok {
+		v := member.DefaultTtsReaderOptOut
+		mc.mutation.SetTtsReaderOptOut(v)
+	}
+	if _, ok := mc.mutation.BitsDonated(); !ok {
+		v := member.DefaultBitsDonated
+		mc.mutation.SetBitsDonated(v)
+	}
+	if _, ok := mc.mutation.Points(); !ok {
+		v := member.DefaultPoints
+		mc.mutation.SetPoints(v)
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		v := member.DefaultCreatedAt()
+		mc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		v := member.DefaultUpdatedAt()
+		mc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *MemberCreate) check() error {
+	if _, ok := mc.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "Member.guild_id"`)}
+	}
+	if _, ok := mc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Member.user_id"`)}
+	}
+	if _, ok := mc.mutation.TtsReaderOptOut(); !ok {
+		return &ValidationError{Name: "tts_reader_opt_out", err: errors.New(`ent: missing required field "Member.tts_reader_opt_out"`)}
+	}
+	if _, ok := mc.mutation.BitsDonated(); !ok {
+		return &ValidationError{Name: "bits_donated", err: errors.New(`ent: missing required field "Member.bits_donated"`)}
+	}
+	if v, ok := mc.mutation.BitsDonated(); ok {
+		if err := member.BitsDonatedValidator(v); err != nil {
+			return &ValidationError{Name: "bits_donated", err: fmt.Errorf(`ent: validator failed for field "Member.bits_donated": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Points(); !ok {
+		return &ValidationError{Name: "points", err: errors.New(`ent: missing required field "Member.points"`)}
+	}
+	if v, ok := mc.mutation.Points(); ok {
+		if err := member.PointsValidator(v); err != nil {
+			return &ValidationError{Name: "points", err: fmt.Errorf(`ent: validator failed for field "Member.points": %w`, err)}
+		}
+	}
+	if len(mc.mutation.GuildIDs()) == 0 {
+		return &ValidationError{Name: "guild", err: errors.New(`ent: missing required edge "Member.guild"`)}
+	}
+	return nil
+}
+
+func (mc *MemberCreate) sqlSave(ctx context.Context) (*Member, error) {
+	if err := mc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mc.mutation.id = &_node.ID
+	mc.mutation.done = true
+	return _node, nil
+}
+
+func (mc *MemberCreate) createSpec() (*Member, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Member{config: mc.config}
+		_spec = sqlgraph.NewCreateSpec(member.Table, sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = mc.conflict
+	if value, ok := mc.mutation.UserID(); ok {
+		_spec.SetField(member.FieldUserID, field.TypeUint64, value)
+		_node.UserID = value
+	}
+	if value, ok := mc.mutation.TtsLanguage(); ok {
+		_spec.SetField(member.FieldTtsLanguage, field.TypeString, value)
+		_node.TtsLanguage = &value
+	}
+	if value, ok := mc.mutation.TtsVoice(); ok {
+		_spec.SetField(member.FieldTtsVoice, field.TypeString, value)
+		_node.TtsVoice = &value
+	}
+	if value, ok := mc.mutation.TtsRate(); ok {
+		_spec.SetField(member.FieldTtsRate, field.TypeFloat64, value)
+		_node.TtsRate = &value
+	}
+	if value, ok := mc.mutation.TtsPitch(); ok {
+		_spec.SetField(member.FieldTtsPitch, field.TypeFloat64, value)
+		_node.TtsPitch = &value
+	}
+	if value, ok := mc.mutation.TtsReaderOptOut(); ok {
+		_spec.SetField(member.FieldTtsReaderOptOut, field.TypeBool, value)
+		_node.TtsReaderOptOut = value
+	}
+	if value, ok := mc.mutation.BitsDonated(); ok {
+		_spec.SetField(member.FieldBitsDonated, field.TypeInt64, value)
+		_node.BitsDonated = value
+	}
+	if value, ok := mc.mutation.Points(); ok {
+		_spec.SetField(member.FieldPoints, field.TypeInt64, value)
+		_node.Points = value
+	}
+	if value, ok := mc.mutation.CreatedAt(); ok {
+		_spec.SetField(member.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mc.mutation.UpdatedAt(); ok {
+		_spec.SetField(member.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := mc.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.GuildTable,
+			Columns: []string{member.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GuildID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Member.Create().
+//		SetGuildID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (mc *MemberCreate) OnConflict(opts ...sql.ConflictOption) *MemberUpsertOne {
+	mc.conflict = opts
+	return &MemberUpsertOne{
+		create: mc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mc *MemberCreate) OnConflictColumns(columns ...string) *MemberUpsertOne {
+	mc.conflict = append(mc.conflict, sql.ConflictColumns(columns...))
+	return &MemberUpsertOne{
+		create: mc,
+	}
+}
+
+type (
+	// MemberUpsertOne is the builder for "upsert"-ing
+	//  one Member node.
+	MemberUpsertOne struct {
+		create *MemberCreate
+	}
+
+	// MemberUpsert is the "OnConflict" setter.
+	MemberUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGuildID sets the "guild_id" field.
+func (u *MemberUpsert) SetGuildID(v snowflake.ID) *MemberUpsert {
+	u.Set(member.FieldGuildID, v)
+	return u
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateGuildID() *MemberUpsert {
+	u.SetExcluded(member.FieldGuildID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MemberUpsert) SetUserID(v snowflake.ID) *MemberUpsert {
+	u.Set(member.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateUserID() *MemberUpsert {
+	u.SetExcluded(member.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *MemberUpsert) AddUserID(v snowflake.ID) *MemberUpsert {
+	u.Add(member.FieldUserID, v)
+	return u
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (u *MemberUpsert) SetTtsLanguage(v string) *MemberUpsert {
+	u.Set(member.FieldTtsLanguage, v)
+	return u
+}
+
+// UpdateTtsLanguage sets the "tts_language" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateTtsLanguage() *MemberUpsert {
+	u.SetExcluded(member.FieldTtsLanguage)
+	return u
+}
+
+// ClearTtsLanguage clears the value of the "tts_language" field.
+func (u *MemberUpsert) ClearTtsLanguage() *MemberUpsert {
+	u.SetNull(member.FieldTtsLanguage)
+	return u
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (u *MemberUpsert) SetTtsVoice(v string) *MemberUpsert {
+	u.Set(member.FieldTtsVoice, v)
+	return u
+}
+
+// UpdateTtsVoice sets the "tts_voice" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateTtsVoice() *MemberUpsert {
+	u.SetExcluded(member.FieldTtsVoice)
+	return u
+}
+
+// ClearTtsVoice clears the value of the "tts_voice" field.
+func (u *MemberUpsert) ClearTtsVoice() *MemberUpsert {
+	u.SetNull(member.FieldTtsVoice)
+	return u
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (u *MemberUpsert) SetTtsRate(v float64) *MemberUpsert {
+	u.Set(member.FieldTtsRate, v)
+	return u
+}
+
+// UpdateTtsRate sets the "tts_rate" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateTtsRate() *MemberUpsert {
+	u.SetExcluded(member.FieldTtsRate)
+	return u
+}
+
+// AddTtsRate adds v to the "tts_rate" field.
+func (u *MemberUpsert) AddTtsRate(v float64) *MemberUpsert {
+	u.Add(member.FieldTtsRate, v)
+	return u
+}
+
+// ClearTtsRate clears the value of the "tts_rate" field.
+func (u *MemberUpsert) ClearTtsRate() *MemberUpsert {
+	u.SetNull(member.FieldTtsRate)
+	return u
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (u *MemberUpsert) SetTtsPitch(v float64) *MemberUpsert {
+	u.Set(member.FieldTtsPitch, v)
+	return u
+}
+
+// UpdateTtsPitch sets the "tts_pitch" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateTtsPitch() *MemberUpsert {
+	u.SetExcluded(member.FieldTtsPitch)
+	return u
+}
+
+// AddTtsPitch adds v to the "tts_pitch" field.
+func (u *MemberUpsert) AddTtsPitch(v float64) *MemberUpsert {
+	u.Add(member.FieldTtsPitch, v)
+	return u
+}
+
+// ClearTtsPitch clears the value of the "tts_pitch" field.
+func (u *MemberUpsert) ClearTtsPitch() *MemberUpsert {
+	u.SetNull(member.FieldTtsPitch)
+	return u
+}
+
+// SetTtsReaderOptOut sets the "tts_reader_opt_out" field.
+func (u *MemberUpsert) SetTtsReaderOptOut(v bool) *MemberUpsert {
+	u.Set(member.FieldTtsReaderOptOut, v)
+	return u
+}
+
+// UpdateTtsReaderOptOut sets the "tts_reader_opt_out" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateTtsReaderOptOut() *MemberUpsert {
+	u.SetExcluded(member.FieldTtsReaderOptOut)
+	return u
+}
+
+// SetBitsDonated sets the "bits_donated" field.
+func (u *MemberUpsert) SetBitsDonated(v int64) *MemberUpsert {
+	u.Set(member.FieldBitsDonated, v)
+	return u
+}
+
+// UpdateBitsDonated sets the "bits_donated" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateBitsDonated() *MemberUpsert {
+	u.SetExcluded(member.FieldBitsDonated)
+	return u
+}
+
+// AddBitsDonated adds v to the "bits_donated" field.
+func (u *MemberUpsert) AddBitsDonated(v int64) *MemberUpsert {
+	u.Add(member.FieldBitsDonated, v)
+	return u
+}
+
+// SetPoints sets the "points" field.
+func (u *MemberUpsert) SetPoints(v int64) *MemberUpsert {
+	u.Set(member.FieldPoints, v)
+	return u
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *MemberUpsert) UpdatePoints() *MemberUpsert {
+	u.SetExcluded(member.FieldPoints)
+	return u
+}
+
+// AddPoints adds v to the "points" field.
+func (u *MemberUpsert) AddPoints(v int64) *MemberUpsert {
+	u.Add(member.FieldPoints, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MemberUpsert) SetCreatedAt(v time.Time) *MemberUpsert {
+	u.Set(member.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateCreatedAt() *MemberUpsert {
+	u.SetExcluded(member.FieldCreatedAt)
+	return u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *MemberUpsert) ClearCreatedAt() *MemberUpsert {
+	u.SetNull(member.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberUpsert) SetUpdatedAt(v time.Time) *MemberUpsert {
+	u.Set(member.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateUpdatedAt() *MemberUpsert {
+	u.SetExcluded(member.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *MemberUpsert) ClearUpdatedAt() *MemberUpsert {
+	u.SetNull(member.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MemberUpsertOne) UpdateNewValues() *MemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MemberUpsertOne) Ignore() *MemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberUpsertOne) DoNothing() *MemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberCreate.OnConflict
+// documentation for more info.
+func (u *MemberUpsertOne) Update(set func(*MemberUpsert)) *MemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGuildID sets the "guild_id" field.
+func (u *MemberUpsertOne) SetGuildID(v snowflake.ID) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetGuildID(v)
+	})
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateGuildID() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateGuildID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MemberUpsertOne) SetUserID(v snowflake.ID) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *MemberUpsertOne) AddUserID(v snowflake.ID) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateUserID() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (u *MemberUpsertOne) SetTtsLanguage(v string) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetTtsLanguage(v)
+	})
+}
+
+// UpdateTtsLanguage sets the "tts_language" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateTtsLanguage() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateTtsLanguage()
+	})
+}
+
+// ClearTtsLanguage clears the value of the "tts_language" field.
+func (u *MemberUpsertOne) ClearTtsLanguage() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearTtsLanguage()
+	})
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (u *MemberUpsertOne) SetTtsVoice(v string) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetTtsVoice(v)
+	})
+}
+
+// UpdateTtsVoice sets the "tts_voice" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateTtsVoice() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateTtsVoice()
+	})
+}
+
+// ClearTtsVoice clears the value of the "tts_voice" field.
+func (u *MemberUpsertOne) ClearTtsVoice() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearTtsVoice()
+	})
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (u *MemberUpsertOne) SetTtsRate(v float64) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetTtsRate(v)
+	})
+}
+
+// AddTtsRate adds v to the "tts_rate" field.
+func (u *MemberUpsertOne) AddTtsRate(v float64) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddTtsRate(v)
+	})
+}
+
+// UpdateTtsRate sets the "tts_rate" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateTtsRate() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateTtsRate()
+	})
+}
+
+// ClearTtsRate clears the value of the "tts_rate" field.
+func (u *MemberUpsertOne) ClearTtsRate() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearTtsRate()
+	})
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (u *MemberUpsertOne) SetTtsPitch(v float64) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetTtsPitch(v)
+	})
+}
+
+// AddTtsPitch adds v to the "tts_pitch" field.
+func (u *MemberUpsertOne) AddTtsPitch(v float64) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddTtsPitch(v)
+	})
+}
+
+// UpdateTtsPitch sets the "tts_pitch" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateTtsPitch() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateTtsPitch()
+	})
+}
+
+// ClearTtsPitch clears the value of the "tts_pitch" field.
+func (u *MemberUpsertOne) ClearTtsPitch() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearTtsPitch()
+	})
+}
+
+// SetTtsReaderOptOut sets the "tts_reader_opt_out" field.
+func (u *MemberUpsertOne) SetTtsReaderOptOut(v bool) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetTtsReaderOptOut(v)
+	})
+}
+
+// UpdateTtsReaderOptOut sets the "tts_reader_opt_out" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateTtsReaderOptOut() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateTtsReaderOptOut()
+	})
+}
+
+// SetBitsDonated sets the "bits_donated" field.
+func (u *MemberUpsertOne) SetBitsDonated(v int64) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetBitsDonated(v)
+	})
+}
+
+// AddBitsDonated adds v to the "bits_donated" field.
+func (u *MemberUpsertOne) AddBitsDonated(v int64) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddBitsDonated(v)
+	})
+}
+
+// UpdateBitsDonated sets the "bits_donated" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateBitsDonated() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateBitsDonated()
+	})
+}
+
+// SetPoints sets the "points" field.
+func (u *MemberUpsertOne) SetPoints(v int64) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetPoints(v)
+	})
+}
+
+// AddPoints adds v to the "points" field.
+func (u *MemberUpsertOne) AddPoints(v int64) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddPoints(v)
+	})
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdatePoints() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdatePoints()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MemberUpsertOne) SetCreatedAt(v time.Time) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateCreatedAt() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *MemberUpsertOne) ClearCreatedAt() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberUpsertOne) SetUpdatedAt(v time.Time) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateUpdatedAt() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *MemberUpsertOne) ClearUpdatedAt() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MemberUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MemberUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MemberCreateBulk is the builder for creating many Member entities in bulk.
+type MemberCreateBulk struct {
+	config
+	err      error
+	builders []*MemberCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Member entities in the database.
+func (mcb *MemberCreateBulk) Save(ctx context.Context) ([]*Member, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Member, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *MemberCreateBulk) SaveX(ctx context.Context) []*Member {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *MemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *MemberCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Member.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (mcb *MemberCreateBulk) OnConflict(opts ...sql.ConflictOption) *MemberUpsertBulk {
+	mcb.conflict = opts
+	return &MemberUpsertBulk{
+		create: mcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mcb *MemberCreateBulk) OnConflictColumns(columns ...string) *MemberUpsertBulk {
+	mcb.conflict = append(mcb.conflict, sql.ConflictColumns(columns...))
+	return &MemberUpsertBulk{
+		create: mcb,
+	}
+}
+
+// MemberUpsertBulk is the builder for "upsert"-ing
+// a bulk of Member nodes.
+type MemberUpsertBulk struct {
+	create *MemberCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MemberUpsertBulk) UpdateNewValues() *MemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MemberUpsertBulk) Ignore() *MemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberUpsertBulk) DoNothing() *MemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberCreateBulk.OnConflict
+// documentation for more info.
+func (u *MemberUpsertBulk) Update(set func(*MemberUpsert)) *MemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGuildID sets the "guild_id" field.
+func (u *MemberUpsertBulk) SetGuildID(v snowflake.ID) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetGuildID(v)
+	})
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateGuildID() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateGuildID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MemberUpsertBulk) SetUserID(v snowflake.ID) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *MemberUpsertBulk) AddUserID(v snowflake.ID) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateUserID() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (u *MemberUpsertBulk) SetTtsLanguage(v string) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetTtsLanguage(v)
+	})
+}
+
+// UpdateTtsLanguage sets the "tts_language" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateTtsLanguage() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateTtsLanguage()
+	})
+}
+
+// ClearTtsLanguage clears the value of the "tts_language" field.
+func (u *MemberUpsertBulk) ClearTtsLanguage() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearTtsLanguage()
+	})
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (u *MemberUpsertBulk) SetTtsVoice(v string) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetTtsVoice(v)
+	})
+}
+
+// UpdateTtsVoice sets the "tts_voice" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateTtsVoice() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateTtsVoice()
+	})
+}
+
+// ClearTtsVoice clears the value of the "tts_voice" field.
+func (u *MemberUpsertBulk) ClearTtsVoice() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearTtsVoice()
+	})
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (u *MemberUpsertBulk) SetTtsRate(v float64) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetTtsRate(v)
+	})
+}
+
+// AddTtsRate adds v to the "tts_rate" field.
+func (u *MemberUpsertBulk) AddTtsRate(v float64) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddTtsRate(v)
+	})
+}
+
+// UpdateTtsRate sets the "tts_rate" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateTtsRate() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateTtsRate()
+	})
+}
+
+// ClearTtsRate clears the value of the "tts_rate" field.
+func (u *MemberUpsertBulk) ClearTtsRate() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearTtsRate()
+	})
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (u *MemberUpsertBulk) SetTtsPitch(v float64) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetTtsPitch(v)
+	})
+}
+
+// AddTtsPitch adds v to the "tts_pitch" field.
+func (u *MemberUpsertBulk) AddTtsPitch(v float64) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddTtsPitch(v)
+	})
+}
+
+// UpdateTtsPitch sets the "tts_pitch" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateTtsPitch() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateTtsPitch()
+	})
+}
+
+// ClearTtsPitch clears the value of the "tts_pitch" field.
+func (u *MemberUpsertBulk) ClearTtsPitch() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearTtsPitch()
+	})
+}
+
+// SetTtsReaderOptOut sets the "tts_reader_opt_out" field.
+func (u *MemberUpsertBulk) SetTtsReaderOptOut(v bool) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetTtsReaderOptOut(v)
+	})
+}
+
+// UpdateTtsReaderOptOut sets the "tts_reader_opt_out" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateTtsReaderOptOut() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateTtsReaderOptOut()
+	})
+}
+
+// SetBitsDonated sets the "bits_donated" field.
+func (u *MemberUpsertBulk) SetBitsDonated(v int64) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetBitsDonated(v)
+	})
+}
+
+// AddBitsDonated adds v to the "bits_donated" field.
+func (u *MemberUpsertBulk) AddBitsDonated(v int64) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddBitsDonated(v)
+	})
+}
+
+// UpdateBitsDonated sets the "bits_donated" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateBitsDonated() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateBitsDonated()
+	})
+}
+
+// SetPoints sets the "points" field.
+func (u *MemberUpsertBulk) SetPoints(v int64) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetPoints(v)
+	})
+}
+
+// AddPoints adds v to the "points" field.
+func (u *MemberUpsertBulk) AddPoints(v int64) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddPoints(v)
+	})
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdatePoints() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdatePoints()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MemberUpsertBulk) SetCreatedAt(v time.Time) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateCreatedAt() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *MemberUpsertBulk) ClearCreatedAt() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MemberUpsertBulk) SetUpdatedAt(v time.Time) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateUpdatedAt() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *MemberUpsertBulk) ClearUpdatedAt() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MemberCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,878 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/member"
+	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+)
+
+// MemberUpdate is the builder for updating Member entities.
+type MemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MemberMutation
+}
+
+// Where appends a list predicates to the MemberUpdate builder.
+func (mu *MemberUpdate) Where(ps ...predicate.Member) *MemberUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetGuildID sets the "guild_id" field.
+func (mu *MemberUpdate) SetGuildID(s snowflake.ID) *MemberUpdate {
+	mu.mutation.SetGuildID(s)
+	return mu
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableGuildID(s *snowflake.ID) *MemberUpdate {
+	if s != nil {
+		mu.SetGuildID(*s)
+	}
+	return mu
+}
+
+// SetUserID sets the "user_id" field.
+func (mu *MemberUpdate) SetUserID(s snowflake.ID) *MemberUpdate {
+	mu.mutation.ResetUserID()
+	mu.mutation.SetUserID(s)
+	return mu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableUserID(s *snowflake.ID) *MemberUpdate {
+	if s != nil {
+		mu.SetUserID(*s)
+	}
+	return mu
+}
+
+// AddUserID adds s to the "user_id" field.
+func (mu *MemberUpdate) AddUserID(s snowflake.ID) *MemberUpdate {
+	mu.mutation.AddUserID(s)
+	return mu
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (mu *MemberUpdate) SetTtsLanguage(s string) *MemberUpdate {
+	mu.mutation.SetTtsLanguage(s)
+	return mu
+}
+
+// SetNillableTtsLanguage sets the "tts_language" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableTtsLanguage(s *string) *MemberUpdate {
+	if s != nil {
+		mu.SetTtsLanguage(*s)
+	}
+	return mu
+}
+
+// ClearTtsLanguage clears the value of the "tts_language" field.
+func (mu *MemberUpdate) ClearTtsLanguage() *MemberUpdate {
+	mu.mutation.ClearTtsLanguage()
+	return mu
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (mu *MemberUpdate) SetTtsVoice(s string) *MemberUpdate {
+	mu.mutation.SetTtsVoice(s)
+	return mu
+}
+
+// SetNillableTtsVoice sets the "tts_voice" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableTtsVoice(s *string) *MemberUpdate {
+	if s != nil {
+		mu.SetTtsVoice(*s)
+	}
+	return mu
+}
+
+// ClearTtsVoice clears the value of the "tts_voice" field.
+func (mu *MemberUpdate) ClearTtsVoice() *MemberUpdate {
+	mu.mutation.ClearTtsVoice()
+	return mu
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (mu *MemberUpdate) SetTtsRate(f float64) *MemberUpdate {
+	mu.mutation.ResetTtsRate()
+	mu.mutation.SetTtsRate(f)
+	return mu
+}
+
+// SetNillableTtsRate sets the "tts_rate" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableTtsRate(f *float64) *MemberUpdate {
+	if f != nil {
+		mu.SetTtsRate(*f)
+	}
+	return mu
+}
+
+// AddTtsRate adds f to the "tts_rate" field.
+func (mu *MemberUpdate) AddTtsRate(f float64) *MemberUpdate {
+	mu.mutation.AddTtsRate(f)
+	return mu
+}
+
+// ClearTtsRate clears the value of the "tts_rate" field.
+func (mu *MemberUpdate) ClearTtsRate() *MemberUpdate {
+	mu.mutation.ClearTtsRate()
+	return mu
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (mu *MemberUpdate) SetTtsPitch(f float64) *MemberUpdate {
+	mu.mutation.ResetTtsPitch()
+	mu.mutation.SetTtsPitch(f)
+	return mu
+}
+
+// SetNillableTtsPitch sets the "tts_pitch" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableTtsPitch(f *float64) *MemberUpdate {
+	if f != nil {
+		mu.SetTtsPitch(*f)
+	}
+	return mu
+}
+
+// AddTtsPitch adds f to the "tts_pitch" field.
+func (mu *MemberUpdate) AddTtsPitch(f float64) *MemberUpdate {
+	mu.mutation.AddTtsPitch(f)
+	return mu
+}
+
+// ClearTtsPitch clears the value of the "tts_pitch" field.
+func (mu *MemberUpdate) ClearTtsPitch() *MemberUpdate {
+	mu.mutation.ClearTtsPitch()
+	return mu
+}
+
+// SetTtsReaderOptOut sets the "tts_reader_opt_out" field.
+func (mu *MemberUpdate) SetTtsReaderOptOut(b bool) *MemberUpdate {
+	mu.mutation.SetTtsReaderOptOut(b)
+	return mu
+}
+
+// SetNillableTtsReaderOptOut sets the "tts_reader_opt_out" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableTtsReaderOptOut(b *bool) *MemberUpdate {
+	if b != nil {
+		mu.SetTtsReaderOptOut(*b)
+	}
+	return mu
+}
+
+// SetBitsDonated sets the "bits_donated" field.
+func (mu *MemberUpdate) SetBitsDonated(i int64) *MemberUpdate {
+	mu.mutation.ResetBitsDonated()
+	mu.mutation.SetBitsDonated(i)
+	return mu
+}
+
+// SetNillableBitsDonated sets the "bits_donated" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableBitsDonated(i *int64) *MemberUpdate {
+	if i != nil {
+		mu.SetBitsDonated(*i)
+	}
+	return mu
+}
+
+// AddBitsDonated adds i to the "bits_donated" field.
+func (mu *MemberUpdate) AddBitsDonated(i int64) *MemberUpdate {
+	mu.mutation.AddBitsDonated(i)
+	return mu
+}
+
+// SetPoints sets the "points" field.
+func (mu *MemberUpdate) SetPoints(i int64) *MemberUpdate {
+	mu.mutation.ResetPoints()
+	mu.mutation.SetPoints(i)
+	return mu
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillablePoints(i *int64) *MemberUpdate {
+	if i != nil {
+		mu.SetPoints(*i)
+	}
+	return mu
+}
+
+// AddPoints adds i to the "points" field.
+func (mu *MemberUpdate) AddPoints(i int64) *MemberUpdate {
+	mu.mutation.AddPoints(i)
+	return mu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mu *MemberUpdate) SetCreatedAt(t time.Time) *MemberUpdate {
+	mu.mutation.SetCreatedAt(t)
+	return mu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableCreatedAt(t *time.Time) *MemberUpdate {
+	if t != nil {
+		mu.SetCreatedAt(*t)
+	}
+	return mu
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (mu *MemberUpdate) ClearCreatedAt() *MemberUpdate {
+	mu.mutation.ClearCreatedAt()
+	return mu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mu *MemberUpdate) SetUpdatedAt(t time.Time) *MemberUpdate {
+	mu.mutation.SetUpdatedAt(t)
+	return mu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (mu *MemberUpdate) ClearUpdatedAt() *MemberUpdate {
+	mu.mutation.ClearUpdatedAt()
+	return mu
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (mu *MemberUpdate) SetGuild(g *Guild) *MemberUpdate {
+	return mu.SetGuildID(g.ID)
+}
+
+// Mutation returns the MemberMutation object of the builder.
+func (mu *MemberUpdate) Mutation() *MemberMutation {
+	return mu.mutation
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (mu *MemberUpdate) ClearGuild() *MemberUpdate {
+	mu.mutation.ClearGuild()
+	return mu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MemberUpdate) Save(ctx context.Context) (int, error) {
+	mu.defaults()
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MemberUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MemberUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *MemberUpdate) defaults() {
+	if _, ok := mu.mutation.UpdatedAt(); !ok && !mu.mutation.UpdatedAtCleared() {
+		v := member.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MemberUpdate) check() error {
+	if v, ok := mu.mutation.BitsDonated(); ok {
+		if err := member.BitsDonatedValidator(v); err != nil {
+			return &ValidationError{Name: "bits_donated", err: fmt.Errorf(`ent: validator failed for field "Member.bits_donated": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Points(); ok {
+		if err := member.PointsValidator(v); err != nil {
+			return &ValidationError{Name: "points", err: fmt.Errorf(`ent: validator failed for field "Member.points": %w`, err)}
+		}
+	}
+	if mu.mutation.GuildCleared() && len(mu.mutation.GuildIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Member.guild"`)
+	}
+	return nil
+}
+
+func (mu *MemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(member.Table, member.Columns, sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.UserID(); ok {
+		_spec.SetField(member.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := mu.mutation.AddedUserID(); ok {
+		_spec.AddField(member.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := mu.mutation.TtsLanguage(); ok {
+		_spec.SetField(member.FieldTtsLanguage, field.TypeString, value)
+	}
+	if mu.mutation.TtsLanguageCleared() {
+		_spec.ClearField(member.FieldTtsLanguage, field.TypeString)
+	}
+	if value, ok := mu.mutation.TtsVoice(); ok {
+		_spec.SetField(member.FieldTtsVoice, field.TypeString, value)
+	}
+	if mu.mutation.TtsVoiceCleared() {
+		_spec.ClearField(member.FieldTtsVoice, field.TypeString)
+	}
+	if value, ok := mu.mutation.TtsRate(); ok {
+		_spec.SetField(member.FieldTtsRate, field.TypeFloat64, value)
+	}
+	if value, ok := mu.mutation.AddedTtsRate(); ok {
+		_spec.AddField(member.FieldTtsRate, field.TypeFloat64, value)
+	}
+	if mu.mutation.TtsRateCleared() {
+		_spec.ClearField(member.FieldTtsRate, field.TypeFloat64)
+	}
+	if value, ok := mu.mutation.TtsPitch(); ok {
+		_spec.SetField(member.FieldTtsPitch, field.TypeFloat64, value)
+	}
+	if value, ok := mu.mutation.AddedTtsPitch(); ok {
+		_spec.AddField(member.FieldTtsPitch, field.TypeFloat64, value)
+	}
+	if mu.mutation.TtsPitchCleared() {
+		_spec.ClearField(member.FieldTtsPitch, field.TypeFloat64)
+	}
+	if value, ok := mu.mutation.TtsReaderOptOut(); ok {
+		_spec.SetField(member.FieldTtsReaderOptOut, field.TypeBool, value)
+	}
+	if value, ok := mu.mutation.BitsDonated(); ok {
+		_spec.SetField(member.FieldBitsDonated, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.AddedBitsDonated(); ok {
+		_spec.AddField(member.FieldBitsDonated, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.Points(); ok {
+		_spec.SetField(member.FieldPoints, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.AddedPoints(); ok {
+		_spec.AddField(member.FieldPoints, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.CreatedAt(); ok {
+		_spec.SetField(member.FieldCreatedAt, field.TypeTime, value)
+	}
+	if mu.mutation.CreatedAtCleared() {
+		_spec.ClearField(member.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := mu.mutation.UpdatedAt(); ok {
+		_spec.SetField(member.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if mu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(member.FieldUpdatedAt, field.TypeTime)
+	}
+	if mu.mutation.GuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.GuildTable,
+			Columns: []string{member.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.GuildTable,
+			Columns: []string{member.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{member.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MemberUpdateOne is the builder for updating a single Member entity.
+type MemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MemberMutation
+}
+
+// SetGuildID sets the "guild_id" field.
+func (muo *MemberUpdateOne) SetGuildID(s snowflake.ID) *MemberUpdateOne {
+	muo.mutation.SetGuildID(s)
+	return muo
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableGuildID(s *snowflake.ID) *MemberUpdateOne {
+	if s != nil {
+		muo.SetGuildID(*s)
+	}
+	return muo
+}
+
+// SetUserID sets the "user_id" field.
+func (muo *MemberUpdateOne) SetUserID(s snowflake.ID) *MemberUpdateOne {
+	muo.mutation.ResetUserID()
+	muo.mutation.SetUserID(s)
+	return muo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableUserID(s *snowflake.ID) *MemberUpdateOne {
+	if s != nil {
+		muo.SetUserID(*s)
+	}
+	return muo
+}
+
+// AddUserID adds s to the "user_id" field.
+func (muo *MemberUpdateOne) AddUserID(s snowflake.ID) *MemberUpdateOne {
+	muo.mutation.AddUserID(s)
+	return muo
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (muo *MemberUpdateOne) SetTtsLanguage(s string) *MemberUpdateOne {
+	muo.mutation.SetTtsLanguage(s)
+	return muo
+}
+
+// SetNillableTtsLanguage sets the "tts_language" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableTtsLanguage(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetTtsLanguage(*s)
+	}
+	return muo
+}
+
+// ClearTtsLanguage clears the value of the "tts_language" field.
+func (muo *MemberUpdateOne) ClearTtsLanguage() *MemberUpdateOne {
+	muo.mutation.ClearTtsLanguage()
+	return muo
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (muo *MemberUpdateOne) SetTtsVoice(s string) *MemberUpdateOne {
+	muo.mutation.SetTtsVoice(s)
+	return muo
+}
+
+// SetNillableTtsVoice sets the "tts_voice" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableTtsVoice(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetTtsVoice(*s)
+	}
+	return muo
+}
+
+// ClearTtsVoice clears the value of the "tts_voice" field.
+func (muo *MemberUpdateOne) ClearTtsVoice() *MemberUpdateOne {
+	muo.mutation.ClearTtsVoice()
+	return muo
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (muo *MemberUpdateOne) SetTtsRate(f float64) *MemberUpdateOne {
+	muo.mutation.ResetTtsRate()
+	muo.mutation.SetTtsRate(f)
+	return muo
+}
+
+// SetNillableTtsRate sets the "tts_rate" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableTtsRate(f *float64) *MemberUpdateOne {
+	if f != nil {
+		muo.SetTtsRate(*f)
+	}
+	return muo
+}
+
+// AddTtsRate adds f to the "tts_rate" field.
+func (muo *MemberUpdateOne) AddTtsRate(f float64) *MemberUpdateOne {
+	muo.mutation.AddTtsRate(f)
+	return muo
+}
+
+// ClearTtsRate clears the value of the "tts_rate" field.
+func (muo *MemberUpdateOne) ClearTtsRate() *MemberUpdateOne {
+	muo.mutation.ClearTtsRate()
+	return muo
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (muo *MemberUpdateOne) SetTtsPitch(f float64) *MemberUpdateOne {
+	muo.mutation.ResetTtsPitch()
+	muo.mutation.SetTtsPitch(f)
+	return muo
+}
+
+// SetNillableTtsPitch sets the "tts_pitch" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableTtsPitch(f *float64) *MemberUpdateOne {
+	if f != nil {
+		muo.SetTtsPitch(*f)
+	}
+	return muo
+}
+
+// AddTtsPitch adds f to the "tts_pitch" field.
+func (muo *MemberUpdateOne) AddTtsPitch(f float64) *MemberUpdateOne {
+	muo.mutation.AddTtsPitch(f)
+	return muo
+}
+
+// ClearTtsPitch clears the value of the "tts_pitch" field.
+func (muo *MemberUpdateOne) ClearTtsPitch() *MemberUpdateOne {
+	muo.mutation.ClearTtsPitch()
+	return muo
+}
+
+// SetTtsReaderOptOut sets the "tts_reader_opt_out" field.
+func (muo *MemberUpdateOne) SetTtsReaderOptOut(b bool) *MemberUpdateOne {
+	muo.mutation.SetTtsReaderOptOut(b)
+	return muo
+}
+
+// SetNillableTtsReaderOptOut sets the "tts_reader_opt_out" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableTtsReaderOptOut(b *bool) *MemberUpdateOne {
+	if b != nil {
+		muo.SetTtsReaderOptOut(*b)
+	}
+	return muo
+}
+
+// SetBitsDonated sets the "bits_donated" field.
+func (muo *MemberUpdateOne) SetBitsDonated(i int64) *MemberUpdateOne {
+	muo.mutation.ResetBitsDonated()
+	muo.mutation.SetBitsDonated(i)
+	return muo
+}
+
+// SetNillableBitsDonated sets the "bits_donated" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableBitsDonated(i *int64) *MemberUpdateOne {
+	if i != nil {
+		muo.SetBitsDonated(*i)
+	}
+	return muo
+}
+
+// AddBitsDonated adds i to the "bits_donated" field.
+func (muo *MemberUpdateOne) AddBitsDonated(i int64) *MemberUpdateOne {
+	muo.mutation.AddBitsDonated(i)
+	return muo
+}
+
+// SetPoints sets the "points" field.
+func (muo *MemberUpdateOne) SetPoints(i int64) *MemberUpdateOne {
+	muo.mutation.ResetPoints()
+	muo.mutation.SetPoints(i)
+	return muo
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillablePoints(i *int64) *MemberUpdateOne {
+	if i != nil {
+		muo.SetPoints(*i)
+	}
+	return muo
+}
+
+// AddPoints adds i to the "points" field.
+func (muo *MemberUpdateOne) AddPoints(i int64) *MemberUpdateOne {
+	muo.mutation.AddPoints(i)
+	return muo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (muo *MemberUpdateOne) SetCreatedAt(t time.Time) *MemberUpdateOne {
+	muo.mutation.SetCreatedAt(t)
+	return muo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableCreatedAt(t *time.Time) *MemberUpdateOne {
+	if t != nil {
+		muo.SetCreatedAt(*t)
+	}
+	return muo
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (muo *MemberUpdateOne) ClearCreatedAt() *MemberUpdateOne {
+	muo.mutation.ClearCreatedAt()
+	return muo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MemberUpdateOne) SetUpdatedAt(t time.Time) *MemberUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (muo *MemberUpdateOne) ClearUpdatedAt() *MemberUpdateOne {
+	muo.mutation.ClearUpdatedAt()
+	return muo
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (muo *MemberUpdateOne) SetGuild(g *Guild) *MemberUpdateOne {
+	return muo.SetGuildID(g.ID)
+}
+
+// Mutation returns the MemberMutation object of the builder.
+func (muo *MemberUpdateOne) Mutation() *MemberMutation {
+	return muo.mutation
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (muo *MemberUpdateOne) ClearGuild() *MemberUpdateOne {
+	muo.mutation.ClearGuild()
+	return muo
+}
+
+// Where appends a list predicates to the MemberUpdate builder.
+func (muo *MemberUpdateOne) Where(ps ...predicate.Member) *MemberUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MemberUpdateOne) Select(field string, fields ...string) *MemberUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Member entity.
+func (muo *MemberUpdateOne) Save(ctx context.Context) (*Member, error) {
+	muo.defaults()
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MemberUpdateOne) SaveX(ctx context.Context) *Member {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MemberUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *MemberUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdatedAt(); !ok && !muo.mutation.UpdatedAtCleared() {
+		v := member.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MemberUpdateOne) check() error {
+	if v, ok := muo.mutation.BitsDonated(); ok {
+		if err := member.BitsDonatedValidator(v); err != nil {
+			return &ValidationError{Name: "bits_donated", err: fmt.Errorf(`ent: validator failed for field "Member.bits_donated": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Points(); ok {
+		if err := member.PointsValidator(v); err != nil {
+			return &ValidationError{Name: "points", err: fmt.Errorf(`ent: validator failed for field "Member.points": %w`, err)}
+		}
+	}
+	if muo.mutation.GuildCleared() && len(muo.mutation.GuildIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Member.guild"`)
+	}
+	return nil
+}
+
+func (muo *MemberUpdateOne) sqlSave(ctx context.Context) (_node *Member, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(member.Table, member.Columns, sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Member.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, member.FieldID)
+		for _, f := range fields {
+			if !member.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != member.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.UserID(); ok {
+		_spec.SetField(member.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := muo.mutation.AddedUserID(); ok {
+		_spec.AddField(member.FieldUserID, field.TypeUint64, value)
+	}
+	if value, ok := muo.mutation.TtsLanguage(); ok {
+		_spec.SetField(member.FieldTtsLanguage, field.TypeString, value)
+	}
+	if muo.mutation.TtsLanguageCleared() {
+		_spec.ClearField(member.FieldTtsLanguage, field.TypeString)
+	}
+	if value, ok := muo.mutation.TtsVoice(); ok {
+		_spec.SetField(member.FieldTtsVoice, field.TypeString, value)
+	}
+	if muo.mutation.TtsVoiceCleared() {
+		_spec.ClearField(member.FieldTtsVoice, field.TypeString)
+	}
+	if value, ok := muo.mutation.TtsRate(); ok {
+		_spec.SetField(member.FieldTtsRate, field.TypeFloat64, value)
+	}
+	if value, ok := muo.mutation.AddedTtsRate(); ok {
+		_spec.AddField(member.FieldTtsRate, field.TypeFloat64, value)
+	}
+	if muo.mutation.TtsRateCleared() {
+		_spec.ClearField(member.FieldTtsRate, field.TypeFloat64)
+	}
+	if value, ok := muo.mutation.TtsPitch(); ok {
+		_spec.SetField(member.FieldTtsPitch, field.TypeFloat64, value)
+	}
+	if value, ok := muo.mutation.AddedTtsPitch(); ok {
+		_spec.AddField(member.FieldTtsPitch, field.TypeFloat64, value)
+	}
+	if muo.mutation.TtsPitchCleared() {
+		_spec.ClearField(member.FieldTtsPitch, field.TypeFloat64)
+	}
+	if value, ok := muo.mutation.TtsReaderOptOut(); ok {
+		_spec.SetField(member.FieldTtsReaderOptOut, field.TypeBool, value)
+	}
+	if value, ok := muo.mutation.BitsDonated(); ok {
+		_spec.SetField(member.FieldBitsDonated, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.AddedBitsDonated(); ok {
+		_spec.AddField(member.FieldBitsDonated, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.Points(); ok {
+		_spec.SetField(member.FieldPoints, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.AddedPoints(); ok {
+		_spec.AddField(member.FieldPoints, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.CreatedAt(); ok {
+		_spec.SetField(member.FieldCreatedAt, field.TypeTime, value)
+	}
+	if muo.mutation.CreatedAtCleared() {
+		_spec.ClearField(member.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.SetField(member.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if muo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(member.FieldUpdatedAt, field.TypeTime)
+	}
+	if muo.mutation.GuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.GuildTable,
+			Columns: []string{member.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.GuildTable,
+			Columns: []string{member.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Member{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{member.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
@@ -8,26 +8,283 @@ import (
 )
 
 var (
+	// CheerSoundsColumns holds the columns for the "cheer_sounds" table.
+	CheerSoundsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tier", Type: field.TypeInt},
+		{Name: "file_name", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "data", Type: field.TypeBytes, Size: 2097152},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "guild_id", Type: field.TypeUint64},
+	}
+	// CheerSoundsTable holds the schema information for the "cheer_sounds" table.
+	CheerSoundsTable = &schema.Table{
+		Name:       "cheer_sounds",
+		Columns:    CheerSoundsColumns,
+		PrimaryKey: []*schema.Column{CheerSoundsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cheer_sounds_guilds_cheer_sounds",
+				Columns:    []*schema.Column{CheerSoundsColumns[7]},
+				RefColumns: []*schema.Column{GuildsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cheersound_guild_id_tier",
+				Unique:  true,
+				Columns: []*schema.Column{CheerSoundsColumns[7], CheerSoundsColumns[1]},
+			},
+		},
+	}
 	// GuildsColumns holds the columns for the "guilds" table.
 	GuildsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "player_channel_id", Type: field.TypeUint64, Unique: true, Nullable: true},
 		{Name: "player_message_id", Type: field.TypeUint64, Unique: true, Nullable: true},
-		{Name: "created_at", Type: field.TypeTime},
-		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "idle_timeout", Type: field.TypeInt, Default: 5},
+		{Name: "alone_timeout", Type: field.TypeInt, Default: 2},
+		{Name: "autoplay", Type: field.TypeBool, Default: false},
+		{Name: "always_on", Type: field.TypeBool, Default: false},
+		{Name: "always_on_channel_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "fallback_query", Type: field.TypeString, Nullable: true},
+		{Name: "tts_provider", Type: field.TypeString, Default: "lavalink"},
+		{Name: "tts_language", Type: field.TypeString, Default: "th-TH"},
+		{Name: "tts_voice", Type: field.TypeString, Default: "th-TH-Neural2-C"},
+		{Name: "tts_rate", Type: field.TypeFloat64, Default: 0.8},
+		{Name: "tts_pitch", Type: field.TypeFloat64, Default: 0},
+		{Name: "tts_reader_channel_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "overlay_token", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "api_token", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "sleep_at", Type: field.TypeTime, Nullable: true},
+		{Name: "sleep_end_of_track", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 	}
 	// GuildsTable holds the schema information for the "guilds" table.
 	GuildsTable = &schema.Table{
 		Name:       "guilds",
 		Columns:    GuildsColumns,
 		PrimaryKey: []*schema.Column{GuildsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "guild_id",
+				Unique:  true,
+				Columns: []*schema.Column{GuildsColumns[0]},
+			},
+		},
+	}
+	// MembersColumns holds the columns for the "members" table.
+	MembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeUint64},
+		{Name: "tts_language", Type: field.TypeString, Nullable: true},
+		{Name: "tts_voice", Type: field.TypeString, Nullable: true},
+		{Name: "tts_rate", Type: field.TypeFloat64, Nullable: true},
+		{Name: "tts_pitch", Type: field.TypeFloat64, Nullable: true},
+		{Name: "tts_reader_opt_out", Type: field.TypeBool, Default: false},
+		{Name: "bits_donated", Type: field.TypeInt64, Default: 0},
+		{Name: "points", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "guild_id", Type: field.TypeUint64},
+	}
+	// MembersTable holds the schema information for the "members" table.
+	MembersTable = &schema.Table{
+		Name:       "members",
+		Columns:    MembersColumns,
+		PrimaryKey: []*schema.Column{MembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "members_guilds_members",
+				Columns:    []*schema.Column{MembersColumns[11]},
+				RefColumns: []*schema.Column{GuildsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "member_guild_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{MembersColumns[11], MembersColumns[1]},
+			},
+		},
+	}
+	// PlaylistsColumns holds the columns for the "playlists" table.
+	PlaylistsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "tracks", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "guild_id", Type: field.TypeUint64},
+	}
+	// PlaylistsTable holds the schema information for the "playlists" table.
+	PlaylistsTable = &schema.Table{
+		Name:       "playlists",
+		Columns:    PlaylistsColumns,
+		PrimaryKey: []*schema.Column{PlaylistsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "playlists_guilds_playlists",
+				Columns:    []*schema.Column{PlaylistsColumns[5]},
+				RefColumns: []*schema.Column{GuildsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "playlist_guild_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{PlaylistsColumns[5], PlaylistsColumns[1]},
+			},
+		},
+	}
+	// PronunciationsColumns holds the columns for the "pronunciations" table.
+	PronunciationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "word", Type: field.TypeString},
+		{Name: "replacement", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "guild_id", Type: field.TypeUint64},
+	}
+	// PronunciationsTable holds the schema information for the "pronunciations" table.
+	PronunciationsTable = &schema.Table{
+		Name:       "pronunciations",
+		Columns:    PronunciationsColumns,
+		PrimaryKey: []*schema.Column{PronunciationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pronunciations_guilds_pronunciations",
+				Columns:    []*schema.Column{PronunciationsColumns[4]},
+				RefColumns: []*schema.Column{GuildsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pronunciation_guild_id_word",
+				Unique:  true,
+				Columns: []*schema.Column{PronunciationsColumns[4], PronunciationsColumns[1]},
+			},
+		},
+	}
+	// TrackPlaysColumns holds the columns for the "track_plays" table.
+	TrackPlaysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "identifier", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString},
+		{Name: "uri", Type: field.TypeString, Nullable: true},
+		{Name: "encoded", Type: field.TypeString, Size: 2147483647},
+		{Name: "length", Type: field.TypeInt64},
+		{Name: "requester", Type: field.TypeString, Nullable: true},
+		{Name: "autoplay", Type: field.TypeBool, Default: false},
+		{Name: "played_at", Type: field.TypeTime},
+		{Name: "guild_id", Type: field.TypeUint64},
+	}
+	// TrackPlaysTable holds the schema information for the "track_plays" table.
+	TrackPlaysTable = &schema.Table{
+		Name:       "track_plays",
+		Columns:    TrackPlaysColumns,
+		PrimaryKey: []*schema.Column{TrackPlaysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "track_plays_guilds_track_plays",
+				Columns:    []*schema.Column{TrackPlaysColumns[10]},
+				RefColumns: []*schema.Column{GuildsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "trackplay_guild_id_played_at",
+				Unique:  false,
+				Columns: []*schema.Column{TrackPlaysColumns[10], TrackPlaysColumns[9]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "delivery_id", Type: field.TypeString},
+		{Name: "event", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "delivered", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "subscription_id", Type: field.TypeInt},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhook_subscriptions_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[10]},
+				RefColumns: []*schema.Column{WebhookSubscriptionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_subscription_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[10], WebhookDeliveriesColumns[8]},
+			},
+		},
+	}
+	// WebhookSubscriptionsColumns holds the columns for the "webhook_subscriptions" table.
+	WebhookSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "guild_id", Type: field.TypeUint64},
+	}
+	// WebhookSubscriptionsTable holds the schema information for the "webhook_subscriptions" table.
+	WebhookSubscriptionsTable = &schema.Table{
+		Name:       "webhook_subscriptions",
+		Columns:    WebhookSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{WebhookSubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_subscriptions_guilds_webhook_subscriptions",
+				Columns:    []*schema.Column{WebhookSubscriptionsColumns[5]},
+				RefColumns: []*schema.Column{GuildsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		CheerSoundsTable,
 		GuildsTable,
+		MembersTable,
+		PlaylistsTable,
+		PronunciationsTable,
+		TrackPlaysTable,
+		WebhookDeliveriesTable,
+		WebhookSubscriptionsTable,
 	}
 )
 
 func init() {
+	CheerSoundsTable.ForeignKeys[0].RefTable = GuildsTable
+	MembersTable.ForeignKeys[0].RefTable = GuildsTable
+	PlaylistsTable.ForeignKeys[0].RefTable = GuildsTable
+	PronunciationsTable.ForeignKeys[0].RefTable = GuildsTable
+	TrackPlaysTable.ForeignKeys[0].RefTable = GuildsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhookSubscriptionsTable
+	WebhookSubscriptionsTable.ForeignKeys[0].RefTable = GuildsTable
 }
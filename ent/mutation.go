@@ -12,8 +12,15 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/cheersound"
 	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/member"
+	"github.com/loukhin/probably-a-music-bot/ent/playlist"
 	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+	"github.com/loukhin/probably-a-music-bot/ent/pronunciation"
+	"github.com/loukhin/probably-a-music-bot/ent/trackplay"
+	"github.com/loukhin/probably-a-music-bot/ent/webhookdelivery"
+	"github.com/loukhin/probably-a-music-bot/ent/webhooksubscription"
 )
 
 const (
@@ -25,39 +32,48 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeGuild = "Guild"
+	TypeCheerSound          = "CheerSound"
+	TypeGuild               = "Guild"
+	TypeMember              = "Member"
+	TypePlaylist            = "Playlist"
+	TypePronunciation       = "Pronunciation"
+	TypeTrackPlay           = "TrackPlay"
+	TypeWebhookDelivery     = "WebhookDelivery"
+	TypeWebhookSubscription = "WebhookSubscription"
 )
 
-// GuildMutation represents an operation that mutates the Guild nodes in the graph.
-type GuildMutation struct {
+// CheerSoundMutation represents an operation that mutates the CheerSound nodes in the graph.
+type CheerSoundMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *snowflake.ID
-	name                 *string
-	player_channel_id    *snowflake.ID
-	addplayer_channel_id *snowflake.ID
-	player_message_id    *snowflake.ID
-	addplayer_message_id *snowflake.ID
-	created_at           *time.Time
-	updated_at           *time.Time
-	clearedFields        map[string]struct{}
-	done                 bool
-	oldValue             func(context.Context) (*Guild, error)
-	predicates           []predicate.Guild
+	op            Op
+	typ           string
+	id            *int
+	tier          *int
+	addtier       *int
+	file_name     *string
+	content_type  *string
+	data          *[]byte
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	guild         *snowflake.ID
+	clearedguild  bool
+	done          bool
+	oldValue      func(context.Context) (*CheerSound, error)
+	predicates    []predicate.CheerSound
 }
 
-var _ ent.Mutation = (*GuildMutation)(nil)
+var _ ent.Mutation = (*CheerSoundMutation)(nil)
 
-// guildOption allows management of the mutation configuration using functional options.
-type guildOption func(*GuildMutation)
+// cheersoundOption allows management of the mutation configuration using functional options.
+type cheersoundOption func(*CheerSoundMutation)
 
-// newGuildMutation creates new mutation for the Guild entity.
-func newGuildMutation(c config, op Op, opts ...guildOption) *GuildMutation {
-	m := &GuildMutation{
+// newCheerSoundMutation creates new mutation for the CheerSound entity.
+func newCheerSoundMutation(c config, op Op, opts ...cheersoundOption) *CheerSoundMutation {
+	m := &CheerSoundMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGuild,
+		typ:           TypeCheerSound,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -66,20 +82,20 @@ func newGuildMutation(c config, op Op, opts ...guildOption) *GuildMutation {
 	return m
 }
 
-// withGuildID sets the ID field of the mutation.
-func withGuildID(id snowflake.ID) guildOption {
-	return func(m *GuildMutation) {
+// withCheerSoundID sets the ID field of the mutation.
+func withCheerSoundID(id int) cheersoundOption {
+	return func(m *CheerSoundMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Guild
+			value *CheerSound
 		)
-		m.oldValue = func(ctx context.Context) (*Guild, error) {
+		m.oldValue = func(ctx context.Context) (*CheerSound, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Guild.Get(ctx, id)
+					value, err = m.Client().CheerSound.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -88,10 +104,10 @@ func withGuildID(id snowflake.ID) guildOption {
 	}
 }
 
-// withGuild sets the old Guild of the mutation.
-func withGuild(node *Guild) guildOption {
-	return func(m *GuildMutation) {
-		m.oldValue = func(context.Context) (*Guild, error) {
+// withCheerSound sets the old CheerSound of the mutation.
+func withCheerSound(node *CheerSound) cheersoundOption {
+	return func(m *CheerSoundMutation) {
+		m.oldValue = func(context.Context) (*CheerSound, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -100,7 +116,7 @@ func withGuild(node *Guild) guildOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GuildMutation) Client() *Client {
+func (m CheerSoundMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -108,7 +124,7 @@ func (m GuildMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GuildMutation) Tx() (*Tx, error) {
+func (m CheerSoundMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -117,15 +133,9 @@ func (m GuildMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Guild entities.
-func (m *GuildMutation) SetID(id snowflake.ID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GuildMutation) ID() (id snowflake.ID, exists bool) {
+func (m *CheerSoundMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -136,204 +146,228 @@ func (m *GuildMutation) ID() (id snowflake.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GuildMutation) IDs(ctx context.Context) ([]snowflake.ID, error) {
+func (m *CheerSoundMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []snowflake.ID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Guild.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CheerSound.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *GuildMutation) SetName(s string) {
-	m.name = &s
+// SetGuildID sets the "guild_id" field.
+func (m *CheerSoundMutation) SetGuildID(s snowflake.ID) {
+	m.guild = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *GuildMutation) Name() (r string, exists bool) {
-	v := m.name
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *CheerSoundMutation) GuildID() (r snowflake.ID, exists bool) {
+	v := m.guild
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Guild entity.
-// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// OldGuildID returns the old "guild_id" field's value of the CheerSound entity.
+// If the CheerSound object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GuildMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CheerSoundMutation) OldGuildID(ctx context.Context) (v snowflake.ID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.GuildID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *GuildMutation) ResetName() {
-	m.name = nil
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *CheerSoundMutation) ResetGuildID() {
+	m.guild = nil
 }
 
-// SetPlayerChannelID sets the "player_channel_id" field.
-func (m *GuildMutation) SetPlayerChannelID(s snowflake.ID) {
-	m.player_channel_id = &s
-	m.addplayer_channel_id = nil
+// SetTier sets the "tier" field.
+func (m *CheerSoundMutation) SetTier(i int) {
+	m.tier = &i
+	m.addtier = nil
 }
 
-// PlayerChannelID returns the value of the "player_channel_id" field in the mutation.
-func (m *GuildMutation) PlayerChannelID() (r snowflake.ID, exists bool) {
-	v := m.player_channel_id
+// Tier returns the value of the "tier" field in the mutation.
+func (m *CheerSoundMutation) Tier() (r int, exists bool) {
+	v := m.tier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlayerChannelID returns the old "player_channel_id" field's value of the Guild entity.
-// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// OldTier returns the old "tier" field's value of the CheerSound entity.
+// If the CheerSound object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GuildMutation) OldPlayerChannelID(ctx context.Context) (v *snowflake.ID, err error) {
+func (m *CheerSoundMutation) OldTier(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlayerChannelID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlayerChannelID requires an ID field in the mutation")
+		return v, errors.New("OldTier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlayerChannelID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTier: %w", err)
 	}
-	return oldValue.PlayerChannelID, nil
+	return oldValue.Tier, nil
 }
 
-// AddPlayerChannelID adds s to the "player_channel_id" field.
-func (m *GuildMutation) AddPlayerChannelID(s snowflake.ID) {
-	if m.addplayer_channel_id != nil {
-		*m.addplayer_channel_id += s
+// AddTier adds i to the "tier" field.
+func (m *CheerSoundMutation) AddTier(i int) {
+	if m.addtier != nil {
+		*m.addtier += i
 	} else {
-		m.addplayer_channel_id = &s
+		m.addtier = &i
 	}
 }
 
-// AddedPlayerChannelID returns the value that was added to the "player_channel_id" field in this mutation.
-func (m *GuildMutation) AddedPlayerChannelID() (r snowflake.ID, exists bool) {
-	v := m.addplayer_channel_id
+// AddedTier returns the value that was added to the "tier" field in this mutation.
+func (m *CheerSoundMutation) AddedTier() (r int, exists bool) {
+	v := m.addtier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearPlayerChannelID clears the value of the "player_channel_id" field.
-func (m *GuildMutation) ClearPlayerChannelID() {
-	m.player_channel_id = nil
-	m.addplayer_channel_id = nil
-	m.clearedFields[guild.FieldPlayerChannelID] = struct{}{}
+// ResetTier resets all changes to the "tier" field.
+func (m *CheerSoundMutation) ResetTier() {
+	m.tier = nil
+	m.addtier = nil
 }
 
-// PlayerChannelIDCleared returns if the "player_channel_id" field was cleared in this mutation.
-func (m *GuildMutation) PlayerChannelIDCleared() bool {
-	_, ok := m.clearedFields[guild.FieldPlayerChannelID]
-	return ok
+// SetFileName sets the "file_name" field.
+func (m *CheerSoundMutation) SetFileName(s string) {
+	m.file_name = &s
 }
 
-// ResetPlayerChannelID resets all changes to the "player_channel_id" field.
-func (m *GuildMutation) ResetPlayerChannelID() {
-	m.player_channel_id = nil
-	m.addplayer_channel_id = nil
-	delete(m.clearedFields, guild.FieldPlayerChannelID)
+// FileName returns the value of the "file_name" field in the mutation.
+func (m *CheerSoundMutation) FileName() (r string, exists bool) {
+	v := m.file_name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetPlayerMessageID sets the "player_message_id" field.
-func (m *GuildMutation) SetPlayerMessageID(s snowflake.ID) {
-	m.player_message_id = &s
-	m.addplayer_message_id = nil
+// OldFileName returns the old "file_name" field's value of the CheerSound entity.
+// If the CheerSound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheerSoundMutation) OldFileName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
+	}
+	return oldValue.FileName, nil
 }
 
-// PlayerMessageID returns the value of the "player_message_id" field in the mutation.
-func (m *GuildMutation) PlayerMessageID() (r snowflake.ID, exists bool) {
-	v := m.player_message_id
+// ResetFileName resets all changes to the "file_name" field.
+func (m *CheerSoundMutation) ResetFileName() {
+	m.file_name = nil
+}
+
+// SetContentType sets the "content_type" field.
+func (m *CheerSoundMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *CheerSoundMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlayerMessageID returns the old "player_message_id" field's value of the Guild entity.
-// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// OldContentType returns the old "content_type" field's value of the CheerSound entity.
+// If the CheerSound object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GuildMutation) OldPlayerMessageID(ctx context.Context) (v *snowflake.ID, err error) {
+func (m *CheerSoundMutation) OldContentType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlayerMessageID is only allowed on UpdateOne operations")
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlayerMessageID requires an ID field in the mutation")
+		return v, errors.New("OldContentType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlayerMessageID: %w", err)
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
 	}
-	return oldValue.PlayerMessageID, nil
+	return oldValue.ContentType, nil
 }
 
-// AddPlayerMessageID adds s to the "player_message_id" field.
-func (m *GuildMutation) AddPlayerMessageID(s snowflake.ID) {
-	if m.addplayer_message_id != nil {
-		*m.addplayer_message_id += s
-	} else {
-		m.addplayer_message_id = &s
-	}
+// ResetContentType resets all changes to the "content_type" field.
+func (m *CheerSoundMutation) ResetContentType() {
+	m.content_type = nil
 }
 
-// AddedPlayerMessageID returns the value that was added to the "player_message_id" field in this mutation.
-func (m *GuildMutation) AddedPlayerMessageID() (r snowflake.ID, exists bool) {
-	v := m.addplayer_message_id
+// SetData sets the "data" field.
+func (m *CheerSoundMutation) SetData(b []byte) {
+	m.data = &b
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *CheerSoundMutation) Data() (r []byte, exists bool) {
+	v := m.data
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearPlayerMessageID clears the value of the "player_message_id" field.
-func (m *GuildMutation) ClearPlayerMessageID() {
-	m.player_message_id = nil
-	m.addplayer_message_id = nil
-	m.clearedFields[guild.FieldPlayerMessageID] = struct{}{}
-}
-
-// PlayerMessageIDCleared returns if the "player_message_id" field was cleared in this mutation.
-func (m *GuildMutation) PlayerMessageIDCleared() bool {
-	_, ok := m.clearedFields[guild.FieldPlayerMessageID]
-	return ok
+// OldData returns the old "data" field's value of the CheerSound entity.
+// If the CheerSound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheerSoundMutation) OldData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
 }
 
-// ResetPlayerMessageID resets all changes to the "player_message_id" field.
-func (m *GuildMutation) ResetPlayerMessageID() {
-	m.player_message_id = nil
-	m.addplayer_message_id = nil
-	delete(m.clearedFields, guild.FieldPlayerMessageID)
+// ResetData resets all changes to the "data" field.
+func (m *CheerSoundMutation) ResetData() {
+	m.data = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *GuildMutation) SetCreatedAt(t time.Time) {
+func (m *CheerSoundMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GuildMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *CheerSoundMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -341,10 +375,10 @@ func (m *GuildMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Guild entity.
-// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the CheerSound entity.
+// If the CheerSound object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GuildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CheerSoundMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -358,18 +392,31 @@ func (m *GuildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 	return oldValue.CreatedAt, nil
 }
 
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *CheerSoundMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[cheersound.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *CheerSoundMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[cheersound.FieldCreatedAt]
+	return ok
+}
+
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GuildMutation) ResetCreatedAt() {
+func (m *CheerSoundMutation) ResetCreatedAt() {
 	m.created_at = nil
+	delete(m.clearedFields, cheersound.FieldCreatedAt)
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *GuildMutation) SetUpdatedAt(t time.Time) {
+func (m *CheerSoundMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GuildMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *CheerSoundMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -377,10 +424,10 @@ func (m *GuildMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Guild entity.
-// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the CheerSound entity.
+// If the CheerSound object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GuildMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CheerSoundMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -394,20 +441,60 @@ func (m *GuildMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 	return oldValue.UpdatedAt, nil
 }
 
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *CheerSoundMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[cheersound.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *CheerSoundMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[cheersound.FieldUpdatedAt]
+	return ok
+}
+
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GuildMutation) ResetUpdatedAt() {
+func (m *CheerSoundMutation) ResetUpdatedAt() {
 	m.updated_at = nil
+	delete(m.clearedFields, cheersound.FieldUpdatedAt)
 }
 
-// Where appends a list predicates to the GuildMutation builder.
-func (m *GuildMutation) Where(ps ...predicate.Guild) {
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (m *CheerSoundMutation) ClearGuild() {
+	m.clearedguild = true
+	m.clearedFields[cheersound.FieldGuildID] = struct{}{}
+}
+
+// GuildCleared reports if the "guild" edge to the Guild entity was cleared.
+func (m *CheerSoundMutation) GuildCleared() bool {
+	return m.clearedguild
+}
+
+// GuildIDs returns the "guild" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GuildID instead. It exists only for internal usage by the builders.
+func (m *CheerSoundMutation) GuildIDs() (ids []snowflake.ID) {
+	if id := m.guild; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGuild resets all changes to the "guild" edge.
+func (m *CheerSoundMutation) ResetGuild() {
+	m.guild = nil
+	m.clearedguild = false
+}
+
+// Where appends a list predicates to the CheerSoundMutation builder.
+func (m *CheerSoundMutation) Where(ps ...predicate.CheerSound) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GuildMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CheerSoundMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GuildMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Guild, len(ps))
+func (m *CheerSoundMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CheerSound, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -415,39 +502,45 @@ func (m *GuildMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GuildMutation) Op() Op {
+func (m *CheerSoundMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GuildMutation) SetOp(op Op) {
+func (m *CheerSoundMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Guild).
-func (m *GuildMutation) Type() string {
+// Type returns the node type of this mutation (CheerSound).
+func (m *CheerSoundMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GuildMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, guild.FieldName)
+func (m *CheerSoundMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.guild != nil {
+		fields = append(fields, cheersound.FieldGuildID)
 	}
-	if m.player_channel_id != nil {
-		fields = append(fields, guild.FieldPlayerChannelID)
+	if m.tier != nil {
+		fields = append(fields, cheersound.FieldTier)
 	}
-	if m.player_message_id != nil {
-		fields = append(fields, guild.FieldPlayerMessageID)
+	if m.file_name != nil {
+		fields = append(fields, cheersound.FieldFileName)
+	}
+	if m.content_type != nil {
+		fields = append(fields, cheersound.FieldContentType)
+	}
+	if m.data != nil {
+		fields = append(fields, cheersound.FieldData)
 	}
 	if m.created_at != nil {
-		fields = append(fields, guild.FieldCreatedAt)
+		fields = append(fields, cheersound.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, guild.FieldUpdatedAt)
+		fields = append(fields, cheersound.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -455,17 +548,21 @@ func (m *GuildMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GuildMutation) Field(name string) (ent.Value, bool) {
+func (m *CheerSoundMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case guild.FieldName:
-		return m.Name()
-	case guild.FieldPlayerChannelID:
-		return m.PlayerChannelID()
-	case guild.FieldPlayerMessageID:
-		return m.PlayerMessageID()
-	case guild.FieldCreatedAt:
+	case cheersound.FieldGuildID:
+		return m.GuildID()
+	case cheersound.FieldTier:
+		return m.Tier()
+	case cheersound.FieldFileName:
+		return m.FileName()
+	case cheersound.FieldContentType:
+		return m.ContentType()
+	case cheersound.FieldData:
+		return m.Data()
+	case cheersound.FieldCreatedAt:
 		return m.CreatedAt()
-	case guild.FieldUpdatedAt:
+	case cheersound.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -474,56 +571,74 @@ func (m *GuildMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GuildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CheerSoundMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case guild.FieldName:
-		return m.OldName(ctx)
-	case guild.FieldPlayerChannelID:
-		return m.OldPlayerChannelID(ctx)
-	case guild.FieldPlayerMessageID:
-		return m.OldPlayerMessageID(ctx)
-	case guild.FieldCreatedAt:
+	case cheersound.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case cheersound.FieldTier:
+		return m.OldTier(ctx)
+	case cheersound.FieldFileName:
+		return m.OldFileName(ctx)
+	case cheersound.FieldContentType:
+		return m.OldContentType(ctx)
+	case cheersound.FieldData:
+		return m.OldData(ctx)
+	case cheersound.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case guild.FieldUpdatedAt:
+	case cheersound.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Guild field %s", name)
+	return nil, fmt.Errorf("unknown CheerSound field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GuildMutation) SetField(name string, value ent.Value) error {
+func (m *CheerSoundMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case guild.FieldName:
+	case cheersound.FieldGuildID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case cheersound.FieldTier:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTier(v)
+		return nil
+	case cheersound.FieldFileName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetFileName(v)
 		return nil
-	case guild.FieldPlayerChannelID:
-		v, ok := value.(snowflake.ID)
+	case cheersound.FieldContentType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlayerChannelID(v)
+		m.SetContentType(v)
 		return nil
-	case guild.FieldPlayerMessageID:
-		v, ok := value.(snowflake.ID)
+	case cheersound.FieldData:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlayerMessageID(v)
+		m.SetData(v)
 		return nil
-	case guild.FieldCreatedAt:
+	case cheersound.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case guild.FieldUpdatedAt:
+	case cheersound.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -531,18 +646,15 @@ func (m *GuildMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Guild field %s", name)
+	return fmt.Errorf("unknown CheerSound field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GuildMutation) AddedFields() []string {
+func (m *CheerSoundMutation) AddedFields() []string {
 	var fields []string
-	if m.addplayer_channel_id != nil {
-		fields = append(fields, guild.FieldPlayerChannelID)
-	}
-	if m.addplayer_message_id != nil {
-		fields = append(fields, guild.FieldPlayerMessageID)
+	if m.addtier != nil {
+		fields = append(fields, cheersound.FieldTier)
 	}
 	return fields
 }
@@ -550,12 +662,10 @@ func (m *GuildMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GuildMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CheerSoundMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case guild.FieldPlayerChannelID:
-		return m.AddedPlayerChannelID()
-	case guild.FieldPlayerMessageID:
-		return m.AddedPlayerMessageID()
+	case cheersound.FieldTier:
+		return m.AddedTier()
 	}
 	return nil, false
 }
@@ -563,127 +673,7646 @@ func (m *GuildMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GuildMutation) AddField(name string, value ent.Value) error {
+func (m *CheerSoundMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case cheersound.FieldTier:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTier(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CheerSound numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CheerSoundMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(cheersound.FieldCreatedAt) {
+		fields = append(fields, cheersound.FieldCreatedAt)
+	}
+	if m.FieldCleared(cheersound.FieldUpdatedAt) {
+		fields = append(fields, cheersound.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CheerSoundMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CheerSoundMutation) ClearField(name string) error {
+	switch name {
+	case cheersound.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case cheersound.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CheerSound nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CheerSoundMutation) ResetField(name string) error {
+	switch name {
+	case cheersound.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case cheersound.FieldTier:
+		m.ResetTier()
+		return nil
+	case cheersound.FieldFileName:
+		m.ResetFileName()
+		return nil
+	case cheersound.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case cheersound.FieldData:
+		m.ResetData()
+		return nil
+	case cheersound.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case cheersound.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CheerSound field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CheerSoundMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.guild != nil {
+		edges = append(edges, cheersound.EdgeGuild)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CheerSoundMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case cheersound.EdgeGuild:
+		if id := m.guild; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CheerSoundMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CheerSoundMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CheerSoundMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedguild {
+		edges = append(edges, cheersound.EdgeGuild)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CheerSoundMutation) EdgeCleared(name string) bool {
+	switch name {
+	case cheersound.EdgeGuild:
+		return m.clearedguild
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CheerSoundMutation) ClearEdge(name string) error {
+	switch name {
+	case cheersound.EdgeGuild:
+		m.ClearGuild()
+		return nil
+	}
+	return fmt.Errorf("unknown CheerSound unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CheerSoundMutation) ResetEdge(name string) error {
+	switch name {
+	case cheersound.EdgeGuild:
+		m.ResetGuild()
+		return nil
+	}
+	return fmt.Errorf("unknown CheerSound edge %s", name)
+}
+
+// GuildMutation represents an operation that mutates the Guild nodes in the graph.
+type GuildMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *snowflake.ID
+	name                         *string
+	player_channel_id            *snowflake.ID
+	addplayer_channel_id         *snowflake.ID
+	player_message_id            *snowflake.ID
+	addplayer_message_id         *snowflake.ID
+	idle_timeout                 *int
+	addidle_timeout              *int
+	alone_timeout                *int
+	addalone_timeout             *int
+	autoplay                     *bool
+	always_on                    *bool
+	always_on_channel_id         *snowflake.ID
+	addalways_on_channel_id      *snowflake.ID
+	fallback_query               *string
+	tts_provider                 *string
+	tts_language                 *string
+	tts_voice                    *string
+	tts_rate                     *float64
+	addtts_rate                  *float64
+	tts_pitch                    *float64
+	addtts_pitch                 *float64
+	tts_reader_channel_id        *snowflake.ID
+	addtts_reader_channel_id     *snowflake.ID
+	overlay_token                *string
+	api_token                    *string
+	sleep_at                     *time.Time
+	sleep_end_of_track           *bool
+	created_at                   *time.Time
+	updated_at                   *time.Time
+	clearedFields                map[string]struct{}
+	members                      map[int]struct{}
+	removedmembers               map[int]struct{}
+	clearedmembers               bool
+	pronunciations               map[int]struct{}
+	removedpronunciations        map[int]struct{}
+	clearedpronunciations        bool
+	cheer_sounds                 map[int]struct{}
+	removedcheer_sounds          map[int]struct{}
+	clearedcheer_sounds          bool
+	webhook_subscriptions        map[int]struct{}
+	removedwebhook_subscriptions map[int]struct{}
+	clearedwebhook_subscriptions bool
+	track_plays                  map[int]struct{}
+	removedtrack_plays           map[int]struct{}
+	clearedtrack_plays           bool
+	playlists                    map[int]struct{}
+	removedplaylists             map[int]struct{}
+	clearedplaylists             bool
+	done                         bool
+	oldValue                     func(context.Context) (*Guild, error)
+	predicates                   []predicate.Guild
+}
+
+var _ ent.Mutation = (*GuildMutation)(nil)
+
+// guildOption allows management of the mutation configuration using functional options.
+type guildOption func(*GuildMutation)
+
+// newGuildMutation creates new mutation for the Guild entity.
+func newGuildMutation(c config, op Op, opts ...guildOption) *GuildMutation {
+	m := &GuildMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGuild,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGuildID sets the ID field of the mutation.
+func withGuildID(id snowflake.ID) guildOption {
+	return func(m *GuildMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Guild
+		)
+		m.oldValue = func(ctx context.Context) (*Guild, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Guild.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGuild sets the old Guild of the mutation.
+func withGuild(node *Guild) guildOption {
+	return func(m *GuildMutation) {
+		m.oldValue = func(context.Context) (*Guild, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GuildMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GuildMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Guild entities.
+func (m *GuildMutation) SetID(id snowflake.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GuildMutation) ID() (id snowflake.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GuildMutation) IDs(ctx context.Context) ([]snowflake.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []snowflake.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Guild.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *GuildMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GuildMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *GuildMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPlayerChannelID sets the "player_channel_id" field.
+func (m *GuildMutation) SetPlayerChannelID(s snowflake.ID) {
+	m.player_channel_id = &s
+	m.addplayer_channel_id = nil
+}
+
+// PlayerChannelID returns the value of the "player_channel_id" field in the mutation.
+func (m *GuildMutation) PlayerChannelID() (r snowflake.ID, exists bool) {
+	v := m.player_channel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayerChannelID returns the old "player_channel_id" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldPlayerChannelID(ctx context.Context) (v *snowflake.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayerChannelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayerChannelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayerChannelID: %w", err)
+	}
+	return oldValue.PlayerChannelID, nil
+}
+
+// AddPlayerChannelID adds s to the "player_channel_id" field.
+func (m *GuildMutation) AddPlayerChannelID(s snowflake.ID) {
+	if m.addplayer_channel_id != nil {
+		*m.addplayer_channel_id += s
+	} else {
+		m.addplayer_channel_id = &s
+	}
+}
+
+// AddedPlayerChannelID returns the value that was added to the "player_channel_id" field in this mutation.
+func (m *GuildMutation) AddedPlayerChannelID() (r snowflake.ID, exists bool) {
+	v := m.addplayer_channel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPlayerChannelID clears the value of the "player_channel_id" field.
+func (m *GuildMutation) ClearPlayerChannelID() {
+	m.player_channel_id = nil
+	m.addplayer_channel_id = nil
+	m.clearedFields[guild.FieldPlayerChannelID] = struct{}{}
+}
+
+// PlayerChannelIDCleared returns if the "player_channel_id" field was cleared in this mutation.
+func (m *GuildMutation) PlayerChannelIDCleared() bool {
+	_, ok := m.clearedFields[guild.FieldPlayerChannelID]
+	return ok
+}
+
+// ResetPlayerChannelID resets all changes to the "player_channel_id" field.
+func (m *GuildMutation) ResetPlayerChannelID() {
+	m.player_channel_id = nil
+	m.addplayer_channel_id = nil
+	delete(m.clearedFields, guild.FieldPlayerChannelID)
+}
+
+// SetPlayerMessageID sets the "player_message_id" field.
+func (m *GuildMutation) SetPlayerMessageID(s snowflake.ID) {
+	m.player_message_id = &s
+	m.addplayer_message_id = nil
+}
+
+// PlayerMessageID returns the value of the "player_message_id" field in the mutation.
+func (m *GuildMutation) PlayerMessageID() (r snowflake.ID, exists bool) {
+	v := m.player_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayerMessageID returns the old "player_message_id" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldPlayerMessageID(ctx context.Context) (v *snowflake.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayerMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayerMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayerMessageID: %w", err)
+	}
+	return oldValue.PlayerMessageID, nil
+}
+
+// AddPlayerMessageID adds s to the "player_message_id" field.
+func (m *GuildMutation) AddPlayerMessageID(s snowflake.ID) {
+	if m.addplayer_message_id != nil {
+		*m.addplayer_message_id += s
+	} else {
+		m.addplayer_message_id = &s
+	}
+}
+
+// AddedPlayerMessageID returns the value that was added to the "player_message_id" field in this mutation.
+func (m *GuildMutation) AddedPlayerMessageID() (r snowflake.ID, exists bool) {
+	v := m.addplayer_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPlayerMessageID clears the value of the "player_message_id" field.
+func (m *GuildMutation) ClearPlayerMessageID() {
+	m.player_message_id = nil
+	m.addplayer_message_id = nil
+	m.clearedFields[guild.FieldPlayerMessageID] = struct{}{}
+}
+
+// PlayerMessageIDCleared returns if the "player_message_id" field was cleared in this mutation.
+func (m *GuildMutation) PlayerMessageIDCleared() bool {
+	_, ok := m.clearedFields[guild.FieldPlayerMessageID]
+	return ok
+}
+
+// ResetPlayerMessageID resets all changes to the "player_message_id" field.
+func (m *GuildMutation) ResetPlayerMessageID() {
+	m.player_message_id = nil
+	m.addplayer_message_id = nil
+	delete(m.clearedFields, guild.FieldPlayerMessageID)
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (m *GuildMutation) SetIdleTimeout(i int) {
+	m.idle_timeout = &i
+	m.addidle_timeout = nil
+}
+
+// IdleTimeout returns the value of the "idle_timeout" field in the mutation.
+func (m *GuildMutation) IdleTimeout() (r int, exists bool) {
+	v := m.idle_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdleTimeout returns the old "idle_timeout" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldIdleTimeout(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdleTimeout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdleTimeout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdleTimeout: %w", err)
+	}
+	return oldValue.IdleTimeout, nil
+}
+
+// AddIdleTimeout adds i to the "idle_timeout" field.
+func (m *GuildMutation) AddIdleTimeout(i int) {
+	if m.addidle_timeout != nil {
+		*m.addidle_timeout += i
+	} else {
+		m.addidle_timeout = &i
+	}
+}
+
+// AddedIdleTimeout returns the value that was added to the "idle_timeout" field in this mutation.
+func (m *GuildMutation) AddedIdleTimeout() (r int, exists bool) {
+	v := m.addidle_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIdleTimeout resets all changes to the "idle_timeout" field.
+func (m *GuildMutation) ResetIdleTimeout() {
+	m.idle_timeout = nil
+	m.addidle_timeout = nil
+}
+
+// SetAloneTimeout sets the "alone_timeout" field.
+func (m *GuildMutation) SetAloneTimeout(i int) {
+	m.alone_timeout = &i
+	m.addalone_timeout = nil
+}
+
+// AloneTimeout returns the value of the "alone_timeout" field in the mutation.
+func (m *GuildMutation) AloneTimeout() (r int, exists bool) {
+	v := m.alone_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAloneTimeout returns the old "alone_timeout" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldAloneTimeout(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAloneTimeout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAloneTimeout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAloneTimeout: %w", err)
+	}
+	return oldValue.AloneTimeout, nil
+}
+
+// AddAloneTimeout adds i to the "alone_timeout" field.
+func (m *GuildMutation) AddAloneTimeout(i int) {
+	if m.addalone_timeout != nil {
+		*m.addalone_timeout += i
+	} else {
+		m.addalone_timeout = &i
+	}
+}
+
+// AddedAloneTimeout returns the value that was added to the "alone_timeout" field in this mutation.
+func (m *GuildMutation) AddedAloneTimeout() (r int, exists bool) {
+	v := m.addalone_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAloneTimeout resets all changes to the "alone_timeout" field.
+func (m *GuildMutation) ResetAloneTimeout() {
+	m.alone_timeout = nil
+	m.addalone_timeout = nil
+}
+
+// SetAutoplay sets the "autoplay" field.
+func (m *GuildMutation) SetAutoplay(b bool) {
+	m.autoplay = &b
+}
+
+// Autoplay returns the value of the "autoplay" field in the mutation.
+func (m *GuildMutation) Autoplay() (r bool, exists bool) {
+	v := m.autoplay
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoplay returns the old "autoplay" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldAutoplay(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoplay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoplay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoplay: %w", err)
+	}
+	return oldValue.Autoplay, nil
+}
+
+// ResetAutoplay resets all changes to the "autoplay" field.
+func (m *GuildMutation) ResetAutoplay() {
+	m.autoplay = nil
+}
+
+// SetAlwaysOn sets the "always_on" field.
+func (m *GuildMutation) SetAlwaysOn(b bool) {
+	m.always_on = &b
+}
+
+// AlwaysOn returns the value of the "always_on" field in the mutation.
+func (m *GuildMutation) AlwaysOn() (r bool, exists bool) {
+	v := m.always_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlwaysOn returns the old "always_on" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldAlwaysOn(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlwaysOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlwaysOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlwaysOn: %w", err)
+	}
+	return oldValue.AlwaysOn, nil
+}
+
+// ResetAlwaysOn resets all changes to the "always_on" field.
+func (m *GuildMutation) ResetAlwaysOn() {
+	m.always_on = nil
+}
+
+// SetAlwaysOnChannelID sets the "always_on_channel_id" field.
+func (m *GuildMutation) SetAlwaysOnChannelID(s snowflake.ID) {
+	m.always_on_channel_id = &s
+	m.addalways_on_channel_id = nil
+}
+
+// AlwaysOnChannelID returns the value of the "always_on_channel_id" field in the mutation.
+func (m *GuildMutation) AlwaysOnChannelID() (r snowflake.ID, exists bool) {
+	v := m.always_on_channel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlwaysOnChannelID returns the old "always_on_channel_id" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldAlwaysOnChannelID(ctx context.Context) (v *snowflake.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlwaysOnChannelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlwaysOnChannelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlwaysOnChannelID: %w", err)
+	}
+	return oldValue.AlwaysOnChannelID, nil
+}
+
+// AddAlwaysOnChannelID adds s to the "always_on_channel_id" field.
+func (m *GuildMutation) AddAlwaysOnChannelID(s snowflake.ID) {
+	if m.addalways_on_channel_id != nil {
+		*m.addalways_on_channel_id += s
+	} else {
+		m.addalways_on_channel_id = &s
+	}
+}
+
+// AddedAlwaysOnChannelID returns the value that was added to the "always_on_channel_id" field in this mutation.
+func (m *GuildMutation) AddedAlwaysOnChannelID() (r snowflake.ID, exists bool) {
+	v := m.addalways_on_channel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAlwaysOnChannelID clears the value of the "always_on_channel_id" field.
+func (m *GuildMutation) ClearAlwaysOnChannelID() {
+	m.always_on_channel_id = nil
+	m.addalways_on_channel_id = nil
+	m.clearedFields[guild.FieldAlwaysOnChannelID] = struct{}{}
+}
+
+// AlwaysOnChannelIDCleared returns if the "always_on_channel_id" field was cleared in this mutation.
+func (m *GuildMutation) AlwaysOnChannelIDCleared() bool {
+	_, ok := m.clearedFields[guild.FieldAlwaysOnChannelID]
+	return ok
+}
+
+// ResetAlwaysOnChannelID resets all changes to the "always_on_channel_id" field.
+func (m *GuildMutation) ResetAlwaysOnChannelID() {
+	m.always_on_channel_id = nil
+	m.addalways_on_channel_id = nil
+	delete(m.clearedFields, guild.FieldAlwaysOnChannelID)
+}
+
+// SetFallbackQuery sets the "fallback_query" field.
+func (m *GuildMutation) SetFallbackQuery(s string) {
+	m.fallback_query = &s
+}
+
+// FallbackQuery returns the value of the "fallback_query" field in the mutation.
+func (m *GuildMutation) FallbackQuery() (r string, exists bool) {
+	v := m.fallback_query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFallbackQuery returns the old "fallback_query" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldFallbackQuery(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFallbackQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFallbackQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFallbackQuery: %w", err)
+	}
+	return oldValue.FallbackQuery, nil
+}
+
+// ClearFallbackQuery clears the value of the "fallback_query" field.
+func (m *GuildMutation) ClearFallbackQuery() {
+	m.fallback_query = nil
+	m.clearedFields[guild.FieldFallbackQuery] = struct{}{}
+}
+
+// FallbackQueryCleared returns if the "fallback_query" field was cleared in this mutation.
+func (m *GuildMutation) FallbackQueryCleared() bool {
+	_, ok := m.clearedFields[guild.FieldFallbackQuery]
+	return ok
+}
+
+// ResetFallbackQuery resets all changes to the "fallback_query" field.
+func (m *GuildMutation) ResetFallbackQuery() {
+	m.fallback_query = nil
+	delete(m.clearedFields, guild.FieldFallbackQuery)
+}
+
+// SetTtsProvider sets the "tts_provider" field.
+func (m *GuildMutation) SetTtsProvider(s string) {
+	m.tts_provider = &s
+}
+
+// TtsProvider returns the value of the "tts_provider" field in the mutation.
+func (m *GuildMutation) TtsProvider() (r string, exists bool) {
+	v := m.tts_provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTtsProvider returns the old "tts_provider" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldTtsProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTtsProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTtsProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTtsProvider: %w", err)
+	}
+	return oldValue.TtsProvider, nil
+}
+
+// ResetTtsProvider resets all changes to the "tts_provider" field.
+func (m *GuildMutation) ResetTtsProvider() {
+	m.tts_provider = nil
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (m *GuildMutation) SetTtsLanguage(s string) {
+	m.tts_language = &s
+}
+
+// TtsLanguage returns the value of the "tts_language" field in the mutation.
+func (m *GuildMutation) TtsLanguage() (r string, exists bool) {
+	v := m.tts_language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTtsLanguage returns the old "tts_language" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldTtsLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTtsLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTtsLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTtsLanguage: %w", err)
+	}
+	return oldValue.TtsLanguage, nil
+}
+
+// ResetTtsLanguage resets all changes to the "tts_language" field.
+func (m *GuildMutation) ResetTtsLanguage() {
+	m.tts_language = nil
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (m *GuildMutation) SetTtsVoice(s string) {
+	m.tts_voice = &s
+}
+
+// TtsVoice returns the value of the "tts_voice" field in the mutation.
+func (m *GuildMutation) TtsVoice() (r string, exists bool) {
+	v := m.tts_voice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTtsVoice returns the old "tts_voice" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldTtsVoice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTtsVoice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTtsVoice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTtsVoice: %w", err)
+	}
+	return oldValue.TtsVoice, nil
+}
+
+// ResetTtsVoice resets all changes to the "tts_voice" field.
+func (m *GuildMutation) ResetTtsVoice() {
+	m.tts_voice = nil
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (m *GuildMutation) SetTtsRate(f float64) {
+	m.tts_rate = &f
+	m.addtts_rate = nil
+}
+
+// TtsRate returns the value of the "tts_rate" field in the mutation.
+func (m *GuildMutation) TtsRate() (r float64, exists bool) {
+	v := m.tts_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTtsRate returns the old "tts_rate" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldTtsRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTtsRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTtsRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTtsRate: %w", err)
+	}
+	return oldValue.TtsRate, nil
+}
+
+// AddTtsRate adds f to the "tts_rate" field.
+func (m *GuildMutation) AddTtsRate(f float64) {
+	if m.addtts_rate != nil {
+		*m.addtts_rate += f
+	} else {
+		m.addtts_rate = &f
+	}
+}
+
+// AddedTtsRate returns the value that was added to the "tts_rate" field in this mutation.
+func (m *GuildMutation) AddedTtsRate() (r float64, exists bool) {
+	v := m.addtts_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTtsRate resets all changes to the "tts_rate" field.
+func (m *GuildMutation) ResetTtsRate() {
+	m.tts_rate = nil
+	m.addtts_rate = nil
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (m *GuildMutation) SetTtsPitch(f float64) {
+	m.tts_pitch = &f
+	m.addtts_pitch = nil
+}
+
+// TtsPitch returns the value of the "tts_pitch" field in the mutation.
+func (m *GuildMutation) TtsPitch() (r float64, exists bool) {
+	v := m.tts_pitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTtsPitch returns the old "tts_pitch" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldTtsPitch(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTtsPitch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTtsPitch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTtsPitch: %w", err)
+	}
+	return oldValue.TtsPitch, nil
+}
+
+// AddTtsPitch adds f to the "tts_pitch" field.
+func (m *GuildMutation) AddTtsPitch(f float64) {
+	if m.addtts_pitch != nil {
+		*m.addtts_pitch += f
+	} else {
+		m.addtts_pitch = &f
+	}
+}
+
+// AddedTtsPitch returns the value that was added to the "tts_pitch" field in this mutation.
+func (m *GuildMutation) AddedTtsPitch() (r float64, exists bool) {
+	v := m.addtts_pitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTtsPitch resets all changes to the "tts_pitch" field.
+func (m *GuildMutation) ResetTtsPitch() {
+	m.tts_pitch = nil
+	m.addtts_pitch = nil
+}
+
+// SetTtsReaderChannelID sets the "tts_reader_channel_id" field.
+func (m *GuildMutation) SetTtsReaderChannelID(s snowflake.ID) {
+	m.tts_reader_channel_id = &s
+	m.addtts_reader_channel_id = nil
+}
+
+// TtsReaderChannelID returns the value of the "tts_reader_channel_id" field in the mutation.
+func (m *GuildMutation) TtsReaderChannelID() (r snowflake.ID, exists bool) {
+	v := m.tts_reader_channel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTtsReaderChannelID returns the old "tts_reader_channel_id" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldTtsReaderChannelID(ctx context.Context) (v *snowflake.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTtsReaderChannelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTtsReaderChannelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTtsReaderChannelID: %w", err)
+	}
+	return oldValue.TtsReaderChannelID, nil
+}
+
+// AddTtsReaderChannelID adds s to the "tts_reader_channel_id" field.
+func (m *GuildMutation) AddTtsReaderChannelID(s snowflake.ID) {
+	if m.addtts_reader_channel_id != nil {
+		*m.addtts_reader_channel_id += s
+	} else {
+		m.addtts_reader_channel_id = &s
+	}
+}
+
+// AddedTtsReaderChannelID returns the value that was added to the "tts_reader_channel_id" field in this mutation.
+func (m *GuildMutation) AddedTtsReaderChannelID() (r snowflake.ID, exists bool) {
+	v := m.addtts_reader_channel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTtsReaderChannelID clears the value of the "tts_reader_channel_id" field.
+func (m *GuildMutation) ClearTtsReaderChannelID() {
+	m.tts_reader_channel_id = nil
+	m.addtts_reader_channel_id = nil
+	m.clearedFields[guild.FieldTtsReaderChannelID] = struct{}{}
+}
+
+// TtsReaderChannelIDCleared returns if the "tts_reader_channel_id" field was cleared in this mutation.
+func (m *GuildMutation) TtsReaderChannelIDCleared() bool {
+	_, ok := m.clearedFields[guild.FieldTtsReaderChannelID]
+	return ok
+}
+
+// ResetTtsReaderChannelID resets all changes to the "tts_reader_channel_id" field.
+func (m *GuildMutation) ResetTtsReaderChannelID() {
+	m.tts_reader_channel_id = nil
+	m.addtts_reader_channel_id = nil
+	delete(m.clearedFields, guild.FieldTtsReaderChannelID)
+}
+
+// SetOverlayToken sets the "overlay_token" field.
+func (m *GuildMutation) SetOverlayToken(s string) {
+	m.overlay_token = &s
+}
+
+// OverlayToken returns the value of the "overlay_token" field in the mutation.
+func (m *GuildMutation) OverlayToken() (r string, exists bool) {
+	v := m.overlay_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOverlayToken returns the old "overlay_token" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldOverlayToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOverlayToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOverlayToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOverlayToken: %w", err)
+	}
+	return oldValue.OverlayToken, nil
+}
+
+// ClearOverlayToken clears the value of the "overlay_token" field.
+func (m *GuildMutation) ClearOverlayToken() {
+	m.overlay_token = nil
+	m.clearedFields[guild.FieldOverlayToken] = struct{}{}
+}
+
+// OverlayTokenCleared returns if the "overlay_token" field was cleared in this mutation.
+func (m *GuildMutation) OverlayTokenCleared() bool {
+	_, ok := m.clearedFields[guild.FieldOverlayToken]
+	return ok
+}
+
+// ResetOverlayToken resets all changes to the "overlay_token" field.
+func (m *GuildMutation) ResetOverlayToken() {
+	m.overlay_token = nil
+	delete(m.clearedFields, guild.FieldOverlayToken)
+}
+
+// SetAPIToken sets the "api_token" field.
+func (m *GuildMutation) SetAPIToken(s string) {
+	m.api_token = &s
+}
+
+// APIToken returns the value of the "api_token" field in the mutation.
+func (m *GuildMutation) APIToken() (r string, exists bool) {
+	v := m.api_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIToken returns the old "api_token" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldAPIToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIToken: %w", err)
+	}
+	return oldValue.APIToken, nil
+}
+
+// ClearAPIToken clears the value of the "api_token" field.
+func (m *GuildMutation) ClearAPIToken() {
+	m.api_token = nil
+	m.clearedFields[guild.FieldAPIToken] = struct{}{}
+}
+
+// APITokenCleared returns if the "api_token" field was cleared in this mutation.
+func (m *GuildMutation) APITokenCleared() bool {
+	_, ok := m.clearedFields[guild.FieldAPIToken]
+	return ok
+}
+
+// ResetAPIToken resets all changes to the "api_token" field.
+func (m *GuildMutation) ResetAPIToken() {
+	m.api_token = nil
+	delete(m.clearedFields, guild.FieldAPIToken)
+}
+
+// SetSleepAt sets the "sleep_at" field.
+func (m *GuildMutation) SetSleepAt(t time.Time) {
+	m.sleep_at = &t
+}
+
+// SleepAt returns the value of the "sleep_at" field in the mutation.
+func (m *GuildMutation) SleepAt() (r time.Time, exists bool) {
+	v := m.sleep_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSleepAt returns the old "sleep_at" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldSleepAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSleepAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSleepAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSleepAt: %w", err)
+	}
+	return oldValue.SleepAt, nil
+}
+
+// ClearSleepAt clears the value of the "sleep_at" field.
+func (m *GuildMutation) ClearSleepAt() {
+	m.sleep_at = nil
+	m.clearedFields[guild.FieldSleepAt] = struct{}{}
+}
+
+// SleepAtCleared returns if the "sleep_at" field was cleared in this mutation.
+func (m *GuildMutation) SleepAtCleared() bool {
+	_, ok := m.clearedFields[guild.FieldSleepAt]
+	return ok
+}
+
+// ResetSleepAt resets all changes to the "sleep_at" field.
+func (m *GuildMutation) ResetSleepAt() {
+	m.sleep_at = nil
+	delete(m.clearedFields, guild.FieldSleepAt)
+}
+
+// SetSleepEndOfTrack sets the "sleep_end_of_track" field.
+func (m *GuildMutation) SetSleepEndOfTrack(b bool) {
+	m.sleep_end_of_track = &b
+}
+
+// SleepEndOfTrack returns the value of the "sleep_end_of_track" field in the mutation.
+func (m *GuildMutation) SleepEndOfTrack() (r bool, exists bool) {
+	v := m.sleep_end_of_track
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSleepEndOfTrack returns the old "sleep_end_of_track" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldSleepEndOfTrack(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSleepEndOfTrack is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSleepEndOfTrack requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSleepEndOfTrack: %w", err)
+	}
+	return oldValue.SleepEndOfTrack, nil
+}
+
+// ResetSleepEndOfTrack resets all changes to the "sleep_end_of_track" field.
+func (m *GuildMutation) ResetSleepEndOfTrack() {
+	m.sleep_end_of_track = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GuildMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GuildMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *GuildMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[guild.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *GuildMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[guild.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GuildMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, guild.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GuildMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GuildMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Guild entity.
+// If the Guild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *GuildMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[guild.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *GuildMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[guild.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GuildMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, guild.FieldUpdatedAt)
+}
+
+// AddMemberIDs adds the "members" edge to the Member entity by ids.
+func (m *GuildMutation) AddMemberIDs(ids ...int) {
+	if m.members == nil {
+		m.members = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the Member entity.
+func (m *GuildMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the Member entity was cleared.
+func (m *GuildMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the Member entity by IDs.
+func (m *GuildMutation) RemoveMemberIDs(ids ...int) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the Member entity.
+func (m *GuildMutation) RemovedMembersIDs() (ids []int) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *GuildMutation) MembersIDs() (ids []int) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *GuildMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// AddPronunciationIDs adds the "pronunciations" edge to the Pronunciation entity by ids.
+func (m *GuildMutation) AddPronunciationIDs(ids ...int) {
+	if m.pronunciations == nil {
+		m.pronunciations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.pronunciations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPronunciations clears the "pronunciations" edge to the Pronunciation entity.
+func (m *GuildMutation) ClearPronunciations() {
+	m.clearedpronunciations = true
+}
+
+// PronunciationsCleared reports if the "pronunciations" edge to the Pronunciation entity was cleared.
+func (m *GuildMutation) PronunciationsCleared() bool {
+	return m.clearedpronunciations
+}
+
+// RemovePronunciationIDs removes the "pronunciations" edge to the Pronunciation entity by IDs.
+func (m *GuildMutation) RemovePronunciationIDs(ids ...int) {
+	if m.removedpronunciations == nil {
+		m.removedpronunciations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.pronunciations, ids[i])
+		m.removedpronunciations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPronunciations returns the removed IDs of the "pronunciations" edge to the Pronunciation entity.
+func (m *GuildMutation) RemovedPronunciationsIDs() (ids []int) {
+	for id := range m.removedpronunciations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PronunciationsIDs returns the "pronunciations" edge IDs in the mutation.
+func (m *GuildMutation) PronunciationsIDs() (ids []int) {
+	for id := range m.pronunciations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPronunciations resets all changes to the "pronunciations" edge.
+func (m *GuildMutation) ResetPronunciations() {
+	m.pronunciations = nil
+	m.clearedpronunciations = false
+	m.removedpronunciations = nil
+}
+
+// AddCheerSoundIDs adds the "cheer_sounds" edge to the CheerSound entity by ids.
+func (m *GuildMutation) AddCheerSoundIDs(ids ...int) {
+	if m.cheer_sounds == nil {
+		m.cheer_sounds = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.cheer_sounds[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCheerSounds clears the "cheer_sounds" edge to the CheerSound entity.
+func (m *GuildMutation) ClearCheerSounds() {
+	m.clearedcheer_sounds = true
+}
+
+// CheerSoundsCleared reports if the "cheer_sounds" edge to the CheerSound entity was cleared.
+func (m *GuildMutation) CheerSoundsCleared() bool {
+	return m.clearedcheer_sounds
+}
+
+// RemoveCheerSoundIDs removes the "cheer_sounds" edge to the CheerSound entity by IDs.
+func (m *GuildMutation) RemoveCheerSoundIDs(ids ...int) {
+	if m.removedcheer_sounds == nil {
+		m.removedcheer_sounds = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.cheer_sounds, ids[i])
+		m.removedcheer_sounds[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCheerSounds returns the removed IDs of the "cheer_sounds" edge to the CheerSound entity.
+func (m *GuildMutation) RemovedCheerSoundsIDs() (ids []int) {
+	for id := range m.removedcheer_sounds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CheerSoundsIDs returns the "cheer_sounds" edge IDs in the mutation.
+func (m *GuildMutation) CheerSoundsIDs() (ids []int) {
+	for id := range m.cheer_sounds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCheerSounds resets all changes to the "cheer_sounds" edge.
+func (m *GuildMutation) ResetCheerSounds() {
+	m.cheer_sounds = nil
+	m.clearedcheer_sounds = false
+	m.removedcheer_sounds = nil
+}
+
+// AddWebhookSubscriptionIDs adds the "webhook_subscriptions" edge to the WebhookSubscription entity by ids.
+func (m *GuildMutation) AddWebhookSubscriptionIDs(ids ...int) {
+	if m.webhook_subscriptions == nil {
+		m.webhook_subscriptions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.webhook_subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWebhookSubscriptions clears the "webhook_subscriptions" edge to the WebhookSubscription entity.
+func (m *GuildMutation) ClearWebhookSubscriptions() {
+	m.clearedwebhook_subscriptions = true
+}
+
+// WebhookSubscriptionsCleared reports if the "webhook_subscriptions" edge to the WebhookSubscription entity was cleared.
+func (m *GuildMutation) WebhookSubscriptionsCleared() bool {
+	return m.clearedwebhook_subscriptions
+}
+
+// RemoveWebhookSubscriptionIDs removes the "webhook_subscriptions" edge to the WebhookSubscription entity by IDs.
+func (m *GuildMutation) RemoveWebhookSubscriptionIDs(ids ...int) {
+	if m.removedwebhook_subscriptions == nil {
+		m.removedwebhook_subscriptions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.webhook_subscriptions, ids[i])
+		m.removedwebhook_subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebhookSubscriptions returns the removed IDs of the "webhook_subscriptions" edge to the WebhookSubscription entity.
+func (m *GuildMutation) RemovedWebhookSubscriptionsIDs() (ids []int) {
+	for id := range m.removedwebhook_subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebhookSubscriptionsIDs returns the "webhook_subscriptions" edge IDs in the mutation.
+func (m *GuildMutation) WebhookSubscriptionsIDs() (ids []int) {
+	for id := range m.webhook_subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebhookSubscriptions resets all changes to the "webhook_subscriptions" edge.
+func (m *GuildMutation) ResetWebhookSubscriptions() {
+	m.webhook_subscriptions = nil
+	m.clearedwebhook_subscriptions = false
+	m.removedwebhook_subscriptions = nil
+}
+
+// AddTrackPlayIDs adds the "track_plays" edge to the TrackPlay entity by ids.
+func (m *GuildMutation) AddTrackPlayIDs(ids ...int) {
+	if m.track_plays == nil {
+		m.track_plays = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.track_plays[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTrackPlays clears the "track_plays" edge to the TrackPlay entity.
+func (m *GuildMutation) ClearTrackPlays() {
+	m.clearedtrack_plays = true
+}
+
+// TrackPlaysCleared reports if the "track_plays" edge to the TrackPlay entity was cleared.
+func (m *GuildMutation) TrackPlaysCleared() bool {
+	return m.clearedtrack_plays
+}
+
+// RemoveTrackPlayIDs removes the "track_plays" edge to the TrackPlay entity by IDs.
+func (m *GuildMutation) RemoveTrackPlayIDs(ids ...int) {
+	if m.removedtrack_plays == nil {
+		m.removedtrack_plays = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.track_plays, ids[i])
+		m.removedtrack_plays[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTrackPlays returns the removed IDs of the "track_plays" edge to the TrackPlay entity.
+func (m *GuildMutation) RemovedTrackPlaysIDs() (ids []int) {
+	for id := range m.removedtrack_plays {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TrackPlaysIDs returns the "track_plays" edge IDs in the mutation.
+func (m *GuildMutation) TrackPlaysIDs() (ids []int) {
+	for id := range m.track_plays {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTrackPlays resets all changes to the "track_plays" edge.
+func (m *GuildMutation) ResetTrackPlays() {
+	m.track_plays = nil
+	m.clearedtrack_plays = false
+	m.removedtrack_plays = nil
+}
+
+// AddPlaylistIDs adds the "playlists" edge to the Playlist entity by ids.
+func (m *GuildMutation) AddPlaylistIDs(ids ...int) {
+	if m.playlists == nil {
+		m.playlists = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.playlists[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPlaylists clears the "playlists" edge to the Playlist entity.
+func (m *GuildMutation) ClearPlaylists() {
+	m.clearedplaylists = true
+}
+
+// PlaylistsCleared reports if the "playlists" edge to the Playlist entity was cleared.
+func (m *GuildMutation) PlaylistsCleared() bool {
+	return m.clearedplaylists
+}
+
+// RemovePlaylistIDs removes the "playlists" edge to the Playlist entity by IDs.
+func (m *GuildMutation) RemovePlaylistIDs(ids ...int) {
+	if m.removedplaylists == nil {
+		m.removedplaylists = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.playlists, ids[i])
+		m.removedplaylists[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPlaylists returns the removed IDs of the "playlists" edge to the Playlist entity.
+func (m *GuildMutation) RemovedPlaylistsIDs() (ids []int) {
+	for id := range m.removedplaylists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PlaylistsIDs returns the "playlists" edge IDs in the mutation.
+func (m *GuildMutation) PlaylistsIDs() (ids []int) {
+	for id := range m.playlists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPlaylists resets all changes to the "playlists" edge.
+func (m *GuildMutation) ResetPlaylists() {
+	m.playlists = nil
+	m.clearedplaylists = false
+	m.removedplaylists = nil
+}
+
+// Where appends a list predicates to the GuildMutation builder.
+func (m *GuildMutation) Where(ps ...predicate.Guild) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GuildMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GuildMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Guild, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GuildMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GuildMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Guild).
+func (m *GuildMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GuildMutation) Fields() []string {
+	fields := make([]string, 0, 21)
+	if m.name != nil {
+		fields = append(fields, guild.FieldName)
+	}
+	if m.player_channel_id != nil {
+		fields = append(fields, guild.FieldPlayerChannelID)
+	}
+	if m.player_message_id != nil {
+		fields = append(fields, guild.FieldPlayerMessageID)
+	}
+	if m.idle_timeout != nil {
+		fields = append(fields, guild.FieldIdleTimeout)
+	}
+	if m.alone_timeout != nil {
+		fields = append(fields, guild.FieldAloneTimeout)
+	}
+	if m.autoplay != nil {
+		fields = append(fields, guild.FieldAutoplay)
+	}
+	if m.always_on != nil {
+		fields = append(fields, guild.FieldAlwaysOn)
+	}
+	if m.always_on_channel_id != nil {
+		fields = append(fields, guild.FieldAlwaysOnChannelID)
+	}
+	if m.fallback_query != nil {
+		fields = append(fields, guild.FieldFallbackQuery)
+	}
+	if m.tts_provider != nil {
+		fields = append(fields, guild.FieldTtsProvider)
+	}
+	if m.tts_language != nil {
+		fields = append(fields, guild.FieldTtsLanguage)
+	}
+	if m.tts_voice != nil {
+		fields = append(fields, guild.FieldTtsVoice)
+	}
+	if m.tts_rate != nil {
+		fields = append(fields, guild.FieldTtsRate)
+	}
+	if m.tts_pitch != nil {
+		fields = append(fields, guild.FieldTtsPitch)
+	}
+	if m.tts_reader_channel_id != nil {
+		fields = append(fields, guild.FieldTtsReaderChannelID)
+	}
+	if m.overlay_token != nil {
+		fields = append(fields, guild.FieldOverlayToken)
+	}
+	if m.api_token != nil {
+		fields = append(fields, guild.FieldAPIToken)
+	}
+	if m.sleep_at != nil {
+		fields = append(fields, guild.FieldSleepAt)
+	}
+	if m.sleep_end_of_track != nil {
+		fields = append(fields, guild.FieldSleepEndOfTrack)
+	}
+	if m.created_at != nil {
+		fields = append(fields, guild.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, guild.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GuildMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case guild.FieldName:
+		return m.Name()
+	case guild.FieldPlayerChannelID:
+		return m.PlayerChannelID()
+	case guild.FieldPlayerMessageID:
+		return m.PlayerMessageID()
+	case guild.FieldIdleTimeout:
+		return m.IdleTimeout()
+	case guild.FieldAloneTimeout:
+		return m.AloneTimeout()
+	case guild.FieldAutoplay:
+		return m.Autoplay()
+	case guild.FieldAlwaysOn:
+		return m.AlwaysOn()
+	case guild.FieldAlwaysOnChannelID:
+		return m.AlwaysOnChannelID()
+	case guild.FieldFallbackQuery:
+		return m.FallbackQuery()
+	case guild.FieldTtsProvider:
+		return m.TtsProvider()
+	case guild.FieldTtsLanguage:
+		return m.TtsLanguage()
+	case guild.FieldTtsVoice:
+		return m.TtsVoice()
+	case guild.FieldTtsRate:
+		return m.TtsRate()
+	case guild.FieldTtsPitch:
+		return m.TtsPitch()
+	case guild.FieldTtsReaderChannelID:
+		return m.TtsReaderChannelID()
+	case guild.FieldOverlayToken:
+		return m.OverlayToken()
+	case guild.FieldAPIToken:
+		return m.APIToken()
+	case guild.FieldSleepAt:
+		return m.SleepAt()
+	case guild.FieldSleepEndOfTrack:
+		return m.SleepEndOfTrack()
+	case guild.FieldCreatedAt:
+		return m.CreatedAt()
+	case guild.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GuildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case guild.FieldName:
+		return m.OldName(ctx)
+	case guild.FieldPlayerChannelID:
+		return m.OldPlayerChannelID(ctx)
+	case guild.FieldPlayerMessageID:
+		return m.OldPlayerMessageID(ctx)
+	case guild.FieldIdleTimeout:
+		return m.OldIdleTimeout(ctx)
+	case guild.FieldAloneTimeout:
+		return m.OldAloneTimeout(ctx)
+	case guild.FieldAutoplay:
+		return m.OldAutoplay(ctx)
+	case guild.FieldAlwaysOn:
+		return m.OldAlwaysOn(ctx)
+	case guild.FieldAlwaysOnChannelID:
+		return m.OldAlwaysOnChannelID(ctx)
+	case guild.FieldFallbackQuery:
+		return m.OldFallbackQuery(ctx)
+	case guild.FieldTtsProvider:
+		return m.OldTtsProvider(ctx)
+	case guild.FieldTtsLanguage:
+		return m.OldTtsLanguage(ctx)
+	case guild.FieldTtsVoice:
+		return m.OldTtsVoice(ctx)
+	case guild.FieldTtsRate:
+		return m.OldTtsRate(ctx)
+	case guild.FieldTtsPitch:
+		return m.OldTtsPitch(ctx)
+	case guild.FieldTtsReaderChannelID:
+		return m.OldTtsReaderChannelID(ctx)
+	case guild.FieldOverlayToken:
+		return m.OldOverlayToken(ctx)
+	case guild.FieldAPIToken:
+		return m.OldAPIToken(ctx)
+	case guild.FieldSleepAt:
+		return m.OldSleepAt(ctx)
+	case guild.FieldSleepEndOfTrack:
+		return m.OldSleepEndOfTrack(ctx)
+	case guild.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case guild.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Guild field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GuildMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case guild.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case guild.FieldPlayerChannelID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayerChannelID(v)
+		return nil
+	case guild.FieldPlayerMessageID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayerMessageID(v)
+		return nil
+	case guild.FieldIdleTimeout:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdleTimeout(v)
+		return nil
+	case guild.FieldAloneTimeout:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAloneTimeout(v)
+		return nil
+	case guild.FieldAutoplay:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoplay(v)
+		return nil
+	case guild.FieldAlwaysOn:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlwaysOn(v)
+		return nil
+	case guild.FieldAlwaysOnChannelID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlwaysOnChannelID(v)
+		return nil
+	case guild.FieldFallbackQuery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFallbackQuery(v)
+		return nil
+	case guild.FieldTtsProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTtsProvider(v)
+		return nil
+	case guild.FieldTtsLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTtsLanguage(v)
+		return nil
+	case guild.FieldTtsVoice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTtsVoice(v)
+		return nil
+	case guild.FieldTtsRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTtsRate(v)
+		return nil
+	case guild.FieldTtsPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTtsPitch(v)
+		return nil
+	case guild.FieldTtsReaderChannelID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTtsReaderChannelID(v)
+		return nil
+	case guild.FieldOverlayToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOverlayToken(v)
+		return nil
+	case guild.FieldAPIToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIToken(v)
+		return nil
+	case guild.FieldSleepAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSleepAt(v)
+		return nil
+	case guild.FieldSleepEndOfTrack:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSleepEndOfTrack(v)
+		return nil
+	case guild.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case guild.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Guild field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GuildMutation) AddedFields() []string {
+	var fields []string
+	if m.addplayer_channel_id != nil {
+		fields = append(fields, guild.FieldPlayerChannelID)
+	}
+	if m.addplayer_message_id != nil {
+		fields = append(fields, guild.FieldPlayerMessageID)
+	}
+	if m.addidle_timeout != nil {
+		fields = append(fields, guild.FieldIdleTimeout)
+	}
+	if m.addalone_timeout != nil {
+		fields = append(fields, guild.FieldAloneTimeout)
+	}
+	if m.addalways_on_channel_id != nil {
+		fields = append(fields, guild.FieldAlwaysOnChannelID)
+	}
+	if m.addtts_rate != nil {
+		fields = append(fields, guild.FieldTtsRate)
+	}
+	if m.addtts_pitch != nil {
+		fields = append(fields, guild.FieldTtsPitch)
+	}
+	if m.addtts_reader_channel_id != nil {
+		fields = append(fields, guild.FieldTtsReaderChannelID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GuildMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case guild.FieldPlayerChannelID:
+		return m.AddedPlayerChannelID()
+	case guild.FieldPlayerMessageID:
+		return m.AddedPlayerMessageID()
+	case guild.FieldIdleTimeout:
+		return m.AddedIdleTimeout()
+	case guild.FieldAloneTimeout:
+		return m.AddedAloneTimeout()
+	case guild.FieldAlwaysOnChannelID:
+		return m.AddedAlwaysOnChannelID()
+	case guild.FieldTtsRate:
+		return m.AddedTtsRate()
+	case guild.FieldTtsPitch:
+		return m.AddedTtsPitch()
+	case guild.FieldTtsReaderChannelID:
+		return m.AddedTtsReaderChannelID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GuildMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case guild.FieldPlayerChannelID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPlayerChannelID(v)
+		return nil
+	case guild.FieldPlayerMessageID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPlayerMessageID(v)
+		return nil
+	case guild.FieldIdleTimeout:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIdleTimeout(v)
+		return nil
+	case guild.FieldAloneTimeout:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAloneTimeout(v)
+		return nil
+	case guild.FieldAlwaysOnChannelID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAlwaysOnChannelID(v)
+		return nil
+	case guild.FieldTtsRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTtsRate(v)
+		return nil
+	case guild.FieldTtsPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTtsPitch(v)
+		return nil
+	case guild.FieldTtsReaderChannelID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTtsReaderChannelID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Guild numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GuildMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(guild.FieldPlayerChannelID) {
+		fields = append(fields, guild.FieldPlayerChannelID)
+	}
+	if m.FieldCleared(guild.FieldPlayerMessageID) {
+		fields = append(fields, guild.FieldPlayerMessageID)
+	}
+	if m.FieldCleared(guild.FieldAlwaysOnChannelID) {
+		fields = append(fields, guild.FieldAlwaysOnChannelID)
+	}
+	if m.FieldCleared(guild.FieldFallbackQuery) {
+		fields = append(fields, guild.FieldFallbackQuery)
+	}
+	if m.FieldCleared(guild.FieldTtsReaderChannelID) {
+		fields = append(fields, guild.FieldTtsReaderChannelID)
+	}
+	if m.FieldCleared(guild.FieldOverlayToken) {
+		fields = append(fields, guild.FieldOverlayToken)
+	}
+	if m.FieldCleared(guild.FieldAPIToken) {
+		fields = append(fields, guild.FieldAPIToken)
+	}
+	if m.FieldCleared(guild.FieldSleepAt) {
+		fields = append(fields, guild.FieldSleepAt)
+	}
+	if m.FieldCleared(guild.FieldCreatedAt) {
+		fields = append(fields, guild.FieldCreatedAt)
+	}
+	if m.FieldCleared(guild.FieldUpdatedAt) {
+		fields = append(fields, guild.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GuildMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GuildMutation) ClearField(name string) error {
 	switch name {
 	case guild.FieldPlayerChannelID:
+		m.ClearPlayerChannelID()
+		return nil
+	case guild.FieldPlayerMessageID:
+		m.ClearPlayerMessageID()
+		return nil
+	case guild.FieldAlwaysOnChannelID:
+		m.ClearAlwaysOnChannelID()
+		return nil
+	case guild.FieldFallbackQuery:
+		m.ClearFallbackQuery()
+		return nil
+	case guild.FieldTtsReaderChannelID:
+		m.ClearTtsReaderChannelID()
+		return nil
+	case guild.FieldOverlayToken:
+		m.ClearOverlayToken()
+		return nil
+	case guild.FieldAPIToken:
+		m.ClearAPIToken()
+		return nil
+	case guild.FieldSleepAt:
+		m.ClearSleepAt()
+		return nil
+	case guild.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case guild.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Guild nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GuildMutation) ResetField(name string) error {
+	switch name {
+	case guild.FieldName:
+		m.ResetName()
+		return nil
+	case guild.FieldPlayerChannelID:
+		m.ResetPlayerChannelID()
+		return nil
+	case guild.FieldPlayerMessageID:
+		m.ResetPlayerMessageID()
+		return nil
+	case guild.FieldIdleTimeout:
+		m.ResetIdleTimeout()
+		return nil
+	case guild.FieldAloneTimeout:
+		m.ResetAloneTimeout()
+		return nil
+	case guild.FieldAutoplay:
+		m.ResetAutoplay()
+		return nil
+	case guild.FieldAlwaysOn:
+		m.ResetAlwaysOn()
+		return nil
+	case guild.FieldAlwaysOnChannelID:
+		m.ResetAlwaysOnChannelID()
+		return nil
+	case guild.FieldFallbackQuery:
+		m.ResetFallbackQuery()
+		return nil
+	case guild.FieldTtsProvider:
+		m.ResetTtsProvider()
+		return nil
+	case guild.FieldTtsLanguage:
+		m.ResetTtsLanguage()
+		return nil
+	case guild.FieldTtsVoice:
+		m.ResetTtsVoice()
+		return nil
+	case guild.FieldTtsRate:
+		m.ResetTtsRate()
+		return nil
+	case guild.FieldTtsPitch:
+		m.ResetTtsPitch()
+		return nil
+	case guild.FieldTtsReaderChannelID:
+		m.ResetTtsReaderChannelID()
+		return nil
+	case guild.FieldOverlayToken:
+		m.ResetOverlayToken()
+		return nil
+	case guild.FieldAPIToken:
+		m.ResetAPIToken()
+		return nil
+	case guild.FieldSleepAt:
+		m.ResetSleepAt()
+		return nil
+	case guild.FieldSleepEndOfTrack:
+		m.ResetSleepEndOfTrack()
+		return nil
+	case guild.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case guild.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Guild field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GuildMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.members != nil {
+		edges = append(edges, guild.EdgeMembers)
+	}
+	if m.pronunciations != nil {
+		edges = append(edges, guild.EdgePronunciations)
+	}
+	if m.cheer_sounds != nil {
+		edges = append(edges, guild.EdgeCheerSounds)
+	}
+	if m.webhook_subscriptions != nil {
+		edges = append(edges, guild.EdgeWebhookSubscriptions)
+	}
+	if m.track_plays != nil {
+		edges = append(edges, guild.EdgeTrackPlays)
+	}
+	if m.playlists != nil {
+		edges = append(edges, guild.EdgePlaylists)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GuildMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case guild.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	case guild.EdgePronunciations:
+		ids := make([]ent.Value, 0, len(m.pronunciations))
+		for id := range m.pronunciations {
+			ids = append(ids, id)
+		}
+		return ids
+	case guild.EdgeCheerSounds:
+		ids := make([]ent.Value, 0, len(m.cheer_sounds))
+		for id := range m.cheer_sounds {
+			ids = append(ids, id)
+		}
+		return ids
+	case guild.EdgeWebhookSubscriptions:
+		ids := make([]ent.Value, 0, len(m.webhook_subscriptions))
+		for id := range m.webhook_subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case guild.EdgeTrackPlays:
+		ids := make([]ent.Value, 0, len(m.track_plays))
+		for id := range m.track_plays {
+			ids = append(ids, id)
+		}
+		return ids
+	case guild.EdgePlaylists:
+		ids := make([]ent.Value, 0, len(m.playlists))
+		for id := range m.playlists {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GuildMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedmembers != nil {
+		edges = append(edges, guild.EdgeMembers)
+	}
+	if m.removedpronunciations != nil {
+		edges = append(edges, guild.EdgePronunciations)
+	}
+	if m.removedcheer_sounds != nil {
+		edges = append(edges, guild.EdgeCheerSounds)
+	}
+	if m.removedwebhook_subscriptions != nil {
+		edges = append(edges, guild.EdgeWebhookSubscriptions)
+	}
+	if m.removedtrack_plays != nil {
+		edges = append(edges, guild.EdgeTrackPlays)
+	}
+	if m.removedplaylists != nil {
+		edges = append(edges, guild.EdgePlaylists)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GuildMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case guild.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	case guild.EdgePronunciations:
+		ids := make([]ent.Value, 0, len(m.removedpronunciations))
+		for id := range m.removedpronunciations {
+			ids = append(ids, id)
+		}
+		return ids
+	case guild.EdgeCheerSounds:
+		ids := make([]ent.Value, 0, len(m.removedcheer_sounds))
+		for id := range m.removedcheer_sounds {
+			ids = append(ids, id)
+		}
+		return ids
+	case guild.EdgeWebhookSubscriptions:
+		ids := make([]ent.Value, 0, len(m.removedwebhook_subscriptions))
+		for id := range m.removedwebhook_subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case guild.EdgeTrackPlays:
+		ids := make([]ent.Value, 0, len(m.removedtrack_plays))
+		for id := range m.removedtrack_plays {
+			ids = append(ids, id)
+		}
+		return ids
+	case guild.EdgePlaylists:
+		ids := make([]ent.Value, 0, len(m.removedplaylists))
+		for id := range m.removedplaylists {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GuildMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedmembers {
+		edges = append(edges, guild.EdgeMembers)
+	}
+	if m.clearedpronunciations {
+		edges = append(edges, guild.EdgePronunciations)
+	}
+	if m.clearedcheer_sounds {
+		edges = append(edges, guild.EdgeCheerSounds)
+	}
+	if m.clearedwebhook_subscriptions {
+		edges = append(edges, guild.EdgeWebhookSubscriptions)
+	}
+	if m.clearedtrack_plays {
+		edges = append(edges, guild.EdgeTrackPlays)
+	}
+	if m.clearedplaylists {
+		edges = append(edges, guild.EdgePlaylists)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GuildMutation) EdgeCleared(name string) bool {
+	switch name {
+	case guild.EdgeMembers:
+		return m.clearedmembers
+	case guild.EdgePronunciations:
+		return m.clearedpronunciations
+	case guild.EdgeCheerSounds:
+		return m.clearedcheer_sounds
+	case guild.EdgeWebhookSubscriptions:
+		return m.clearedwebhook_subscriptions
+	case guild.EdgeTrackPlays:
+		return m.clearedtrack_plays
+	case guild.EdgePlaylists:
+		return m.clearedplaylists
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GuildMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Guild unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GuildMutation) ResetEdge(name string) error {
+	switch name {
+	case guild.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	case guild.EdgePronunciations:
+		m.ResetPronunciations()
+		return nil
+	case guild.EdgeCheerSounds:
+		m.ResetCheerSounds()
+		return nil
+	case guild.EdgeWebhookSubscriptions:
+		m.ResetWebhookSubscriptions()
+		return nil
+	case guild.EdgeTrackPlays:
+		m.ResetTrackPlays()
+		return nil
+	case guild.EdgePlaylists:
+		m.ResetPlaylists()
+		return nil
+	}
+	return fmt.Errorf("unknown Guild edge %s", name)
+}
+
+// MemberMutation represents an operation that mutates the Member nodes in the graph.
+type MemberMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	user_id            *snowflake.ID
+	adduser_id         *snowflake.ID
+	tts_language       *string
+	tts_voice          *string
+	tts_rate           *float64
+	addtts_rate        *float64
+	tts_pitch          *float64
+	addtts_pitch       *float64
+	tts_reader_opt_out *bool
+	bits_donated       *int64
+	addbits_donated    *int64
+	points             *int64
+	addpoints          *int64
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	guild              *snowflake.ID
+	clearedguild       bool
+	done               bool
+	oldValue           func(context.Context) (*Member, error)
+	predicates         []predicate.Member
+}
+
+var _ ent.Mutation = (*MemberMutation)(nil)
+
+// memberOption allows management of the mutation configuration using functional options.
+type memberOption func(*MemberMutation)
+
+// newMemberMutation creates new mutation for the Member entity.
+func newMemberMutation(c config, op Op, opts ...memberOption) *MemberMutation {
+	m := &MemberMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMember,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMemberID sets the ID field of the mutation.
+func withMemberID(id int) memberOption {
+	return func(m *MemberMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Member
+		)
+		m.oldValue = func(ctx context.Context) (*Member, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Member.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMember sets the old Member of the mutation.
+func withMember(node *Member) memberOption {
+	return func(m *MemberMutation) {
+		m.oldValue = func(context.Context) (*Member, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemberMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemberMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemberMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemberMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Member.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *MemberMutation) SetGuildID(s snowflake.ID) {
+	m.guild = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *MemberMutation) GuildID() (r snowflake.ID, exists bool) {
+	v := m.guild
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldGuildID(ctx context.Context) (v snowflake.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *MemberMutation) ResetGuildID() {
+	m.guild = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MemberMutation) SetUserID(s snowflake.ID) {
+	m.user_id = &s
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MemberMutation) UserID() (r snowflake.ID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldUserID(ctx context.Context) (v snowflake.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds s to the "user_id" field.
+func (m *MemberMutation) AddUserID(s snowflake.ID) {
+	if m.adduser_id != nil {
+		*m.adduser_id += s
+	} else {
+		m.adduser_id = &s
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *MemberMutation) AddedUserID() (r snowflake.ID, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MemberMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetTtsLanguage sets the "tts_language" field.
+func (m *MemberMutation) SetTtsLanguage(s string) {
+	m.tts_language = &s
+}
+
+// TtsLanguage returns the value of the "tts_language" field in the mutation.
+func (m *MemberMutation) TtsLanguage() (r string, exists bool) {
+	v := m.tts_language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTtsLanguage returns the old "tts_language" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldTtsLanguage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTtsLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTtsLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTtsLanguage: %w", err)
+	}
+	return oldValue.TtsLanguage, nil
+}
+
+// ClearTtsLanguage clears the value of the "tts_language" field.
+func (m *MemberMutation) ClearTtsLanguage() {
+	m.tts_language = nil
+	m.clearedFields[member.FieldTtsLanguage] = struct{}{}
+}
+
+// TtsLanguageCleared returns if the "tts_language" field was cleared in this mutation.
+func (m *MemberMutation) TtsLanguageCleared() bool {
+	_, ok := m.clearedFields[member.FieldTtsLanguage]
+	return ok
+}
+
+// ResetTtsLanguage resets all changes to the "tts_language" field.
+func (m *MemberMutation) ResetTtsLanguage() {
+	m.tts_language = nil
+	delete(m.clearedFields, member.FieldTtsLanguage)
+}
+
+// SetTtsVoice sets the "tts_voice" field.
+func (m *MemberMutation) SetTtsVoice(s string) {
+	m.tts_voice = &s
+}
+
+// TtsVoice returns the value of the "tts_voice" field in the mutation.
+func (m *MemberMutation) TtsVoice() (r string, exists bool) {
+	v := m.tts_voice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTtsVoice returns the old "tts_voice" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldTtsVoice(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTtsVoice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTtsVoice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTtsVoice: %w", err)
+	}
+	return oldValue.TtsVoice, nil
+}
+
+// ClearTtsVoice clears the value of the "tts_voice" field.
+func (m *MemberMutation) ClearTtsVoice() {
+	m.tts_voice = nil
+	m.clearedFields[member.FieldTtsVoice] = struct{}{}
+}
+
+// TtsVoiceCleared returns if the "tts_voice" field was cleared in this mutation.
+func (m *MemberMutation) TtsVoiceCleared() bool {
+	_, ok := m.clearedFields[member.FieldTtsVoice]
+	return ok
+}
+
+// ResetTtsVoice resets all changes to the "tts_voice" field.
+func (m *MemberMutation) ResetTtsVoice() {
+	m.tts_voice = nil
+	delete(m.clearedFields, member.FieldTtsVoice)
+}
+
+// SetTtsRate sets the "tts_rate" field.
+func (m *MemberMutation) SetTtsRate(f float64) {
+	m.tts_rate = &f
+	m.addtts_rate = nil
+}
+
+// TtsRate returns the value of the "tts_rate" field in the mutation.
+func (m *MemberMutation) TtsRate() (r float64, exists bool) {
+	v := m.tts_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTtsRate returns the old "tts_rate" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldTtsRate(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTtsRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTtsRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTtsRate: %w", err)
+	}
+	return oldValue.TtsRate, nil
+}
+
+// AddTtsRate adds f to the "tts_rate" field.
+func (m *MemberMutation) AddTtsRate(f float64) {
+	if m.addtts_rate != nil {
+		*m.addtts_rate += f
+	} else {
+		m.addtts_rate = &f
+	}
+}
+
+// AddedTtsRate returns the value that was added to the "tts_rate" field in this mutation.
+func (m *MemberMutation) AddedTtsRate() (r float64, exists bool) {
+	v := m.addtts_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTtsRate clears the value of the "tts_rate" field.
+func (m *MemberMutation) ClearTtsRate() {
+	m.tts_rate = nil
+	m.addtts_rate = nil
+	m.clearedFields[member.FieldTtsRate] = struct{}{}
+}
+
+// TtsRateCleared returns if the "tts_rate" field was cleared in this mutation.
+func (m *MemberMutation) TtsRateCleared() bool {
+	_, ok := m.clearedFields[member.FieldTtsRate]
+	return ok
+}
+
+// ResetTtsRate resets all changes to the "tts_rate" field.
+func (m *MemberMutation) ResetTtsRate() {
+	m.tts_rate = nil
+	m.addtts_rate = nil
+	delete(m.clearedFields, member.FieldTtsRate)
+}
+
+// SetTtsPitch sets the "tts_pitch" field.
+func (m *MemberMutation) SetTtsPitch(f float64) {
+	m.tts_pitch = &f
+	m.addtts_pitch = nil
+}
+
+// TtsPitch returns the value of the "tts_pitch" field in the mutation.
+func (m *MemberMutation) TtsPitch() (r float64, exists bool) {
+	v := m.tts_pitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTtsPitch returns the old "tts_pitch" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldTtsPitch(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTtsPitch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTtsPitch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTtsPitch: %w", err)
+	}
+	return oldValue.TtsPitch, nil
+}
+
+// AddTtsPitch adds f to the "tts_pitch" field.
+func (m *MemberMutation) AddTtsPitch(f float64) {
+	if m.addtts_pitch != nil {
+		*m.addtts_pitch += f
+	} else {
+		m.addtts_pitch = &f
+	}
+}
+
+// AddedTtsPitch returns the value that was added to the "tts_pitch" field in this mutation.
+func (m *MemberMutation) AddedTtsPitch() (r float64, exists bool) {
+	v := m.addtts_pitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTtsPitch clears the value of the "tts_pitch" field.
+func (m *MemberMutation) ClearTtsPitch() {
+	m.tts_pitch = nil
+	m.addtts_pitch = nil
+	m.clearedFields[member.FieldTtsPitch] = struct{}{}
+}
+
+// TtsPitchCleared returns if the "tts_pitch" field was cleared in this mutation.
+func (m *MemberMutation) TtsPitchCleared() bool {
+	_, ok := m.clearedFields[member.FieldTtsPitch]
+	return ok
+}
+
+// ResetTtsPitch resets all changes to the "tts_pitch" field.
+func (m *MemberMutation) ResetTtsPitch() {
+	m.tts_pitch = nil
+	m.addtts_pitch = nil
+	delete(m.clearedFields, member.FieldTtsPitch)
+}
+
+// SetTtsReaderOptOut sets the "tts_reader_opt_out" field.
+func (m *MemberMutation) SetTtsReaderOptOut(b bool) {
+	m.tts_reader_opt_out = &b
+}
+
+// TtsReaderOptOut returns the value of the "tts_reader_opt_out" field in the mutation.
+func (m *MemberMutation) TtsReaderOptOut() (r bool, exists bool) {
+	v := m.tts_reader_opt_out
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTtsReaderOptOut returns the old "tts_reader_opt_out" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldTtsReaderOptOut(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTtsReaderOptOut is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTtsReaderOptOut requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTtsReaderOptOut: %w", err)
+	}
+	return oldValue.TtsReaderOptOut, nil
+}
+
+// ResetTtsReaderOptOut resets all changes to the "tts_reader_opt_out" field.
+func (m *MemberMutation) ResetTtsReaderOptOut() {
+	m.tts_reader_opt_out = nil
+}
+
+// SetBitsDonated sets the "bits_donated" field.
+func (m *MemberMutation) SetBitsDonated(i int64) {
+	m.bits_donated = &i
+	m.addbits_donated = nil
+}
+
+// BitsDonated returns the value of the "bits_donated" field in the mutation.
+func (m *MemberMutation) BitsDonated() (r int64, exists bool) {
+	v := m.bits_donated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBitsDonated returns the old "bits_donated" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldBitsDonated(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBitsDonated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBitsDonated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBitsDonated: %w", err)
+	}
+	return oldValue.BitsDonated, nil
+}
+
+// AddBitsDonated adds i to the "bits_donated" field.
+func (m *MemberMutation) AddBitsDonated(i int64) {
+	if m.addbits_donated != nil {
+		*m.addbits_donated += i
+	} else {
+		m.addbits_donated = &i
+	}
+}
+
+// AddedBitsDonated returns the value that was added to the "bits_donated" field in this mutation.
+func (m *MemberMutation) AddedBitsDonated() (r int64, exists bool) {
+	v := m.addbits_donated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBitsDonated resets all changes to the "bits_donated" field.
+func (m *MemberMutation) ResetBitsDonated() {
+	m.bits_donated = nil
+	m.addbits_donated = nil
+}
+
+// SetPoints sets the "points" field.
+func (m *MemberMutation) SetPoints(i int64) {
+	m.points = &i
+	m.addpoints = nil
+}
+
+// Points returns the value of the "points" field in the mutation.
+func (m *MemberMutation) Points() (r int64, exists bool) {
+	v := m.points
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPoints returns the old "points" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldPoints(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPoints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPoints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoints: %w", err)
+	}
+	return oldValue.Points, nil
+}
+
+// AddPoints adds i to the "points" field.
+func (m *MemberMutation) AddPoints(i int64) {
+	if m.addpoints != nil {
+		*m.addpoints += i
+	} else {
+		m.addpoints = &i
+	}
+}
+
+// AddedPoints returns the value that was added to the "points" field in this mutation.
+func (m *MemberMutation) AddedPoints() (r int64, exists bool) {
+	v := m.addpoints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPoints resets all changes to the "points" field.
+func (m *MemberMutation) ResetPoints() {
+	m.points = nil
+	m.addpoints = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MemberMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MemberMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *MemberMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[member.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *MemberMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[member.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MemberMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, member.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MemberMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MemberMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *MemberMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[member.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *MemberMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[member.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MemberMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, member.FieldUpdatedAt)
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (m *MemberMutation) ClearGuild() {
+	m.clearedguild = true
+	m.clearedFields[member.FieldGuildID] = struct{}{}
+}
+
+// GuildCleared reports if the "guild" edge to the Guild entity was cleared.
+func (m *MemberMutation) GuildCleared() bool {
+	return m.clearedguild
+}
+
+// GuildIDs returns the "guild" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GuildID instead. It exists only for internal usage by the builders.
+func (m *MemberMutation) GuildIDs() (ids []snowflake.ID) {
+	if id := m.guild; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGuild resets all changes to the "guild" edge.
+func (m *MemberMutation) ResetGuild() {
+	m.guild = nil
+	m.clearedguild = false
+}
+
+// Where appends a list predicates to the MemberMutation builder.
+func (m *MemberMutation) Where(ps ...predicate.Member) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Member, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Member).
+func (m *MemberMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MemberMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.guild != nil {
+		fields = append(fields, member.FieldGuildID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, member.FieldUserID)
+	}
+	if m.tts_language != nil {
+		fields = append(fields, member.FieldTtsLanguage)
+	}
+	if m.tts_voice != nil {
+		fields = append(fields, member.FieldTtsVoice)
+	}
+	if m.tts_rate != nil {
+		fields = append(fields, member.FieldTtsRate)
+	}
+	if m.tts_pitch != nil {
+		fields = append(fields, member.FieldTtsPitch)
+	}
+	if m.tts_reader_opt_out != nil {
+		fields = append(fields, member.FieldTtsReaderOptOut)
+	}
+	if m.bits_donated != nil {
+		fields = append(fields, member.FieldBitsDonated)
+	}
+	if m.points != nil {
+		fields = append(fields, member.FieldPoints)
+	}
+	if m.created_at != nil {
+		fields = append(fields, member.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, member.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MemberMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case member.FieldGuildID:
+		return m.GuildID()
+	case member.FieldUserID:
+		return m.UserID()
+	case member.FieldTtsLanguage:
+		return m.TtsLanguage()
+	case member.FieldTtsVoice:
+		return m.TtsVoice()
+	case member.FieldTtsRate:
+		return m.TtsRate()
+	case member.FieldTtsPitch:
+		return m.TtsPitch()
+	case member.FieldTtsReaderOptOut:
+		return m.TtsReaderOptOut()
+	case member.FieldBitsDonated:
+		return m.BitsDonated()
+	case member.FieldPoints:
+		return m.Points()
+	case member.FieldCreatedAt:
+		return m.CreatedAt()
+	case member.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case member.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case member.FieldUserID:
+		return m.OldUserID(ctx)
+	case member.FieldTtsLanguage:
+		return m.OldTtsLanguage(ctx)
+	case member.FieldTtsVoice:
+		return m.OldTtsVoice(ctx)
+	case member.FieldTtsRate:
+		return m.OldTtsRate(ctx)
+	case member.FieldTtsPitch:
+		return m.OldTtsPitch(ctx)
+	case member.FieldTtsReaderOptOut:
+		return m.OldTtsReaderOptOut(ctx)
+	case member.FieldBitsDonated:
+		return m.OldBitsDonated(ctx)
+	case member.FieldPoints:
+		return m.OldPoints(ctx)
+	case member.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case member.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Member field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case member.FieldGuildID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case member.FieldUserID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case member.FieldTtsLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTtsLanguage(v)
+		return nil
+	case member.FieldTtsVoice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTtsVoice(v)
+		return nil
+	case member.FieldTtsRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTtsRate(v)
+		return nil
+	case member.FieldTtsPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTtsPitch(v)
+		return nil
+	case member.FieldTtsReaderOptOut:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTtsReaderOptOut(v)
+		return nil
+	case member.FieldBitsDonated:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBitsDonated(v)
+		return nil
+	case member.FieldPoints:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPoints(v)
+		return nil
+	case member.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case member.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Member field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MemberMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, member.FieldUserID)
+	}
+	if m.addtts_rate != nil {
+		fields = append(fields, member.FieldTtsRate)
+	}
+	if m.addtts_pitch != nil {
+		fields = append(fields, member.FieldTtsPitch)
+	}
+	if m.addbits_donated != nil {
+		fields = append(fields, member.FieldBitsDonated)
+	}
+	if m.addpoints != nil {
+		fields = append(fields, member.FieldPoints)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MemberMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case member.FieldUserID:
+		return m.AddedUserID()
+	case member.FieldTtsRate:
+		return m.AddedTtsRate()
+	case member.FieldTtsPitch:
+		return m.AddedTtsPitch()
+	case member.FieldBitsDonated:
+		return m.AddedBitsDonated()
+	case member.FieldPoints:
+		return m.AddedPoints()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case member.FieldUserID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case member.FieldTtsRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTtsRate(v)
+		return nil
+	case member.FieldTtsPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTtsPitch(v)
+		return nil
+	case member.FieldBitsDonated:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBitsDonated(v)
+		return nil
+	case member.FieldPoints:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPoints(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Member numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MemberMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(member.FieldTtsLanguage) {
+		fields = append(fields, member.FieldTtsLanguage)
+	}
+	if m.FieldCleared(member.FieldTtsVoice) {
+		fields = append(fields, member.FieldTtsVoice)
+	}
+	if m.FieldCleared(member.FieldTtsRate) {
+		fields = append(fields, member.FieldTtsRate)
+	}
+	if m.FieldCleared(member.FieldTtsPitch) {
+		fields = append(fields, member.FieldTtsPitch)
+	}
+	if m.FieldCleared(member.FieldCreatedAt) {
+		fields = append(fields, member.FieldCreatedAt)
+	}
+	if m.FieldCleared(member.FieldUpdatedAt) {
+		fields = append(fields, member.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MemberMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MemberMutation) ClearField(name string) error {
+	switch name {
+	case member.FieldTtsLanguage:
+		m.ClearTtsLanguage()
+		return nil
+	case member.FieldTtsVoice:
+		m.ClearTtsVoice()
+		return nil
+	case member.FieldTtsRate:
+		m.ClearTtsRate()
+		return nil
+	case member.FieldTtsPitch:
+		m.ClearTtsPitch()
+		return nil
+	case member.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case member.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Member nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MemberMutation) ResetField(name string) error {
+	switch name {
+	case member.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case member.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case member.FieldTtsLanguage:
+		m.ResetTtsLanguage()
+		return nil
+	case member.FieldTtsVoice:
+		m.ResetTtsVoice()
+		return nil
+	case member.FieldTtsRate:
+		m.ResetTtsRate()
+		return nil
+	case member.FieldTtsPitch:
+		m.ResetTtsPitch()
+		return nil
+	case member.FieldTtsReaderOptOut:
+		m.ResetTtsReaderOptOut()
+		return nil
+	case member.FieldBitsDonated:
+		m.ResetBitsDonated()
+		return nil
+	case member.FieldPoints:
+		m.ResetPoints()
+		return nil
+	case member.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case member.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Member field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.guild != nil {
+		edges = append(edges, member.EdgeGuild)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MemberMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case member.EdgeGuild:
+		if id := m.guild; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MemberMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedguild {
+		edges = append(edges, member.EdgeGuild)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MemberMutation) EdgeCleared(name string) bool {
+	switch name {
+	case member.EdgeGuild:
+		return m.clearedguild
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MemberMutation) ClearEdge(name string) error {
+	switch name {
+	case member.EdgeGuild:
+		m.ClearGuild()
+		return nil
+	}
+	return fmt.Errorf("unknown Member unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MemberMutation) ResetEdge(name string) error {
+	switch name {
+	case member.EdgeGuild:
+		m.ResetGuild()
+		return nil
+	}
+	return fmt.Errorf("unknown Member edge %s", name)
+}
+
+// PlaylistMutation represents an operation that mutates the Playlist nodes in the graph.
+type PlaylistMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	tracks        *[]string
+	appendtracks  []string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	guild         *snowflake.ID
+	clearedguild  bool
+	done          bool
+	oldValue      func(context.Context) (*Playlist, error)
+	predicates    []predicate.Playlist
+}
+
+var _ ent.Mutation = (*PlaylistMutation)(nil)
+
+// playlistOption allows management of the mutation configuration using functional options.
+type playlistOption func(*PlaylistMutation)
+
+// newPlaylistMutation creates new mutation for the Playlist entity.
+func newPlaylistMutation(c config, op Op, opts ...playlistOption) *PlaylistMutation {
+	m := &PlaylistMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlaylist,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlaylistID sets the ID field of the mutation.
+func withPlaylistID(id int) playlistOption {
+	return func(m *PlaylistMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Playlist
+		)
+		m.oldValue = func(ctx context.Context) (*Playlist, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Playlist.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlaylist sets the old Playlist of the mutation.
+func withPlaylist(node *Playlist) playlistOption {
+	return func(m *PlaylistMutation) {
+		m.oldValue = func(context.Context) (*Playlist, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlaylistMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlaylistMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlaylistMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlaylistMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Playlist.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *PlaylistMutation) SetGuildID(s snowflake.ID) {
+	m.guild = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *PlaylistMutation) GuildID() (r snowflake.ID, exists bool) {
+	v := m.guild
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistMutation) OldGuildID(ctx context.Context) (v snowflake.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *PlaylistMutation) ResetGuildID() {
+	m.guild = nil
+}
+
+// SetName sets the "name" field.
+func (m *PlaylistMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PlaylistMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PlaylistMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTracks sets the "tracks" field.
+func (m *PlaylistMutation) SetTracks(s []string) {
+	m.tracks = &s
+	m.appendtracks = nil
+}
+
+// Tracks returns the value of the "tracks" field in the mutation.
+func (m *PlaylistMutation) Tracks() (r []string, exists bool) {
+	v := m.tracks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTracks returns the old "tracks" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistMutation) OldTracks(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTracks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTracks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTracks: %w", err)
+	}
+	return oldValue.Tracks, nil
+}
+
+// AppendTracks adds s to the "tracks" field.
+func (m *PlaylistMutation) AppendTracks(s []string) {
+	m.appendtracks = append(m.appendtracks, s...)
+}
+
+// AppendedTracks returns the list of values that were appended to the "tracks" field in this mutation.
+func (m *PlaylistMutation) AppendedTracks() ([]string, bool) {
+	if len(m.appendtracks) == 0 {
+		return nil, false
+	}
+	return m.appendtracks, true
+}
+
+// ResetTracks resets all changes to the "tracks" field.
+func (m *PlaylistMutation) ResetTracks() {
+	m.tracks = nil
+	m.appendtracks = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PlaylistMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PlaylistMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *PlaylistMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[playlist.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *PlaylistMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[playlist.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PlaylistMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, playlist.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PlaylistMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PlaylistMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *PlaylistMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[playlist.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *PlaylistMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[playlist.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PlaylistMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, playlist.FieldUpdatedAt)
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (m *PlaylistMutation) ClearGuild() {
+	m.clearedguild = true
+	m.clearedFields[playlist.FieldGuildID] = struct{}{}
+}
+
+// GuildCleared reports if the "guild" edge to the Guild entity was cleared.
+func (m *PlaylistMutation) GuildCleared() bool {
+	return m.clearedguild
+}
+
+// GuildIDs returns the "guild" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GuildID instead. It exists only for internal usage by the builders.
+func (m *PlaylistMutation) GuildIDs() (ids []snowflake.ID) {
+	if id := m.guild; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGuild resets all changes to the "guild" edge.
+func (m *PlaylistMutation) ResetGuild() {
+	m.guild = nil
+	m.clearedguild = false
+}
+
+// Where appends a list predicates to the PlaylistMutation builder.
+func (m *PlaylistMutation) Where(ps ...predicate.Playlist) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlaylistMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlaylistMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Playlist, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlaylistMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlaylistMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Playlist).
+func (m *PlaylistMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlaylistMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.guild != nil {
+		fields = append(fields, playlist.FieldGuildID)
+	}
+	if m.name != nil {
+		fields = append(fields, playlist.FieldName)
+	}
+	if m.tracks != nil {
+		fields = append(fields, playlist.FieldTracks)
+	}
+	if m.created_at != nil {
+		fields = append(fields, playlist.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, playlist.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlaylistMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case playlist.FieldGuildID:
+		return m.GuildID()
+	case playlist.FieldName:
+		return m.Name()
+	case playlist.FieldTracks:
+		return m.Tracks()
+	case playlist.FieldCreatedAt:
+		return m.CreatedAt()
+	case playlist.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlaylistMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case playlist.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case playlist.FieldName:
+		return m.OldName(ctx)
+	case playlist.FieldTracks:
+		return m.OldTracks(ctx)
+	case playlist.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case playlist.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Playlist field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaylistMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case playlist.FieldGuildID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case playlist.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case playlist.FieldTracks:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTracks(v)
+		return nil
+	case playlist.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case playlist.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Playlist field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlaylistMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlaylistMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaylistMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Playlist numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlaylistMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(playlist.FieldCreatedAt) {
+		fields = append(fields, playlist.FieldCreatedAt)
+	}
+	if m.FieldCleared(playlist.FieldUpdatedAt) {
+		fields = append(fields, playlist.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlaylistMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlaylistMutation) ClearField(name string) error {
+	switch name {
+	case playlist.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case playlist.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Playlist nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlaylistMutation) ResetField(name string) error {
+	switch name {
+	case playlist.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case playlist.FieldName:
+		m.ResetName()
+		return nil
+	case playlist.FieldTracks:
+		m.ResetTracks()
+		return nil
+	case playlist.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case playlist.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Playlist field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlaylistMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.guild != nil {
+		edges = append(edges, playlist.EdgeGuild)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlaylistMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case playlist.EdgeGuild:
+		if id := m.guild; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlaylistMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlaylistMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlaylistMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedguild {
+		edges = append(edges, playlist.EdgeGuild)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlaylistMutation) EdgeCleared(name string) bool {
+	switch name {
+	case playlist.EdgeGuild:
+		return m.clearedguild
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlaylistMutation) ClearEdge(name string) error {
+	switch name {
+	case playlist.EdgeGuild:
+		m.ClearGuild()
+		return nil
+	}
+	return fmt.Errorf("unknown Playlist unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlaylistMutation) ResetEdge(name string) error {
+	switch name {
+	case playlist.EdgeGuild:
+		m.ResetGuild()
+		return nil
+	}
+	return fmt.Errorf("unknown Playlist edge %s", name)
+}
+
+// PronunciationMutation represents an operation that mutates the Pronunciation nodes in the graph.
+type PronunciationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	word          *string
+	replacement   *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	guild         *snowflake.ID
+	clearedguild  bool
+	done          bool
+	oldValue      func(context.Context) (*Pronunciation, error)
+	predicates    []predicate.Pronunciation
+}
+
+var _ ent.Mutation = (*PronunciationMutation)(nil)
+
+// pronunciationOption allows management of the mutation configuration using functional options.
+type pronunciationOption func(*PronunciationMutation)
+
+// newPronunciationMutation creates new mutation for the Pronunciation entity.
+func newPronunciationMutation(c config, op Op, opts ...pronunciationOption) *PronunciationMutation {
+	m := &PronunciationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePronunciation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPronunciationID sets the ID field of the mutation.
+func withPronunciationID(id int) pronunciationOption {
+	return func(m *PronunciationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Pronunciation
+		)
+		m.oldValue = func(ctx context.Context) (*Pronunciation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Pronunciation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPronunciation sets the old Pronunciation of the mutation.
+func withPronunciation(node *Pronunciation) pronunciationOption {
+	return func(m *PronunciationMutation) {
+		m.oldValue = func(context.Context) (*Pronunciation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PronunciationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PronunciationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PronunciationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PronunciationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Pronunciation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *PronunciationMutation) SetGuildID(s snowflake.ID) {
+	m.guild = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *PronunciationMutation) GuildID() (r snowflake.ID, exists bool) {
+	v := m.guild
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the Pronunciation entity.
+// If the Pronunciation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PronunciationMutation) OldGuildID(ctx context.Context) (v snowflake.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *PronunciationMutation) ResetGuildID() {
+	m.guild = nil
+}
+
+// SetWord sets the "word" field.
+func (m *PronunciationMutation) SetWord(s string) {
+	m.word = &s
+}
+
+// Word returns the value of the "word" field in the mutation.
+func (m *PronunciationMutation) Word() (r string, exists bool) {
+	v := m.word
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWord returns the old "word" field's value of the Pronunciation entity.
+// If the Pronunciation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PronunciationMutation) OldWord(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWord is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWord requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWord: %w", err)
+	}
+	return oldValue.Word, nil
+}
+
+// ResetWord resets all changes to the "word" field.
+func (m *PronunciationMutation) ResetWord() {
+	m.word = nil
+}
+
+// SetReplacement sets the "replacement" field.
+func (m *PronunciationMutation) SetReplacement(s string) {
+	m.replacement = &s
+}
+
+// Replacement returns the value of the "replacement" field in the mutation.
+func (m *PronunciationMutation) Replacement() (r string, exists bool) {
+	v := m.replacement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplacement returns the old "replacement" field's value of the Pronunciation entity.
+// If the Pronunciation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PronunciationMutation) OldReplacement(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplacement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplacement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplacement: %w", err)
+	}
+	return oldValue.Replacement, nil
+}
+
+// ResetReplacement resets all changes to the "replacement" field.
+func (m *PronunciationMutation) ResetReplacement() {
+	m.replacement = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PronunciationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PronunciationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Pronunciation entity.
+// If the Pronunciation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PronunciationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *PronunciationMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[pronunciation.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *PronunciationMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[pronunciation.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PronunciationMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, pronunciation.FieldCreatedAt)
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (m *PronunciationMutation) ClearGuild() {
+	m.clearedguild = true
+	m.clearedFields[pronunciation.FieldGuildID] = struct{}{}
+}
+
+// GuildCleared reports if the "guild" edge to the Guild entity was cleared.
+func (m *PronunciationMutation) GuildCleared() bool {
+	return m.clearedguild
+}
+
+// GuildIDs returns the "guild" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GuildID instead. It exists only for internal usage by the builders.
+func (m *PronunciationMutation) GuildIDs() (ids []snowflake.ID) {
+	if id := m.guild; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGuild resets all changes to the "guild" edge.
+func (m *PronunciationMutation) ResetGuild() {
+	m.guild = nil
+	m.clearedguild = false
+}
+
+// Where appends a list predicates to the PronunciationMutation builder.
+func (m *PronunciationMutation) Where(ps ...predicate.Pronunciation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PronunciationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PronunciationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pronunciation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PronunciationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PronunciationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Pronunciation).
+func (m *PronunciationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PronunciationMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.guild != nil {
+		fields = append(fields, pronunciation.FieldGuildID)
+	}
+	if m.word != nil {
+		fields = append(fields, pronunciation.FieldWord)
+	}
+	if m.replacement != nil {
+		fields = append(fields, pronunciation.FieldReplacement)
+	}
+	if m.created_at != nil {
+		fields = append(fields, pronunciation.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PronunciationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pronunciation.FieldGuildID:
+		return m.GuildID()
+	case pronunciation.FieldWord:
+		return m.Word()
+	case pronunciation.FieldReplacement:
+		return m.Replacement()
+	case pronunciation.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PronunciationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pronunciation.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case pronunciation.FieldWord:
+		return m.OldWord(ctx)
+	case pronunciation.FieldReplacement:
+		return m.OldReplacement(ctx)
+	case pronunciation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Pronunciation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PronunciationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pronunciation.FieldGuildID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case pronunciation.FieldWord:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWord(v)
+		return nil
+	case pronunciation.FieldReplacement:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplacement(v)
+		return nil
+	case pronunciation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pronunciation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PronunciationMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PronunciationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PronunciationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Pronunciation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PronunciationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pronunciation.FieldCreatedAt) {
+		fields = append(fields, pronunciation.FieldCreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PronunciationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PronunciationMutation) ClearField(name string) error {
+	switch name {
+	case pronunciation.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Pronunciation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PronunciationMutation) ResetField(name string) error {
+	switch name {
+	case pronunciation.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case pronunciation.FieldWord:
+		m.ResetWord()
+		return nil
+	case pronunciation.FieldReplacement:
+		m.ResetReplacement()
+		return nil
+	case pronunciation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Pronunciation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PronunciationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.guild != nil {
+		edges = append(edges, pronunciation.EdgeGuild)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PronunciationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pronunciation.EdgeGuild:
+		if id := m.guild; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PronunciationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PronunciationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PronunciationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedguild {
+		edges = append(edges, pronunciation.EdgeGuild)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PronunciationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pronunciation.EdgeGuild:
+		return m.clearedguild
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PronunciationMutation) ClearEdge(name string) error {
+	switch name {
+	case pronunciation.EdgeGuild:
+		m.ClearGuild()
+		return nil
+	}
+	return fmt.Errorf("unknown Pronunciation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PronunciationMutation) ResetEdge(name string) error {
+	switch name {
+	case pronunciation.EdgeGuild:
+		m.ResetGuild()
+		return nil
+	}
+	return fmt.Errorf("unknown Pronunciation edge %s", name)
+}
+
+// TrackPlayMutation represents an operation that mutates the TrackPlay nodes in the graph.
+type TrackPlayMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	identifier    *string
+	title         *string
+	author        *string
+	uri           *string
+	encoded       *string
+	length        *int64
+	addlength     *int64
+	requester     *string
+	autoplay      *bool
+	played_at     *time.Time
+	clearedFields map[string]struct{}
+	guild         *snowflake.ID
+	clearedguild  bool
+	done          bool
+	oldValue      func(context.Context) (*TrackPlay, error)
+	predicates    []predicate.TrackPlay
+}
+
+var _ ent.Mutation = (*TrackPlayMutation)(nil)
+
+// trackplayOption allows management of the mutation configuration using functional options.
+type trackplayOption func(*TrackPlayMutation)
+
+// newTrackPlayMutation creates new mutation for the TrackPlay entity.
+func newTrackPlayMutation(c config, op Op, opts ...trackplayOption) *TrackPlayMutation {
+	m := &TrackPlayMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTrackPlay,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTrackPlayID sets the ID field of the mutation.
+func withTrackPlayID(id int) trackplayOption {
+	return func(m *TrackPlayMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TrackPlay
+		)
+		m.oldValue = func(ctx context.Context) (*TrackPlay, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TrackPlay.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTrackPlay sets the old TrackPlay of the mutation.
+func withTrackPlay(node *TrackPlay) trackplayOption {
+	return func(m *TrackPlayMutation) {
+		m.oldValue = func(context.Context) (*TrackPlay, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TrackPlayMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TrackPlayMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TrackPlayMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TrackPlayMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TrackPlay.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *TrackPlayMutation) SetGuildID(s snowflake.ID) {
+	m.guild = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *TrackPlayMutation) GuildID() (r snowflake.ID, exists bool) {
+	v := m.guild
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the TrackPlay entity.
+// If the TrackPlay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackPlayMutation) OldGuildID(ctx context.Context) (v snowflake.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *TrackPlayMutation) ResetGuildID() {
+	m.guild = nil
+}
+
+// SetIdentifier sets the "identifier" field.
+func (m *TrackPlayMutation) SetIdentifier(s string) {
+	m.identifier = &s
+}
+
+// Identifier returns the value of the "identifier" field in the mutation.
+func (m *TrackPlayMutation) Identifier() (r string, exists bool) {
+	v := m.identifier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdentifier returns the old "identifier" field's value of the TrackPlay entity.
+// If the TrackPlay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackPlayMutation) OldIdentifier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdentifier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdentifier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdentifier: %w", err)
+	}
+	return oldValue.Identifier, nil
+}
+
+// ResetIdentifier resets all changes to the "identifier" field.
+func (m *TrackPlayMutation) ResetIdentifier() {
+	m.identifier = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *TrackPlayMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *TrackPlayMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the TrackPlay entity.
+// If the TrackPlay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackPlayMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *TrackPlayMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetAuthor sets the "author" field.
+func (m *TrackPlayMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *TrackPlayMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the TrackPlay entity.
+// If the TrackPlay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackPlayMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *TrackPlayMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetURI sets the "uri" field.
+func (m *TrackPlayMutation) SetURI(s string) {
+	m.uri = &s
+}
+
+// URI returns the value of the "uri" field in the mutation.
+func (m *TrackPlayMutation) URI() (r string, exists bool) {
+	v := m.uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURI returns the old "uri" field's value of the TrackPlay entity.
+// If the TrackPlay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackPlayMutation) OldURI(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+	}
+	return oldValue.URI, nil
+}
+
+// ClearURI clears the value of the "uri" field.
+func (m *TrackPlayMutation) ClearURI() {
+	m.uri = nil
+	m.clearedFields[trackplay.FieldURI] = struct{}{}
+}
+
+// URICleared returns if the "uri" field was cleared in this mutation.
+func (m *TrackPlayMutation) URICleared() bool {
+	_, ok := m.clearedFields[trackplay.FieldURI]
+	return ok
+}
+
+// ResetURI resets all changes to the "uri" field.
+func (m *TrackPlayMutation) ResetURI() {
+	m.uri = nil
+	delete(m.clearedFields, trackplay.FieldURI)
+}
+
+// SetEncoded sets the "encoded" field.
+func (m *TrackPlayMutation) SetEncoded(s string) {
+	m.encoded = &s
+}
+
+// Encoded returns the value of the "encoded" field in the mutation.
+func (m *TrackPlayMutation) Encoded() (r string, exists bool) {
+	v := m.encoded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncoded returns the old "encoded" field's value of the TrackPlay entity.
+// If the TrackPlay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackPlayMutation) OldEncoded(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncoded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncoded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncoded: %w", err)
+	}
+	return oldValue.Encoded, nil
+}
+
+// ResetEncoded resets all changes to the "encoded" field.
+func (m *TrackPlayMutation) ResetEncoded() {
+	m.encoded = nil
+}
+
+// SetLength sets the "length" field.
+func (m *TrackPlayMutation) SetLength(i int64) {
+	m.length = &i
+	m.addlength = nil
+}
+
+// Length returns the value of the "length" field in the mutation.
+func (m *TrackPlayMutation) Length() (r int64, exists bool) {
+	v := m.length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLength returns the old "length" field's value of the TrackPlay entity.
+// If the TrackPlay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackPlayMutation) OldLength(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLength: %w", err)
+	}
+	return oldValue.Length, nil
+}
+
+// AddLength adds i to the "length" field.
+func (m *TrackPlayMutation) AddLength(i int64) {
+	if m.addlength != nil {
+		*m.addlength += i
+	} else {
+		m.addlength = &i
+	}
+}
+
+// AddedLength returns the value that was added to the "length" field in this mutation.
+func (m *TrackPlayMutation) AddedLength() (r int64, exists bool) {
+	v := m.addlength
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLength resets all changes to the "length" field.
+func (m *TrackPlayMutation) ResetLength() {
+	m.length = nil
+	m.addlength = nil
+}
+
+// SetRequester sets the "requester" field.
+func (m *TrackPlayMutation) SetRequester(s string) {
+	m.requester = &s
+}
+
+// Requester returns the value of the "requester" field in the mutation.
+func (m *TrackPlayMutation) Requester() (r string, exists bool) {
+	v := m.requester
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequester returns the old "requester" field's value of the TrackPlay entity.
+// If the TrackPlay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackPlayMutation) OldRequester(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequester is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequester requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequester: %w", err)
+	}
+	return oldValue.Requester, nil
+}
+
+// ClearRequester clears the value of the "requester" field.
+func (m *TrackPlayMutation) ClearRequester() {
+	m.requester = nil
+	m.clearedFields[trackplay.FieldRequester] = struct{}{}
+}
+
+// RequesterCleared returns if the "requester" field was cleared in this mutation.
+func (m *TrackPlayMutation) RequesterCleared() bool {
+	_, ok := m.clearedFields[trackplay.FieldRequester]
+	return ok
+}
+
+// ResetRequester resets all changes to the "requester" field.
+func (m *TrackPlayMutation) ResetRequester() {
+	m.requester = nil
+	delete(m.clearedFields, trackplay.FieldRequester)
+}
+
+// SetAutoplay sets the "autoplay" field.
+func (m *TrackPlayMutation) SetAutoplay(b bool) {
+	m.autoplay = &b
+}
+
+// Autoplay returns the value of the "autoplay" field in the mutation.
+func (m *TrackPlayMutation) Autoplay() (r bool, exists bool) {
+	v := m.autoplay
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoplay returns the old "autoplay" field's value of the TrackPlay entity.
+// If the TrackPlay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackPlayMutation) OldAutoplay(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoplay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoplay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoplay: %w", err)
+	}
+	return oldValue.Autoplay, nil
+}
+
+// ResetAutoplay resets all changes to the "autoplay" field.
+func (m *TrackPlayMutation) ResetAutoplay() {
+	m.autoplay = nil
+}
+
+// SetPlayedAt sets the "played_at" field.
+func (m *TrackPlayMutation) SetPlayedAt(t time.Time) {
+	m.played_at = &t
+}
+
+// PlayedAt returns the value of the "played_at" field in the mutation.
+func (m *TrackPlayMutation) PlayedAt() (r time.Time, exists bool) {
+	v := m.played_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayedAt returns the old "played_at" field's value of the TrackPlay entity.
+// If the TrackPlay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackPlayMutation) OldPlayedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayedAt: %w", err)
+	}
+	return oldValue.PlayedAt, nil
+}
+
+// ResetPlayedAt resets all changes to the "played_at" field.
+func (m *TrackPlayMutation) ResetPlayedAt() {
+	m.played_at = nil
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (m *TrackPlayMutation) ClearGuild() {
+	m.clearedguild = true
+	m.clearedFields[trackplay.FieldGuildID] = struct{}{}
+}
+
+// GuildCleared reports if the "guild" edge to the Guild entity was cleared.
+func (m *TrackPlayMutation) GuildCleared() bool {
+	return m.clearedguild
+}
+
+// GuildIDs returns the "guild" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GuildID instead. It exists only for internal usage by the builders.
+func (m *TrackPlayMutation) GuildIDs() (ids []snowflake.ID) {
+	if id := m.guild; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGuild resets all changes to the "guild" edge.
+func (m *TrackPlayMutation) ResetGuild() {
+	m.guild = nil
+	m.clearedguild = false
+}
+
+// Where appends a list predicates to the TrackPlayMutation builder.
+func (m *TrackPlayMutation) Where(ps ...predicate.TrackPlay) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TrackPlayMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TrackPlayMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TrackPlay, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TrackPlayMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TrackPlayMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TrackPlay).
+func (m *TrackPlayMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TrackPlayMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.guild != nil {
+		fields = append(fields, trackplay.FieldGuildID)
+	}
+	if m.identifier != nil {
+		fields = append(fields, trackplay.FieldIdentifier)
+	}
+	if m.title != nil {
+		fields = append(fields, trackplay.FieldTitle)
+	}
+	if m.author != nil {
+		fields = append(fields, trackplay.FieldAuthor)
+	}
+	if m.uri != nil {
+		fields = append(fields, trackplay.FieldURI)
+	}
+	if m.encoded != nil {
+		fields = append(fields, trackplay.FieldEncoded)
+	}
+	if m.length != nil {
+		fields = append(fields, trackplay.FieldLength)
+	}
+	if m.requester != nil {
+		fields = append(fields, trackplay.FieldRequester)
+	}
+	if m.autoplay != nil {
+		fields = append(fields, trackplay.FieldAutoplay)
+	}
+	if m.played_at != nil {
+		fields = append(fields, trackplay.FieldPlayedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TrackPlayMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case trackplay.FieldGuildID:
+		return m.GuildID()
+	case trackplay.FieldIdentifier:
+		return m.Identifier()
+	case trackplay.FieldTitle:
+		return m.Title()
+	case trackplay.FieldAuthor:
+		return m.Author()
+	case trackplay.FieldURI:
+		return m.URI()
+	case trackplay.FieldEncoded:
+		return m.Encoded()
+	case trackplay.FieldLength:
+		return m.Length()
+	case trackplay.FieldRequester:
+		return m.Requester()
+	case trackplay.FieldAutoplay:
+		return m.Autoplay()
+	case trackplay.FieldPlayedAt:
+		return m.PlayedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TrackPlayMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case trackplay.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case trackplay.FieldIdentifier:
+		return m.OldIdentifier(ctx)
+	case trackplay.FieldTitle:
+		return m.OldTitle(ctx)
+	case trackplay.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case trackplay.FieldURI:
+		return m.OldURI(ctx)
+	case trackplay.FieldEncoded:
+		return m.OldEncoded(ctx)
+	case trackplay.FieldLength:
+		return m.OldLength(ctx)
+	case trackplay.FieldRequester:
+		return m.OldRequester(ctx)
+	case trackplay.FieldAutoplay:
+		return m.OldAutoplay(ctx)
+	case trackplay.FieldPlayedAt:
+		return m.OldPlayedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TrackPlay field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TrackPlayMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case trackplay.FieldGuildID:
+		v, ok := value.(snowflake.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case trackplay.FieldIdentifier:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdentifier(v)
+		return nil
+	case trackplay.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case trackplay.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case trackplay.FieldURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURI(v)
+		return nil
+	case trackplay.FieldEncoded:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncoded(v)
+		return nil
+	case trackplay.FieldLength:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLength(v)
+		return nil
+	case trackplay.FieldRequester:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequester(v)
+		return nil
+	case trackplay.FieldAutoplay:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoplay(v)
+		return nil
+	case trackplay.FieldPlayedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TrackPlay field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TrackPlayMutation) AddedFields() []string {
+	var fields []string
+	if m.addlength != nil {
+		fields = append(fields, trackplay.FieldLength)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TrackPlayMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case trackplay.FieldLength:
+		return m.AddedLength()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TrackPlayMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case trackplay.FieldLength:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLength(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TrackPlay numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TrackPlayMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(trackplay.FieldURI) {
+		fields = append(fields, trackplay.FieldURI)
+	}
+	if m.FieldCleared(trackplay.FieldRequester) {
+		fields = append(fields, trackplay.FieldRequester)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TrackPlayMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TrackPlayMutation) ClearField(name string) error {
+	switch name {
+	case trackplay.FieldURI:
+		m.ClearURI()
+		return nil
+	case trackplay.FieldRequester:
+		m.ClearRequester()
+		return nil
+	}
+	return fmt.Errorf("unknown TrackPlay nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TrackPlayMutation) ResetField(name string) error {
+	switch name {
+	case trackplay.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case trackplay.FieldIdentifier:
+		m.ResetIdentifier()
+		return nil
+	case trackplay.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case trackplay.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case trackplay.FieldURI:
+		m.ResetURI()
+		return nil
+	case trackplay.FieldEncoded:
+		m.ResetEncoded()
+		return nil
+	case trackplay.FieldLength:
+		m.ResetLength()
+		return nil
+	case trackplay.FieldRequester:
+		m.ResetRequester()
+		return nil
+	case trackplay.FieldAutoplay:
+		m.ResetAutoplay()
+		return nil
+	case trackplay.FieldPlayedAt:
+		m.ResetPlayedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TrackPlay field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TrackPlayMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.guild != nil {
+		edges = append(edges, trackplay.EdgeGuild)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TrackPlayMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case trackplay.EdgeGuild:
+		if id := m.guild; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TrackPlayMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TrackPlayMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TrackPlayMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedguild {
+		edges = append(edges, trackplay.EdgeGuild)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TrackPlayMutation) EdgeCleared(name string) bool {
+	switch name {
+	case trackplay.EdgeGuild:
+		return m.clearedguild
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TrackPlayMutation) ClearEdge(name string) error {
+	switch name {
+	case trackplay.EdgeGuild:
+		m.ClearGuild()
+		return nil
+	}
+	return fmt.Errorf("unknown TrackPlay unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TrackPlayMutation) ResetEdge(name string) error {
+	switch name {
+	case trackplay.EdgeGuild:
+		m.ResetGuild()
+		return nil
+	}
+	return fmt.Errorf("unknown TrackPlay edge %s", name)
+}
+
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	delivery_id         *string
+	event               *string
+	payload             *string
+	attempts            *int
+	addattempts         *int
+	status_code         *int
+	addstatus_code      *int
+	error               *string
+	delivered           *bool
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	subscription        *int
+	clearedsubscription bool
+	done                bool
+	oldValue            func(context.Context) (*WebhookDelivery, error)
+	predicates          []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id int) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeliveryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (m *WebhookDeliveryMutation) SetSubscriptionID(i int) {
+	m.subscription = &i
+}
+
+// SubscriptionID returns the value of the "subscription_id" field in the mutation.
+func (m *WebhookDeliveryMutation) SubscriptionID() (r int, exists bool) {
+	v := m.subscription
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubscriptionID returns the old "subscription_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldSubscriptionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubscriptionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubscriptionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubscriptionID: %w", err)
+	}
+	return oldValue.SubscriptionID, nil
+}
+
+// ResetSubscriptionID resets all changes to the "subscription_id" field.
+func (m *WebhookDeliveryMutation) ResetSubscriptionID() {
+	m.subscription = nil
+}
+
+// SetDeliveryID sets the "delivery_id" field.
+func (m *WebhookDeliveryMutation) SetDeliveryID(s string) {
+	m.delivery_id = &s
+}
+
+// DeliveryID returns the value of the "delivery_id" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveryID() (r string, exists bool) {
+	v := m.delivery_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveryID returns the old "delivery_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDeliveryID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveryID: %w", err)
+	}
+	return oldValue.DeliveryID, nil
+}
+
+// ResetDeliveryID resets all changes to the "delivery_id" field.
+func (m *WebhookDeliveryMutation) ResetDeliveryID() {
+	m.delivery_id = nil
+}
+
+// SetEvent sets the "event" field.
+func (m *WebhookDeliveryMutation) SetEvent(s string) {
+	m.event = &s
+}
+
+// Event returns the value of the "event" field in the mutation.
+func (m *WebhookDeliveryMutation) Event() (r string, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvent returns the old "event" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEvent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
+}
+
+// ResetEvent resets all changes to the "event" field.
+func (m *WebhookDeliveryMutation) ResetEvent() {
+	m.event = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetStatusCode sets the "status_code" field.
+func (m *WebhookDeliveryMutation) SetStatusCode(i int) {
+	m.status_code = &i
+	m.addstatus_code = nil
+}
+
+// StatusCode returns the value of the "status_code" field in the mutation.
+func (m *WebhookDeliveryMutation) StatusCode() (r int, exists bool) {
+	v := m.status_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusCode returns the old "status_code" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldStatusCode(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusCode: %w", err)
+	}
+	return oldValue.StatusCode, nil
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (m *WebhookDeliveryMutation) AddStatusCode(i int) {
+	if m.addstatus_code != nil {
+		*m.addstatus_code += i
+	} else {
+		m.addstatus_code = &i
+	}
+}
+
+// AddedStatusCode returns the value that was added to the "status_code" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedStatusCode() (r int, exists bool) {
+	v := m.addstatus_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (m *WebhookDeliveryMutation) ClearStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+	m.clearedFields[webhookdelivery.FieldStatusCode] = struct{}{}
+}
+
+// StatusCodeCleared returns if the "status_code" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) StatusCodeCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldStatusCode]
+	return ok
+}
+
+// ResetStatusCode resets all changes to the "status_code" field.
+func (m *WebhookDeliveryMutation) ResetStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+	delete(m.clearedFields, webhookdelivery.FieldStatusCode)
+}
+
+// SetError sets the "error" field.
+func (m *WebhookDeliveryMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *WebhookDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *WebhookDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[webhookdelivery.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *WebhookDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, webhookdelivery.FieldError)
+}
+
+// SetDelivered sets the "delivered" field.
+func (m *WebhookDeliveryMutation) SetDelivered(b bool) {
+	m.delivered = &b
+}
+
+// Delivered returns the value of the "delivered" field in the mutation.
+func (m *WebhookDeliveryMutation) Delivered() (r bool, exists bool) {
+	v := m.delivered
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDelivered returns the old "delivered" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDelivered(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDelivered is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDelivered requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDelivered: %w", err)
+	}
+	return oldValue.Delivered, nil
+}
+
+// ResetDelivered resets all changes to the "delivered" field.
+func (m *WebhookDeliveryMutation) ResetDelivered() {
+	m.delivered = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *WebhookDeliveryMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[webhookdelivery.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookDeliveryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *WebhookDeliveryMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[webhookdelivery.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookDeliveryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldUpdatedAt)
+}
+
+// ClearSubscription clears the "subscription" edge to the WebhookSubscription entity.
+func (m *WebhookDeliveryMutation) ClearSubscription() {
+	m.clearedsubscription = true
+	m.clearedFields[webhookdelivery.FieldSubscriptionID] = struct{}{}
+}
+
+// SubscriptionCleared reports if the "subscription" edge to the WebhookSubscription entity was cleared.
+func (m *WebhookDeliveryMutation) SubscriptionCleared() bool {
+	return m.clearedsubscription
+}
+
+// SubscriptionIDs returns the "subscription" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SubscriptionID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) SubscriptionIDs() (ids []int) {
+	if id := m.subscription; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSubscription resets all changes to the "subscription" edge.
+func (m *WebhookDeliveryMutation) ResetSubscription() {
+	m.subscription = nil
+	m.clearedsubscription = false
+}
+
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WebhookDeliveryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.subscription != nil {
+		fields = append(fields, webhookdelivery.FieldSubscriptionID)
+	}
+	if m.delivery_id != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveryID)
+	}
+	if m.event != nil {
+		fields = append(fields, webhookdelivery.FieldEvent)
+	}
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.status_code != nil {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
+	}
+	if m.error != nil {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.delivered != nil {
+		fields = append(fields, webhookdelivery.FieldDelivered)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, webhookdelivery.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldSubscriptionID:
+		return m.SubscriptionID()
+	case webhookdelivery.FieldDeliveryID:
+		return m.DeliveryID()
+	case webhookdelivery.FieldEvent:
+		return m.Event()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldStatusCode:
+		return m.StatusCode()
+	case webhookdelivery.FieldError:
+		return m.Error()
+	case webhookdelivery.FieldDelivered:
+		return m.Delivered()
+	case webhookdelivery.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhookdelivery.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhookdelivery.FieldSubscriptionID:
+		return m.OldSubscriptionID(ctx)
+	case webhookdelivery.FieldDeliveryID:
+		return m.OldDeliveryID(ctx)
+	case webhookdelivery.FieldEvent:
+		return m.OldEvent(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldStatusCode:
+		return m.OldStatusCode(ctx)
+	case webhookdelivery.FieldError:
+		return m.OldError(ctx)
+	case webhookdelivery.FieldDelivered:
+		return m.OldDelivered(ctx)
+	case webhookdelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhookdelivery.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhookdelivery.FieldSubscriptionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscriptionID(v)
+		return nil
+	case webhookdelivery.FieldDeliveryID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveryID(v)
+		return nil
+	case webhookdelivery.FieldEvent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvent(v)
+		return nil
+	case webhookdelivery.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusCode(v)
+		return nil
+	case webhookdelivery.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case webhookdelivery.FieldDelivered:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDelivered(v)
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhookdelivery.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addstatus_code != nil {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldStatusCode:
+		return m.AddedStatusCode()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatusCode(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookdelivery.FieldStatusCode) {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
+	}
+	if m.FieldCleared(webhookdelivery.FieldError) {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldCreatedAt) {
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
+	}
+	if m.FieldCleared(webhookdelivery.FieldUpdatedAt) {
+		fields = append(fields, webhookdelivery.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case webhookdelivery.FieldStatusCode:
+		m.ClearStatusCode()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ClearError()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case webhookdelivery.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
+	switch name {
+	case webhookdelivery.FieldSubscriptionID:
+		m.ResetSubscriptionID()
+		return nil
+	case webhookdelivery.FieldDeliveryID:
+		m.ResetDeliveryID()
+		return nil
+	case webhookdelivery.FieldEvent:
+		m.ResetEvent()
+		return nil
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		m.ResetStatusCode()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ResetError()
+		return nil
+	case webhookdelivery.FieldDelivered:
+		m.ResetDelivered()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhookdelivery.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.subscription != nil {
+		edges = append(edges, webhookdelivery.EdgeSubscription)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhookdelivery.EdgeSubscription:
+		if id := m.subscription; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsubscription {
+		edges = append(edges, webhookdelivery.EdgeSubscription)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhookdelivery.EdgeSubscription:
+		return m.clearedsubscription
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
+	switch name {
+	case webhookdelivery.EdgeSubscription:
+		m.ClearSubscription()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
+	switch name {
+	case webhookdelivery.EdgeSubscription:
+		m.ResetSubscription()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
+}
+
+// WebhookSubscriptionMutation represents an operation that mutates the WebhookSubscription nodes in the graph.
+type WebhookSubscriptionMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	url               *string
+	secret            *string
+	events            *[]string
+	appendevents      []string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	guild             *snowflake.ID
+	clearedguild      bool
+	deliveries        map[int]struct{}
+	removeddeliveries map[int]struct{}
+	cleareddeliveries bool
+	done              bool
+	oldValue          func(context.Context) (*WebhookSubscription, error)
+	predicates        []predicate.WebhookSubscription
+}
+
+var _ ent.Mutation = (*WebhookSubscriptionMutation)(nil)
+
+// webhooksubscriptionOption allows management of the mutation configuration using functional options.
+type webhooksubscriptionOption func(*WebhookSubscriptionMutation)
+
+// newWebhookSubscriptionMutation creates new mutation for the WebhookSubscription entity.
+func newWebhookSubscriptionMutation(c config, op Op, opts ...webhooksubscriptionOption) *WebhookSubscriptionMutation {
+	m := &WebhookSubscriptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookSubscription,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookSubscriptionID sets the ID field of the mutation.
+func withWebhookSubscriptionID(id int) webhooksubscriptionOption {
+	return func(m *WebhookSubscriptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookSubscription
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookSubscription, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookSubscription.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhookSubscription sets the old WebhookSubscription of the mutation.
+func withWebhookSubscription(node *WebhookSubscription) webhooksubscriptionOption {
+	return func(m *WebhookSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*WebhookSubscription, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookSubscriptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookSubscriptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookSubscriptionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookSubscriptionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookSubscription.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *WebhookSubscriptionMutation) SetGuildID(s snowflake.ID) {
+	m.guild = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *WebhookSubscriptionMutation) GuildID() (r snowflake.ID, exists bool) {
+	v := m.guild
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldGuildID(ctx context.Context) (v snowflake.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *WebhookSubscriptionMutation) ResetGuildID() {
+	m.guild = nil
+}
+
+// SetURL sets the "url" field.
+func (m *WebhookSubscriptionMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookSubscriptionMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookSubscriptionMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *WebhookSubscriptionMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookSubscriptionMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookSubscriptionMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetEvents sets the "events" field.
+func (m *WebhookSubscriptionMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
+}
+
+// Events returns the value of the "events" field in the mutation.
+func (m *WebhookSubscriptionMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvents returns the old "events" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldEvents(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// AppendEvents adds s to the "events" field.
+func (m *WebhookSubscriptionMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
+}
+
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *WebhookSubscriptionMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
+	}
+	return m.appendevents, true
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *WebhookSubscriptionMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookSubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *WebhookSubscriptionMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[webhooksubscription.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *WebhookSubscriptionMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[webhooksubscription.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookSubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, webhooksubscription.FieldCreatedAt)
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (m *WebhookSubscriptionMutation) ClearGuild() {
+	m.clearedguild = true
+	m.clearedFields[webhooksubscription.FieldGuildID] = struct{}{}
+}
+
+// GuildCleared reports if the "guild" edge to the Guild entity was cleared.
+func (m *WebhookSubscriptionMutation) GuildCleared() bool {
+	return m.clearedguild
+}
+
+// GuildIDs returns the "guild" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GuildID instead. It exists only for internal usage by the builders.
+func (m *WebhookSubscriptionMutation) GuildIDs() (ids []snowflake.ID) {
+	if id := m.guild; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGuild resets all changes to the "guild" edge.
+func (m *WebhookSubscriptionMutation) ResetGuild() {
+	m.guild = nil
+	m.clearedguild = false
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookSubscriptionMutation) AddDeliveryIDs(ids ...int) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookSubscriptionMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
+}
+
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookSubscriptionMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookSubscriptionMutation) RemoveDeliveryIDs(ids ...int) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookSubscriptionMutation) RemovedDeliveriesIDs() (ids []int) {
+	for id := range m.removeddeliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookSubscriptionMutation) DeliveriesIDs() (ids []int) {
+	for id := range m.deliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookSubscriptionMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
+}
+
+// Where appends a list predicates to the WebhookSubscriptionMutation builder.
+func (m *WebhookSubscriptionMutation) Where(ps ...predicate.WebhookSubscription) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WebhookSubscriptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WebhookSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookSubscription, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WebhookSubscriptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WebhookSubscriptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WebhookSubscription).
+func (m *WebhookSubscriptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WebhookSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.guild != nil {
+		fields = append(fields, webhooksubscription.FieldGuildID)
+	}
+	if m.url != nil {
+		fields = append(fields, webhooksubscription.FieldURL)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhooksubscription.FieldSecret)
+	}
+	if m.events != nil {
+		fields = append(fields, webhooksubscription.FieldEvents)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhooksubscription.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WebhookSubscriptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhooksubscription.FieldGuildID:
+		return m.GuildID()
+	case webhooksubscription.FieldURL:
+		return m.URL()
+	case webhooksubscription.FieldSecret:
+		return m.Secret()
+	case webhooksubscription.FieldEvents:
+		return m.Events()
+	case webhooksubscription.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhooksubscription.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case webhooksubscription.FieldURL:
+		return m.OldURL(ctx)
+	case webhooksubscription.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhooksubscription.FieldEvents:
+		return m.OldEvents(ctx)
+	case webhooksubscription.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookSubscription field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookSubscriptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhooksubscription.FieldGuildID:
 		v, ok := value.(snowflake.ID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPlayerChannelID(v)
+		m.SetGuildID(v)
 		return nil
-	case guild.FieldPlayerMessageID:
-		v, ok := value.(snowflake.ID)
+	case webhooksubscription.FieldURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPlayerMessageID(v)
+		m.SetURL(v)
+		return nil
+	case webhooksubscription.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhooksubscription.FieldEvents:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case webhooksubscription.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Guild numeric field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WebhookSubscriptionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WebhookSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookSubscriptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WebhookSubscription numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GuildMutation) ClearedFields() []string {
+func (m *WebhookSubscriptionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(guild.FieldPlayerChannelID) {
-		fields = append(fields, guild.FieldPlayerChannelID)
-	}
-	if m.FieldCleared(guild.FieldPlayerMessageID) {
-		fields = append(fields, guild.FieldPlayerMessageID)
+	if m.FieldCleared(webhooksubscription.FieldCreatedAt) {
+		fields = append(fields, webhooksubscription.FieldCreatedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GuildMutation) FieldCleared(name string) bool {
+func (m *WebhookSubscriptionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GuildMutation) ClearField(name string) error {
+func (m *WebhookSubscriptionMutation) ClearField(name string) error {
 	switch name {
-	case guild.FieldPlayerChannelID:
-		m.ClearPlayerChannelID()
-		return nil
-	case guild.FieldPlayerMessageID:
-		m.ClearPlayerMessageID()
+	case webhooksubscription.FieldCreatedAt:
+		m.ClearCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Guild nullable field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GuildMutation) ResetField(name string) error {
+func (m *WebhookSubscriptionMutation) ResetField(name string) error {
 	switch name {
-	case guild.FieldName:
-		m.ResetName()
+	case webhooksubscription.FieldGuildID:
+		m.ResetGuildID()
 		return nil
-	case guild.FieldPlayerChannelID:
-		m.ResetPlayerChannelID()
+	case webhooksubscription.FieldURL:
+		m.ResetURL()
 		return nil
-	case guild.FieldPlayerMessageID:
-		m.ResetPlayerMessageID()
+	case webhooksubscription.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case guild.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case webhooksubscription.FieldEvents:
+		m.ResetEvents()
 		return nil
-	case guild.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case webhooksubscription.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Guild field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GuildMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookSubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.guild != nil {
+		edges = append(edges, webhooksubscription.EdgeGuild)
+	}
+	if m.deliveries != nil {
+		edges = append(edges, webhooksubscription.EdgeDeliveries)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GuildMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookSubscriptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhooksubscription.EdgeGuild:
+		if id := m.guild; id != nil {
+			return []ent.Value{*id}
+		}
+	case webhooksubscription.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GuildMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookSubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhooksubscription.EdgeDeliveries)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GuildMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookSubscriptionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case webhooksubscription.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GuildMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookSubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedguild {
+		edges = append(edges, webhooksubscription.EdgeGuild)
+	}
+	if m.cleareddeliveries {
+		edges = append(edges, webhooksubscription.EdgeDeliveries)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GuildMutation) EdgeCleared(name string) bool {
+func (m *WebhookSubscriptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhooksubscription.EdgeGuild:
+		return m.clearedguild
+	case webhooksubscription.EdgeDeliveries:
+		return m.cleareddeliveries
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GuildMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Guild unique edge %s", name)
+func (m *WebhookSubscriptionMutation) ClearEdge(name string) error {
+	switch name {
+	case webhooksubscription.EdgeGuild:
+		m.ClearGuild()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookSubscription unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GuildMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Guild edge %s", name)
+func (m *WebhookSubscriptionMutation) ResetEdge(name string) error {
+	switch name {
+	case webhooksubscription.EdgeGuild:
+		m.ResetGuild()
+		return nil
+	case webhooksubscription.EdgeDeliveries:
+		m.ResetDeliveries()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookSubscription edge %s", name)
 }
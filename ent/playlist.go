@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/playlist"
+)
+
+// Playlist is the model entity for the Playlist schema.
+type Playlist struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID snowflake.ID `json:"guild_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Encoded lavalink tracks
+	Tracks []string `json:"tracks,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlaylistQuery when eager-loading is set.
+	Edges        PlaylistEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlaylistEdges holds the relations/edges for other nodes in the graph.
+type PlaylistEdges struct {
+	// Guild holds the value of the guild edge.
+	Guild *Guild `json:"guild,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GuildOrErr returns the Guild value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlaylistEdges) GuildOrErr() (*Guild, error) {
+	if e.Guild != nil {
+		return e.Guild, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: guild.Label}
+	}
+	return nil, &NotLoadedError{edge: "guild"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Playlist) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case playlist.FieldTracks:
+			values[i] = new([]byte)
+		case playlist.FieldID, playlist.FieldGuildID:
+			values[i] = new(sql.NullInt64)
+		case playlist.FieldName:
+			values[i] = new(sql.NullString)
+		case playlist.FieldCreatedAt, playlist.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Playlist fields.
+func (pl *Playlist) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case playlist.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pl.ID = int(value.Int64)
+		case playlist.FieldGuildID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				pl.GuildID = snowflake.ID(value.Int64)
+			}
+		case playlist.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pl.Name = value.String
+			}
+		case playlist.FieldTracks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tracks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pl.Tracks); err != nil {
+					return fmt.Errorf("unmarshal field tracks: %w", err)
+				}
+			}
+		case playlist.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pl.CreatedAt = value.Time
+			}
+		case playlist.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pl.UpdatedAt = value.Time
+			}
+		default:
+			pl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Playlist.
+// This includes values selected through modifiers, order, etc.
+func (pl *Playlist) Value(name string) (ent.Value, error) {
+	return pl.selectValues.Get(name)
+}
+
+// QueryGuild queries the "guild" edge of the Playlist entity.
+func (pl *Playlist) QueryGuild() *GuildQuery {
+	return NewPlaylistClient(pl.config).QueryGuild(pl)
+}
+
+// Update returns a builder for updating this Playlist.
+// Note that you need to call Playlist.Unwrap() before calling this method if this Playlist
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pl *Playlist) Update() *PlaylistUpdateOne {
+	return NewPlaylistClient(pl.config).UpdateOne(pl)
+}
+
+// Unwrap unwraps the Playlist entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pl *Playlist) Unwrap() *Playlist {
+	_tx, ok := pl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Playlist is not a transactional entity")
+	}
+	pl.config.driver = _tx.drv
+	return pl
+}
+
+// String implements the fmt.Stringer.
+func (pl *Playlist) String() string {
+	var builder strings.Builder
+	builder.WriteString("Playlist(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pl.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(fmt.Sprintf("%v", pl.GuildID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pl.Name)
+	builder.WriteString(", ")
+	builder.WriteString("tracks=")
+	builder.WriteString(fmt.Sprintf("%v", pl.Tracks))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Playlists is a parsable slice of Playlist.
+type Playlists []*Playlist
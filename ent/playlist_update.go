@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/playlist"
+	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+)
+
+// PlaylistUpdate is the builder for updating Playlist entities.
+type PlaylistUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlaylistMutation
+}
+
+// Where appends a list predicates to the PlaylistUpdate builder.
+func (pu *PlaylistUpdate) Where(ps ...predicate.Playlist) *PlaylistUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetGuildID sets the "guild_id" field.
+func (pu *PlaylistUpdate) SetGuildID(s snowflake.ID) *PlaylistUpdate {
+	pu.mutation.SetGuildID(s)
+	return pu
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (pu *PlaylistUpdate) SetNillableGuildID(s *snowflake.ID) *PlaylistUpdate {
+	if s != nil {
+		pu.SetGuildID(*s)
+	}
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *PlaylistUpdate) SetName(s string) *PlaylistUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *PlaylistUpdate) SetNillableName(s *string) *PlaylistUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetTracks sets the "tracks" field.
+func (pu *PlaylistUpdate) SetTracks(s []string) *PlaylistUpdate {
+	pu.mutation.SetTracks(s)
+	return pu
+}
+
+// AppendTracks appends s to the "tracks" field.
+func (pu *PlaylistUpdate) AppendTracks(s []string) *PlaylistUpdate {
+	pu.mutation.AppendTracks(s)
+	return pu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pu *PlaylistUpdate) SetCreatedAt(t time.Time) *PlaylistUpdate {
+	pu.mutation.SetCreatedAt(t)
+	return pu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pu *PlaylistUpdate) SetNillableCreatedAt(t *time.Time) *PlaylistUpdate {
+	if t != nil {
+		pu.SetCreatedAt(*t)
+	}
+	return pu
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (pu *PlaylistUpdate) ClearCreatedAt() *PlaylistUpdate {
+	pu.mutation.ClearCreatedAt()
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PlaylistUpdate) SetUpdatedAt(t time.Time) *PlaylistUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (pu *PlaylistUpdate) ClearUpdatedAt() *PlaylistUpdate {
+	pu.mutation.ClearUpdatedAt()
+	return pu
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (pu *PlaylistUpdate) SetGuild(g *Guild) *PlaylistUpdate {
+	return pu.SetGuildID(g.ID)
+}
+
+// Mutation returns the PlaylistMutation object of the builder.
+func (pu *PlaylistUpdate) Mutation() *PlaylistMutation {
+	return pu.mutation
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (pu *PlaylistUpdate) ClearGuild() *PlaylistUpdate {
+	pu.mutation.ClearGuild()
+	return pu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PlaylistUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PlaylistUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PlaylistUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PlaylistUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PlaylistUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok && !pu.mutation.UpdatedAtCleared() {
+		v := playlist.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PlaylistUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := playlist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Playlist.name": %w`, err)}
+		}
+	}
+	if pu.mutation.GuildCleared() && len(pu.mutation.GuildIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Playlist.guild"`)
+	}
+	return nil
+}
+
+func (pu *PlaylistUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlist.Table, playlist.Columns, sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(playlist.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Tracks(); ok {
+		_spec.SetField(playlist.FieldTracks, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedTracks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, playlist.FieldTracks, value)
+		})
+	}
+	if value, ok := pu.mutation.CreatedAt(); ok {
+		_spec.SetField(playlist.FieldCreatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.CreatedAtCleared() {
+		_spec.ClearField(playlist.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(playlist.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(playlist.FieldUpdatedAt, field.TypeTime)
+	}
+	if pu.mutation.GuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlist.GuildTable,
+			Columns: []string{playlist.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlist.GuildTable,
+			Columns: []string{playlist.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PlaylistUpdateOne is the builder for updating a single Playlist entity.
+type PlaylistUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlaylistMutation
+}
+
+// SetGuildID sets the "guild_id" field.
+func (puo *PlaylistUpdateOne) SetGuildID(s snowflake.ID) *PlaylistUpdateOne {
+	puo.mutation.SetGuildID(s)
+	return puo
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (puo *PlaylistUpdateOne) SetNillableGuildID(s *snowflake.ID) *PlaylistUpdateOne {
+	if s != nil {
+		puo.SetGuildID(*s)
+	}
+	return puo
+}
+
+// SetName sets the "name" field.
+func (puo *PlaylistUpdateOne) SetName(s string) *PlaylistUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *PlaylistUpdateOne) SetNillableName(s *string) *PlaylistUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetTracks sets the "tracks" field.
+func (puo *PlaylistUpdateOne) SetTracks(s []string) *PlaylistUpdateOne {
+	puo.mutation.SetTracks(s)
+	return puo
+}
+
+// AppendTracks appends s to the "tracks" field.
+func (puo *PlaylistUpdateOne) AppendTracks(s []string) *PlaylistUpdateOne {
+	puo.mutation.AppendTracks(s)
+	return puo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (puo *PlaylistUpdateOne) SetCreatedAt(t time.Time) *PlaylistUpdateOne {
+	puo.mutation.SetCreatedAt(t)
+	return puo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (puo *PlaylistUpdateOne) SetNillableCreatedAt(t *time.Time) *PlaylistUpdateOne {
+	if t != nil {
+		puo.SetCreatedAt(*t)
+	}
+	return puo
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (puo *PlaylistUpdateOne) ClearCreatedAt() *PlaylistUpdateOne {
+	puo.mutation.ClearCreatedAt()
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PlaylistUpdateOne) SetUpdatedAt(t time.Time) *PlaylistUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (puo *PlaylistUpdateOne) ClearUpdatedAt() *PlaylistUpdateOne {
+	puo.mutation.ClearUpdatedAt()
+	return puo
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (puo *PlaylistUpdateOne) SetGuild(g *Guild) *PlaylistUpdateOne {
+	return puo.SetGuildID(g.ID)
+}
+
+// Mutation returns the PlaylistMutation object of the builder.
+func (puo *PlaylistUpdateOne) Mutation() *PlaylistMutation {
+	return puo.mutation
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (puo *PlaylistUpdateOne) ClearGuild() *PlaylistUpdateOne {
+	puo.mutation.ClearGuild()
+	return puo
+}
+
+// Where appends a list predicates to the PlaylistUpdate builder.
+func (puo *PlaylistUpdateOne) Where(ps ...predicate.Playlist) *PlaylistUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PlaylistUpdateOne) Select(field string, fields ...string) *PlaylistUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Playlist entity.
+func (puo *PlaylistUpdateOne) Save(ctx context.Context) (*Playlist, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PlaylistUpdateOne) SaveX(ctx context.Context) *Playlist {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PlaylistUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PlaylistUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PlaylistUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok && !puo.mutation.UpdatedAtCleared() {
+		v := playlist.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PlaylistUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := playlist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Playlist.name": %w`, err)}
+		}
+	}
+	if puo.mutation.GuildCleared() && len(puo.mutation.GuildIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Playlist.guild"`)
+	}
+	return nil
+}
+
+func (puo *PlaylistUpdateOne) sqlSave(ctx context.Context) (_node *Playlist, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlist.Table, playlist.Columns, sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Playlist.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playlist.FieldID)
+		for _, f := range fields {
+			if !playlist.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != playlist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(playlist.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Tracks(); ok {
+		_spec.SetField(playlist.FieldTracks, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedTracks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, playlist.FieldTracks, value)
+		})
+	}
+	if value, ok := puo.mutation.CreatedAt(); ok {
+		_spec.SetField(playlist.FieldCreatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.CreatedAtCleared() {
+		_spec.ClearField(playlist.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(playlist.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(playlist.FieldUpdatedAt, field.TypeTime)
+	}
+	if puo.mutation.GuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlist.GuildTable,
+			Columns: []string{playlist.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlist.GuildTable,
+			Columns: []string{playlist.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Playlist{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/pronunciation"
+)
+
+// Pronunciation is the model entity for the Pronunciation schema.
+type Pronunciation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID snowflake.ID `json:"guild_id,omitempty"`
+	// Word holds the value of the "word" field.
+	Word string `json:"word,omitempty"`
+	// Replacement holds the value of the "replacement" field.
+	Replacement string `json:"replacement,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PronunciationQuery when eager-loading is set.
+	Edges        PronunciationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PronunciationEdges holds the relations/edges for other nodes in the graph.
+type PronunciationEdges struct {
+	// Guild holds the value of the guild edge.
+	Guild *Guild `json:"guild,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GuildOrErr returns the Guild value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PronunciationEdges) GuildOrErr() (*Guild, error) {
+	if e.Guild != nil {
+		return e.Guild, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: guild.Label}
+	}
+	return nil, &NotLoadedError{edge: "guild"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Pronunciation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pronunciation.FieldID, pronunciation.FieldGuildID:
+			values[i] = new(sql.NullInt64)
+		case pronunciation.FieldWord, pronunciation.FieldReplacement:
+			values[i] = new(sql.NullString)
+		case pronunciation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Pronunciation fields.
+func (pr *Pronunciation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pronunciation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case pronunciation.FieldGuildID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				pr.GuildID = snowflake.ID(value.Int64)
+			}
+		case pronunciation.FieldWord:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field word", values[i])
+			} else if value.Valid {
+				pr.Word = value.String
+			}
+		case pronunciation.FieldReplacement:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field replacement", values[i])
+			} else if value.Valid {
+				pr.Replacement = value.String
+			}
+		case pronunciation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Pronunciation.
+// This includes values selected through modifiers, order, etc.
+func (pr *Pronunciation) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryGuild queries the "guild" edge of the Pronunciation entity.
+func (pr *Pronunciation) QueryGuild() *GuildQuery {
+	return NewPronunciationClient(pr.config).QueryGuild(pr)
+}
+
+// Update returns a builder for updating this Pronunciation.
+// Note that you need to call Pronunciation.Unwrap() before calling this method if this Pronunciation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Pronunciation) Update() *PronunciationUpdateOne {
+	return NewPronunciationClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the Pronunciation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *Pronunciation) Unwrap() *Pronunciation {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Pronunciation is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Pronunciation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Pronunciation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.GuildID))
+	builder.WriteString(", ")
+	builder.WriteString("word=")
+	builder.WriteString(pr.Word)
+	builder.WriteString(", ")
+	builder.WriteString("replacement=")
+	builder.WriteString(pr.Replacement)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Pronunciations is a parsable slice of Pronunciation.
+type Pronunciations []*Pronunciation
@@ -0,0 +1,326 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pronunciation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldLTE(FieldID, id))
+}
+
+// GuildID applies equality check predicate on the "guild_id" field. It's identical to GuildIDEQ.
+func GuildID(v snowflake.ID) predicate.Pronunciation {
+	vc := uint64(v)
+	return predicate.Pronunciation(sql.FieldEQ(FieldGuildID, vc))
+}
+
+// Word applies equality check predicate on the "word" field. It's identical to WordEQ.
+func Word(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldEQ(FieldWord, v))
+}
+
+// Replacement applies equality check predicate on the "replacement" field. It's identical to ReplacementEQ.
+func Replacement(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldEQ(FieldReplacement, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// GuildIDEQ applies the EQ predicate on the "guild_id" field.
+func GuildIDEQ(v snowflake.ID) predicate.Pronunciation {
+	vc := uint64(v)
+	return predicate.Pronunciation(sql.FieldEQ(FieldGuildID, vc))
+}
+
+// GuildIDNEQ applies the NEQ predicate on the "guild_id" field.
+func GuildIDNEQ(v snowflake.ID) predicate.Pronunciation {
+	vc := uint64(v)
+	return predicate.Pronunciation(sql.FieldNEQ(FieldGuildID, vc))
+}
+
+// GuildIDIn applies the In predicate on the "guild_id" field.
+func GuildIDIn(vs ...snowflake.ID) predicate.Pronunciation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint64(vs[i])
+	}
+	return predicate.Pronunciation(sql.FieldIn(FieldGuildID, v...))
+}
+
+// GuildIDNotIn applies the NotIn predicate on the "guild_id" field.
+func GuildIDNotIn(vs ...snowflake.ID) predicate.Pronunciation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint64(vs[i])
+	}
+	return predicate.Pronunciation(sql.FieldNotIn(FieldGuildID, v...))
+}
+
+// WordEQ applies the EQ predicate on the "word" field.
+func WordEQ(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldEQ(FieldWord, v))
+}
+
+// WordNEQ applies the NEQ predicate on the "word" field.
+func WordNEQ(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldNEQ(FieldWord, v))
+}
+
+// WordIn applies the In predicate on the "word" field.
+func WordIn(vs ...string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldIn(FieldWord, vs...))
+}
+
+// WordNotIn applies the NotIn predicate on the "word" field.
+func WordNotIn(vs ...string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldNotIn(FieldWord, vs...))
+}
+
+// WordGT applies the GT predicate on the "word" field.
+func WordGT(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldGT(FieldWord, v))
+}
+
+// WordGTE applies the GTE predicate on the "word" field.
+func WordGTE(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldGTE(FieldWord, v))
+}
+
+// WordLT applies the LT predicate on the "word" field.
+func WordLT(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldLT(FieldWord, v))
+}
+
+// WordLTE applies the LTE predicate on the "word" field.
+func WordLTE(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldLTE(FieldWord, v))
+}
+
+// WordContains applies the Contains predicate on the "word" field.
+func WordContains(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldContains(FieldWord, v))
+}
+
+// WordHasPrefix applies the HasPrefix predicate on the "word" field.
+func WordHasPrefix(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldHasPrefix(FieldWord, v))
+}
+
+// WordHasSuffix applies the HasSuffix predicate on the "word" field.
+func WordHasSuffix(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldHasSuffix(FieldWord, v))
+}
+
+// WordEqualFold applies the EqualFold predicate on the "word" field.
+func WordEqualFold(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldEqualFold(FieldWord, v))
+}
+
+// WordContainsFold applies the ContainsFold predicate on the "word" field.
+func WordContainsFold(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldContainsFold(FieldWord, v))
+}
+
+// ReplacementEQ applies the EQ predicate on the "replacement" field.
+func ReplacementEQ(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldEQ(FieldReplacement, v))
+}
+
+// ReplacementNEQ applies the NEQ predicate on the "replacement" field.
+func ReplacementNEQ(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldNEQ(FieldReplacement, v))
+}
+
+// ReplacementIn applies the In predicate on the "replacement" field.
+func ReplacementIn(vs ...string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldIn(FieldReplacement, vs...))
+}
+
+// ReplacementNotIn applies the NotIn predicate on the "replacement" field.
+func ReplacementNotIn(vs ...string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldNotIn(FieldReplacement, vs...))
+}
+
+// ReplacementGT applies the GT predicate on the "replacement" field.
+func ReplacementGT(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldGT(FieldReplacement, v))
+}
+
+// ReplacementGTE applies the GTE predicate on the "replacement" field.
+func ReplacementGTE(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldGTE(FieldReplacement, v))
+}
+
+// ReplacementLT applies the LT predicate on the "replacement" field.
+func ReplacementLT(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldLT(FieldReplacement, v))
+}
+
+// ReplacementLTE applies the LTE predicate on the "replacement" field.
+func ReplacementLTE(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldLTE(FieldReplacement, v))
+}
+
+// ReplacementContains applies the Contains predicate on the "replacement" field.
+func ReplacementContains(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldContains(FieldReplacement, v))
+}
+
+// ReplacementHasPrefix applies the HasPrefix predicate on the "replacement" field.
+func ReplacementHasPrefix(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldHasPrefix(FieldReplacement, v))
+}
+
+// ReplacementHasSuffix applies the HasSuffix predicate on the "replacement" field.
+func ReplacementHasSuffix(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldHasSuffix(FieldReplacement, v))
+}
+
+// ReplacementEqualFold applies the EqualFold predicate on the "replacement" field.
+func ReplacementEqualFold(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldEqualFold(FieldReplacement, v))
+}
+
+// ReplacementContainsFold applies the ContainsFold predicate on the "replacement" field.
+func ReplacementContainsFold(v string) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldContainsFold(FieldReplacement, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.Pronunciation {
+	return predicate.Pronunciation(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// HasGuild applies the HasEdge predicate on the "guild" edge.
+func HasGuild() predicate.Pronunciation {
+	return predicate.Pronunciation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GuildTable, GuildColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGuildWith applies the HasEdge predicate on the "guild" edge with a given conditions (other predicates).
+func HasGuildWith(preds ...predicate.Guild) predicate.Pronunciation {
+	return predicate.Pronunciation(func(s *sql.Selector) {
+		step := newGuildStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Pronunciation) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Pronunciation) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Pronunciation) predicate.Pronunciation {
+	return predicate.Pronunciation(sql.NotPredicates(p))
+}
@@ -0,0 +1,677 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/pronunciation"
+)
+
+// PronunciationCreate is the builder for creating a Pronunciation entity.
+type PronunciationCreate struct {
+	config
+	mutation *PronunciationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGuildID sets the "guild_id" field.
+func (pc *PronunciationCreate) SetGuildID(s snowflake.ID) *PronunciationCreate {
+	pc.mutation.SetGuildID(s)
+	return pc
+}
+
+// SetWord sets the "word" field.
+func (pc *PronunciationCreate) SetWord(s string) *PronunciationCreate {
+	pc.mutation.SetWord(s)
+	return pc
+}
+
+// SetReplacement sets the "replacement" field.
+func (pc *PronunciationCreate) SetReplacement(s string) *PronunciationCreate {
+	pc.mutation.SetReplacement(s)
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PronunciationCreate) SetCreatedAt(t time.Time) *PronunciationCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PronunciationCreate) SetNillableCreatedAt(t *time.Time) *PronunciationCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (pc *PronunciationCreate) SetGuild(g *Guild) *PronunciationCreate {
+	return pc.SetGuildID(g.ID)
+}
+
+// Mutation returns the PronunciationMutation object of the builder.
+func (pc *PronunciationCreate) Mutation() *PronunciationMutation {
+	return pc.mutation
+}
+
+// Save creates the Pronunciation in the database.
+func (pc *PronunciationCreate) Save(ctx context.Context) (*Pronunciation, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PronunciationCreate) SaveX(ctx context.Context) *Pronunciation {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PronunciationCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PronunciationCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PronunciationCreate) defaults() {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := pronunciation.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PronunciationCreate) check() error {
+	if _, ok := pc.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "Pronunciation.guild_id"`)}
+	}
+	if _, ok := pc.mutation.Word(); !ok {
+		return &ValidationError{Name: "word", err: errors.New(`ent: missing required field "Pronunciation.word"`)}
+	}
+	if v, ok := pc.mutation.Word(); ok {
+		if err := pronunciation.WordValidator(v); err != nil {
+			return &ValidationError{Name: "word", err: fmt.Errorf(`ent: validator failed for field "Pronunciation.word": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Replacement(); !ok {
+		return &ValidationError{Name: "replacement", err: errors.New(`ent: missing required field "Pronunciation.replacement"`)}
+	}
+	if len(pc.mutation.GuildIDs()) == 0 {
+		return &ValidationError{Name: "guild", err: errors.New(`ent: missing required edge "Pronunciation.guild"`)}
+	}
+	return nil
+}
+
+func (pc *PronunciationCreate) sqlSave(ctx context.Context) (*Pronunciation, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PronunciationCreate) createSpec() (*Pronunciation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Pronunciation{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(pronunciation.Table, sqlgraph.NewFieldSpec(pronunciation.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = pc.conflict
+	if value, ok := pc.mutation.Word(); ok {
+		_spec.SetField(pronunciation.FieldWord, field.TypeString, value)
+		_node.Word = value
+	}
+	if value, ok := pc.mutation.Replacement(); ok {
+		_spec.SetField(pronunciation.FieldReplacement, field.TypeString, value)
+		_node.Replacement = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(pronunciation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pc.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pronunciation.GuildTable,
+			Columns: []string{pronunciation.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GuildID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Pronunciation.Create().
+//		SetGuildID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PronunciationUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *PronunciationCreate) OnConflict(opts ...sql.ConflictOption) *PronunciationUpsertOne {
+	pc.conflict = opts
+	return &PronunciationUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Pronunciation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *PronunciationCreate) OnConflictColumns(columns ...string) *PronunciationUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PronunciationUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PronunciationUpsertOne is the builder for "upsert"-ing
+	//  one Pronunciation node.
+	PronunciationUpsertOne struct {
+		create *PronunciationCreate
+	}
+
+	// PronunciationUpsert is the "OnConflict" setter.
+	PronunciationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGuildID sets the "guild_id" field.
+func (u *PronunciationUpsert) SetGuildID(v snowflake.ID) *PronunciationUpsert {
+	u.Set(pronunciation.FieldGuildID, v)
+	return u
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *PronunciationUpsert) UpdateGuildID() *PronunciationUpsert {
+	u.SetExcluded(pronunciation.FieldGuildID)
+	return u
+}
+
+// SetWord sets the "word" field.
+func (u *PronunciationUpsert) SetWord(v string) *PronunciationUpsert {
+	u.Set(pronunciation.FieldWord, v)
+	return u
+}
+
+// UpdateWord sets the "word" field to the value that was provided on create.
+func (u *PronunciationUpsert) UpdateWord() *PronunciationUpsert {
+	u.SetExcluded(pronunciation.FieldWord)
+	return u
+}
+
+// SetReplacement sets the "replacement" field.
+func (u *PronunciationUpsert) SetReplacement(v string) *PronunciationUpsert {
+	u.Set(pronunciation.FieldReplacement, v)
+	return u
+}
+
+// UpdateReplacement sets the "replacement" field to the value that was provided on create.
+func (u *PronunciationUpsert) UpdateReplacement() *PronunciationUpsert {
+	u.SetExcluded(pronunciation.FieldReplacement)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PronunciationUpsert) SetCreatedAt(v time.Time) *PronunciationUpsert {
+	u.Set(pronunciation.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PronunciationUpsert) UpdateCreatedAt() *PronunciationUpsert {
+	u.SetExcluded(pronunciation.FieldCreatedAt)
+	return u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *PronunciationUpsert) ClearCreatedAt() *PronunciationUpsert {
+	u.SetNull(pronunciation.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Pronunciation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PronunciationUpsertOne) UpdateNewValues() *PronunciationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Pronunciation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PronunciationUpsertOne) Ignore() *PronunciationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PronunciationUpsertOne) DoNothing() *PronunciationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PronunciationCreate.OnConflict
+// documentation for more info.
+func (u *PronunciationUpsertOne) Update(set func(*PronunciationUpsert)) *PronunciationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PronunciationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGuildID sets the "guild_id" field.
+func (u *PronunciationUpsertOne) SetGuildID(v snowflake.ID) *PronunciationUpsertOne {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.SetGuildID(v)
+	})
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *PronunciationUpsertOne) UpdateGuildID() *PronunciationUpsertOne {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.UpdateGuildID()
+	})
+}
+
+// SetWord sets the "word" field.
+func (u *PronunciationUpsertOne) SetWord(v string) *PronunciationUpsertOne {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.SetWord(v)
+	})
+}
+
+// UpdateWord sets the "word" field to the value that was provided on create.
+func (u *PronunciationUpsertOne) UpdateWord() *PronunciationUpsertOne {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.UpdateWord()
+	})
+}
+
+// SetReplacement sets the "replacement" field.
+func (u *PronunciationUpsertOne) SetReplacement(v string) *PronunciationUpsertOne {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.SetReplacement(v)
+	})
+}
+
+// UpdateReplacement sets the "replacement" field to the value that was provided on create.
+func (u *PronunciationUpsertOne) UpdateReplacement() *PronunciationUpsertOne {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.UpdateReplacement()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PronunciationUpsertOne) SetCreatedAt(v time.Time) *PronunciationUpsertOne {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PronunciationUpsertOne) UpdateCreatedAt() *PronunciationUpsertOne {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *PronunciationUpsertOne) ClearCreatedAt() *PronunciationUpsertOne {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PronunciationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PronunciationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PronunciationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PronunciationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PronunciationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PronunciationCreateBulk is the builder for creating many Pronunciation entities in bulk.
+type PronunciationCreateBulk struct {
+	config
+	err      error
+	builders []*PronunciationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Pronunciation entities in the database.
+func (pcb *PronunciationCreateBulk) Save(ctx context.Context) ([]*Pronunciation, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Pronunciation, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PronunciationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PronunciationCreateBulk) SaveX(ctx context.Context) []*Pronunciation {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PronunciationCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PronunciationCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Pronunciation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PronunciationUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *PronunciationCreateBulk) OnConflict(opts ...sql.ConflictOption) *PronunciationUpsertBulk {
+	pcb.conflict = opts
+	return &PronunciationUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Pronunciation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *PronunciationCreateBulk) OnConflictColumns(columns ...string) *PronunciationUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PronunciationUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PronunciationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Pronunciation nodes.
+type PronunciationUpsertBulk struct {
+	create *PronunciationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Pronunciation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PronunciationUpsertBulk) UpdateNewValues() *PronunciationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Pronunciation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PronunciationUpsertBulk) Ignore() *PronunciationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PronunciationUpsertBulk) DoNothing() *PronunciationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PronunciationCreateBulk.OnConflict
+// documentation for more info.
+func (u *PronunciationUpsertBulk) Update(set func(*PronunciationUpsert)) *PronunciationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PronunciationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGuildID sets the "guild_id" field.
+func (u *PronunciationUpsertBulk) SetGuildID(v snowflake.ID) *PronunciationUpsertBulk {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.SetGuildID(v)
+	})
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *PronunciationUpsertBulk) UpdateGuildID() *PronunciationUpsertBulk {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.UpdateGuildID()
+	})
+}
+
+// SetWord sets the "word" field.
+func (u *PronunciationUpsertBulk) SetWord(v string) *PronunciationUpsertBulk {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.SetWord(v)
+	})
+}
+
+// UpdateWord sets the "word" field to the value that was provided on create.
+func (u *PronunciationUpsertBulk) UpdateWord() *PronunciationUpsertBulk {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.UpdateWord()
+	})
+}
+
+// SetReplacement sets the "replacement" field.
+func (u *PronunciationUpsertBulk) SetReplacement(v string) *PronunciationUpsertBulk {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.SetReplacement(v)
+	})
+}
+
+// UpdateReplacement sets the "replacement" field to the value that was provided on create.
+func (u *PronunciationUpsertBulk) UpdateReplacement() *PronunciationUpsertBulk {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.UpdateReplacement()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PronunciationUpsertBulk) SetCreatedAt(v time.Time) *PronunciationUpsertBulk {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PronunciationUpsertBulk) UpdateCreatedAt() *PronunciationUpsertBulk {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *PronunciationUpsertBulk) ClearCreatedAt() *PronunciationUpsertBulk {
+	return u.Update(func(s *PronunciationUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PronunciationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PronunciationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PronunciationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PronunciationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
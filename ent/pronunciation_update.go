@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+	"github.com/loukhin/probably-a-music-bot/ent/pronunciation"
+)
+
+// PronunciationUpdate is the builder for updating Pronunciation entities.
+type PronunciationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PronunciationMutation
+}
+
+// Where appends a list predicates to the PronunciationUpdate builder.
+func (pu *PronunciationUpdate) Where(ps ...predicate.Pronunciation) *PronunciationUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetGuildID sets the "guild_id" field.
+func (pu *PronunciationUpdate) SetGuildID(s snowflake.ID) *PronunciationUpdate {
+	pu.mutation.SetGuildID(s)
+	return pu
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (pu *PronunciationUpdate) SetNillableGuildID(s *snowflake.ID) *PronunciationUpdate {
+	if s != nil {
+		pu.SetGuildID(*s)
+	}
+	return pu
+}
+
+// SetWord sets the "word" field.
+func (pu *PronunciationUpdate) SetWord(s string) *PronunciationUpdate {
+	pu.mutation.SetWord(s)
+	return pu
+}
+
+// SetNillableWord sets the "word" field if the given value is not nil.
+func (pu *PronunciationUpdate) SetNillableWord(s *string) *PronunciationUpdate {
+	if s != nil {
+		pu.SetWord(*s)
+	}
+	return pu
+}
+
+// SetReplacement sets the "replacement" field.
+func (pu *PronunciationUpdate) SetReplacement(s string) *PronunciationUpdate {
+	pu.mutation.SetReplacement(s)
+	return pu
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (pu *PronunciationUpdate) SetNillableReplacement(s *string) *PronunciationUpdate {
+	if s != nil {
+		pu.SetReplacement(*s)
+	}
+	return pu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pu *PronunciationUpdate) SetCreatedAt(t time.Time) *PronunciationUpdate {
+	pu.mutation.SetCreatedAt(t)
+	return pu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pu *PronunciationUpdate) SetNillableCreatedAt(t *time.Time) *PronunciationUpdate {
+	if t != nil {
+		pu.SetCreatedAt(*t)
+	}
+	return pu
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (pu *PronunciationUpdate) ClearCreatedAt() *PronunciationUpdate {
+	pu.mutation.ClearCreatedAt()
+	return pu
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (pu *PronunciationUpdate) SetGuild(g *Guild) *PronunciationUpdate {
+	return pu.SetGuildID(g.ID)
+}
+
+// Mutation returns the PronunciationMutation object of the builder.
+func (pu *PronunciationUpdate) Mutation() *PronunciationMutation {
+	return pu.mutation
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (pu *PronunciationUpdate) ClearGuild() *PronunciationUpdate {
+	pu.mutation.ClearGuild()
+	return pu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PronunciationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PronunciationUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PronunciationUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PronunciationUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PronunciationUpdate) check() error {
+	if v, ok := pu.mutation.Word(); ok {
+		if err := pronunciation.WordValidator(v); err != nil {
+			return &ValidationError{Name: "word", err: fmt.Errorf(`ent: validator failed for field "Pronunciation.word": %w`, err)}
+		}
+	}
+	if pu.mutation.GuildCleared() && len(pu.mutation.GuildIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Pronunciation.guild"`)
+	}
+	return nil
+}
+
+func (pu *PronunciationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pronunciation.Table, pronunciation.Columns, sqlgraph.NewFieldSpec(pronunciation.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Word(); ok {
+		_spec.SetField(pronunciation.FieldWord, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Replacement(); ok {
+		_spec.SetField(pronunciation.FieldReplacement, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.CreatedAt(); ok {
+		_spec.SetField(pronunciation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.CreatedAtCleared() {
+		_spec.ClearField(pronunciation.FieldCreatedAt, field.TypeTime)
+	}
+	if pu.mutation.GuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pronunciation.GuildTable,
+			Columns: []string{pronunciation.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pronunciation.GuildTable,
+			Columns: []string{pronunciation.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pronunciation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PronunciationUpdateOne is the builder for updating a single Pronunciation entity.
+type PronunciationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PronunciationMutation
+}
+
+// SetGuildID sets the "guild_id" field.
+func (puo *PronunciationUpdateOne) SetGuildID(s snowflake.ID) *PronunciationUpdateOne {
+	puo.mutation.SetGuildID(s)
+	return puo
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (puo *PronunciationUpdateOne) SetNillableGuildID(s *snowflake.ID) *PronunciationUpdateOne {
+	if s != nil {
+		puo.SetGuildID(*s)
+	}
+	return puo
+}
+
+// SetWord sets the "word" field.
+func (puo *PronunciationUpdateOne) SetWord(s string) *PronunciationUpdateOne {
+	puo.mutation.SetWord(s)
+	return puo
+}
+
+// SetNillableWord sets the "word" field if the given value is not nil.
+func (puo *PronunciationUpdateOne) SetNillableWord(s *string) *PronunciationUpdateOne {
+	if s != nil {
+		puo.SetWord(*s)
+	}
+	return puo
+}
+
+// SetReplacement sets the "replacement" field.
+func (puo *PronunciationUpdateOne) SetReplacement(s string) *PronunciationUpdateOne {
+	puo.mutation.SetReplacement(s)
+	return puo
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (puo *PronunciationUpdateOne) SetNillableReplacement(s *string) *PronunciationUpdateOne {
+	if s != nil {
+		puo.SetReplacement(*s)
+	}
+	return puo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (puo *PronunciationUpdateOne) SetCreatedAt(t time.Time) *PronunciationUpdateOne {
+	puo.mutation.SetCreatedAt(t)
+	return puo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (puo *PronunciationUpdateOne) SetNillableCreatedAt(t *time.Time) *PronunciationUpdateOne {
+	if t != nil {
+		puo.SetCreatedAt(*t)
+	}
+	return puo
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (puo *PronunciationUpdateOne) ClearCreatedAt() *PronunciationUpdateOne {
+	puo.mutation.ClearCreatedAt()
+	return puo
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (puo *PronunciationUpdateOne) SetGuild(g *Guild) *PronunciationUpdateOne {
+	return puo.SetGuildID(g.ID)
+}
+
+// Mutation returns the PronunciationMutation object of the builder.
+func (puo *PronunciationUpdateOne) Mutation() *PronunciationMutation {
+	return puo.mutation
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (puo *PronunciationUpdateOne) ClearGuild() *PronunciationUpdateOne {
+	puo.mutation.ClearGuild()
+	return puo
+}
+
+// Where appends a list predicates to the PronunciationUpdate builder.
+func (puo *PronunciationUpdateOne) Where(ps ...predicate.Pronunciation) *PronunciationUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PronunciationUpdateOne) Select(field string, fields ...string) *PronunciationUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Pronunciation entity.
+func (puo *PronunciationUpdateOne) Save(ctx context.Context) (*Pronunciation, error) {
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PronunciationUpdateOne) SaveX(ctx context.Context) *Pronunciation {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PronunciationUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PronunciationUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PronunciationUpdateOne) check() error {
+	if v, ok := puo.mutation.Word(); ok {
+		if err := pronunciation.WordValidator(v); err != nil {
+			return &ValidationError{Name: "word", err: fmt.Errorf(`ent: validator failed for field "Pronunciation.word": %w`, err)}
+		}
+	}
+	if puo.mutation.GuildCleared() && len(puo.mutation.GuildIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Pronunciation.guild"`)
+	}
+	return nil
+}
+
+func (puo *PronunciationUpdateOne) sqlSave(ctx context.Context) (_node *Pronunciation, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pronunciation.Table, pronunciation.Columns, sqlgraph.NewFieldSpec(pronunciation.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Pronunciation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pronunciation.FieldID)
+		for _, f := range fields {
+			if !pronunciation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pronunciation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Word(); ok {
+		_spec.SetField(pronunciation.FieldWord, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Replacement(); ok {
+		_spec.SetField(pronunciation.FieldReplacement, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.CreatedAt(); ok {
+		_spec.SetField(pronunciation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.CreatedAtCleared() {
+		_spec.ClearField(pronunciation.FieldCreatedAt, field.TypeTime)
+	}
+	if puo.mutation.GuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pronunciation.GuildTable,
+			Columns: []string{pronunciation.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pronunciation.GuildTable,
+			Columns: []string{pronunciation.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Pronunciation{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pronunciation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
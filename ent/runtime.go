@@ -5,24 +5,235 @@ package ent
 import (
 	"time"
 
+	"github.com/loukhin/probably-a-music-bot/ent/cheersound"
 	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/member"
+	"github.com/loukhin/probably-a-music-bot/ent/playlist"
+	"github.com/loukhin/probably-a-music-bot/ent/pronunciation"
 	"github.com/loukhin/probably-a-music-bot/ent/schema"
+	"github.com/loukhin/probably-a-music-bot/ent/trackplay"
+	"github.com/loukhin/probably-a-music-bot/ent/webhookdelivery"
+	"github.com/loukhin/probably-a-music-bot/ent/webhooksubscription"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	cheersoundFields := schema.CheerSound{}.Fields()
+	_ = cheersoundFields
+	// cheersoundDescTier is the schema descriptor for tier field.
+	cheersoundDescTier := cheersoundFields[1].Descriptor()
+	// cheersound.TierValidator is a validator for the "tier" field. It is called by the builders before save.
+	cheersound.TierValidator = cheersoundDescTier.Validators[0].(func(int) error)
+	// cheersoundDescData is the schema descriptor for data field.
+	cheersoundDescData := cheersoundFields[4].Descriptor()
+	// cheersound.DataValidator is a validator for the "data" field. It is called by the builders before save.
+	cheersound.DataValidator = cheersoundDescData.Validators[0].(func([]byte) error)
+	// cheersoundDescCreatedAt is the schema descriptor for created_at field.
+	cheersoundDescCreatedAt := cheersoundFields[5].Descriptor()
+	// cheersound.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cheersound.DefaultCreatedAt = cheersoundDescCreatedAt.Default.(func() time.Time)
+	// cheersoundDescUpdatedAt is the schema descriptor for updated_at field.
+	cheersoundDescUpdatedAt := cheersoundFields[6].Descriptor()
+	// cheersound.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	cheersound.DefaultUpdatedAt = cheersoundDescUpdatedAt.Default.(func() time.Time)
+	// cheersound.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	cheersound.UpdateDefaultUpdatedAt = cheersoundDescUpdatedAt.UpdateDefault.(func() time.Time)
 	guildFields := schema.Guild{}.Fields()
 	_ = guildFields
+	// guildDescIdleTimeout is the schema descriptor for idle_timeout field.
+	guildDescIdleTimeout := guildFields[4].Descriptor()
+	// guild.DefaultIdleTimeout holds the default value on creation for the idle_timeout field.
+	guild.DefaultIdleTimeout = guildDescIdleTimeout.Default.(int)
+	// guild.IdleTimeoutValidator is a validator for the "idle_timeout" field. It is called by the builders before save.
+	guild.IdleTimeoutValidator = guildDescIdleTimeout.Validators[0].(func(int) error)
+	// guildDescAloneTimeout is the schema descriptor for alone_timeout field.
+	guildDescAloneTimeout := guildFields[5].Descriptor()
+	// guild.DefaultAloneTimeout holds the default value on creation for the alone_timeout field.
+	guild.DefaultAloneTimeout = guildDescAloneTimeout.Default.(int)
+	// guild.AloneTimeoutValidator is a validator for the "alone_timeout" field. It is called by the builders before save.
+	guild.AloneTimeoutValidator = guildDescAloneTimeout.Validators[0].(func(int) error)
+	// guildDescAutoplay is the schema descriptor for autoplay field.
+	guildDescAutoplay := guildFields[6].Descriptor()
+	// guild.DefaultAutoplay holds the default value on creation for the autoplay field.
+	guild.DefaultAutoplay = guildDescAutoplay.Default.(bool)
+	// guildDescAlwaysOn is the schema descriptor for always_on field.
+	guildDescAlwaysOn := guildFields[7].Descriptor()
+	// guild.DefaultAlwaysOn holds the default value on creation for the always_on field.
+	guild.DefaultAlwaysOn = guildDescAlwaysOn.Default.(bool)
+	// guildDescTtsProvider is the schema descriptor for tts_provider field.
+	guildDescTtsProvider := guildFields[10].Descriptor()
+	// guild.DefaultTtsProvider holds the default value on creation for the tts_provider field.
+	guild.DefaultTtsProvider = guildDescTtsProvider.Default.(string)
+	// guildDescTtsLanguage is the schema descriptor for tts_language field.
+	guildDescTtsLanguage := guildFields[11].Descriptor()
+	// guild.DefaultTtsLanguage holds the default value on creation for the tts_language field.
+	guild.DefaultTtsLanguage = guildDescTtsLanguage.Default.(string)
+	// guildDescTtsVoice is the schema descriptor for tts_voice field.
+	guildDescTtsVoice := guildFields[12].Descriptor()
+	// guild.DefaultTtsVoice holds the default value on creation for the tts_voice field.
+	guild.DefaultTtsVoice = guildDescTtsVoice.Default.(string)
+	// guildDescTtsRate is the schema descriptor for tts_rate field.
+	guildDescTtsRate := guildFields[13].Descriptor()
+	// guild.DefaultTtsRate holds the default value on creation for the tts_rate field.
+	guild.DefaultTtsRate = guildDescTtsRate.Default.(float64)
+	// guild.TtsRateValidator is a validator for the "tts_rate" field. It is called by the builders before save.
+	guild.TtsRateValidator = func() func(float64) error {
+		validators := guildDescTtsRate.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(tts_rate float64) error {
+			for _, fn := range fns {
+				if err := fn(tts_rate); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// guildDescTtsPitch is the schema descriptor for tts_pitch field.
+	guildDescTtsPitch := guildFields[14].Descriptor()
+	// guild.DefaultTtsPitch holds the default value on creation for the tts_pitch field.
+	guild.DefaultTtsPitch = guildDescTtsPitch.Default.(float64)
+	// guild.TtsPitchValidator is a validator for the "tts_pitch" field. It is called by the builders before save.
+	guild.TtsPitchValidator = func() func(float64) error {
+		validators := guildDescTtsPitch.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(tts_pitch float64) error {
+			for _, fn := range fns {
+				if err := fn(tts_pitch); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// guildDescSleepEndOfTrack is the schema descriptor for sleep_end_of_track field.
+	guildDescSleepEndOfTrack := guildFields[19].Descriptor()
+	// guild.DefaultSleepEndOfTrack holds the default value on creation for the sleep_end_of_track field.
+	guild.DefaultSleepEndOfTrack = guildDescSleepEndOfTrack.Default.(bool)
 	// guildDescCreatedAt is the schema descriptor for created_at field.
-	guildDescCreatedAt := guildFields[4].Descriptor()
+	guildDescCreatedAt := guildFields[20].Descriptor()
 	// guild.DefaultCreatedAt holds the default value on creation for the created_at field.
 	guild.DefaultCreatedAt = guildDescCreatedAt.Default.(func() time.Time)
 	// guildDescUpdatedAt is the schema descriptor for updated_at field.
-	guildDescUpdatedAt := guildFields[5].Descriptor()
+	guildDescUpdatedAt := guildFields[21].Descriptor()
 	// guild.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	guild.DefaultUpdatedAt = guildDescUpdatedAt.Default.(func() time.Time)
 	// guild.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	guild.UpdateDefaultUpdatedAt = guildDescUpdatedAt.UpdateDefault.(func() time.Time)
+	memberFields := schema.Member{}.Fields()
+	_ = memberFields
+	// memberDescTtsReaderOptOut is the schema descriptor for tts_reader_opt_out field.
+	memberDescTtsReaderOptOut := memberFields[6].Descriptor()
+	// member.DefaultTtsReaderOptOut holds the default value on creation for the tts_reader_opt_out field.
+	member.DefaultTtsReaderOptOut = memberDescTtsReaderOptOut.Default.(bool)
+	// memberDescBitsDonated is the schema descriptor for bits_donated field.
+	memberDescBitsDonated := memberFields[7].Descriptor()
+	// member.DefaultBitsDonated holds the default value on creation for the bits_donated field.
+	member.DefaultBitsDonated = memberDescBitsDonated.Default.(int64)
+	// member.BitsDonatedValidator is a validator for the "bits_donated" field. It is called by the builders before save.
+	member.BitsDonatedValidator = memberDescBitsDonated.Validators[0].(func(int64) error)
+	// memberDescPoints is the schema descriptor for points field.
+	memberDescPoints := memberFields[8].Descriptor()
+	// member.DefaultPoints holds the default value on creation for the points field.
+	member.DefaultPoints = memberDescPoints.Default.(int64)
+	// member.PointsValidator is a validator for the "points" field. It is called by the builders before save.
+	member.PointsValidator = memberDescPoints.Validators[0].(func(int64) error)
+	// memberDescCreatedAt is the schema descriptor for created_at field.
+	memberDescCreatedAt := memberFields[9].Descriptor()
+	// member.DefaultCreatedAt holds the default value on creation for the created_at field.
+	member.DefaultCreatedAt = memberDescCreatedAt.Default.(func() time.Time)
+	// memberDescUpdatedAt is the schema descriptor for updated_at field.
+	memberDescUpdatedAt := memberFields[10].Descriptor()
+	// member.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	member.DefaultUpdatedAt = memberDescUpdatedAt.Default.(func() time.Time)
+	// member.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	member.UpdateDefaultUpdatedAt = memberDescUpdatedAt.UpdateDefault.(func() time.Time)
+	playlistFields := schema.Playlist{}.Fields()
+	_ = playlistFields
+	// playlistDescName is the schema descriptor for name field.
+	playlistDescName := playlistFields[1].Descriptor()
+	// playlist.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	playlist.NameValidator = func() func(string) error {
+		validators := playlistDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// playlistDescCreatedAt is the schema descriptor for created_at field.
+	playlistDescCreatedAt := playlistFields[3].Descriptor()
+	// playlist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	playlist.DefaultCreatedAt = playlistDescCreatedAt.Default.(func() time.Time)
+	// playlistDescUpdatedAt is the schema descriptor for updated_at field.
+	playlistDescUpdatedAt := playlistFields[4].Descriptor()
+	// playlist.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	playlist.DefaultUpdatedAt = playlistDescUpdatedAt.Default.(func() time.Time)
+	// playlist.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	playlist.UpdateDefaultUpdatedAt = playlistDescUpdatedAt.UpdateDefault.(func() time.Time)
+	pronunciationFields := schema.Pronunciation{}.Fields()
+	_ = pronunciationFields
+	// pronunciationDescWord is the schema descriptor for word field.
+	pronunciationDescWord := pronunciationFields[1].Descriptor()
+	// pronunciation.WordValidator is a validator for the "word" field. It is called by the builders before save.
+	pronunciation.WordValidator = pronunciationDescWord.Validators[0].(func(string) error)
+	// pronunciationDescCreatedAt is the schema descriptor for created_at field.
+	pronunciationDescCreatedAt := pronunciationFields[3].Descriptor()
+	// pronunciation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pronunciation.DefaultCreatedAt = pronunciationDescCreatedAt.Default.(func() time.Time)
+	trackplayFields := schema.TrackPlay{}.Fields()
+	_ = trackplayFields
+	// trackplayDescAutoplay is the schema descriptor for autoplay field.
+	trackplayDescAutoplay := trackplayFields[8].Descriptor()
+	// trackplay.DefaultAutoplay holds the default value on creation for the autoplay field.
+	trackplay.DefaultAutoplay = trackplayDescAutoplay.Default.(bool)
+	// trackplayDescPlayedAt is the schema descriptor for played_at field.
+	trackplayDescPlayedAt := trackplayFields[9].Descriptor()
+	// trackplay.DefaultPlayedAt holds the default value on creation for the played_at field.
+	trackplay.DefaultPlayedAt = trackplayDescPlayedAt.Default.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescDelivered is the schema descriptor for delivered field.
+	webhookdeliveryDescDelivered := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultDelivered holds the default value on creation for the delivered field.
+	webhookdelivery.DefaultDelivered = webhookdeliveryDescDelivered.Default.(bool)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhooksubscriptionFields := schema.WebhookSubscription{}.Fields()
+	_ = webhooksubscriptionFields
+	// webhooksubscriptionDescURL is the schema descriptor for url field.
+	webhooksubscriptionDescURL := webhooksubscriptionFields[1].Descriptor()
+	// webhooksubscription.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhooksubscription.URLValidator = webhooksubscriptionDescURL.Validators[0].(func(string) error)
+	// webhooksubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	webhooksubscriptionDescCreatedAt := webhooksubscriptionFields[4].Descriptor()
+	// webhooksubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhooksubscription.DefaultCreatedAt = webhooksubscriptionDescCreatedAt.Default.(func() time.Time)
 }
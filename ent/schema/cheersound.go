@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// CheerSound holds the schema definition for the CheerSound entity, a sound uploaded for a cheer tier of a guild.
+type CheerSound struct {
+	ent.Schema
+}
+
+// Fields of the CheerSound.
+func (CheerSound) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("guild_id").GoType(snowflake.New(time.Now())),
+		field.Int("tier").Positive().Comment("Minimum amount of bits of the tier"),
+		field.String("file_name"),
+		field.String("content_type"),
+		field.Bytes("data").MaxLen(2 << 20),
+		field.Time("created_at").Optional().Default(time.Now),
+		field.Time("updated_at").Optional().Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the CheerSound.
+func (CheerSound) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("guild", Guild.Type).
+			Ref("cheer_sounds").
+			Field("guild_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (CheerSound) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guild_id", "tier").
+			Unique(),
+	}
+}
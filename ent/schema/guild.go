@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/disgoorg/snowflake/v2"
@@ -21,6 +22,22 @@ func (Guild) Fields() []ent.Field {
 		field.String("name"),
 		field.Uint64("player_channel_id").Unique().Optional().Nillable().GoType(snowflake.New(time.Now())),
 		field.Uint64("player_message_id").Unique().Optional().Nillable().GoType(snowflake.New(time.Now())),
+		field.Int("idle_timeout").NonNegative().Default(5).Comment("Minutes to stay connected after the queue ended"),
+		field.Int("alone_timeout").NonNegative().Default(2).Comment("Minutes to stay paused when everyone left the voice channel"),
+		field.Bool("autoplay").Default(false),
+		field.Bool("always_on").Default(false),
+		field.Uint64("always_on_channel_id").Optional().Nillable().GoType(snowflake.New(time.Now())),
+		field.String("fallback_query").Optional(),
+		field.String("tts_provider").Default("lavalink"),
+		field.String("tts_language").Default("th-TH"),
+		field.String("tts_voice").Default("th-TH-Neural2-C"),
+		field.Float("tts_rate").Min(0.25).Max(4).Default(0.8),
+		field.Float("tts_pitch").Min(-20).Max(20).Default(0),
+		field.Uint64("tts_reader_channel_id").Optional().Nillable().GoType(snowflake.New(time.Now())).Comment("Channel whose messages are read aloud"),
+		field.String("overlay_token").Optional().Nillable().Unique().Sensitive(),
+		field.String("api_token").Optional().Nillable().Unique().Sensitive(),
+		field.Time("sleep_at").Optional().Nillable(),
+		field.Bool("sleep_end_of_track").Default(false),
 		field.Time("created_at").Optional().Default(time.Now),
 		field.Time("updated_at").Optional().Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -28,7 +45,14 @@ func (Guild) Fields() []ent.Field {
 
 // Edges of the Guild.
 func (Guild) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("members", Member.Type),
+		edge.To("pronunciations", Pronunciation.Type),
+		edge.To("cheer_sounds", CheerSound.Type),
+		edge.To("webhook_subscriptions", WebhookSubscription.Type),
+		edge.To("track_plays", TrackPlay.Type),
+		edge.To("playlists", Playlist.Type),
+	}
 }
 
 func (Guild) Indexes() []ent.Index {
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// Member holds the schema definition for the Member entity, a user inside a guild.
+type Member struct {
+	ent.Schema
+}
+
+// Fields of the Member.
+func (Member) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("guild_id").GoType(snowflake.New(time.Now())),
+		field.Uint64("user_id").GoType(snowflake.New(time.Now())),
+		field.String("tts_language").Optional().Nillable(),
+		field.String("tts_voice").Optional().Nillable(),
+		field.Float("tts_rate").Optional().Nillable(),
+		field.Float("tts_pitch").Optional().Nillable(),
+		field.Bool("tts_reader_opt_out").Default(false),
+		field.Int64("bits_donated").NonNegative().Default(0),
+		field.Int64("points").NonNegative().Default(0),
+		field.Time("created_at").Optional().Default(time.Now),
+		field.Time("updated_at").Optional().Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Member.
+func (Member) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("guild", Guild.Type).
+			Ref("members").
+			Field("guild_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (Member) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guild_id", "user_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// Playlist holds the schema definition for the Playlist entity, a list of tracks saved by the admins of a guild.
+type Playlist struct {
+	ent.Schema
+}
+
+// Fields of the Playlist.
+func (Playlist) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("guild_id").GoType(snowflake.New(time.Now())),
+		field.String("name").NotEmpty().MaxLen(100),
+		field.Strings("tracks").Comment("Encoded lavalink tracks"),
+		field.Time("created_at").Optional().Default(time.Now),
+		field.Time("updated_at").Optional().Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Playlist.
+func (Playlist) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("guild", Guild.Type).
+			Ref("playlists").
+			Field("guild_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (Playlist) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guild_id", "name").
+			Unique(),
+	}
+}
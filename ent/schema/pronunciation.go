@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// Pronunciation holds the schema definition for the Pronunciation entity, an entry of the TTS dictionary of a guild.
+type Pronunciation struct {
+	ent.Schema
+}
+
+// Fields of the Pronunciation.
+func (Pronunciation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("guild_id").GoType(snowflake.New(time.Now())),
+		field.String("word").NotEmpty(),
+		field.String("replacement"),
+		field.Time("created_at").Optional().Default(time.Now),
+	}
+}
+
+// Edges of the Pronunciation.
+func (Pronunciation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("guild", Guild.Type).
+			Ref("pronunciations").
+			Field("guild_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (Pronunciation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guild_id", "word").
+			Unique(),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// TrackPlay holds the schema definition for the TrackPlay entity, an entry of the play history of a guild.
+type TrackPlay struct {
+	ent.Schema
+}
+
+// Fields of the TrackPlay.
+func (TrackPlay) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("guild_id").GoType(snowflake.New(time.Now())),
+		field.String("identifier"),
+		field.String("title"),
+		field.String("author"),
+		field.String("uri").Optional().Nillable(),
+		field.Text("encoded").Comment("Encoded lavalink track, used to queue the track again"),
+		field.Int64("length").Comment("Length in milliseconds"),
+		field.String("requester").Optional(),
+		field.Bool("autoplay").Default(false),
+		field.Time("played_at").Default(time.Now),
+	}
+}
+
+// Edges of the TrackPlay.
+func (TrackPlay) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("guild", Guild.Type).
+			Ref("track_plays").
+			Field("guild_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (TrackPlay) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guild_id", "played_at"),
+	}
+}
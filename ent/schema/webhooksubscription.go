@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// WebhookSubscription holds the schema definition for the WebhookSubscription entity, an url receiving the player events of a guild.
+type WebhookSubscription struct {
+	ent.Schema
+}
+
+// Fields of the WebhookSubscription.
+func (WebhookSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("guild_id").GoType(snowflake.New(time.Now())),
+		field.String("url").NotEmpty(),
+		field.String("secret").Sensitive(),
+		field.Strings("events").Comment("Subscribed events, empty means every event"),
+		field.Time("created_at").Optional().Default(time.Now),
+	}
+}
+
+// Edges of the WebhookSubscription.
+func (WebhookSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("guild", Guild.Type).
+			Ref("webhook_subscriptions").
+			Field("guild_id").
+			Unique().
+			Required(),
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/trackplay"
+)
+
+// TrackPlay is the model entity for the TrackPlay schema.
+type TrackPlay struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID snowflake.ID `json:"guild_id,omitempty"`
+	// Identifier holds the value of the "identifier" field.
+	Identifier string `json:"identifier,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Author holds the value of the "author" field.
+	Author string `json:"author,omitempty"`
+	// URI holds the value of the "uri" field.
+	URI *string `json:"uri,omitempty"`
+	// Encoded lavalink track, used to queue the track again
+	Encoded string `json:"encoded,omitempty"`
+	// Length in milliseconds
+	Length int64 `json:"length,omitempty"`
+	// Requester holds the value of the "requester" field.
+	Requester string `json:"requester,omitempty"`
+	// Autoplay holds the value of the "autoplay" field.
+	Autoplay bool `json:"autoplay,omitempty"`
+	// PlayedAt holds the value of the "played_at" field.
+	PlayedAt time.Time `json:"played_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TrackPlayQuery when eager-loading is set.
+	Edges        TrackPlayEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TrackPlayEdges holds the relations/edges for other nodes in the graph.
+type TrackPlayEdges struct {
+	// Guild holds the value of the guild edge.
+	Guild *Guild `json:"guild,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GuildOrErr returns the Guild value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TrackPlayEdges) GuildOrErr() (*Guild, error) {
+	if e.Guild != nil {
+		return e.Guild, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: guild.Label}
+	}
+	return nil, &NotLoadedError{edge: "guild"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TrackPlay) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case trackplay.FieldAutoplay:
+			values[i] = new(sql.NullBool)
+		case trackplay.FieldID, trackplay.FieldGuildID, trackplay.FieldLength:
+			values[i] = new(sql.NullInt64)
+		case trackplay.FieldIdentifier, trackplay.FieldTitle, trackplay.FieldAuthor, trackplay.FieldURI, trackplay.FieldEncoded, trackplay.FieldRequester:
+			values[i] = new(sql.NullString)
+		case trackplay.FieldPlayedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TrackPlay fields.
+func (tp *TrackPlay) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case trackplay.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tp.ID = int(value.Int64)
+		case trackplay.FieldGuildID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				tp.GuildID = snowflake.ID(value.Int64)
+			}
+		case trackplay.FieldIdentifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field identifier", values[i])
+			} else if value.Valid {
+				tp.Identifier = value.String
+			}
+		case trackplay.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				tp.Title = value.String
+			}
+		case trackplay.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				tp.Author = value.String
+			}
+		case trackplay.FieldURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uri", values[i])
+			} else if value.Valid {
+				tp.URI = new(string)
+				*tp.URI = value.String
+			}
+		case trackplay.FieldEncoded:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field encoded", values[i])
+			} else if value.Valid {
+				tp.Encoded = value.String
+			}
+		case trackplay.FieldLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field length", values[i])
+			} else if value.Valid {
+				tp.Length = value.Int64
+			}
+		case trackplay.FieldRequester:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requester", values[i])
+			} else if value.Valid {
+				tp.Requester = value.String
+			}
+		case trackplay.FieldAutoplay:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field autoplay", values[i])
+			} else if value.Valid {
+				tp.Autoplay = value.Bool
+			}
+		case trackplay.FieldPlayedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field played_at", values[i])
+			} else if value.Valid {
+				tp.PlayedAt = value.Time
+			}
+		default:
+			tp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TrackPlay.
+// This includes values selected through modifiers, order, etc.
+func (tp *TrackPlay) Value(name string) (ent.Value, error) {
+	return tp.selectValues.Get(name)
+}
+
+// QueryGuild queries the "guild" edge of the TrackPlay entity.
+func (tp *TrackPlay) QueryGuild() *GuildQuery {
+	return NewTrackPlayClient(tp.config).QueryGuild(tp)
+}
+
+// Update returns a builder for updating this TrackPlay.
+// Note that you need to call TrackPlay.Unwrap() before calling this method if this TrackPlay
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tp *TrackPlay) Update() *TrackPlayUpdateOne {
+	return NewTrackPlayClient(tp.config).UpdateOne(tp)
+}
+
+// Unwrap unwraps the TrackPlay entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tp *TrackPlay) Unwrap() *TrackPlay {
+	_tx, ok := tp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TrackPlay is not a transactional entity")
+	}
+	tp.config.driver = _tx.drv
+	return tp
+}
+
+// String implements the fmt.Stringer.
+func (tp *TrackPlay) String() string {
+	var builder strings.Builder
+	builder.WriteString("TrackPlay(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tp.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(fmt.Sprintf("%v", tp.GuildID))
+	builder.WriteString(", ")
+	builder.WriteString("identifier=")
+	builder.WriteString(tp.Identifier)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(tp.Title)
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(tp.Author)
+	builder.WriteString(", ")
+	if v := tp.URI; v != nil {
+		builder.WriteString("uri=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("encoded=")
+	builder.WriteString(tp.Encoded)
+	builder.WriteString(", ")
+	builder.WriteString("length=")
+	builder.WriteString(fmt.Sprintf("%v", tp.Length))
+	builder.WriteString(", ")
+	builder.WriteString("requester=")
+	builder.WriteString(tp.Requester)
+	builder.WriteString(", ")
+	builder.WriteString("autoplay=")
+	builder.WriteString(fmt.Sprintf("%v", tp.Autoplay))
+	builder.WriteString(", ")
+	builder.WriteString("played_at=")
+	builder.WriteString(tp.PlayedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TrackPlays is a parsable slice of TrackPlay.
+type TrackPlays []*TrackPlay
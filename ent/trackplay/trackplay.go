@@ -0,0 +1,152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trackplay
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the trackplay type in the database.
+	Label = "track_play"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGuildID holds the string denoting the guild_id field in the database.
+	FieldGuildID = "guild_id"
+	// FieldIdentifier holds the string denoting the identifier field in the database.
+	FieldIdentifier = "identifier"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldAuthor holds the string denoting the author field in the database.
+	FieldAuthor = "author"
+	// FieldURI holds the string denoting the uri field in the database.
+	FieldURI = "uri"
+	// FieldEncoded holds the string denoting the encoded field in the database.
+	FieldEncoded = "encoded"
+	// FieldLength holds the string denoting the length field in the database.
+	FieldLength = "length"
+	// FieldRequester holds the string denoting the requester field in the database.
+	FieldRequester = "requester"
+	// FieldAutoplay holds the string denoting the autoplay field in the database.
+	FieldAutoplay = "autoplay"
+	// FieldPlayedAt holds the string denoting the played_at field in the database.
+	FieldPlayedAt = "played_at"
+	// EdgeGuild holds the string denoting the guild edge name in mutations.
+	EdgeGuild = "guild"
+	// Table holds the table name of the trackplay in the database.
+	Table = "track_plays"
+	// GuildTable is the table that holds the guild relation/edge.
+	GuildTable = "track_plays"
+	// GuildInverseTable is the table name for the Guild entity.
+	// It exists in this package in order to avoid circular dependency with the "guild" package.
+	GuildInverseTable = "guilds"
+	// GuildColumn is the table column denoting the guild relation/edge.
+	GuildColumn = "guild_id"
+)
+
+// Columns holds all SQL columns for trackplay fields.
+var Columns = []string{
+	FieldID,
+	FieldGuildID,
+	FieldIdentifier,
+	FieldTitle,
+	FieldAuthor,
+	FieldURI,
+	FieldEncoded,
+	FieldLength,
+	FieldRequester,
+	FieldAutoplay,
+	FieldPlayedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAutoplay holds the default value on creation for the "autoplay" field.
+	DefaultAutoplay bool
+	// DefaultPlayedAt holds the default value on creation for the "played_at" field.
+	DefaultPlayedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TrackPlay queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGuildID orders the results by the guild_id field.
+func ByGuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuildID, opts...).ToFunc()
+}
+
+// ByIdentifier orders the results by the identifier field.
+func ByIdentifier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdentifier, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByAuthor orders the results by the author field.
+func ByAuthor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthor, opts...).ToFunc()
+}
+
+// ByURI orders the results by the uri field.
+func ByURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURI, opts...).ToFunc()
+}
+
+// ByEncoded orders the results by the encoded field.
+func ByEncoded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEncoded, opts...).ToFunc()
+}
+
+// ByLength orders the results by the length field.
+func ByLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLength, opts...).ToFunc()
+}
+
+// ByRequester orders the results by the requester field.
+func ByRequester(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequester, opts...).ToFunc()
+}
+
+// ByAutoplay orders the results by the autoplay field.
+func ByAutoplay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoplay, opts...).ToFunc()
+}
+
+// ByPlayedAt orders the results by the played_at field.
+func ByPlayedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayedAt, opts...).ToFunc()
+}
+
+// ByGuildField orders the results by guild field.
+func ByGuildField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGuildStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newGuildStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GuildInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GuildTable, GuildColumn),
+	)
+}
@@ -0,0 +1,676 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trackplay
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLTE(FieldID, id))
+}
+
+// GuildID applies equality check predicate on the "guild_id" field. It's identical to GuildIDEQ.
+func GuildID(v snowflake.ID) predicate.TrackPlay {
+	vc := uint64(v)
+	return predicate.TrackPlay(sql.FieldEQ(FieldGuildID, vc))
+}
+
+// Identifier applies equality check predicate on the "identifier" field. It's identical to IdentifierEQ.
+func Identifier(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldIdentifier, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldTitle, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldAuthor, v))
+}
+
+// URI applies equality check predicate on the "uri" field. It's identical to URIEQ.
+func URI(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldURI, v))
+}
+
+// Encoded applies equality check predicate on the "encoded" field. It's identical to EncodedEQ.
+func Encoded(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldEncoded, v))
+}
+
+// Length applies equality check predicate on the "length" field. It's identical to LengthEQ.
+func Length(v int64) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldLength, v))
+}
+
+// Requester applies equality check predicate on the "requester" field. It's identical to RequesterEQ.
+func Requester(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldRequester, v))
+}
+
+// Autoplay applies equality check predicate on the "autoplay" field. It's identical to AutoplayEQ.
+func Autoplay(v bool) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldAutoplay, v))
+}
+
+// PlayedAt applies equality check predicate on the "played_at" field. It's identical to PlayedAtEQ.
+func PlayedAt(v time.Time) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldPlayedAt, v))
+}
+
+// GuildIDEQ applies the EQ predicate on the "guild_id" field.
+func GuildIDEQ(v snowflake.ID) predicate.TrackPlay {
+	vc := uint64(v)
+	return predicate.TrackPlay(sql.FieldEQ(FieldGuildID, vc))
+}
+
+// GuildIDNEQ applies the NEQ predicate on the "guild_id" field.
+func GuildIDNEQ(v snowflake.ID) predicate.TrackPlay {
+	vc := uint64(v)
+	return predicate.TrackPlay(sql.FieldNEQ(FieldGuildID, vc))
+}
+
+// GuildIDIn applies the In predicate on the "guild_id" field.
+func GuildIDIn(vs ...snowflake.ID) predicate.TrackPlay {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint64(vs[i])
+	}
+	return predicate.TrackPlay(sql.FieldIn(FieldGuildID, v...))
+}
+
+// GuildIDNotIn applies the NotIn predicate on the "guild_id" field.
+func GuildIDNotIn(vs ...snowflake.ID) predicate.TrackPlay {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint64(vs[i])
+	}
+	return predicate.TrackPlay(sql.FieldNotIn(FieldGuildID, v...))
+}
+
+// IdentifierEQ applies the EQ predicate on the "identifier" field.
+func IdentifierEQ(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldIdentifier, v))
+}
+
+// IdentifierNEQ applies the NEQ predicate on the "identifier" field.
+func IdentifierNEQ(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNEQ(FieldIdentifier, v))
+}
+
+// IdentifierIn applies the In predicate on the "identifier" field.
+func IdentifierIn(vs ...string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldIn(FieldIdentifier, vs...))
+}
+
+// IdentifierNotIn applies the NotIn predicate on the "identifier" field.
+func IdentifierNotIn(vs ...string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNotIn(FieldIdentifier, vs...))
+}
+
+// IdentifierGT applies the GT predicate on the "identifier" field.
+func IdentifierGT(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGT(FieldIdentifier, v))
+}
+
+// IdentifierGTE applies the GTE predicate on the "identifier" field.
+func IdentifierGTE(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGTE(FieldIdentifier, v))
+}
+
+// IdentifierLT applies the LT predicate on the "identifier" field.
+func IdentifierLT(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLT(FieldIdentifier, v))
+}
+
+// IdentifierLTE applies the LTE predicate on the "identifier" field.
+func IdentifierLTE(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLTE(FieldIdentifier, v))
+}
+
+// IdentifierContains applies the Contains predicate on the "identifier" field.
+func IdentifierContains(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldContains(FieldIdentifier, v))
+}
+
+// IdentifierHasPrefix applies the HasPrefix predicate on the "identifier" field.
+func IdentifierHasPrefix(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldHasPrefix(FieldIdentifier, v))
+}
+
+// IdentifierHasSuffix applies the HasSuffix predicate on the "identifier" field.
+func IdentifierHasSuffix(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldHasSuffix(FieldIdentifier, v))
+}
+
+// IdentifierEqualFold applies the EqualFold predicate on the "identifier" field.
+func IdentifierEqualFold(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEqualFold(FieldIdentifier, v))
+}
+
+// IdentifierContainsFold applies the ContainsFold predicate on the "identifier" field.
+func IdentifierContainsFold(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldContainsFold(FieldIdentifier, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// URIEQ applies the EQ predicate on the "uri" field.
+func URIEQ(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldURI, v))
+}
+
+// URINEQ applies the NEQ predicate on the "uri" field.
+func URINEQ(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNEQ(FieldURI, v))
+}
+
+// URIIn applies the In predicate on the "uri" field.
+func URIIn(vs ...string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldIn(FieldURI, vs...))
+}
+
+// URINotIn applies the NotIn predicate on the "uri" field.
+func URINotIn(vs ...string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNotIn(FieldURI, vs...))
+}
+
+// URIGT applies the GT predicate on the "uri" field.
+func URIGT(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGT(FieldURI, v))
+}
+
+// URIGTE applies the GTE predicate on the "uri" field.
+func URIGTE(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGTE(FieldURI, v))
+}
+
+// URILT applies the LT predicate on the "uri" field.
+func URILT(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLT(FieldURI, v))
+}
+
+// URILTE applies the LTE predicate on the "uri" field.
+func URILTE(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLTE(FieldURI, v))
+}
+
+// URIContains applies the Contains predicate on the "uri" field.
+func URIContains(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldContains(FieldURI, v))
+}
+
+// URIHasPrefix applies the HasPrefix predicate on the "uri" field.
+func URIHasPrefix(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldHasPrefix(FieldURI, v))
+}
+
+// URIHasSuffix applies the HasSuffix predicate on the "uri" field.
+func URIHasSuffix(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldHasSuffix(FieldURI, v))
+}
+
+// URIIsNil applies the IsNil predicate on the "uri" field.
+func URIIsNil() predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldIsNull(FieldURI))
+}
+
+// URINotNil applies the NotNil predicate on the "uri" field.
+func URINotNil() predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNotNull(FieldURI))
+}
+
+// URIEqualFold applies the EqualFold predicate on the "uri" field.
+func URIEqualFold(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEqualFold(FieldURI, v))
+}
+
+// URIContainsFold applies the ContainsFold predicate on the "uri" field.
+func URIContainsFold(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldContainsFold(FieldURI, v))
+}
+
+// EncodedEQ applies the EQ predicate on the "encoded" field.
+func EncodedEQ(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldEncoded, v))
+}
+
+// EncodedNEQ applies the NEQ predicate on the "encoded" field.
+func EncodedNEQ(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNEQ(FieldEncoded, v))
+}
+
+// EncodedIn applies the In predicate on the "encoded" field.
+func EncodedIn(vs ...string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldIn(FieldEncoded, vs...))
+}
+
+// EncodedNotIn applies the NotIn predicate on the "encoded" field.
+func EncodedNotIn(vs ...string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNotIn(FieldEncoded, vs...))
+}
+
+// EncodedGT applies the GT predicate on the "encoded" field.
+func EncodedGT(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGT(FieldEncoded, v))
+}
+
+// EncodedGTE applies the GTE predicate on the "encoded" field.
+func EncodedGTE(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGTE(FieldEncoded, v))
+}
+
+// EncodedLT applies the LT predicate on the "encoded" field.
+func EncodedLT(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLT(FieldEncoded, v))
+}
+
+// EncodedLTE applies the LTE predicate on the "encoded" field.
+func EncodedLTE(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLTE(FieldEncoded, v))
+}
+
+// EncodedContains applies the Contains predicate on the "encoded" field.
+func EncodedContains(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldContains(FieldEncoded, v))
+}
+
+// EncodedHasPrefix applies the HasPrefix predicate on the "encoded" field.
+func EncodedHasPrefix(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldHasPrefix(FieldEncoded, v))
+}
+
+// EncodedHasSuffix applies the HasSuffix predicate on the "encoded" field.
+func EncodedHasSuffix(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldHasSuffix(FieldEncoded, v))
+}
+
+// EncodedEqualFold applies the EqualFold predicate on the "encoded" field.
+func EncodedEqualFold(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEqualFold(FieldEncoded, v))
+}
+
+// EncodedContainsFold applies the ContainsFold predicate on the "encoded" field.
+func EncodedContainsFold(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldContainsFold(FieldEncoded, v))
+}
+
+// LengthEQ applies the EQ predicate on the "length" field.
+func LengthEQ(v int64) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldLength, v))
+}
+
+// LengthNEQ applies the NEQ predicate on the "length" field.
+func LengthNEQ(v int64) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNEQ(FieldLength, v))
+}
+
+// LengthIn applies the In predicate on the "length" field.
+func LengthIn(vs ...int64) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldIn(FieldLength, vs...))
+}
+
+// LengthNotIn applies the NotIn predicate on the "length" field.
+func LengthNotIn(vs ...int64) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNotIn(FieldLength, vs...))
+}
+
+// LengthGT applies the GT predicate on the "length" field.
+func LengthGT(v int64) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGT(FieldLength, v))
+}
+
+// LengthGTE applies the GTE predicate on the "length" field.
+func LengthGTE(v int64) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGTE(FieldLength, v))
+}
+
+// LengthLT applies the LT predicate on the "length" field.
+func LengthLT(v int64) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLT(FieldLength, v))
+}
+
+// LengthLTE applies the LTE predicate on the "length" field.
+func LengthLTE(v int64) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLTE(FieldLength, v))
+}
+
+// RequesterEQ applies the EQ predicate on the "requester" field.
+func RequesterEQ(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldRequester, v))
+}
+
+// RequesterNEQ applies the NEQ predicate on the "requester" field.
+func RequesterNEQ(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNEQ(FieldRequester, v))
+}
+
+// RequesterIn applies the In predicate on the "requester" field.
+func RequesterIn(vs ...string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldIn(FieldRequester, vs...))
+}
+
+// RequesterNotIn applies the NotIn predicate on the "requester" field.
+func RequesterNotIn(vs ...string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNotIn(FieldRequester, vs...))
+}
+
+// RequesterGT applies the GT predicate on the "requester" field.
+func RequesterGT(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGT(FieldRequester, v))
+}
+
+// RequesterGTE applies the GTE predicate on the "requester" field.
+func RequesterGTE(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGTE(FieldRequester, v))
+}
+
+// RequesterLT applies the LT predicate on the "requester" field.
+func RequesterLT(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLT(FieldRequester, v))
+}
+
+// RequesterLTE applies the LTE predicate on the "requester" field.
+func RequesterLTE(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLTE(FieldRequester, v))
+}
+
+// RequesterContains applies the Contains predicate on the "requester" field.
+func RequesterContains(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldContains(FieldRequester, v))
+}
+
+// RequesterHasPrefix applies the HasPrefix predicate on the "requester" field.
+func RequesterHasPrefix(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldHasPrefix(FieldRequester, v))
+}
+
+// RequesterHasSuffix applies the HasSuffix predicate on the "requester" field.
+func RequesterHasSuffix(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldHasSuffix(FieldRequester, v))
+}
+
+// RequesterIsNil applies the IsNil predicate on the "requester" field.
+func RequesterIsNil() predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldIsNull(FieldRequester))
+}
+
+// RequesterNotNil applies the NotNil predicate on the "requester" field.
+func RequesterNotNil() predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNotNull(FieldRequester))
+}
+
+// RequesterEqualFold applies the EqualFold predicate on the "requester" field.
+func RequesterEqualFold(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEqualFold(FieldRequester, v))
+}
+
+// RequesterContainsFold applies the ContainsFold predicate on the "requester" field.
+func RequesterContainsFold(v string) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldContainsFold(FieldRequester, v))
+}
+
+// AutoplayEQ applies the EQ predicate on the "autoplay" field.
+func AutoplayEQ(v bool) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldAutoplay, v))
+}
+
+// AutoplayNEQ applies the NEQ predicate on the "autoplay" field.
+func AutoplayNEQ(v bool) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNEQ(FieldAutoplay, v))
+}
+
+// PlayedAtEQ applies the EQ predicate on the "played_at" field.
+func PlayedAtEQ(v time.Time) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldEQ(FieldPlayedAt, v))
+}
+
+// PlayedAtNEQ applies the NEQ predicate on the "played_at" field.
+func PlayedAtNEQ(v time.Time) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNEQ(FieldPlayedAt, v))
+}
+
+// PlayedAtIn applies the In predicate on the "played_at" field.
+func PlayedAtIn(vs ...time.Time) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldIn(FieldPlayedAt, vs...))
+}
+
+// PlayedAtNotIn applies the NotIn predicate on the "played_at" field.
+func PlayedAtNotIn(vs ...time.Time) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldNotIn(FieldPlayedAt, vs...))
+}
+
+// PlayedAtGT applies the GT predicate on the "played_at" field.
+func PlayedAtGT(v time.Time) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGT(FieldPlayedAt, v))
+}
+
+// PlayedAtGTE applies the GTE predicate on the "played_at" field.
+func PlayedAtGTE(v time.Time) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldGTE(FieldPlayedAt, v))
+}
+
+// PlayedAtLT applies the LT predicate on the "played_at" field.
+func PlayedAtLT(v time.Time) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLT(FieldPlayedAt, v))
+}
+
+// PlayedAtLTE applies the LTE predicate on the "played_at" field.
+func PlayedAtLTE(v time.Time) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.FieldLTE(FieldPlayedAt, v))
+}
+
+// HasGuild applies the HasEdge predicate on the "guild" edge.
+func HasGuild() predicate.TrackPlay {
+	return predicate.TrackPlay(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GuildTable, GuildColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGuildWith applies the HasEdge predicate on the "guild" edge with a given conditions (other predicates).
+func HasGuildWith(preds ...predicate.Guild) predicate.TrackPlay {
+	return predicate.TrackPlay(func(s *sql.Selector) {
+		step := newGuildStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TrackPlay) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TrackPlay) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TrackPlay) predicate.TrackPlay {
+	return predicate.TrackPlay(sql.NotPredicates(p))
+}
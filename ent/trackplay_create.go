@@ -0,0 +1,1055 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/trackplay"
+)
+
+// TrackPlayCreate is the builder for creating a TrackPlay entity.
+type TrackPlayCreate struct {
+	config
+	mutation *TrackPlayMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGuildID sets the "guild_id" field.
+func (tpc *TrackPlayCreate) SetGuildID(s snowflake.ID) *TrackPlayCreate {
+	tpc.mutation.SetGuildID(s)
+	return tpc
+}
+
+// SetIdentifier sets the "identifier" field.
+func (tpc *TrackPlayCreate) SetIdentifier(s string) *TrackPlayCreate {
+	tpc.mutation.SetIdentifier(s)
+	return tpc
+}
+
+// SetTitle sets the "title" field.
+func (tpc *TrackPlayCreate) SetTitle(s string) *TrackPlayCreate {
+	tpc.mutation.SetTitle(s)
+	return tpc
+}
+
+// SetAuthor sets the "author" field.
+func (tpc *TrackPlayCreate) SetAuthor(s string) *TrackPlayCreate {
+	tpc.mutation.SetAuthor(s)
+	return tpc
+}
+
+// SetURI sets the "uri" field.
+func (tpc *TrackPlayCreate) SetURI(s string) *TrackPlayCreate {
+	tpc.mutation.SetURI(s)
+	return tpc
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (tpc *TrackPlayCreate) SetNillableURI(s *string) *TrackPlayCreate {
+	if s != nil {
+		tpc.SetURI(*s)
+	}
+	return tpc
+}
+
+// SetEncoded sets the "encoded" field.
+func (tpc *TrackPlayCreate) SetEncoded(s string) *TrackPlayCreate {
+	tpc.mutation.SetEncoded(s)
+	return tpc
+}
+
+// SetLength sets the "length" field.
+func (tpc *TrackPlayCreate) SetLength(i int64) *TrackPlayCreate {
+	tpc.mutation.SetLength(i)
+	return tpc
+}
+
+// SetRequester sets the "requester" field.
+func (tpc *TrackPlayCreate) SetRequester(s string) *TrackPlayCreate {
+	tpc.mutation.SetRequester(s)
+	return tpc
+}
+
+// SetNillableRequester sets the "requester" field if the given value is not nil.
+func (tpc *TrackPlayCreate) SetNillableRequester(s *string) *TrackPlayCreate {
+	if s != nil {
+		tpc.SetRequester(*s)
+	}
+	return tpc
+}
+
+// SetAutoplay sets the "autoplay" field.
+func (tpc *TrackPlayCreate) SetAutoplay(b bool) *TrackPlayCreate {
+	tpc.mutation.SetAutoplay(b)
+	return tpc
+}
+
+// SetNillableAutoplay sets the "autoplay" field if the given value is not nil.
+func (tpc *TrackPlayCreate) SetNillableAutoplay(b *bool) *TrackPlayCreate {
+	if b != nil {
+		tpc.SetAutoplay(*b)
+	}
+	return tpc
+}
+
+// SetPlayedAt sets the "played_at" field.
+func (tpc *TrackPlayCreate) SetPlayedAt(t time.Time) *TrackPlayCreate {
+	tpc.mutation.SetPlayedAt(t)
+	return tpc
+}
+
+// SetNillablePlayedAt sets the "played_at" field if the given value is not nil.
+func (tpc *TrackPlayCreate) SetNillablePlayedAt(t *time.Time) *TrackPlayCreate {
+	if t != nil {
+		tpc.SetPlayedAt(*t)
+	}
+	return tpc
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (tpc *TrackPlayCreate) SetGuild(g *Guild) *TrackPlayCreate {
+	return tpc.SetGuildID(g.ID)
+}
+
+// Mutation returns the TrackPlayMutation object of the builder.
+func (tpc *TrackPlayCreate) Mutation() *TrackPlayMutation {
+	return tpc.mutation
+}
+
+// Save creates the TrackPlay in the database.
+func (tpc *TrackPlayCreate) Save(ctx context.Context) (*TrackPlay, error) {
+	tpc.defaults()
+	return withHooks(ctx, tpc.sqlSave, tpc.mutation, tpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tpc *TrackPlayCreate) SaveX(ctx context.Context) *TrackPlay {
+	v, err := tpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tpc *TrackPlayCreate) Exec(ctx context.Context) error {
+	_, err := tpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpc *TrackPlayCreate) ExecX(ctx context.Context) {
+	if err := tpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tpc *TrackPlayCreate) defaults() {
+	if _, ok := tpc.mutation.Autoplay(); !ok {
+		v := trackplay.DefaultAutoplay
+		tpc.mutation.SetAutoplay(v)
+	}
+	if _, ok := tpc.mutation.PlayedAt(); !ok {
+		v := trackplay.DefaultPlayedAt()
+		tpc.mutation.SetPlayedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tpc *TrackPlayCreate) check() error {
+	if _, ok := tpc.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "TrackPlay.guild_id"`)}
+	}
+	if _, ok := tpc.mutation.Identifier(); !ok {
+		return &ValidationError{Name: "identifier", err: errors.New(`ent: missing required field "TrackPlay.identifier"`)}
+	}
+	if _, ok := tpc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "TrackPlay.title"`)}
+	}
+	if _, ok := tpc.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required field "TrackPlay.author"`)}
+	}
+	if _, ok := tpc.mutation.Encoded(); !ok {
+		return &ValidationError{Name: "encoded", err: errors.New(`ent: missing required field "TrackPlay.encoded"`)}
+	}
+	if _, ok := tpc.mutation.Length(); !ok {
+		return &ValidationError{Name: "length", err: errors.New(`ent: missing required field "TrackPlay.length"`)}
+	}
+	if _, ok := tpc.mutation.Autoplay(); !ok {
+		return &ValidationError{Name: "autoplay", err: errors.New(`ent: missing required field "TrackPlay.autoplay"`)}
+	}
+	if _, ok := tpc.mutation.PlayedAt(); !ok {
+		return &ValidationError{Name: "played_at", err: errors.New(`ent: missing required field "TrackPlay.played_at"`)}
+	}
+	if len(tpc.mutation.GuildIDs()) == 0 {
+		return &ValidationError{Name: "guild", err: errors.New(`ent: missing required edge "TrackPlay.guild"`)}
+	}
+	return nil
+}
+
+func (tpc *TrackPlayCreate) sqlSave(ctx context.Context) (*TrackPlay, error) {
+	if err := tpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tpc.mutation.id = &_node.ID
+	tpc.mutation.done = true
+	return _node, nil
+}
+
+func (tpc *TrackPlayCreate) createSpec() (*TrackPlay, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TrackPlay{config: tpc.config}
+		_spec = sqlgraph.NewCreateSpec(trackplay.Table, sqlgraph.NewFieldSpec(trackplay.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = tpc.conflict
+	if value, ok := tpc.mutation.Identifier(); ok {
+		_spec.SetField(trackplay.FieldIdentifier, field.TypeString, value)
+		_node.Identifier = value
+	}
+	if value, ok := tpc.mutation.Title(); ok {
+		_spec.SetField(trackplay.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := tpc.mutation.Author(); ok {
+		_spec.SetField(trackplay.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := tpc.mutation.URI(); ok {
+		_spec.SetField(trackplay.FieldURI, field.TypeString, value)
+		_node.URI = &value
+	}
+	if value, ok := tpc.mutation.Encoded(); ok {
+		_spec.SetField(trackplay.FieldEncoded, field.TypeString, value)
+		_node.Encoded = value
+	}
+	if value, ok := tpc.mutation.Length(); ok {
+		_spec.SetField(trackplay.FieldLength, field.TypeInt64, value)
+		_node.Length = value
+	}
+	if value, ok := tpc.mutation.Requester(); ok {
+		_spec.SetField(trackplay.FieldRequester, field.TypeString, value)
+		_node.Requester = value
+	}
+	if value, ok := tpc.mutation.Autoplay(); ok {
+		_spec.SetField(trackplay.FieldAutoplay, field.TypeBool, value)
+		_node.Autoplay = value
+	}
+	if value, ok := tpc.mutation.PlayedAt(); ok {
+		_spec.SetField(trackplay.FieldPlayedAt, field.TypeTime, value)
+		_node.PlayedAt = value
+	}
+	if nodes := tpc.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trackplay.GuildTable,
+			Columns: []string{trackplay.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GuildID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TrackPlay.Create().
+//		SetGuildID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TrackPlayUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (tpc *TrackPlayCreate) OnConflict(opts ...sql.ConflictOption) *TrackPlayUpsertOne {
+	tpc.conflict = opts
+	return &TrackPlayUpsertOne{
+		create: tpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TrackPlay.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tpc *TrackPlayCreate) OnConflictColumns(columns ...string) *TrackPlayUpsertOne {
+	tpc.conflict = append(tpc.conflict, sql.ConflictColumns(columns...))
+	return &TrackPlayUpsertOne{
+		create: tpc,
+	}
+}
+
+type (
+	// TrackPlayUpsertOne is the builder for "upsert"-ing
+	//  one TrackPlay node.
+	TrackPlayUpsertOne struct {
+		create *TrackPlayCreate
+	}
+
+	// TrackPlayUpsert is the "OnConflict" setter.
+	TrackPlayUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGuildID sets the "guild_id" field.
+func (u *TrackPlayUpsert) SetGuildID(v snowflake.ID) *TrackPlayUpsert {
+	u.Set(trackplay.FieldGuildID, v)
+	return u
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *TrackPlayUpsert) UpdateGuildID() *TrackPlayUpsert {
+	u.SetExcluded(trackplay.FieldGuildID)
+	return u
+}
+
+// SetIdentifier sets the "identifier" field.
+func (u *TrackPlayUpsert) SetIdentifier(v string) *TrackPlayUpsert {
+	u.Set(trackplay.FieldIdentifier, v)
+	return u
+}
+
+// UpdateIdentifier sets the "identifier" field to the value that was provided on create.
+func (u *TrackPlayUpsert) UpdateIdentifier() *TrackPlayUpsert {
+	u.SetExcluded(trackplay.FieldIdentifier)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *TrackPlayUpsert) SetTitle(v string) *TrackPlayUpsert {
+	u.Set(trackplay.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TrackPlayUpsert) UpdateTitle() *TrackPlayUpsert {
+	u.SetExcluded(trackplay.FieldTitle)
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *TrackPlayUpsert) SetAuthor(v string) *TrackPlayUpsert {
+	u.Set(trackplay.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *TrackPlayUpsert) UpdateAuthor() *TrackPlayUpsert {
+	u.SetExcluded(trackplay.FieldAuthor)
+	return u
+}
+
+// SetURI sets the "uri" field.
+func (u *TrackPlayUpsert) SetURI(v string) *TrackPlayUpsert {
+	u.Set(trackplay.FieldURI, v)
+	return u
+}
+
+// UpdateURI sets the "uri" field to the value that was provided on create.
+func (u *TrackPlayUpsert) UpdateURI() *TrackPlayUpsert {
+	u.SetExcluded(trackplay.FieldURI)
+	return u
+}
+
+// ClearURI clears the value of the "uri" field.
+func (u *TrackPlayUpsert) ClearURI() *TrackPlayUpsert {
+	u.SetNull(trackplay.FieldURI)
+	return u
+}
+
+// SetEncoded sets the "encoded" field.
+func (u *TrackPlayUpsert) SetEncoded(v string) *TrackPlayUpsert {
+	u.Set(trackplay.FieldEncoded, v)
+	return u
+}
+
+// UpdateEncoded sets the "encoded" field to the value that was provided on create.
+func (u *TrackPlayUpsert) UpdateEncoded() *TrackPlayUpsert {
+	u.SetExcluded(trackplay.FieldEncoded)
+	return u
+}
+
+// SetLength sets the "length" field.
+func (u *TrackPlayUpsert) SetLength(v int64) *TrackPlayUpsert {
+	u.Set(trackplay.FieldLength, v)
+	return u
+}
+
+// UpdateLength sets the "length" field to the value that was provided on create.
+func (u *TrackPlayUpsert) UpdateLength() *TrackPlayUpsert {
+	u.SetExcluded(trackplay.FieldLength)
+	return u
+}
+
+// AddLength adds v to the "length" field.
+func (u *TrackPlayUpsert) AddLength(v int64) *TrackPlayUpsert {
+	u.Add(trackplay.FieldLength, v)
+	return u
+}
+
+// SetRequester sets the "requester" field.
+func (u *TrackPlayUpsert) SetRequester(v string) *TrackPlayUpsert {
+	u.Set(trackplay.FieldRequester, v)
+	return u
+}
+
+// UpdateRequester sets the "requester" field to the value that was provided on create.
+func (u *TrackPlayUpsert) UpdateRequester() *TrackPlayUpsert {
+	u.SetExcluded(trackplay.FieldRequester)
+	return u
+}
+
+// ClearRequester clears the value of the "requester" field.
+func (u *TrackPlayUpsert) ClearRequester() *TrackPlayUpsert {
+	u.SetNull(trackplay.FieldRequester)
+	return u
+}
+
+// SetAutoplay sets the "autoplay" field.
+func (u *TrackPlayUpsert) SetAutoplay(v bool) *TrackPlayUpsert {
+	u.Set(trackplay.FieldAutoplay, v)
+	return u
+}
+
+// UpdateAutoplay sets the "autoplay" field to the value that was provided on create.
+func (u *TrackPlayUpsert) UpdateAutoplay() *TrackPlayUpsert {
+	u.SetExcluded(trackplay.FieldAutoplay)
+	return u
+}
+
+// SetPlayedAt sets the "played_at" field.
+func (u *TrackPlayUpsert) SetPlayedAt(v time.Time) *TrackPlayUpsert {
+	u.Set(trackplay.FieldPlayedAt, v)
+	return u
+}
+
+// UpdatePlayedAt sets the "played_at" field to the value that was provided on create.
+func (u *TrackPlayUpsert) UpdatePlayedAt() *TrackPlayUpsert {
+	u.SetExcluded(trackplay.FieldPlayedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TrackPlay.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TrackPlayUpsertOne) UpdateNewValues() *TrackPlayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TrackPlay.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TrackPlayUpsertOne) Ignore() *TrackPlayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TrackPlayUpsertOne) DoNothing() *TrackPlayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TrackPlayCreate.OnConflict
+// documentation for more info.
+func (u *TrackPlayUpsertOne) Update(set func(*TrackPlayUpsert)) *TrackPlayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TrackPlayUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGuildID sets the "guild_id" field.
+func (u *TrackPlayUpsertOne) SetGuildID(v snowflake.ID) *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetGuildID(v)
+	})
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *TrackPlayUpsertOne) UpdateGuildID() *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateGuildID()
+	})
+}
+
+// SetIdentifier sets the "identifier" field.
+func (u *TrackPlayUpsertOne) SetIdentifier(v string) *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetIdentifier(v)
+	})
+}
+
+// UpdateIdentifier sets the "identifier" field to the value that was provided on create.
+func (u *TrackPlayUpsertOne) UpdateIdentifier() *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateIdentifier()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *TrackPlayUpsertOne) SetTitle(v string) *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TrackPlayUpsertOne) UpdateTitle() *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *TrackPlayUpsertOne) SetAuthor(v string) *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *TrackPlayUpsertOne) UpdateAuthor() *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetURI sets the "uri" field.
+func (u *TrackPlayUpsertOne) SetURI(v string) *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetURI(v)
+	})
+}
+
+// UpdateURI sets the "uri" field to the value that was provided on create.
+func (u *TrackPlayUpsertOne) UpdateURI() *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateURI()
+	})
+}
+
+// ClearURI clears the value of the "uri" field.
+func (u *TrackPlayUpsertOne) ClearURI() *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.ClearURI()
+	})
+}
+
+// SetEncoded sets the "encoded" field.
+func (u *TrackPlayUpsertOne) SetEncoded(v string) *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetEncoded(v)
+	})
+}
+
+// UpdateEncoded sets the "encoded" field to the value that was provided on create.
+func (u *TrackPlayUpsertOne) UpdateEncoded() *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateEncoded()
+	})
+}
+
+// SetLength sets the "length" field.
+func (u *TrackPlayUpsertOne) SetLength(v int64) *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetLength(v)
+	})
+}
+
+// AddLength adds v to the "length" field.
+func (u *TrackPlayUpsertOne) AddLength(v int64) *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.AddLength(v)
+	})
+}
+
+// UpdateLength sets the "length" field to the value that was provided on create.
+func (u *TrackPlayUpsertOne) UpdateLength() *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateLength()
+	})
+}
+
+// SetRequester sets the "requester" field.
+func (u *TrackPlayUpsertOne) SetRequester(v string) *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetRequester(v)
+	})
+}
+
+// UpdateRequester sets the "requester" field to the value that was provided on create.
+func (u *TrackPlayUpsertOne) UpdateRequester() *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateRequester()
+	})
+}
+
+// ClearRequester clears the value of the "requester" field.
+func (u *TrackPlayUpsertOne) ClearRequester() *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.ClearRequester()
+	})
+}
+
+// SetAutoplay sets the "autoplay" field.
+func (u *TrackPlayUpsertOne) SetAutoplay(v bool) *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetAutoplay(v)
+	})
+}
+
+// UpdateAutoplay sets the "autoplay" field to the value that was provided on create.
+func (u *TrackPlayUpsertOne) UpdateAutoplay() *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateAutoplay()
+	})
+}
+
+// SetPlayedAt sets the "played_at" field.
+func (u *TrackPlayUpsertOne) SetPlayedAt(v time.Time) *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetPlayedAt(v)
+	})
+}
+
+// UpdatePlayedAt sets the "played_at" field to the value that was provided on create.
+func (u *TrackPlayUpsertOne) UpdatePlayedAt() *TrackPlayUpsertOne {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdatePlayedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TrackPlayUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TrackPlayCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TrackPlayUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TrackPlayUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TrackPlayUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TrackPlayCreateBulk is the builder for creating many TrackPlay entities in bulk.
+type TrackPlayCreateBulk struct {
+	config
+	err      error
+	builders []*TrackPlayCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TrackPlay entities in the database.
+func (tpcb *TrackPlayCreateBulk) Save(ctx context.Context) ([]*TrackPlay, error) {
+	if tpcb.err != nil {
+		return nil, tpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tpcb.builders))
+	nodes := make([]*TrackPlay, len(tpcb.builders))
+	mutators := make([]Mutator, len(tpcb.builders))
+	for i := range tpcb.builders {
+		func(i int, root context.Context) {
+			builder := tpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TrackPlayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tpcb *TrackPlayCreateBulk) SaveX(ctx context.Context) []*TrackPlay {
+	v, err := tpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tpcb *TrackPlayCreateBulk) Exec(ctx context.Context) error {
+	_, err := tpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpcb *TrackPlayCreateBulk) ExecX(ctx context.Context) {
+	if err := tpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TrackPlay.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TrackPlayUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (tpcb *TrackPlayCreateBulk) OnConflict(opts ...sql.ConflictOption) *TrackPlayUpsertBulk {
+	tpcb.conflict = opts
+	return &TrackPlayUpsertBulk{
+		create: tpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TrackPlay.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tpcb *TrackPlayCreateBulk) OnConflictColumns(columns ...string) *TrackPlayUpsertBulk {
+	tpcb.conflict = append(tpcb.conflict, sql.ConflictColumns(columns...))
+	return &TrackPlayUpsertBulk{
+		create: tpcb,
+	}
+}
+
+// TrackPlayUpsertBulk is the builder for "upsert"-ing
+// a bulk of TrackPlay nodes.
+type TrackPlayUpsertBulk struct {
+	create *TrackPlayCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TrackPlay.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TrackPlayUpsertBulk) UpdateNewValues() *TrackPlayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TrackPlay.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TrackPlayUpsertBulk) Ignore() *TrackPlayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TrackPlayUpsertBulk) DoNothing() *TrackPlayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TrackPlayCreateBulk.OnConflict
+// documentation for more info.
+func (u *TrackPlayUpsertBulk) Update(set func(*TrackPlayUpsert)) *TrackPlayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TrackPlayUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGuildID sets the "guild_id" field.
+func (u *TrackPlayUpsertBulk) SetGuildID(v snowflake.ID) *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetGuildID(v)
+	})
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *TrackPlayUpsertBulk) UpdateGuildID() *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateGuildID()
+	})
+}
+
+// SetIdentifier sets the "identifier" field.
+func (u *TrackPlayUpsertBulk) SetIdentifier(v string) *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetIdentifier(v)
+	})
+}
+
+// UpdateIdentifier sets the "identifier" field to the value that was provided on create.
+func (u *TrackPlayUpsertBulk) UpdateIdentifier() *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateIdentifier()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *TrackPlayUpsertBulk) SetTitle(v string) *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TrackPlayUpsertBulk) UpdateTitle() *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *TrackPlayUpsertBulk) SetAuthor(v string) *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *TrackPlayUpsertBulk) UpdateAuthor() *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetURI sets the "uri" field.
+func (u *TrackPlayUpsertBulk) SetURI(v string) *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetURI(v)
+	})
+}
+
+// UpdateURI sets the "uri" field to the value that was provided on create.
+func (u *TrackPlayUpsertBulk) UpdateURI() *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateURI()
+	})
+}
+
+// ClearURI clears the value of the "uri" field.
+func (u *TrackPlayUpsertBulk) ClearURI() *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.ClearURI()
+	})
+}
+
+// SetEncoded sets the "encoded" field.
+func (u *TrackPlayUpsertBulk) SetEncoded(v string) *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetEncoded(v)
+	})
+}
+
+// UpdateEncoded sets the "encoded" field to the value that was provided on create.
+func (u *TrackPlayUpsertBulk) UpdateEncoded() *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateEncoded()
+	})
+}
+
+// SetLength sets the "length" field.
+func (u *TrackPlayUpsertBulk) SetLength(v int64) *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetLength(v)
+	})
+}
+
+// AddLength adds v to the "length" field.
+func (u *TrackPlayUpsertBulk) AddLength(v int64) *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.AddLength(v)
+	})
+}
+
+// UpdateLength sets the "length" field to the value that was provided on create.
+func (u *TrackPlayUpsertBulk) UpdateLength() *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateLength()
+	})
+}
+
+// SetRequester sets the "requester" field.
+func (u *TrackPlayUpsertBulk) SetRequester(v string) *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetRequester(v)
+	})
+}
+
+// UpdateRequester sets the "requester" field to the value that was provided on create.
+func (u *TrackPlayUpsertBulk) UpdateRequester() *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateRequester()
+	})
+}
+
+// ClearRequester clears the value of the "requester" field.
+func (u *TrackPlayUpsertBulk) ClearRequester() *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.ClearRequester()
+	})
+}
+
+// SetAutoplay sets the "autoplay" field.
+func (u *TrackPlayUpsertBulk) SetAutoplay(v bool) *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetAutoplay(v)
+	})
+}
+
+// UpdateAutoplay sets the "autoplay" field to the value that was provided on create.
+func (u *TrackPlayUpsertBulk) UpdateAutoplay() *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdateAutoplay()
+	})
+}
+
+// SetPlayedAt sets the "played_at" field.
+func (u *TrackPlayUpsertBulk) SetPlayedAt(v time.Time) *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.SetPlayedAt(v)
+	})
+}
+
+// UpdatePlayedAt sets the "played_at" field to the value that was provided on create.
+func (u *TrackPlayUpsertBulk) UpdatePlayedAt() *TrackPlayUpsertBulk {
+	return u.Update(func(s *TrackPlayUpsert) {
+		s.UpdatePlayedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TrackPlayUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TrackPlayCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TrackPlayCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TrackPlayUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+	"github.com/loukhin/probably-a-music-bot/ent/trackplay"
+)
+
+// TrackPlayDelete is the builder for deleting a TrackPlay entity.
+type TrackPlayDelete struct {
+	config
+	hooks    []Hook
+	mutation *TrackPlayMutation
+}
+
+// Where appends a list predicates to the TrackPlayDelete builder.
+func (tpd *TrackPlayDelete) Where(ps ...predicate.TrackPlay) *TrackPlayDelete {
+	tpd.mutation.Where(ps...)
+	return tpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tpd *TrackPlayDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tpd.sqlExec, tpd.mutation, tpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpd *TrackPlayDelete) ExecX(ctx context.Context) int {
+	n, err := tpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tpd *TrackPlayDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(trackplay.Table, sqlgraph.NewFieldSpec(trackplay.FieldID, field.TypeInt))
+	if ps := tpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tpd.mutation.done = true
+	return affected, err
+}
+
+// TrackPlayDeleteOne is the builder for deleting a single TrackPlay entity.
+type TrackPlayDeleteOne struct {
+	tpd *TrackPlayDelete
+}
+
+// Where appends a list predicates to the TrackPlayDelete builder.
+func (tpdo *TrackPlayDeleteOne) Where(ps ...predicate.TrackPlay) *TrackPlayDeleteOne {
+	tpdo.tpd.mutation.Where(ps...)
+	return tpdo
+}
+
+// Exec executes the deletion query.
+func (tpdo *TrackPlayDeleteOne) Exec(ctx context.Context) error {
+	n, err := tpdo.tpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{trackplay.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpdo *TrackPlayDeleteOne) ExecX(ctx context.Context) {
+	if err := tpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
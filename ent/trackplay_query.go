@@ -0,0 +1,607 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+	"github.com/loukhin/probably-a-music-bot/ent/trackplay"
+)
+
+// TrackPlayQuery is the builder for querying TrackPlay entities.
+type TrackPlayQuery struct {
+	config
+	ctx        *QueryContext
+	order      []trackplay.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TrackPlay
+	withGuild  *GuildQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TrackPlayQuery builder.
+func (tpq *TrackPlayQuery) Where(ps ...predicate.TrackPlay) *TrackPlayQuery {
+	tpq.predicates = append(tpq.predicates, ps...)
+	return tpq
+}
+
+// Limit the number of records to be returned by this query.
+func (tpq *TrackPlayQuery) Limit(limit int) *TrackPlayQuery {
+	tpq.ctx.Limit = &limit
+	return tpq
+}
+
+// Offset to start from.
+func (tpq *TrackPlayQuery) Offset(offset int) *TrackPlayQuery {
+	tpq.ctx.Offset = &offset
+	return tpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tpq *TrackPlayQuery) Unique(unique bool) *TrackPlayQuery {
+	tpq.ctx.Unique = &unique
+	return tpq
+}
+
+// Order specifies how the records should be ordered.
+func (tpq *TrackPlayQuery) Order(o ...trackplay.OrderOption) *TrackPlayQuery {
+	tpq.order = append(tpq.order, o...)
+	return tpq
+}
+
+// QueryGuild chains the current query on the "guild" edge.
+func (tpq *TrackPlayQuery) QueryGuild() *GuildQuery {
+	query := (&GuildClient{config: tpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(trackplay.Table, trackplay.FieldID, selector),
+			sqlgraph.To(guild.Table, guild.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, trackplay.GuildTable, trackplay.GuildColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TrackPlay entity from the query.
+// Returns a *NotFoundError when no TrackPlay was found.
+func (tpq *TrackPlayQuery) First(ctx context.Context) (*TrackPlay, error) {
+	nodes, err := tpq.Limit(1).All(setContextOp(ctx, tpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{trackplay.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tpq *TrackPlayQuery) FirstX(ctx context.Context) *TrackPlay {
+	node, err := tpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TrackPlay ID from the query.
+// Returns a *NotFoundError when no TrackPlay ID was found.
+func (tpq *TrackPlayQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tpq.Limit(1).IDs(setContextOp(ctx, tpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{trackplay.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tpq *TrackPlayQuery) FirstIDX(ctx context.Context) int {
+	id, err := tpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TrackPlay entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TrackPlay entity is found.
+// Returns a *NotFoundError when no TrackPlay entities are found.
+func (tpq *TrackPlayQuery) Only(ctx context.Context) (*TrackPlay, error) {
+	nodes, err := tpq.Limit(2).All(setContextOp(ctx, tpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{trackplay.Label}
+	default:
+		return nil, &NotSingularError{trackplay.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tpq *TrackPlayQuery) OnlyX(ctx context.Context) *TrackPlay {
+	node, err := tpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TrackPlay ID in the query.
+// Returns a *NotSingularError when more than one TrackPlay ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tpq *TrackPlayQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tpq.Limit(2).IDs(setContextOp(ctx, tpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{trackplay.Label}
+	default:
+		err = &NotSingularError{trackplay.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tpq *TrackPlayQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TrackPlays.
+func (tpq *TrackPlayQuery) All(ctx context.Context) ([]*TrackPlay, error) {
+	ctx = setContextOp(ctx, tpq.ctx, ent.OpQueryAll)
+	if err := tpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TrackPlay, *TrackPlayQuery]()
+	return withInterceptors[[]*TrackPlay](ctx, tpq, qr, tpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tpq *TrackPlayQuery) AllX(ctx context.Context) []*TrackPlay {
+	nodes, err := tpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TrackPlay IDs.
+func (tpq *TrackPlayQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tpq.ctx.Unique == nil && tpq.path != nil {
+		tpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tpq.ctx, ent.OpQueryIDs)
+	if err = tpq.Select(trackplay.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tpq *TrackPlayQuery) IDsX(ctx context.Context) []int {
+	ids, err := tpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tpq *TrackPlayQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tpq.ctx, ent.OpQueryCount)
+	if err := tpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tpq, querierCount[*TrackPlayQuery](), tpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tpq *TrackPlayQuery) CountX(ctx context.Context) int {
+	count, err := tpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tpq *TrackPlayQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tpq.ctx, ent.OpQueryExist)
+	switch _, err := tpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tpq *TrackPlayQuery) ExistX(ctx context.Context) bool {
+	exist, err := tpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TrackPlayQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tpq *TrackPlayQuery) Clone() *TrackPlayQuery {
+	if tpq == nil {
+		return nil
+	}
+	return &TrackPlayQuery{
+		config:     tpq.config,
+		ctx:        tpq.ctx.Clone(),
+		order:      append([]trackplay.OrderOption{}, tpq.order...),
+		inters:     append([]Interceptor{}, tpq.inters...),
+		predicates: append([]predicate.TrackPlay{}, tpq.predicates...),
+		withGuild:  tpq.withGuild.Clone(),
+		// clone intermediate query.
+		sql:  tpq.sql.Clone(),
+		path: tpq.path,
+	}
+}
+
+// WithGuild tells the query-builder to eager-load the nodes that are connected to
+// the "guild" edge. The optional arguments are used to configure the query builder of the edge.
+func (tpq *TrackPlayQuery) WithGuild(opts ...func(*GuildQuery)) *TrackPlayQuery {
+	query := (&GuildClient{config: tpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tpq.withGuild = query
+	return tpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID snowflake.ID `json:"guild_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TrackPlay.Query().
+//		GroupBy(trackplay.FieldGuildID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tpq *TrackPlayQuery) GroupBy(field string, fields ...string) *TrackPlayGroupBy {
+	tpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TrackPlayGroupBy{build: tpq}
+	grbuild.flds = &tpq.ctx.Fields
+	grbuild.label = trackplay.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID snowflake.ID `json:"guild_id,omitempty"`
+//	}
+//
+//	client.TrackPlay.Query().
+//		Select(trackplay.FieldGuildID).
+//		Scan(ctx, &v)
+func (tpq *TrackPlayQuery) Select(fields ...string) *TrackPlaySelect {
+	tpq.ctx.Fields = append(tpq.ctx.Fields, fields...)
+	sbuild := &TrackPlaySelect{TrackPlayQuery: tpq}
+	sbuild.label = trackplay.Label
+	sbuild.flds, sbuild.scan = &tpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TrackPlaySelect configured with the given aggregations.
+func (tpq *TrackPlayQuery) Aggregate(fns ...AggregateFunc) *TrackPlaySelect {
+	return tpq.Select().Aggregate(fns...)
+}
+
+func (tpq *TrackPlayQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tpq.ctx.Fields {
+		if !trackplay.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tpq.path != nil {
+		prev, err := tpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tpq.sql = prev
+	}
+	return nil
+}
+
+func (tpq *TrackPlayQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TrackPlay, error) {
+	var (
+		nodes       = []*TrackPlay{}
+		_spec       = tpq.querySpec()
+		loadedTypes = [1]bool{
+			tpq.withGuild != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TrackPlay).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TrackPlay{config: tpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tpq.withGuild; query != nil {
+		if err := tpq.loadGuild(ctx, query, nodes, nil,
+			func(n *TrackPlay, e *Guild) { n.Edges.Guild = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tpq *TrackPlayQuery) loadGuild(ctx context.Context, query *GuildQuery, nodes []*TrackPlay, init func(*TrackPlay), assign func(*TrackPlay, *Guild)) error {
+	ids := make([]snowflake.ID, 0, len(nodes))
+	nodeids := make(map[snowflake.ID][]*TrackPlay)
+	for i := range nodes {
+		fk := nodes[i].GuildID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(guild.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "guild_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tpq *TrackPlayQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tpq.querySpec()
+	_spec.Node.Columns = tpq.ctx.Fields
+	if len(tpq.ctx.Fields) > 0 {
+		_spec.Unique = tpq.ctx.Unique != nil && *tpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tpq.driver, _spec)
+}
+
+func (tpq *TrackPlayQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(trackplay.Table, trackplay.Columns, sqlgraph.NewFieldSpec(trackplay.FieldID, field.TypeInt))
+	_spec.From = tpq.sql
+	if unique := tpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trackplay.FieldID)
+		for i := range fields {
+			if fields[i] != trackplay.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tpq.withGuild != nil {
+			_spec.Node.AddColumnOnce(trackplay.FieldGuildID)
+		}
+	}
+	if ps := tpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tpq *TrackPlayQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tpq.driver.Dialect())
+	t1 := builder.Table(trackplay.Table)
+	columns := tpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = trackplay.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tpq.sql != nil {
+		selector = tpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tpq.ctx.Unique != nil && *tpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tpq.predicates {
+		p(selector)
+	}
+	for _, p := range tpq.order {
+		p(selector)
+	}
+	if offset := tpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TrackPlayGroupBy is the group-by builder for TrackPlay entities.
+type TrackPlayGroupBy struct {
+	selector
+	build *TrackPlayQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tpgb *TrackPlayGroupBy) Aggregate(fns ...AggregateFunc) *TrackPlayGroupBy {
+	tpgb.fns = append(tpgb.fns, fns...)
+	return tpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tpgb *TrackPlayGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TrackPlayQuery, *TrackPlayGroupBy](ctx, tpgb.build, tpgb, tpgb.build.inters, v)
+}
+
+func (tpgb *TrackPlayGroupBy) sqlScan(ctx context.Context, root *TrackPlayQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tpgb.fns))
+	for _, fn := range tpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tpgb.flds)+len(tpgb.fns))
+		for _, f := range *tpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TrackPlaySelect is the builder for selecting fields of TrackPlay entities.
+type TrackPlaySelect struct {
+	*TrackPlayQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tps *TrackPlaySelect) Aggregate(fns ...AggregateFunc) *TrackPlaySelect {
+	tps.fns = append(tps.fns, fns...)
+	return tps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tps *TrackPlaySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tps.ctx, ent.OpQuerySelect)
+	if err := tps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TrackPlayQuery, *TrackPlaySelect](ctx, tps.TrackPlayQuery, tps, tps.inters, v)
+}
+
+func (tps *TrackPlaySelect) sqlScan(ctx context.Context, root *TrackPlayQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tps.fns))
+	for _, fn := range tps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,670 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	snowflake "github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+	"github.com/loukhin/probably-a-music-bot/ent/predicate"
+	"github.com/loukhin/probably-a-music-bot/ent/trackplay"
+)
+
+// TrackPlayUpdate is the builder for updating TrackPlay entities.
+type TrackPlayUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TrackPlayMutation
+}
+
+// Where appends a list predicates to the TrackPlayUpdate builder.
+func (tpu *TrackPlayUpdate) Where(ps ...predicate.TrackPlay) *TrackPlayUpdate {
+	tpu.mutation.Where(ps...)
+	return tpu
+}
+
+// SetGuildID sets the "guild_id" field.
+func (tpu *TrackPlayUpdate) SetGuildID(s snowflake.ID) *TrackPlayUpdate {
+	tpu.mutation.SetGuildID(s)
+	return tpu
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (tpu *TrackPlayUpdate) SetNillableGuildID(s *snowflake.ID) *TrackPlayUpdate {
+	if s != nil {
+		tpu.SetGuildID(*s)
+	}
+	return tpu
+}
+
+// SetIdentifier sets the "identifier" field.
+func (tpu *TrackPlayUpdate) SetIdentifier(s string) *TrackPlayUpdate {
+	tpu.mutation.SetIdentifier(s)
+	return tpu
+}
+
+// SetNillableIdentifier sets the "identifier" field if the given value is not nil.
+func (tpu *TrackPlayUpdate) SetNillableIdentifier(s *string) *TrackPlayUpdate {
+	if s != nil {
+		tpu.SetIdentifier(*s)
+	}
+	return tpu
+}
+
+// SetTitle sets the "title" field.
+func (tpu *TrackPlayUpdate) SetTitle(s string) *TrackPlayUpdate {
+	tpu.mutation.SetTitle(s)
+	return tpu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (tpu *TrackPlayUpdate) SetNillableTitle(s *string) *TrackPlayUpdate {
+	if s != nil {
+		tpu.SetTitle(*s)
+	}
+	return tpu
+}
+
+// SetAuthor sets the "author" field.
+func (tpu *TrackPlayUpdate) SetAuthor(s string) *TrackPlayUpdate {
+	tpu.mutation.SetAuthor(s)
+	return tpu
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (tpu *TrackPlayUpdate) SetNillableAuthor(s *string) *TrackPlayUpdate {
+	if s != nil {
+		tpu.SetAuthor(*s)
+	}
+	return tpu
+}
+
+// SetURI sets the "uri" field.
+func (tpu *TrackPlayUpdate) SetURI(s string) *TrackPlayUpdate {
+	tpu.mutation.SetURI(s)
+	return tpu
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (tpu *TrackPlayUpdate) SetNillableURI(s *string) *TrackPlayUpdate {
+	if s != nil {
+		tpu.SetURI(*s)
+	}
+	return tpu
+}
+
+// ClearURI clears the value of the "uri" field.
+func (tpu *TrackPlayUpdate) ClearURI() *TrackPlayUpdate {
+	tpu.mutation.ClearURI()
+	return tpu
+}
+
+// SetEncoded sets the "encoded" field.
+func (tpu *TrackPlayUpdate) SetEncoded(s string) *TrackPlayUpdate {
+	tpu.mutation.SetEncoded(s)
+	return tpu
+}
+
+// SetNillableEncoded sets the "encoded" field if the given value is not nil.
+func (tpu *TrackPlayUpdate) SetNillableEncoded(s *string) *TrackPlayUpdate {
+	if s != nil {
+		tpu.SetEncoded(*s)
+	}
+	return tpu
+}
+
+// SetLength sets the "length" field.
+func (tpu *TrackPlayUpdate) SetLength(i int64) *TrackPlayUpdate {
+	tpu.mutation.ResetLength()
+	tpu.mutation.SetLength(i)
+	return tpu
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (tpu *TrackPlayUpdate) SetNillableLength(i *int64) *TrackPlayUpdate {
+	if i != nil {
+		tpu.SetLength(*i)
+	}
+	return tpu
+}
+
+// AddLength adds i to the "length" field.
+func (tpu *TrackPlayUpdate) AddLength(i int64) *TrackPlayUpdate {
+	tpu.mutation.AddLength(i)
+	return tpu
+}
+
+// SetRequester sets the "requester" field.
+func (tpu *TrackPlayUpdate) SetRequester(s string) *TrackPlayUpdate {
+	tpu.mutation.SetRequester(s)
+	return tpu
+}
+
+// SetNillableRequester sets the "requester" field if the given value is not nil.
+func (tpu *TrackPlayUpdate) SetNillableRequester(s *string) *TrackPlayUpdate {
+	if s != nil {
+		tpu.SetRequester(*s)
+	}
+	return tpu
+}
+
+// ClearRequester clears the value of the "requester" field.
+func (tpu *TrackPlayUpdate) ClearRequester() *TrackPlayUpdate {
+	tpu.mutation.ClearRequester()
+	return tpu
+}
+
+// SetAutoplay sets the "autoplay" field.
+func (tpu *TrackPlayUpdate) SetAutoplay(b bool) *TrackPlayUpdate {
+	tpu.mutation.SetAutoplay(b)
+	return tpu
+}
+
+// SetNillableAutoplay sets the "autoplay" field if the given value is not nil.
+func (tpu *TrackPlayUpdate) SetNillableAutoplay(b *bool) *TrackPlayUpdate {
+	if b != nil {
+		tpu.SetAutoplay(*b)
+	}
+	return tpu
+}
+
+// SetPlayedAt sets the "played_at" field.
+func (tpu *TrackPlayUpdate) SetPlayedAt(t time.Time) *TrackPlayUpdate {
+	tpu.mutation.SetPlayedAt(t)
+	return tpu
+}
+
+// SetNillablePlayedAt sets the "played_at" field if the given value is not nil.
+func (tpu *TrackPlayUpdate) SetNillablePlayedAt(t *time.Time) *TrackPlayUpdate {
+	if t != nil {
+		tpu.SetPlayedAt(*t)
+	}
+	return tpu
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (tpu *TrackPlayUpdate) SetGuild(g *Guild) *TrackPlayUpdate {
+	return tpu.SetGuildID(g.ID)
+}
+
+// Mutation returns the TrackPlayMutation object of the builder.
+func (tpu *TrackPlayUpdate) Mutation() *TrackPlayMutation {
+	return tpu.mutation
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (tpu *TrackPlayUpdate) ClearGuild() *TrackPlayUpdate {
+	tpu.mutation.ClearGuild()
+	return tpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tpu *TrackPlayUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tpu.sqlSave, tpu.mutation, tpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tpu *TrackPlayUpdate) SaveX(ctx context.Context) int {
+	affected, err := tpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tpu *TrackPlayUpdate) Exec(ctx context.Context) error {
+	_, err := tpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpu *TrackPlayUpdate) ExecX(ctx context.Context) {
+	if err := tpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tpu *TrackPlayUpdate) check() error {
+	if tpu.mutation.GuildCleared() && len(tpu.mutation.GuildIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TrackPlay.guild"`)
+	}
+	return nil
+}
+
+func (tpu *TrackPlayUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(trackplay.Table, trackplay.Columns, sqlgraph.NewFieldSpec(trackplay.FieldID, field.TypeInt))
+	if ps := tpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tpu.mutation.Identifier(); ok {
+		_spec.SetField(trackplay.FieldIdentifier, field.TypeString, value)
+	}
+	if value, ok := tpu.mutation.Title(); ok {
+		_spec.SetField(trackplay.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := tpu.mutation.Author(); ok {
+		_spec.SetField(trackplay.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := tpu.mutation.URI(); ok {
+		_spec.SetField(trackplay.FieldURI, field.TypeString, value)
+	}
+	if tpu.mutation.URICleared() {
+		_spec.ClearField(trackplay.FieldURI, field.TypeString)
+	}
+	if value, ok := tpu.mutation.Encoded(); ok {
+		_spec.SetField(trackplay.FieldEncoded, field.TypeString, value)
+	}
+	if value, ok := tpu.mutation.Length(); ok {
+		_spec.SetField(trackplay.FieldLength, field.TypeInt64, value)
+	}
+	if value, ok := tpu.mutation.AddedLength(); ok {
+		_spec.AddField(trackplay.FieldLength, field.TypeInt64, value)
+	}
+	if value, ok := tpu.mutation.Requester(); ok {
+		_spec.SetField(trackplay.FieldRequester, field.TypeString, value)
+	}
+	if tpu.mutation.RequesterCleared() {
+		_spec.ClearField(trackplay.FieldRequester, field.TypeString)
+	}
+	if value, ok := tpu.mutation.Autoplay(); ok {
+		_spec.SetField(trackplay.FieldAutoplay, field.TypeBool, value)
+	}
+	if value, ok := tpu.mutation.PlayedAt(); ok {
+		_spec.SetField(trackplay.FieldPlayedAt, field.TypeTime, value)
+	}
+	if tpu.mutation.GuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trackplay.GuildTable,
+			Columns: []string{trackplay.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tpu.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trackplay.GuildTable,
+			Columns: []string{trackplay.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trackplay.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tpu.mutation.done = true
+	return n, nil
+}
+
+// TrackPlayUpdateOne is the builder for updating a single TrackPlay entity.
+type TrackPlayUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TrackPlayMutation
+}
+
+// SetGuildID sets the "guild_id" field.
+func (tpuo *TrackPlayUpdateOne) SetGuildID(s snowflake.ID) *TrackPlayUpdateOne {
+	tpuo.mutation.SetGuildID(s)
+	return tpuo
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (tpuo *TrackPlayUpdateOne) SetNillableGuildID(s *snowflake.ID) *TrackPlayUpdateOne {
+	if s != nil {
+		tpuo.SetGuildID(*s)
+	}
+	return tpuo
+}
+
+// SetIdentifier sets the "identifier" field.
+func (tpuo *TrackPlayUpdateOne) SetIdentifier(s string) *TrackPlayUpdateOne {
+	tpuo.mutation.SetIdentifier(s)
+	return tpuo
+}
+
+// SetNillableIdentifier sets the "identifier" field if the given value is not nil.
+func (tpuo *TrackPlayUpdateOne) SetNillableIdentifier(s *string) *TrackPlayUpdateOne {
+	if s != nil {
+		tpuo.SetIdentifier(*s)
+	}
+	return tpuo
+}
+
+// SetTitle sets the "title" field.
+func (tpuo *TrackPlayUpdateOne) SetTitle(s string) *TrackPlayUpdateOne {
+	tpuo.mutation.SetTitle(s)
+	return tpuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (tpuo *TrackPlayUpdateOne) SetNillableTitle(s *string) *TrackPlayUpdateOne {
+	if s != nil {
+		tpuo.SetTitle(*s)
+	}
+	return tpuo
+}
+
+// SetAuthor sets the "author" field.
+func (tpuo *TrackPlayUpdateOne) SetAuthor(s string) *TrackPlayUpdateOne {
+	tpuo.mutation.SetAuthor(s)
+	return tpuo
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (tpuo *TrackPlayUpdateOne) SetNillableAuthor(s *string) *TrackPlayUpdateOne {
+	if s != nil {
+		tpuo.SetAuthor(*s)
+	}
+	return tpuo
+}
+
+// SetURI sets the "uri" field.
+func (tpuo *TrackPlayUpdateOne) SetURI(s string) *TrackPlayUpdateOne {
+	tpuo.mutation.SetURI(s)
+	return tpuo
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (tpuo *TrackPlayUpdateOne) SetNillableURI(s *string) *TrackPlayUpdateOne {
+	if s != nil {
+		tpuo.SetURI(*s)
+	}
+	return tpuo
+}
+
+// ClearURI clears the value of the "uri" field.
+func (tpuo *TrackPlayUpdateOne) ClearURI() *TrackPlayUpdateOne {
+	tpuo.mutation.ClearURI()
+	return tpuo
+}
+
+// SetEncoded sets the "encoded" field.
+func (tpuo *TrackPlayUpdateOne) SetEncoded(s string) *TrackPlayUpdateOne {
+	tpuo.mutation.SetEncoded(s)
+	return tpuo
+}
+
+// SetNillableEncoded sets the "encoded" field if the given value is not nil.
+func (tpuo *TrackPlayUpdateOne) SetNillableEncoded(s *string) *TrackPlayUpdateOne {
+	if s != nil {
+		tpuo.SetEncoded(*s)
+	}
+	return tpuo
+}
+
+// SetLength sets the "length" field.
+func (tpuo *TrackPlayUpdateOne) SetLength(i int64) *TrackPlayUpdateOne {
+	tpuo.mutation.ResetLength()
+	tpuo.mutation.SetLength(i)
+	return tpuo
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (tpuo *TrackPlayUpdateOne) SetNillableLength(i *int64) *TrackPlayUpdateOne {
+	if i != nil {
+		tpuo.SetLength(*i)
+	}
+	return tpuo
+}
+
+// AddLength adds i to the "length" field.
+func (tpuo *TrackPlayUpdateOne) AddLength(i int64) *TrackPlayUpdateOne {
+	tpuo.mutation.AddLength(i)
+	return tpuo
+}
+
+// SetRequester sets the "requester" field.
+func (tpuo *TrackPlayUpdateOne) SetRequester(s string) *TrackPlayUpdateOne {
+	tpuo.mutation.SetRequester(s)
+	return tpuo
+}
+
+// SetNillableRequester sets the "requester" field if the given value is not nil.
+func (tpuo *TrackPlayUpdateOne) SetNillableRequester(s *string) *TrackPlayUpdateOne {
+	if s != nil {
+		tpuo.SetRequester(*s)
+	}
+	return tpuo
+}
+
+// ClearRequester clears the value of the "requester" field.
+func (tpuo *TrackPlayUpdateOne) ClearRequester() *TrackPlayUpdateOne {
+	tpuo.mutation.ClearRequester()
+	return tpuo
+}
+
+// SetAutoplay sets the "autoplay" field.
+func (tpuo *TrackPlayUpdateOne) SetAutoplay(b bool) *TrackPlayUpdateOne {
+	tpuo.mutation.SetAutoplay(b)
+	return tpuo
+}
+
+// SetNillableAutoplay sets the "autoplay" field if the given value is not nil.
+func (tpuo *TrackPlayUpdateOne) SetNillableAutoplay(b *bool) *TrackPlayUpdateOne {
+	if b != nil {
+		tpuo.SetAutoplay(*b)
+	}
+	return tpuo
+}
+
+// SetPlayedAt sets the "played_at" field.
+func (tpuo *TrackPlayUpdateOne) SetPlayedAt(t time.Time) *TrackPlayUpdateOne {
+	tpuo.mutation.SetPlayedAt(t)
+	return tpuo
+}
+
+// SetNillablePlayedAt sets the "played_at" field if the given value is not nil.
+func (tpuo *TrackPlayUpdateOne) SetNillablePlayedAt(t *time.Time) *TrackPlayUpdateOne {
+	if t != nil {
+		tpuo.SetPlayedAt(*t)
+	}
+	return tpuo
+}
+
+// SetGuild sets the "guild" edge to the Guild entity.
+func (tpuo *TrackPlayUpdateOne) SetGuild(g *Guild) *TrackPlayUpdateOne {
+	return tpuo.SetGuildID(g.ID)
+}
+
+// Mutation returns the TrackPlayMutation object of the builder.
+func (tpuo *TrackPlayUpdateOne) Mutation() *TrackPlayMutation {
+	return tpuo.mutation
+}
+
+// ClearGuild clears the "guild" edge to the Guild entity.
+func (tpuo *TrackPlayUpdateOne) ClearGuild() *TrackPlayUpdateOne {
+	tpuo.mutation.ClearGuild()
+	return tpuo
+}
+
+// Where appends a list predicates to the TrackPlayUpdate builder.
+func (tpuo *TrackPlayUpdateOne) Where(ps ...predicate.TrackPlay) *TrackPlayUpdateOne {
+	tpuo.mutation.Where(ps...)
+	return tpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tpuo *TrackPlayUpdateOne) Select(field string, fields ...string) *TrackPlayUpdateOne {
+	tpuo.fields = append([]string{field}, fields...)
+	return tpuo
+}
+
+// Save executes the query and returns the updated TrackPlay entity.
+func (tpuo *TrackPlayUpdateOne) Save(ctx context.Context) (*TrackPlay, error) {
+	return withHooks(ctx, tpuo.sqlSave, tpuo.mutation, tpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tpuo *TrackPlayUpdateOne) SaveX(ctx context.Context) *TrackPlay {
+	node, err := tpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tpuo *TrackPlayUpdateOne) Exec(ctx context.Context) error {
+	_, err := tpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpuo *TrackPlayUpdateOne) ExecX(ctx context.Context) {
+	if err := tpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tpuo *TrackPlayUpdateOne) check() error {
+	if tpuo.mutation.GuildCleared() && len(tpuo.mutation.GuildIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TrackPlay.guild"`)
+	}
+	return nil
+}
+
+func (tpuo *TrackPlayUpdateOne) sqlSave(ctx context.Context) (_node *TrackPlay, err error) {
+	if err := tpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(trackplay.Table, trackplay.Columns, sqlgraph.NewFieldSpec(trackplay.FieldID, field.TypeInt))
+	id, ok := tpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TrackPlay.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trackplay.FieldID)
+		for _, f := range fields {
+			if !trackplay.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != trackplay.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tpuo.mutation.Identifier(); ok {
+		_spec.SetField(trackplay.FieldIdentifier, field.TypeString, value)
+	}
+	if value, ok := tpuo.mutation.Title(); ok {
+		_spec.SetField(trackplay.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := tpuo.mutation.Author(); ok {
+		_spec.SetField(trackplay.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := tpuo.mutation.URI(); ok {
+		_spec.SetField(trackplay.FieldURI, field.TypeString, value)
+	}
+	if tpuo.mutation.URICleared() {
+		_spec.ClearField(trackplay.FieldURI, field.TypeString)
+	}
+	if value, ok := tpuo.mutation.Encoded(); ok {
+		_spec.SetField(trackplay.FieldEncoded, field.TypeString, value)
+	}
+	if value, ok := tpuo.mutation.Length(); ok {
+		_spec.SetField(trackplay.FieldLength, field.TypeInt64, value)
+	}
+	if value, ok := tpuo.mutation.AddedLength(); ok {
+		_spec.AddField(trackplay.FieldLength, field.TypeInt64, value)
+	}
+	if value, ok := tpuo.mutation.Requester(); ok {
+		_spec.SetField(trackplay.FieldRequester, field.TypeString, value)
+	}
+	if tpuo.mutation.RequesterCleared() {
+		_spec.ClearField(trackplay.FieldRequester, field.TypeString)
+	}
+	if value, ok := tpuo.mutation.Autoplay(); ok {
+		_spec.SetField(trackplay.FieldAutoplay, field.TypeBool, value)
+	}
+	if value, ok := tpuo.mutation.PlayedAt(); ok {
+		_spec.SetField(trackplay.FieldPlayedAt, field.TypeTime, value)
+	}
+	if tpuo.mutation.GuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trackplay.GuildTable,
+			Columns: []string{trackplay.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tpuo.mutation.GuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trackplay.GuildTable,
+			Columns: []string{trackplay.GuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TrackPlay{config: tpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trackplay.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tpuo.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,22 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// CheerSound is the client for interacting with the CheerSound builders.
+	CheerSound *CheerSoundClient
 	// Guild is the client for interacting with the Guild builders.
 	Guild *GuildClient
+	// Member is the client for interacting with the Member builders.
+	Member *MemberClient
+	// Playlist is the client for interacting with the Playlist builders.
+	Playlist *PlaylistClient
+	// Pronunciation is the client for interacting with the Pronunciation builders.
+	Pronunciation *PronunciationClient
+	// TrackPlay is the client for interacting with the TrackPlay builders.
+	TrackPlay *TrackPlayClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +159,14 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.CheerSound = NewCheerSoundClient(tx.config)
 	tx.Guild = NewGuildClient(tx.config)
+	tx.Member = NewMemberClient(tx.config)
+	tx.Playlist = NewPlaylistClient(tx.config)
+	tx.Pronunciation = NewPronunciationClient(tx.config)
+	tx.TrackPlay = NewTrackPlayClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookSubscription = NewWebhookSubscriptionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +176,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Guild.QueryXXX(), the query will be executed
+// applies a query, for example: CheerSound.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -2,43 +2,75 @@ package main
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
-	"github.com/disgoorg/log"
+	"github.com/getsentry/sentry-go"
 	"github.com/loukhin/probably-a-music-bot/ent/guild"
 )
 
-func (b *Bot) onApplicationCommand(event *events.ApplicationCommandInteractionCreate) {
+func (b *Bot) onApplicationCommand(event *events.ApplicationCommandInteractionCreate, scope *EventScope) {
 	data := event.SlashCommandInteractionData()
 
 	_ = event.DeferCreateMessage(false)
 
+	transaction := sentry.StartTransaction(sentry.SetHubOnContext(context.Background(), scope.Hub), "/"+data.CommandName(), sentry.OpName("command"))
+	defer func() {
+		// the status is still undefined when the handler panicked
+		if transaction.Status == sentry.SpanStatusUndefined {
+			transaction.Status = sentry.SpanStatusInternalError
+		}
+		transaction.Finish()
+	}()
+
+	start := time.Now()
 	handler, ok := b.Handlers[data.CommandName()]
 	if !ok {
-		log.Info("unknown command: ", data.CommandName())
+		scope.Logger.Info("Unknown command")
+		observeCommand(data.CommandName(), "unknown", start)
+		transaction.Status = sentry.SpanStatusNotFound
 		return
 	}
 	if err := handler(event, data); err != nil {
-		log.Error("error handling command: ", err)
+		scope.Logger.Error("Failed to handle command", "err", err)
+		observeCommand(data.CommandName(), "error", start)
+		transaction.Status = sentry.SpanStatusInternalError
+		scope.Hub.CaptureException(err)
+		return
+	}
+	observeCommand(data.CommandName(), "ok", start)
+	transaction.Status = sentry.SpanStatusOK
+}
+
+func (b *Bot) onAutocomplete(event *events.AutocompleteInteractionCreate) {
+	data := event.Data
+	focused := data.Focused()
+	if focused.Name != "voice" {
+		return
+	}
+
+	choices := ttsVoiceChoices(b.ttsProvider(*event.GuildID()), data.String(focused.Name))
+	if err := event.AutocompleteResult(choices); err != nil {
+		slog.Error("Failed to respond to autocomplete", "guild_id", *event.GuildID(), "err", err)
 	}
 }
 
 func (b *Bot) onVoiceStateUpdate(event *events.GuildVoiceStateUpdate) {
 	if event.VoiceState.UserID != b.Client.ApplicationID() {
 		botVoiceState, ok := b.Client.Caches().VoiceState(event.VoiceState.GuildID, b.Client.ID())
-		if !ok || botVoiceState.ChannelID != event.VoiceState.ChannelID {
-			return
-		}
-		audioChannel, ok := b.Client.Caches().GuildAudioChannel(*botVoiceState.ChannelID)
-		if !ok {
+		if !ok || botVoiceState.ChannelID == nil {
 			return
 		}
-		members := b.Client.Caches().AudioChannelMembers(audioChannel)
-		if len(members) == 1 {
-			b.updateVoiceState(event.VoiceState.GuildID, nil)
+		botChannelID := *botVoiceState.ChannelID
+		joined := event.VoiceState.ChannelID != nil && *event.VoiceState.ChannelID == botChannelID
+		left := !joined && event.OldVoiceState.ChannelID != nil && *event.OldVoiceState.ChannelID == botChannelID
+		if joined {
+			b.resumeWhenRejoined(event.VoiceState.GuildID)
+		} else if left && b.listenerCount(event.VoiceState.GuildID, botChannelID) == 0 {
+			b.pauseWhenAlone(event.VoiceState.GuildID)
 		}
 		return
 	}
@@ -46,29 +78,49 @@ func (b *Bot) onVoiceStateUpdate(event *events.GuildVoiceStateUpdate) {
 	if event.VoiceState.ChannelID == nil {
 		b.Guilds.Delete(event.VoiceState.GuildID)
 		b.updatePlayerMessage(event.VoiceState.GuildID)
+		if b.Guilds.Get(event.VoiceState.GuildID).currentSettings().AlwaysOn {
+			b.rejoinAlwaysOn(event.VoiceState.GuildID)
+		}
 	}
 }
 
 func (b *Bot) onVoiceServerUpdate(event *events.VoiceServerUpdate) {
 	b.Lavalink.OnVoiceServerUpdate(context.TODO(), event.GuildID, event.Token, *event.Endpoint)
+	b.resumeVoice(event.GuildID)
 }
 
+// onGuildJoin saves the guild with the default settings of the config, a guild that's already saved only gets its name updated
 func (b *Bot) onGuildJoin(event *events.GuildJoin) {
+	defaults := currentConfig().Defaults
 	err := b.EntClient.Guild.Create().SetID(event.GuildID).SetName(event.Guild.Name).
+		SetIdleTimeout(defaults.IdleTimeout).
+		SetAloneTimeout(defaults.AloneTimeout).
+		SetAutoplay(defaults.Autoplay).
+		SetTtsProvider(defaults.TTSProvider).
+		SetTtsLanguage(defaults.TTSLanguage).
+		SetTtsVoice(defaults.TTSVoice).
 		OnConflict(
 			sql.ConflictColumns("id"),
 			sql.ResolveWithNewValues(),
 			sql.ResolveWith(func(u *sql.UpdateSet) {
 				u.SetIgnore(guild.FieldID)
 				u.SetIgnore(guild.FieldCreatedAt)
+				u.SetIgnore(guild.FieldIdleTimeout)
+				u.SetIgnore(guild.FieldAloneTimeout)
+				u.SetIgnore(guild.FieldAutoplay)
+				u.SetIgnore(guild.FieldTtsProvider)
+				u.SetIgnore(guild.FieldTtsLanguage)
+				u.SetIgnore(guild.FieldTtsVoice)
 			}),
 		).Exec(context.Background())
 	if err != nil {
-		log.Error(err)
+		slog.Error("Failed to save guild", "guild_id", event.GuildID, "err", err)
 	}
 }
 
 func (b *Bot) onGuildMessageCreate(event *events.GuildMessageCreate) {
+	b.readMessage(event)
+
 	guildPlayer := b.Guilds.GetGuildPlayer(event.GuildID)
 	if guildPlayer.IsPlayerChannel(event.ChannelID) {
 		if !guildPlayer.IsPlayerMessage(event.MessageID) {
@@ -85,14 +137,14 @@ func (b *Bot) onGuildMessageCreate(event *events.GuildMessageCreate) {
 		if len(event.Message.Attachments) > 0 {
 			for _, attachment := range event.Message.Attachments {
 				// Check if the attachment is an audio file
-				if isAudioFile(*attachment.ContentType) {
-					b.playOrQueue(event.GuildID, *event.Message.Member, attachment.URL, func(embed discord.Embed) {
+				if attachment.ContentType != nil && isAudioFile(*attachment.ContentType) {
+					b.playOrQueue(event.GuildID, *event.Message.Member, attachment.URL, false, func(embed discord.Embed) {
 						messageCreate := discord.NewMessageCreateBuilder()
 						messageCreate.SetMessageReference(event.Message.MessageReference)
 						messageCreate.SetEmbeds(embed)
 						_, err := event.Client().Rest().CreateMessage(event.ChannelID, messageCreate.Build())
 						if err != nil {
-							log.Error(err)
+							slog.Error("Failed to reply to audio file", "guild_id", event.GuildID, "channel_id", event.ChannelID, "err", err)
 						}
 						b.updatePlayerMessage(event.GuildID)
 					})
@@ -103,13 +155,13 @@ func (b *Bot) onGuildMessageCreate(event *events.GuildMessageCreate) {
 
 		// Handle regular text messages
 		if guildPlayer.messageID != nil {
-			b.playOrQueue(event.GuildID, *event.Message.Member, event.Message.Content, func(embed discord.Embed) {
+			b.playOrQueue(event.GuildID, *event.Message.Member, event.Message.Content, false, func(embed discord.Embed) {
 				messageCreate := discord.NewMessageCreateBuilder()
 				messageCreate.SetMessageReference(event.Message.MessageReference)
 				messageCreate.SetEmbeds(embed)
 				_, err := event.Client().Rest().CreateMessage(event.ChannelID, messageCreate.Build())
 				if err != nil {
-					log.Error(err)
+					slog.Error("Failed to reply to query", "guild_id", event.GuildID, "channel_id", event.ChannelID, "err", err)
 				}
 				b.updatePlayerMessage(event.GuildID)
 			})
@@ -139,17 +191,17 @@ func (b *Bot) onGuildMessageUpdate(event *events.GuildMessageUpdate) {
 	if guildPlayer.IsPlayerChannel(event.ChannelID) && guildPlayer.IsPlayerMessage(event.MessageID) && len(newMessageEmbed) == 0 {
 		guild, err := b.EntClient.Guild.Get(context.TODO(), event.GuildID)
 		if err != nil {
-			log.Error(err)
+			slog.Error("Failed to load guild", "guild_id", event.GuildID, "err", err)
 			return
 		}
 		_, err = guild.Update().ClearPlayerChannelID().ClearPlayerMessageID().Save(context.TODO())
 		if err != nil {
-			log.Error(err)
+			slog.Error("Failed to clear player message", "guild_id", event.GuildID, "err", err)
 			return
 		}
 		err = event.Client().Rest().DeleteMessage(event.ChannelID, event.MessageID)
 		if err != nil {
-			log.Error(err)
+			slog.Error("Failed to delete player message", "guild_id", event.GuildID, "err", err)
 			return
 		}
 		if ok := b.createPlayerMessage(event.GuildID, event.ChannelID); ok {
@@ -0,0 +1,415 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/disgolink/v3/disgolink"
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+	_ "modernc.org/sqlite"
+
+	"github.com/loukhin/probably-a-music-bot/ent"
+)
+
+const (
+	testBotID   = snowflake.ID(1000)
+	testGuildID = snowflake.ID(2000)
+)
+
+// newTestBot returns a bot with the default config, an in-memory database and fake discord and lavalink clients
+func newTestBot(t *testing.T) (*Bot, *fakeDiscord, *fakeLavalink) {
+	t.Helper()
+	activeConfig.Store(defaultConfig())
+
+	db, err := sql.Open("sqlite", "file:"+strings.ReplaceAll(t.Name(), "/", "_")+"?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { _ = client.Close() })
+	if err = client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Guild.Create().SetID(testGuildID).SetName("test").Exec(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	b := newBot()
+	b.EntClient = client
+	discordClient := newFakeDiscord()
+	b.Client = discordClient
+	lavalinkClient := newFakeLavalink()
+	b.Lavalink = lavalinkClient
+	return b, discordClient, lavalinkClient
+}
+
+// fakeDiscord is a bot.Client with real caches and a fake rest client, joining voice only updates the cached voice state
+type fakeDiscord struct {
+	bot.Client
+	caches cache.Caches
+	rest   *fakeRest
+}
+
+func newFakeDiscord() *fakeDiscord {
+	return &fakeDiscord{
+		caches: cache.New(cache.WithCaches(cache.FlagsAll)),
+		rest: &fakeRest{
+			members:  make(map[snowflake.ID]discord.Member),
+			messages: make(map[snowflake.ID]discord.Message),
+		},
+	}
+}
+
+func (d *fakeDiscord) ID() snowflake.ID {
+	return testBotID
+}
+
+func (d *fakeDiscord) ApplicationID() snowflake.ID {
+	return testBotID
+}
+
+func (d *fakeDiscord) Caches() cache.Caches {
+	return d.caches
+}
+
+func (d *fakeDiscord) Rest() rest.Rest {
+	return d.rest
+}
+
+func (d *fakeDiscord) UpdateVoiceState(_ context.Context, guildID snowflake.ID, channelID *snowflake.ID, _ bool, _ bool) error {
+	d.joinVoice(guildID, testBotID, channelID)
+	return nil
+}
+
+// joinVoice puts the user in the voice channel, a nil channelID leaves it
+func (d *fakeDiscord) joinVoice(guildID snowflake.ID, userID snowflake.ID, channelID *snowflake.ID) {
+	if channelID == nil {
+		d.caches.RemoveVoiceState(guildID, userID)
+		return
+	}
+	d.caches.AddVoiceState(discord.VoiceState{GuildID: guildID, UserID: userID, ChannelID: channelID})
+}
+
+// fakeRest answers member lookups from members and message requests from messages,
+// it fails the first commandFailures command registrations
+type fakeRest struct {
+	rest.Rest
+
+	mu              sync.Mutex
+	members         map[snowflake.ID]discord.Member
+	memberRequests  int
+	messages        map[snowflake.ID]discord.Message
+	commandFailures int
+	commandRequests int
+}
+
+func (r *fakeRest) GetMember(_ snowflake.ID, userID snowflake.ID, _ ...rest.RequestOpt) (*discord.Member, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.memberRequests++
+	member, ok := r.members[userID]
+	if !ok {
+		return nil, rest.NewError(nil, nil, &http.Response{StatusCode: http.StatusNotFound}, nil)
+	}
+	return &member, nil
+}
+
+func (r *fakeRest) SetGlobalCommands(_ snowflake.ID, commandCreates []discord.ApplicationCommandCreate, _ ...rest.RequestOpt) ([]discord.ApplicationCommand, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.commandRequests++
+	if r.commandFailures > 0 {
+		r.commandFailures--
+		return nil, rest.NewError(nil, nil, &http.Response{StatusCode: http.StatusServiceUnavailable}, nil)
+	}
+	return make([]discord.ApplicationCommand, 0, len(commandCreates)), nil
+}
+
+func (r *fakeRest) GetMessage(_ snowflake.ID, messageID snowflake.ID, _ ...rest.RequestOpt) (*discord.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	message, ok := r.messages[messageID]
+	if !ok {
+		return nil, rest.NewError(nil, nil, &http.Response{StatusCode: http.StatusNotFound}, nil)
+	}
+	return &message, nil
+}
+
+// UpdateMessage only replaces the content and embeds of the message
+func (r *fakeRest) UpdateMessage(_ snowflake.ID, messageID snowflake.ID, messageUpdate discord.MessageUpdate, _ ...rest.RequestOpt) (*discord.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	message, ok := r.messages[messageID]
+	if !ok {
+		return nil, rest.NewError(nil, nil, &http.Response{StatusCode: http.StatusNotFound}, nil)
+	}
+	if messageUpdate.Content != nil {
+		message.Content = *messageUpdate.Content
+	}
+	if messageUpdate.Embeds != nil {
+		message.Embeds = *messageUpdate.Embeds
+	}
+	r.messages[messageID] = message
+	return &message, nil
+}
+
+func (r *fakeRest) message(messageID snowflake.ID) discord.Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.messages[messageID]
+}
+
+// fakeLavalink is a disgolink.Client with one node and players that only remember their last update,
+// calling a method it doesn't implement panics through the nil embedded interface
+type fakeLavalink struct {
+	disgolink.Client
+	node *fakeNode
+
+	mu      sync.Mutex
+	players map[snowflake.ID]*fakePlayer
+}
+
+func newFakeLavalink() *fakeLavalink {
+	f := &fakeLavalink{players: make(map[snowflake.ID]*fakePlayer)}
+	f.node = &fakeNode{lavalink: f, tracks: make(map[string]lavalink.Track)}
+	return f
+}
+
+func (f *fakeLavalink) BestNode() disgolink.Node {
+	return f.node
+}
+
+func (f *fakeLavalink) Node(name string) disgolink.Node {
+	if name != f.node.Config().Name {
+		return nil
+	}
+	return f.node
+}
+
+func (f *fakeLavalink) ForNodes(nodeFunc func(node disgolink.Node)) {
+	nodeFunc(f.node)
+}
+
+func (f *fakeLavalink) Player(guildID snowflake.ID) disgolink.Player {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	player, ok := f.players[guildID]
+	if !ok {
+		player = &fakePlayer{lavalink: f, guildID: guildID, volume: 100}
+		f.players[guildID] = player
+	}
+	return player
+}
+
+func (f *fakeLavalink) ExistingPlayer(guildID snowflake.ID) disgolink.Player {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if player, ok := f.players[guildID]; ok {
+		return player
+	}
+	return nil
+}
+
+func (f *fakeLavalink) ForPlayers(playerFunc func(player disgolink.Player)) {
+	f.mu.Lock()
+	players := make([]*fakePlayer, 0, len(f.players))
+	for _, player := range f.players {
+		players = append(players, player)
+	}
+	f.mu.Unlock()
+	for _, player := range players {
+		playerFunc(player)
+	}
+}
+
+func (f *fakeLavalink) OnVoiceStateUpdate(context.Context, snowflake.ID, *snowflake.ID, string) {}
+
+func (f *fakeLavalink) RemovePlayer(guildID snowflake.ID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.players, guildID)
+}
+
+// fakeNode resolves every identifier to a track named after it unless load is set
+type fakeNode struct {
+	disgolink.Node
+	lavalink *fakeLavalink
+	load     func(identifier string) (*lavalink.LoadResult, error)
+
+	mu          sync.Mutex
+	identifiers []string
+	tracks      map[string]lavalink.Track
+}
+
+func newFakeTrack(identifier string) lavalink.Track {
+	uri := identifier
+	return lavalink.Track{
+		Encoded: "encoded:" + identifier,
+		Info: lavalink.TrackInfo{
+			Identifier: identifier,
+			Title:      identifier,
+			Length:     lavalink.Minute,
+			URI:        &uri,
+		},
+	}
+}
+
+func (n *fakeNode) Config() disgolink.NodeConfig {
+	return disgolink.NodeConfig{Name: "fake"}
+}
+
+func (n *fakeNode) Status() disgolink.Status {
+	return disgolink.StatusConnected
+}
+
+func (n *fakeNode) LoadTracks(_ context.Context, identifier string) (*lavalink.LoadResult, error) {
+	n.mu.Lock()
+	n.identifiers = append(n.identifiers, identifier)
+	n.mu.Unlock()
+	if n.load != nil {
+		return n.load(identifier)
+	}
+	track := newFakeTrack(identifier)
+	n.addTrack(track)
+	return &lavalink.LoadResult{LoadType: lavalink.LoadTypeTrack, Data: track}, nil
+}
+
+func (n *fakeNode) addTrack(track lavalink.Track) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.tracks[track.Encoded] = track
+}
+
+func (n *fakeNode) loaded() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]string(nil), n.identifiers...)
+}
+
+// fakePlayer applies updates to its state without emitting events
+type fakePlayer struct {
+	disgolink.Player
+	lavalink *fakeLavalink
+	guildID  snowflake.ID
+
+	mu       sync.Mutex
+	track    *lavalink.Track
+	position lavalink.Duration
+	volume   int
+	paused   bool
+	updates  int
+}
+
+func (p *fakePlayer) GuildID() snowflake.ID {
+	return p.guildID
+}
+
+func (p *fakePlayer) Node() disgolink.Node {
+	return p.lavalink.node
+}
+
+func (p *fakePlayer) Track() *lavalink.Track {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.track
+}
+
+func (p *fakePlayer) Paused() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.paused
+}
+
+func (p *fakePlayer) Position() lavalink.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.position
+}
+
+func (p *fakePlayer) Volume() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.volume
+}
+
+func (p *fakePlayer) Update(_ context.Context, opts ...lavalink.PlayerUpdateOpt) error {
+	var update lavalink.PlayerUpdate
+	update.Apply(opts)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.updates++
+	if update.Track != nil && update.Track.Encoded != nil {
+		if update.Track.Encoded.IsNull() {
+			p.track = nil
+		} else {
+			p.lavalink.node.mu.Lock()
+			track, ok := p.lavalink.node.tracks[update.Track.Encoded.Value()]
+			p.lavalink.node.mu.Unlock()
+			if !ok {
+				track = lavalink.Track{Encoded: update.Track.Encoded.Value()}
+			}
+			if userData, ok := update.Track.UserData.(lavalink.RawData); ok {
+				track.UserData = userData
+			}
+			p.track = &track
+		}
+		p.position = 0
+	}
+	if update.Position != nil {
+		p.position = *update.Position
+	}
+	if update.Volume != nil {
+		p.volume = *update.Volume
+	}
+	if update.Paused != nil {
+		p.paused = *update.Paused
+	}
+	return nil
+}
+
+func (p *fakePlayer) Destroy(context.Context) error {
+	p.lavalink.RemovePlayer(p.guildID)
+	return nil
+}
+
+// stubTTSProvider turns the text into a track named after it and counts the requests
+type stubTTSProvider struct {
+	name   string
+	voices []TTSVoice
+
+	mu       sync.Mutex
+	requests []TTSRequest
+}
+
+func (p *stubTTSProvider) Name() string {
+	return p.name
+}
+
+func (p *stubTTSProvider) Voices() []TTSVoice {
+	return p.voices
+}
+
+func (p *stubTTSProvider) Synthesize(_ context.Context, request TTSRequest) (lavalink.Track, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests = append(p.requests, request)
+	return newFakeTrack(request.Voice.Name + ":" + request.Text), nil
+}
+
+func (p *stubTTSProvider) calls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.requests)
+}
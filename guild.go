@@ -2,10 +2,14 @@ package main
 
 import (
 	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/disgoorg/disgolink/v3/lavalink"
-	"github.com/disgoorg/log"
 	"github.com/disgoorg/snowflake/v2"
+	entguild "github.com/loukhin/probably-a-music-bot/ent/guild"
 )
 
 type GuildPlayer struct {
@@ -13,40 +17,186 @@ type GuildPlayer struct {
 	messageID *snowflake.ID
 }
 
+// VoiceReconnect holds the playback state to restore once the voice connection is re-established
+type VoiceReconnect struct {
+	channelID   snowflake.ID
+	track       *lavalink.Track
+	position    lavalink.Duration
+	paused      bool
+	pending     bool
+	attempts    int
+	attemptedAt time.Time
+}
+
+// GuildSettings holds the per-guild settings stored in the database
+type GuildSettings struct {
+	IdleTimeout  time.Duration
+	AloneTimeout time.Duration
+	// Autoplay queues related tracks when the queue runs dry
+	Autoplay bool
+	// TTSProvider is the name of the TTSProvider used for /tts and /bits
+	TTSProvider string
+	// TTSVoice is the default voice of members without a preference
+	TTSVoice TTSVoice
+	// AlwaysOn keeps the bot in AlwaysOnChannelID and plays FallbackQuery when the queue runs out
+	AlwaysOn          bool
+	AlwaysOnChannelID *snowflake.ID
+	FallbackQuery     string
+	// TTSReaderChannelID is the channel whose messages are read aloud
+	TTSReaderChannelID *snowflake.ID
+}
+
 type Guild struct {
 	guildPlayer *GuildPlayer
 	queue       *Queue
+	// settings is shared with the guild that replaces this one on Delete, it's read with currentSettings
+	// and replaced with updateSettings, the GuildSettings it points to are never changed
+	settings *atomic.Pointer[GuildSettings]
+	reader   *TTSReader
+
+	// mu guards the playback state below, it's changed by the listeners, the timers and the API requests
+	mu        sync.Mutex
+	reconnect *VoiceReconnect
+	idle      *IdleTimer
+	sleep     *SleepTimer
+	// notice is shown in the player embed until the next track starts
+	notice string
+	// announcements holds the TTS clips waiting for the playing one, interrupted is the music they preempted
+	announcements []lavalink.Track
+	interrupted   *InterruptedTrack
+	// resumed is the encoded track that continues after an announcement or a voice reconnect, its start isn't a new play
+	resumed string
+	// history holds the identifiers of the recently played tracks
+	history []string
+	// autoPaused is set when the player was paused because everyone left the voice channel
+	autoPaused bool
+	// leaving is set when the bot left the voice channel on its own, the 4014 close that follows isn't an error
+	leaving atomic.Bool
 }
 
 type GuildManager struct {
 	bot    *Bot
+	mu     sync.Mutex
 	guilds map[snowflake.ID]*Guild
 }
 
+// Get returns the state of the guild, it's loaded from the database on first use
 func (gm *GuildManager) Get(guildID snowflake.ID) *Guild {
+	gm.mu.Lock()
 	guild, ok := gm.guilds[guildID]
-	if !ok {
-		queue := &Queue{
-			Tracks: make([]lavalink.Track, 0),
-			Type:   QueueTypeNoRepeat,
-		}
-		dbGuild, err := gm.bot.EntClient.Guild.Get(context.TODO(), guildID)
-		if err != nil {
-			log.Error(err)
-		}
-		guildPlayer := &GuildPlayer{
-			channelID: dbGuild.PlayerChannelID,
-			messageID: dbGuild.PlayerMessageID,
-		}
-		gm.guilds[guildID] = &Guild{
-			queue:       queue,
-			guildPlayer: guildPlayer,
-		}
-		guild, _ = gm.guilds[guildID]
+	gm.mu.Unlock()
+	if ok {
+		return guild
 	}
+
+	// the database isn't queried under the lock, a slow query would block every guild
+	loaded := gm.load(guildID)
+	gm.mu.Lock()
+	if guild, ok = gm.guilds[guildID]; ok {
+		// another goroutine loaded it first
+		gm.mu.Unlock()
+		return guild
+	}
+	gm.guilds[guildID] = loaded
+	gm.mu.Unlock()
+	loaded.mu.Lock()
+	if loaded.sleep != nil {
+		gm.bot.armSleepTimer(guildID, loaded.sleep)
+	}
+	loaded.mu.Unlock()
+	return loaded
+}
+
+// load reads the settings of the guild, the defaults of the config are used when they can't be read
+func (gm *GuildManager) load(guildID snowflake.ID) *Guild {
+	guild := &Guild{
+		queue:       gm.newQueue(guildID),
+		guildPlayer: &GuildPlayer{},
+		settings:    new(atomic.Pointer[GuildSettings]),
+		reader:      newTTSReader(),
+	}
+	guild.settings.Store(defaultGuildSettings())
+	dbGuild, err := gm.bot.EntClient.Guild.Get(context.TODO(), guildID)
+	if err != nil {
+		slog.Error("Failed to load guild settings, using the defaults", "guild_id", guildID, "err", err)
+		return guild
+	}
+	guild.guildPlayer.channelID = dbGuild.PlayerChannelID
+	guild.guildPlayer.messageID = dbGuild.PlayerMessageID
+	guild.settings.Store(&GuildSettings{
+		IdleTimeout:  time.Duration(dbGuild.IdleTimeout) * time.Minute,
+		AloneTimeout: time.Duration(dbGuild.AloneTimeout) * time.Minute,
+		Autoplay:     dbGuild.Autoplay,
+		TTSProvider:  dbGuild.TtsProvider,
+		TTSVoice: TTSVoice{
+			LanguageCode: dbGuild.TtsLanguage,
+			Name:         dbGuild.TtsVoice,
+			SpeakingRate: dbGuild.TtsRate,
+			Pitch:        dbGuild.TtsPitch,
+		},
+
+		AlwaysOn:          dbGuild.AlwaysOn,
+		AlwaysOnChannelID: dbGuild.AlwaysOnChannelID,
+		FallbackQuery:     dbGuild.FallbackQuery,
+
+		TTSReaderChannelID: dbGuild.TtsReaderChannelID,
+	})
+	guild.sleep = sleepTimerFromEnt(dbGuild)
 	return guild
 }
 
+// defaultGuildSettings are the settings of a guild that isn't saved, like the ones onGuildJoin saves
+func defaultGuildSettings() *GuildSettings {
+	defaults := currentConfig().Defaults
+	return &GuildSettings{
+		IdleTimeout:  time.Duration(defaults.IdleTimeout) * time.Minute,
+		AloneTimeout: time.Duration(defaults.AloneTimeout) * time.Minute,
+		Autoplay:     defaults.Autoplay,
+		TTSProvider:  defaults.TTSProvider,
+		TTSVoice: TTSVoice{
+			LanguageCode: defaults.TTSLanguage,
+			Name:         defaults.TTSVoice,
+			SpeakingRate: entguild.DefaultTtsRate,
+		},
+	}
+}
+
+// newQueue creates a queue that reports its changes to the webhook subscriptions and stream clients of the guild
+func (gm *GuildManager) newQueue(guildID snowflake.ID) *Queue {
+	return newQueue(func(queue QueueSnapshot) {
+		withTaskRecover("QueueUpdate", guildTags(guildID), func() {
+			event := newWebhookQueueEvent(queue)
+			gm.bot.Webhooks.Dispatch(guildID, WebhookEventQueueUpdate, event)
+			gm.bot.Stream.Publish(guildID, WebhookEventQueueUpdate, event)
+		})()
+	})
+}
+
+// currentSettings returns the settings of the guild, they're replaced on every change so don't modify them
+func (g *Guild) currentSettings() *GuildSettings {
+	return g.settings.Load()
+}
+
+// updateSettings stores a copy of the settings with update applied, update may run again when the settings
+// change concurrently
+func (g *Guild) updateSettings(update func(settings *GuildSettings)) *GuildSettings {
+	for {
+		current := g.settings.Load()
+		settings := *current
+		update(&settings)
+		if g.settings.CompareAndSwap(current, &settings) {
+			return &settings
+		}
+	}
+}
+
+// sleepTimer returns the running sleep timer of the guild
+func (g *Guild) sleepTimer() *SleepTimer {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.sleep
+}
+
 func (gm *GuildManager) GetQueue(guildID snowflake.ID) *Queue {
 	guild := gm.Get(guildID)
 	return guild.queue
@@ -57,8 +207,35 @@ func (gm *GuildManager) GetGuildPlayer(guildID snowflake.ID) *GuildPlayer {
 	return guild.guildPlayer
 }
 
+// Delete resets the playback state of a guild while keeping its player channel binding,
+// the manager lock is released before locking the guild, callers holding the guild may look guilds up
 func (gm *GuildManager) Delete(guildID snowflake.ID) {
-	delete(gm.guilds, guildID)
+	gm.mu.Lock()
+	guild, ok := gm.guilds[guildID]
+	gm.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	guild.mu.Lock()
+	guild.idle.Stop()
+	reset := &Guild{
+		queue:       gm.newQueue(guildID),
+		guildPlayer: guild.guildPlayer,
+		settings:    guild.settings,
+		sleep:       guild.sleep,
+		notice:      guild.notice,
+		reader:      guild.reader,
+	}
+	guild.mu.Unlock()
+	reset.leaving.Store(guild.leaving.Load())
+
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+	// another Delete may have replaced the guild in the meantime
+	if gm.guilds[guildID] == guild {
+		gm.guilds[guildID] = reset
+	}
 }
 
 func (gp *GuildPlayer) IsPlayerChannel(channelID snowflake.ID) bool {
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgolink/v3/disgolink"
+)
+
+const (
+	HealthStatusOK          = "ok"
+	HealthStatusUnavailable = "unavailable"
+
+	healthCheckTimeout = 2 * time.Second
+)
+
+type HealthCheck struct {
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// HealthReport is the response of /healthz and /readyz, it's only ok when every check is ok
+type HealthReport struct {
+	Status string                 `json:"status"`
+	Checks map[string]HealthCheck `json:"checks,omitempty"`
+}
+
+func healthCheck(ok bool, detail string) HealthCheck {
+	if ok {
+		return HealthCheck{Status: HealthStatusOK, Detail: detail}
+	}
+	return HealthCheck{Status: HealthStatusUnavailable, Detail: detail}
+}
+
+// registerHealth adds the probes of the container orchestrator, /healthz only tells the process is alive
+func (b *Bot) registerHealth(mux *http.ServeMux) {
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeAPIJSON(w, http.StatusOK, HealthReport{Status: HealthStatusOK})
+	})
+	mux.HandleFunc("GET /readyz", b.serveReady)
+}
+
+// serveReady reports whether the bot can serve commands, i.e. they are registered and discord, lavalink and the database are reachable
+func (b *Bot) serveReady(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+	defer cancel()
+
+	report := HealthReport{
+		Status: HealthStatusOK,
+		Checks: map[string]HealthCheck{
+			"commands": b.checkCommands(),
+			"gateway":  b.checkGateway(),
+			"lavalink": b.checkLavalink(),
+			"database": b.checkDatabase(ctx),
+		},
+	}
+	status := http.StatusOK
+	for _, check := range report.Checks {
+		if check.Status != HealthStatusOK {
+			report.Status = HealthStatusUnavailable
+			status = http.StatusServiceUnavailable
+		}
+	}
+	writeAPIJSON(w, status, report)
+}
+
+func (b *Bot) checkCommands() HealthCheck {
+	if !b.commandsRegistered.Load() {
+		return healthCheck(false, "commands are not registered")
+	}
+	return healthCheck(true, "")
+}
+
+func (b *Bot) checkGateway() HealthCheck {
+	if !b.Client.HasGateway() {
+		return healthCheck(false, "no gateway")
+	}
+	status := b.Client.Gateway().Status()
+	if status != gateway.StatusReady {
+		return healthCheck(false, status.String())
+	}
+	return healthCheck(true, fmt.Sprintf("latency %s", b.Client.Gateway().Latency()))
+}
+
+func (b *Bot) checkLavalink() HealthCheck {
+	var nodes, connected int
+	b.Lavalink.ForNodes(func(node disgolink.Node) {
+		nodes++
+		if node.Status() == disgolink.StatusConnected {
+			connected++
+		}
+	})
+	return healthCheck(connected > 0, fmt.Sprintf("%d/%d nodes connected", connected, nodes))
+}
+
+func (b *Bot) checkDatabase(ctx context.Context) HealthCheck {
+	if err := b.EntClient.PingContext(ctx); err != nil {
+		return healthCheck(false, err.Error())
+	}
+	return healthCheck(true, "")
+}
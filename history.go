@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent"
+	"github.com/loukhin/probably-a-music-bot/ent/trackplay"
+)
+
+const (
+	// trackPlayRetention is how long the play history is kept
+	trackPlayRetention = 90 * 24 * time.Hour
+	// playStatsSize is the number of entries of the top lists of the stats
+	playStatsSize = 10
+)
+
+type HistoryEntry struct {
+	Track    WebhookTrack `json:"track"`
+	Autoplay bool         `json:"autoplay"`
+	PlayedAt time.Time    `json:"played_at"`
+}
+
+type TrackPlayCount struct {
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	Author     string `json:"author"`
+	Count      int    `json:"count"`
+}
+
+type RequesterPlayCount struct {
+	Requester string `json:"requester"`
+	Count     int    `json:"count"`
+}
+
+type PlayStats struct {
+	Plays         int                  `json:"plays"`
+	UniqueTracks  int                  `json:"unique_tracks"`
+	Length        int64                `json:"length_ms"`
+	TopTracks     []TrackPlayCount     `json:"top_tracks"`
+	TopRequesters []RequesterPlayCount `json:"top_requesters"`
+	Since         time.Time            `json:"since"`
+}
+
+// recordTrackPlay adds the track to the play history of the guild
+func (b *Bot) recordTrackPlay(guildID snowflake.ID, track lavalink.Track) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := b.EntClient.TrackPlay.Delete().
+		Where(trackplay.GuildID(guildID), trackplay.PlayedAtLT(time.Now().Add(-trackPlayRetention))).
+		Exec(ctx)
+	if err != nil {
+		dbLog.Error("Failed to prune play history", "guild_id", guildID, "err", err)
+	}
+
+	userData := getTrackUserData(track)
+	err = b.EntClient.TrackPlay.Create().
+		SetGuildID(guildID).
+		SetIdentifier(track.Info.Identifier).
+		SetTitle(track.Info.Title).
+		SetAuthor(track.Info.Author).
+		SetNillableURI(track.Info.URI).
+		SetEncoded(track.Encoded).
+		SetLength(track.Info.Length.Milliseconds()).
+		SetRequester(userData.Requester).
+		SetAutoplay(userData.Autoplay).
+		Exec(ctx)
+	if err != nil {
+		dbLog.Error("Failed to record track play", "guild_id", guildID, "track", track.Info.Identifier, "err", err)
+	}
+}
+
+func (b *Bot) playHistory(ctx context.Context, guildID snowflake.ID, limit int) ([]HistoryEntry, error) {
+	plays, err := b.EntClient.TrackPlay.Query().
+		Where(trackplay.GuildID(guildID)).
+		Order(ent.Desc(trackplay.FieldPlayedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]HistoryEntry, 0, len(plays))
+	for _, play := range plays {
+		entry := HistoryEntry{
+			Track: WebhookTrack{
+				Title:      play.Title,
+				Author:     play.Author,
+				Identifier: play.Identifier,
+				Length:     play.Length,
+				Requester:  play.Requester,
+			},
+			Autoplay: play.Autoplay,
+			PlayedAt: play.PlayedAt,
+		}
+		if play.URI != nil {
+			entry.Track.URI = *play.URI
+		}
+		history = append(history, entry)
+	}
+	return history, nil
+}
+
+func (b *Bot) playStats(ctx context.Context, guildID snowflake.ID) (*PlayStats, error) {
+	query := b.EntClient.TrackPlay.Query().Where(trackplay.GuildID(guildID))
+
+	var totals []struct {
+		Count int `json:"count"`
+		// the sum is null without plays
+		Length sql.NullInt64 `json:"sum"`
+	}
+	if err := query.Clone().Aggregate(ent.Count(), ent.Sum(trackplay.FieldLength)).Scan(ctx, &totals); err != nil {
+		return nil, err
+	}
+	stats := &PlayStats{
+		TopTracks:     make([]TrackPlayCount, 0),
+		TopRequesters: make([]RequesterPlayCount, 0),
+		Since:         time.Now().Add(-trackPlayRetention),
+	}
+	if len(totals) > 0 {
+		stats.Plays = totals[0].Count
+		stats.Length = totals[0].Length.Int64
+	}
+
+	var tracks []TrackPlayCount
+	err := query.Clone().
+		GroupBy(trackplay.FieldIdentifier, trackplay.FieldTitle, trackplay.FieldAuthor).
+		Aggregate(ent.Count()).
+		Scan(ctx, &tracks)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(tracks, func(i, j int) bool { return tracks[i].Count > tracks[j].Count })
+	stats.UniqueTracks = len(tracks)
+	stats.TopTracks = append(stats.TopTracks, tracks[:min(playStatsSize, len(tracks))]...)
+
+	var requesters []RequesterPlayCount
+	err = query.Clone().
+		Where(trackplay.RequesterNEQ("")).
+		GroupBy(trackplay.FieldRequester).
+		Aggregate(ent.Count()).
+		Scan(ctx, &requesters)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(requesters, func(i, j int) bool { return requesters[i].Count > requesters[j].Count })
+	stats.TopRequesters = append(stats.TopRequesters, requesters[:min(playStatsSize, len(requesters))]...)
+	return stats, nil
+}
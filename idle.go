@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+type IdleReason string
+
+const (
+	IdleReasonQueueEnded IdleReason = "queue_ended"
+	IdleReasonAlone      IdleReason = "alone"
+)
+
+func (r IdleReason) String() string {
+	switch r {
+	case IdleReasonQueueEnded:
+		return "Queue ended"
+	case IdleReasonAlone:
+		return "Everyone left the voice channel"
+	default:
+		return "unknown"
+	}
+}
+
+// IdleTimer disconnects the bot from the voice channel once its deadline is reached
+type IdleTimer struct {
+	Reason   IdleReason
+	Deadline time.Time
+	timer    *time.Timer
+}
+
+func (t *IdleTimer) Stop() {
+	if t == nil {
+		return
+	}
+	t.timer.Stop()
+}
+
+// startIdleTimer replaces the running idle timer of the guild, a zero timeout disconnects immediately
+func (b *Bot) startIdleTimer(guildID snowflake.ID, reason IdleReason) {
+	guild := b.Guilds.Get(guildID)
+	settings := guild.currentSettings()
+	timeout := settings.IdleTimeout
+	if reason == IdleReasonAlone {
+		timeout = settings.AloneTimeout
+	}
+
+	guild.mu.Lock()
+	guild.idle.Stop()
+	guild.idle = nil
+	if settings.AlwaysOn {
+		guild.mu.Unlock()
+		b.updatePlayerMessage(guildID)
+		return
+	}
+	if timeout <= 0 {
+		guild.mu.Unlock()
+		b.updateVoiceState(guildID, nil)
+		return
+	}
+
+	idle := &IdleTimer{
+		Reason:   reason,
+		Deadline: time.Now().Add(timeout),
+	}
+	idle.timer = time.AfterFunc(timeout, withTaskRecover("IdleTimeout", guildTags(guildID), func() {
+		b.onIdleTimeout(guildID, idle)
+	}))
+	guild.idle = idle
+	guild.mu.Unlock()
+	b.updatePlayerMessage(guildID)
+}
+
+// stopIdleTimer stops the running idle timer if it was started for one of the given reasons
+func (b *Bot) stopIdleTimer(guildID snowflake.ID, reasons ...IdleReason) bool {
+	guild := b.Guilds.Get(guildID)
+	guild.mu.Lock()
+	defer guild.mu.Unlock()
+	if guild.idle == nil {
+		return false
+	}
+	for _, reason := range reasons {
+		if guild.idle.Reason == reason {
+			guild.idle.Stop()
+			guild.idle = nil
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Bot) onIdleTimeout(guildID snowflake.ID, idle *IdleTimer) {
+	guild := b.Guilds.Get(guildID)
+	guild.mu.Lock()
+	if guild.idle != idle {
+		guild.mu.Unlock()
+		return
+	}
+	guild.idle = nil
+	guild.mu.Unlock()
+	slog.Info("Disconnecting idle player", "guild_id", guildID, "reason", idle.Reason)
+	b.updateVoiceState(guildID, nil)
+}
+
+// pauseWhenAlone pauses the player and starts the grace period for listeners to come back
+func (b *Bot) pauseWhenAlone(guildID snowflake.ID) {
+	guild := b.Guilds.Get(guildID)
+	if guild.currentSettings().AlwaysOn {
+		return
+	}
+	player := b.Lavalink.ExistingPlayer(guildID)
+	if player != nil && player.Track() != nil && !player.Paused() {
+		if err := player.Update(context.TODO(), lavalink.WithPaused(true)); err != nil {
+			slog.Error("Failed to pause player", "guild_id", guildID, "err", err)
+		} else {
+			guild.mu.Lock()
+			guild.autoPaused = true
+			guild.mu.Unlock()
+		}
+	}
+	b.startIdleTimer(guildID, IdleReasonAlone)
+}
+
+// resumeWhenRejoined resumes the player paused by pauseWhenAlone
+func (b *Bot) resumeWhenRejoined(guildID snowflake.ID) {
+	if !b.stopIdleTimer(guildID, IdleReasonAlone) {
+		return
+	}
+	guild := b.Guilds.Get(guildID)
+	guild.mu.Lock()
+	autoPaused := guild.autoPaused
+	guild.autoPaused = false
+	guild.mu.Unlock()
+	player := b.Lavalink.ExistingPlayer(guildID)
+	if autoPaused && player != nil {
+		if err := player.Update(context.TODO(), lavalink.WithPaused(false)); err != nil {
+			slog.Error("Failed to resume player", "guild_id", guildID, "err", err)
+		}
+	}
+	if player != nil && player.Track() == nil {
+		b.startIdleTimer(guildID, IdleReasonQueueEnded)
+		return
+	}
+	b.updatePlayerMessage(guildID)
+}
+
+// listenerCount returns the amount of users other than the bot in the voice channel
+func (b *Bot) listenerCount(guildID snowflake.ID, channelID snowflake.ID) int {
+	count := 0
+	b.Client.Caches().VoiceStatesForEach(guildID, func(state discord.VoiceState) {
+		if state.UserID != b.Client.ID() && state.ChannelID != nil && *state.ChannelID == channelID {
+			count++
+		}
+	})
+	return count
+}
+
+func formatIdleCountdown(idle *IdleTimer) string {
+	return fmt.Sprintf("%s, leaving <t:%d:R>", idle.Reason, idle.Deadline.Unix())
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// TextRun is a part of a message written in a single language
+type TextRun struct {
+	Language string
+	Text     string
+}
+
+// defaultLanguageCodes maps the detected languages to the language code used when the provider has no catalog
+var defaultLanguageCodes = map[string]string{
+	"th": "th-TH",
+	"en": "en-US",
+	"ja": "ja-JP",
+	"ko": "ko-KR",
+	"zh": "cmn-CN",
+}
+
+// scriptLanguage returns the language written with the script of the rune, neutral runes like digits,
+// punctuation, spaces and emoji return an empty string
+func scriptLanguage(r rune, hasKana bool) string {
+	switch {
+	case unicode.Is(unicode.Thai, r):
+		return "th"
+	case unicode.In(r, unicode.Hiragana, unicode.Katakana):
+		return "ja"
+	case unicode.Is(unicode.Hangul, r):
+		return "ko"
+	case unicode.Is(unicode.Han, r):
+		// kanji and hanzi share the script, kana anywhere in the text means it's japanese
+		if hasKana {
+			return "ja"
+		}
+		return "zh"
+	case unicode.Is(unicode.Latin, r):
+		return "en"
+	default:
+		return ""
+	}
+}
+
+// detectLanguageRuns splits the text into runs of the same script. Neutral characters stick to the run
+// before them, or to the first run when the text starts with them. Text without any letters becomes
+// a single run in fallbackLanguage.
+func detectLanguageRuns(text string, fallbackLanguage string) []TextRun {
+	hasKana := strings.IndexFunc(text, func(r rune) bool {
+		return unicode.In(r, unicode.Hiragana, unicode.Katakana)
+	}) >= 0
+
+	var (
+		runs    []TextRun
+		current strings.Builder
+		lang    string
+	)
+	flush := func() {
+		if strings.TrimSpace(current.String()) != "" {
+			runs = append(runs, TextRun{Language: lang, Text: strings.TrimSpace(current.String())})
+		}
+		current.Reset()
+	}
+
+	for _, r := range text {
+		runeLanguage := scriptLanguage(r, hasKana)
+		switch {
+		case runeLanguage == "" || runeLanguage == lang:
+		case lang == "":
+			lang = runeLanguage
+		default:
+			flush()
+			lang = runeLanguage
+		}
+		current.WriteRune(r)
+	}
+	if lang == "" {
+		lang = fallbackLanguage
+	}
+	flush()
+	return runs
+}
+
+// languageOf returns the language part of a language code, e.g. th for th-TH
+func languageOf(languageCode string) string {
+	language, _, _ := strings.Cut(strings.ToLower(languageCode), "-")
+	if language == "cmn" || language == "yue" {
+		return "zh"
+	}
+	return language
+}
+
+// voiceForLanguage picks the voice to read a run in the given language, preferring the chosen voice
+func voiceForLanguage(provider TTSProvider, voice TTSVoice, language string) TTSVoice {
+	if languageOf(voice.LanguageCode) == language {
+		return voice
+	}
+
+	var fallback *TTSVoice
+	for _, catalogVoice := range provider.Voices() {
+		if languageOf(catalogVoice.LanguageCode) != language {
+			continue
+		}
+		if catalogVoice.LanguageCode == defaultLanguageCodes[language] {
+			fallback = &catalogVoice
+			break
+		}
+		if fallback == nil {
+			fallback = &catalogVoice
+		}
+	}
+	if fallback != nil {
+		voice.Name = fallback.Name
+		voice.LanguageCode = fallback.LanguageCode
+		return voice
+	}
+	if len(provider.Voices()) == 0 {
+		if languageCode, ok := defaultLanguageCodes[language]; ok {
+			voice.LanguageCode = languageCode
+		}
+	}
+	return voice
+}
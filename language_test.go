@@ -0,0 +1,172 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDetectLanguageRuns(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		fallback string
+		want     []TextRun
+	}{
+		{
+			name: "thai then english",
+			text: "สวัสดี hello",
+			want: []TextRun{{Language: "th", Text: "สวัสดี"}, {Language: "en", Text: "hello"}},
+		},
+		{
+			name: "english between thai",
+			text: "เพลง Shape of You เพราะมาก",
+			want: []TextRun{{Language: "th", Text: "เพลง"}, {Language: "en", Text: "Shape of You"}, {Language: "th", Text: "เพราะมาก"}},
+		},
+		{
+			name: "digits stick to the run before them",
+			text: "ราคา 100 บาท",
+			want: []TextRun{{Language: "th", Text: "ราคา 100 บาท"}},
+		},
+		{
+			name: "leading digits stick to the first run",
+			text: "100 บาท",
+			want: []TextRun{{Language: "th", Text: "100 บาท"}},
+		},
+		{
+			name: "punctuation next to each script",
+			text: "hello, สวัสดี! (ok)",
+			want: []TextRun{{Language: "en", Text: "hello,"}, {Language: "th", Text: "สวัสดี! ("}, {Language: "en", Text: "ok)"}},
+		},
+		{
+			name: "digits between scripts",
+			text: "track 2 เพลงที่ 3",
+			want: []TextRun{{Language: "en", Text: "track 2"}, {Language: "th", Text: "เพลงที่ 3"}},
+		},
+		{
+			name: "kana makes kanji japanese",
+			text: "今日はgood",
+			want: []TextRun{{Language: "ja", Text: "今日は"}, {Language: "en", Text: "good"}},
+		},
+		{
+			name: "kanji without kana is chinese",
+			text: "你好 hello",
+			want: []TextRun{{Language: "zh", Text: "你好"}, {Language: "en", Text: "hello"}},
+		},
+		{
+			name: "korean",
+			text: "안녕 สวัสดี",
+			want: []TextRun{{Language: "ko", Text: "안녕"}, {Language: "th", Text: "สวัสดี"}},
+		},
+		{
+			name:     "no letters uses the fallback",
+			text:     "123 !!",
+			fallback: "th",
+			want:     []TextRun{{Language: "th", Text: "123 !!"}},
+		},
+		{
+			name:     "empty",
+			text:     "",
+			fallback: "th",
+			want:     nil,
+		},
+		{
+			name:     "whitespace only",
+			text:     " \t\n ",
+			fallback: "th",
+			want:     nil,
+		},
+		{
+			name: "surrounding whitespace is trimmed",
+			text: "  hello  ",
+			want: []TextRun{{Language: "en", Text: "hello"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detectLanguageRuns(tt.text, tt.fallback); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("detectLanguageRuns(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLanguageOf(t *testing.T) {
+	tests := []struct {
+		languageCode string
+		want         string
+	}{
+		{languageCode: "th-TH", want: "th"},
+		{languageCode: "EN-us", want: "en"},
+		{languageCode: "ja", want: "ja"},
+		{languageCode: "cmn-CN", want: "zh"},
+		{languageCode: "yue-HK", want: "zh"},
+		{languageCode: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.languageCode, func(t *testing.T) {
+			if got := languageOf(tt.languageCode); got != tt.want {
+				t.Errorf("languageOf(%q) = %q, want %q", tt.languageCode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVoiceForLanguage(t *testing.T) {
+	thaiVoice := TTSVoice{LanguageCode: "th-TH", Name: "th-TH-Neural2-C", SpeakingRate: 0.8, Pitch: 1}
+	tests := []struct {
+		name     string
+		catalog  []TTSVoice
+		voice    TTSVoice
+		language string
+		want     TTSVoice
+	}{
+		{
+			name:     "chosen voice speaks the language",
+			catalog:  googleTTSVoices,
+			voice:    thaiVoice,
+			language: "th",
+			want:     thaiVoice,
+		},
+		{
+			name:     "prefers the default language code",
+			catalog:  []TTSVoice{{LanguageCode: "en-GB", Name: "en-GB-Neural2-A"}, {LanguageCode: "en-US", Name: "en-US-Neural2-C"}},
+			voice:    thaiVoice,
+			language: "en",
+			want:     TTSVoice{LanguageCode: "en-US", Name: "en-US-Neural2-C", SpeakingRate: 0.8, Pitch: 1},
+		},
+		{
+			name:     "falls back to the first voice of the language",
+			catalog:  []TTSVoice{{LanguageCode: "en-GB", Name: "en-GB-Neural2-A"}, {LanguageCode: "en-GB", Name: "en-GB-Neural2-B"}},
+			voice:    thaiVoice,
+			language: "en",
+			want:     TTSVoice{LanguageCode: "en-GB", Name: "en-GB-Neural2-A", SpeakingRate: 0.8, Pitch: 1},
+		},
+		{
+			name:     "no voice in the catalog keeps the chosen voice",
+			catalog:  googleTTSVoices,
+			voice:    thaiVoice,
+			language: "fr",
+			want:     thaiVoice,
+		},
+		{
+			name:     "empty catalog maps the language code",
+			voice:    TTSVoice{LanguageCode: "th", Name: "th"},
+			language: "en",
+			want:     TTSVoice{LanguageCode: "en-US", Name: "th"},
+		},
+		{
+			name:     "empty catalog without a mapped language keeps the voice",
+			voice:    TTSVoice{LanguageCode: "th", Name: "th"},
+			language: "fr",
+			want:     TTSVoice{LanguageCode: "th", Name: "th"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &stubTTSProvider{voices: tt.catalog}
+			if got := voiceForLanguage(provider, tt.voice, tt.language); got != tt.want {
+				t.Errorf("voiceForLanguage(%s) = %+v, want %+v", tt.language, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+)
+
+// the subsystems have their own log level, everything else logs as bot
+const (
+	LogSubsystemBot      = "bot"
+	LogSubsystemGateway  = "gateway"
+	LogSubsystemLavalink = "lavalink"
+	LogSubsystemDB       = "db"
+	LogSubsystemTTS      = "tts"
+)
+
+var (
+	logLevels = map[string]*slog.LevelVar{
+		LogSubsystemBot:      new(slog.LevelVar),
+		LogSubsystemGateway:  new(slog.LevelVar),
+		LogSubsystemLavalink: new(slog.LevelVar),
+		LogSubsystemDB:       new(slog.LevelVar),
+		LogSubsystemTTS:      new(slog.LevelVar),
+	}
+
+	gatewayLog  = slog.Default()
+	lavalinkLog = slog.Default()
+	dbLog       = slog.Default()
+	ttsLog      = slog.Default()
+)
+
+// levelHandler filters the records below the level of its subsystem, the level can change while running
+type levelHandler struct {
+	slog.Handler
+	level slog.Leveler
+}
+
+func (h levelHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+func (h levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return levelHandler{Handler: h.Handler.WithAttrs(attrs), level: h.level}
+}
+
+func (h levelHandler) WithGroup(name string) slog.Handler {
+	return levelHandler{Handler: h.Handler.WithGroup(name), level: h.level}
+}
+
+// setupLogging sets the default logger and the subsystem loggers, format is text or json
+func setupLogging(format string, level string, subsystemLevels map[string]string) error {
+	var handler slog.Handler
+	options := &slog.HandlerOptions{AddSource: true, Level: slog.LevelDebug}
+	switch format {
+	case "", "text":
+		handler = slog.NewTextHandler(os.Stderr, options)
+	case "json":
+		handler = slog.NewJSONHandler(os.Stderr, options)
+	default:
+		return fmt.Errorf("unknown log format %q, expected text or json", format)
+	}
+	if err := setLogLevels(level, subsystemLevels); err != nil {
+		return err
+	}
+
+	newLogger := func(subsystem string) *slog.Logger {
+		return slog.New(levelHandler{
+			Handler: handler.WithAttrs([]slog.Attr{slog.String("subsystem", subsystem)}),
+			level:   logLevels[subsystem],
+		})
+	}
+	slog.SetDefault(newLogger(LogSubsystemBot))
+	gatewayLog = newLogger(LogSubsystemGateway)
+	lavalinkLog = newLogger(LogSubsystemLavalink)
+	dbLog = newLogger(LogSubsystemDB)
+	ttsLog = newLogger(LogSubsystemTTS)
+	return nil
+}
+
+// setLogLevels sets every subsystem to level, subsystemLevels overrides it per subsystem, e.g. {"db": "debug"}
+func setLogLevels(level string, subsystemLevels map[string]string) error {
+	levels, err := parseLogLevels(level, subsystemLevels)
+	if err != nil {
+		return err
+	}
+	// only apply them once all are valid
+	for subsystem, subsystemLevel := range levels {
+		logLevels[subsystem].Set(subsystemLevel)
+	}
+	return nil
+}
+
+// parseLogLevels resolves the level of every subsystem without applying them
+func parseLogLevels(level string, subsystemLevels map[string]string) (map[string]slog.Level, error) {
+	defaultLevel := slog.LevelInfo
+	if level != "" {
+		if err := defaultLevel.UnmarshalText([]byte(level)); err != nil {
+			return nil, fmt.Errorf("invalid log level %q: %w", level, err)
+		}
+	}
+	levels := make(map[string]slog.Level, len(logLevels))
+	for subsystem := range logLevels {
+		levels[subsystem] = defaultLevel
+	}
+	for subsystem, value := range subsystemLevels {
+		if _, ok := logLevels[subsystem]; !ok {
+			return nil, fmt.Errorf("unknown log subsystem %q", subsystem)
+		}
+		var subsystemLevel slog.Level
+		if err := subsystemLevel.UnmarshalText([]byte(value)); err != nil {
+			return nil, fmt.Errorf("invalid log level %q of %s: %w", value, subsystem, err)
+		}
+		levels[subsystem] = subsystemLevel
+	}
+	return levels, nil
+}
+
+// fatal logs the error and exits, like log.Fatal
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+// newCorrelationID identifies the logs and the sentry events of one event
+func newCorrelationID() string {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -2,13 +2,16 @@ package main
 
 import (
 	"context"
+	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
 	"regexp"
-	"strconv"
 	"syscall"
 	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/loukhin/probably-a-music-bot/ent"
 
 	"github.com/disgoorg/disgo"
@@ -17,166 +20,230 @@ import (
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/rest"
 	"github.com/disgoorg/disgolink/v3/disgolink"
-	"github.com/disgoorg/log"
-	"github.com/disgoorg/snowflake/v2"
 	"github.com/getsentry/sentry-go"
 	_ "github.com/lib/pq"
 
 	_ "github.com/joho/godotenv/autoload"
 )
 
-var (
-	urlPattern = regexp.MustCompile("^https?://[-a-zA-Z0-9+&@#/%?=~_|!:,.;]*[-a-zA-Z0-9+&@#/%=~_|]?")
-
-	Debug, _ = strconv.ParseBool(os.Getenv("DEBUG"))
-
-	Token   = os.Getenv("TOKEN")
-	GuildId = snowflake.GetEnv("GUILD_ID")
-
-	NodeName      = os.Getenv("NODE_NAME")
-	NodeAddress   = os.Getenv("NODE_ADDRESS")
-	NodePassword  = os.Getenv("NODE_PASSWORD")
-	NodeSecure, _ = strconv.ParseBool(os.Getenv("NODE_SECURE"))
-
-	DatabaseConnectionString = os.Getenv("DATABASE_URL")
-
-	SentryDsn           = os.Getenv("SENTRY_DSN")
-	SentrySampleRate, _ = strconv.ParseFloat(os.Getenv("SENTRY_SAMPLE_RATE"), 64)
-)
+var urlPattern = regexp.MustCompile("^https?://[-a-zA-Z0-9+&@#/%?=~_|!:,.;]*[-a-zA-Z0-9+&@#/%=~_|]?")
 
 func main() {
-	log.SetFlags(log.LstdFlags | log.Lshortfile)
-	log.SetLevel(log.LevelInfo)
-	if Debug {
-		log.SetLevel(log.LevelDebug)
+	configPath := os.Getenv("CONFIG_FILE")
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		fatal("Can't load config", "path", configPath, "err", err)
 	}
-	log.Info("disgo version: ", disgo.Version)
-	log.Info("disgolink version: ", disgolink.Version)
-
-	var err error
+	activeConfig.Store(cfg)
+	if err = setupLogging(cfg.Log.Format, cfg.logLevel(), cfg.Log.Levels); err != nil {
+		fatal("Can't initialize logging", "err", err)
+	}
+	slog.Info("Starting", "disgo_version", disgo.Version, "disgolink_version", disgolink.Version)
 
-	if SentryDsn != "" {
+	if cfg.Sentry.DSN != "" {
 		err = sentry.Init(sentry.ClientOptions{
-			Dsn: SentryDsn,
+			Dsn: cfg.Sentry.DSN,
 			// Set TracesSampleRate to 1.0 to capture 100%
 			// of transactions for performance monitoring.
 			// We recommend adjusting this value in production,
-			TracesSampleRate: SentrySampleRate,
+			TracesSampleRate: cfg.Sentry.SampleRate,
 		})
 		if err != nil {
-			log.Fatalf("Can't initialize sentry: %s", err)
+			fatal("Can't initialize sentry", "err", err)
 		}
 		defer sentry.Flush(2 * time.Second)
 	}
 
 	b := newBot()
 
-	entClient, err := ent.Open("postgres", DatabaseConnectionString)
+	driver, err := entsql.Open(dialect.Postgres, cfg.Database.URL)
 	if err != nil {
-		log.Fatalf("Can't initialize ent: %s", err)
+		fatal("Can't initialize ent", "err", err)
 		return
 	}
+	entClient := ent.NewClient(ent.Driver(metricsDriver{Driver: driver}))
 	err = entClient.Ping()
 	if err != nil {
-		log.Fatalf("Can't initialize database connection: %s", err)
+		fatal("Can't initialize database connection", "err", err)
 		return
 	}
 	if len(os.Args) >= 2 && os.Args[1] == "--migrate" {
-		log.Info("--migrate flag present, migrating database changes...")
+		slog.Info("--migrate flag present, migrating database changes...")
 		err = migrateDatabase(entClient)
 		if err != nil {
-			log.Fatalf("failed creating schema resources: %v", err)
+			fatal("Failed creating schema resources", "err", err)
 			return
 		}
 	}
 	b.EntClient = entClient
 
-	client, err := disgo.New(Token,
+	client, err := disgo.New(cfg.Discord.Token,
 		bot.WithGatewayConfigOpts(
 			gateway.WithIntents(gateway.IntentGuilds|gateway.IntentGuildVoiceStates|gateway.IntentGuildMessages|gateway.IntentMessageContent),
 			gateway.WithPresenceOpts(gateway.WithPlayingActivity("something")),
 		),
-		bot.WithCacheConfigOpts(cache.WithCaches(cache.FlagVoiceStates, cache.FlagMessages, cache.FlagChannels, cache.FlagGuilds)),
-		bot.WithEventListenerFunc(b.onApplicationCommand),
-		bot.WithEventListenerFunc(b.onVoiceStateUpdate),
-		bot.WithEventListenerFunc(b.onVoiceServerUpdate),
-		bot.WithEventListenerFunc(b.onGuildJoin),
-		bot.WithEventListenerFunc(b.onGuildMessageCreate),
-		bot.WithEventListenerFunc(b.onGuildMessageUpdate),
+		bot.WithLogger(gatewayLog),
+		bot.WithRestClientConfigOpts(rest.WithHTTPClient(newRestMetricsClient())),
+		bot.WithCacheConfigOpts(cache.WithCaches(cache.FlagVoiceStates, cache.FlagMessages, cache.FlagChannels, cache.FlagGuilds, cache.FlagRoles)),
+		bot.WithEventListenerFunc(withScope(b, b.onApplicationCommand)),
+		bot.WithEventListenerFunc(withRecover(b, b.onAutocomplete)),
+		bot.WithEventListenerFunc(withRecover(b, b.onVoiceStateUpdate)),
+		bot.WithEventListenerFunc(withRecover(b, b.onVoiceServerUpdate)),
+		bot.WithEventListenerFunc(withRecover(b, b.onGuildJoin)),
+		bot.WithEventListenerFunc(withRecover(b, b.onGuildMessageCreate)),
+		bot.WithEventListenerFunc(withRecover(b, b.onGuildMessageUpdate)),
 		//bot.WithEventListeners(manager),
 	)
 	if err != nil {
 		sentry.CaptureException(err)
-		log.Fatalf("error while building disgo instance: %s", err)
+		fatal("Error while building disgo instance", "err", err)
 		return
 	}
 	b.Client = client
 
 	user, err := client.Rest().GetCurrentUser("")
 	if err != nil {
-		log.Fatalf("error while getting current user: %s", err)
+		fatal("Error while getting current user", "err", err)
 		return
 	}
-	log.Infof("authenticated as %s", user.Username)
+	slog.Info("Authenticated", "user", user.Username, "user_id", user.ID)
 
-	registerCommands(client)
+	go withTaskRecover("RegisterCommands", nil, func() {
+		b.retryRegisterCommands(client, commandRegisterRetryDelay)
+	})()
 
 	b.Lavalink = disgolink.New(client.ApplicationID(),
-		disgolink.WithListenerFunc(b.onPlayerPause),
-		disgolink.WithListenerFunc(b.onPlayerResume),
-		disgolink.WithListenerFunc(b.onTrackStart),
-		disgolink.WithListenerFunc(b.onTrackEnd),
-		disgolink.WithListenerFunc(b.onTrackException),
-		disgolink.WithListenerFunc(b.onTrackStuck),
-		disgolink.WithListenerFunc(b.onWebSocketClosed),
+		disgolink.WithLogger(lavalinkLog),
+		disgolink.WithListenerFunc(withPlayerRecover(b.onPlayerPause)),
+		disgolink.WithListenerFunc(withPlayerRecover(b.onPlayerResume)),
+		disgolink.WithListenerFunc(withPlayerRecover(b.onPlayerUpdate)),
+		disgolink.WithListenerFunc(withPlayerRecover(b.onTrackStart)),
+		disgolink.WithListenerFunc(withPlayerRecover(b.onTrackEnd)),
+		disgolink.WithListenerFunc(withPlayerRecover(b.onTrackException)),
+		disgolink.WithListenerFunc(withPlayerRecover(b.onTrackStuck)),
+		disgolink.WithListenerFunc(withPlayerRecover(b.onWebSocketClosed)),
 	)
+	b.addTTSProvider(NewLavalinkTTSProvider(b.Lavalink))
+	if cfg.TTS.HTTPURL != "" {
+		b.addTTSProvider(NewHTTPTTSProvider(b.Lavalink, cfg.TTS.HTTPURL, parseTTSVoices(cfg.TTS.HTTPVoices)))
+	}
+	if cfg.TTS.LocalCommand != "" {
+		localTTSProvider, err := NewLocalTTSProvider(b.Lavalink, cfg.TTS.LocalCommand, cfg.TTS.LocalDirectory, cfg.HTTP.PublicURL, parseTTSVoices(cfg.TTS.LocalVoices))
+		if err != nil {
+			fatal("Can't initialize local tts provider", "err", err)
+		}
+		b.addTTSProvider(localTTSProvider)
+		b.HTTP.Handle("GET /tts/{file}", localTTSProvider)
+	}
+	b.HTTP.HandleFunc("GET /cheers/{guild}/{tier}", b.serveCheerSound)
+	if cfg.Features.Overlay {
+		b.HTTP.HandleFunc("GET /overlay/{token}", b.serveOverlay)
+		b.HTTP.HandleFunc("GET /overlay/{token}/events", b.serveOverlayEvents)
+	}
+	if cfg.Features.API {
+		b.registerAPI()
+	}
+	if cfg.dashboardEnabled() {
+		NewDashboard(b, cfg.Discord.ClientSecret, cfg.dashboardRedirectURL(), cfg.Discord.AuthorizeURL, cfg.Discord.APIURL).Register(b.HTTP)
+	}
+	if secrets := cfg.donationWebhookSecrets(); cfg.Features.DonationWebhooks && len(secrets) > 0 {
+		b.HTTP.Handle("POST /webhooks/donation", NewDonationWebhook(b, secrets))
+	}
+
 	b.Handlers = map[string]func(event *events.ApplicationCommandInteractionCreate, data discord.SlashCommandInteractionData) error{
-		"play":        b.play,
-		"pause":       b.pause,
-		"now-playing": b.nowPlaying,
-		"stop":        b.stop,
-		"queue":       b.queue,
-		"clear-queue": b.clearQueue,
-		"repeat":      b.repeatType,
-		"shuffle":     b.shuffle,
-		"seek":        b.seek,
-		"volume":      b.volume,
-		"skip":        b.skip,
-		"disconnect":  b.disconnect,
-		"setup":       b.setup,
-		"remove":      b.removeQueue,
-		"tts":         b.tts,
-		"bits":        b.bits,
+		"play":             b.play,
+		"pause":            b.pause,
+		"now-playing":      b.nowPlaying,
+		"stop":             b.stop,
+		"queue":            b.queue,
+		"clear-queue":      b.clearQueue,
+		"repeat":           b.repeatType,
+		"shuffle":          b.shuffle,
+		"seek":             b.seek,
+		"volume":           b.volume,
+		"skip":             b.skip,
+		"disconnect":       b.disconnect,
+		"setup":            b.setup,
+		"settings":         b.settings,
+		"24-7":             b.alwaysOn,
+		"autoplay":         b.autoplayMode,
+		"sleep":            b.sleep,
+		"remove":           b.removeQueue,
+		"tts":              b.tts,
+		"bits":             b.bits,
+		"tts-voice":        b.ttsVoice,
+		"tts-dict":         b.ttsDictionary,
+		"tts-reader":       b.ttsReader,
+		"tts-opt-out":      b.ttsOptOut,
+		"tts-cache":        b.ttsCache,
+		"cheer-sound":      b.cheerSound,
+		"bits-leaderboard": b.bitsLeaderboard,
+		"play-next":        b.playNext,
+		"balance":          b.balance,
+		"leaderboard":      b.leaderboard,
+		"points":           b.points,
+		"webhooks":         b.webhooks,
+		"overlay":          b.overlay,
+		"api-token":        b.apiTokenCommand,
+	}
+
+	if cfg.HTTP.HealthAddress != "" {
+		healthMux := http.NewServeMux()
+		b.registerHealth(healthMux)
+		if cfg.Features.Metrics {
+			b.registerMetrics(healthMux)
+		}
+		healthServer := startHTTPServer("health", cfg.HTTP.HealthAddress, healthMux)
+		defer shutdownHTTPServer(healthServer)
+	} else {
+		b.registerHealth(b.HTTP)
+		if cfg.Features.Metrics {
+			slog.Warn("Metrics are only served on http.health_address, set it to scrape them")
+		}
+	}
+	if cfg.HTTP.Address != "" {
+		httpServer := startHTTPServer("http", cfg.HTTP.Address, b.HTTP)
+		defer shutdownHTTPServer(httpServer)
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	if err = client.OpenGateway(ctx); err != nil {
-		log.Fatal(err)
+		fatal("Can't open gateway", "err", err)
 	}
 	defer client.Close(context.TODO())
 	defer func(client *ent.Client) {
 		_ = client.Close()
 	}(b.EntClient)
 
-	node, err := b.Lavalink.AddNode(ctx, disgolink.NodeConfig{
-		Name:     NodeName,
-		Address:  NodeAddress,
-		Password: NodePassword,
-		Secure:   NodeSecure,
-	})
-	if err != nil {
-		log.Fatal(err)
-	}
-	version, err := node.Version(ctx)
-	if err != nil {
-		log.Fatal(err)
+	for _, nodeConfig := range cfg.Nodes {
+		node, err := b.Lavalink.AddNode(ctx, nodeConfig.disgolinkConfig())
+		if err != nil {
+			fatal("Can't add lavalink node", "node", nodeConfig.Name, "err", err)
+		}
+		version, err := node.Version(ctx)
+		if err != nil {
+			fatal("Can't get lavalink node version", "node", nodeConfig.Name, "err", err)
+		}
+		lavalinkLog.Info("Node connected", "node", node.Config().Name, "version", version)
 	}
-	log.Infof("node version: %s", version)
 
-	log.Infof("bot is now running. Press CTRL and C on your keyboard together to exit.")
+	b.restoreAlwaysOn()
+	b.restoreSleepTimers()
+	b.startPointsTicker()
+	b.Webhooks.StartPruning()
+
+	slog.Info("Bot is now running. Press CTRL and C on your keyboard together to exit.")
 	s := make(chan os.Signal, 1)
-	signal.Notify(s, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
-	<-s
+	signal.Notify(s, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP, os.Interrupt)
+	for sig := range s {
+		if sig != syscall.SIGHUP {
+			break
+		}
+		reloadCtx, reloadCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		b.reloadConfig(reloadCtx, configPath)
+		reloadCancel()
+	}
 }
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/disgoorg/disgolink/v3/disgolink"
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "music_bot"
+
+var (
+	commandsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "commands_total",
+		Help:      "Slash commands handled, by command and result.",
+	}, []string{"command", "result"})
+	commandDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "command_duration_seconds",
+		Help:      "Time spent handling slash commands.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+	}, []string{"command"})
+
+	loadTracksDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "lavalink_load_tracks_duration_seconds",
+		Help:      "Latency of lavalink LoadTracks requests, by load type.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+	}, []string{"load_type"})
+	trackEventsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "track_events_total",
+		Help:      "Lavalink track events, by event and end reason.",
+	}, []string{"event", "reason"})
+
+	restRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "discord_rest_requests_total",
+		Help:      "Discord REST requests, by method and status code.",
+	}, []string{"method", "status"})
+	restRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "discord_rest_request_duration_seconds",
+		Help:      "Latency of Discord REST requests.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+	restRateLimitsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "discord_rest_rate_limits_total",
+		Help:      "Discord REST responses with status 429, by rate limit scope.",
+	}, []string{"scope"})
+
+	ttsSynthesesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "tts_syntheses_total",
+		Help:      "TTS clips requested, by provider and result (ok, cached or error).",
+	}, []string{"provider", "result"})
+	ttsSynthesisDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "tts_synthesis_duration_seconds",
+		Help:      "Time spent synthesizing TTS clips that weren't cached.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+	}, []string{"provider"})
+
+	dbQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Latency of database queries, by statement.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 12),
+	}, []string{"statement"})
+)
+
+// registerMetrics adds the gauges read from the bot state and serves all metrics on /metrics of mux,
+// it's the health listener, the metrics aren't meant for the public
+func (b *Bot) registerMetrics(mux *http.ServeMux) {
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "players_active",
+		Help:      "Players with a playing track.",
+	}, func() float64 {
+		var active int
+		b.Lavalink.ForPlayers(func(player disgolink.Player) {
+			if player.Track() != nil {
+				active++
+			}
+		})
+		return float64(active)
+	})
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "queue_tracks",
+		Help:      "Tracks waiting in the queues of all guilds.",
+	}, func() float64 {
+		return float64(b.Guilds.queuedTracks())
+	})
+
+	mux.Handle("GET /metrics", promhttp.Handler())
+}
+
+// queuedTracks counts the tracks in all queues
+func (gm *GuildManager) queuedTracks() int {
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+	var tracks int
+	for _, guild := range gm.guilds {
+		tracks += guild.queue.Len()
+	}
+	return tracks
+}
+
+// observeCommand records a handled slash command, result is ok, error or unknown
+func observeCommand(command string, result string, start time.Time) {
+	commandsTotal.WithLabelValues(command, result).Inc()
+	commandDuration.WithLabelValues(command).Observe(time.Since(start).Seconds())
+}
+
+func observeTrackEvent(event string, reason lavalink.TrackEndReason) {
+	trackEventsTotal.WithLabelValues(event, string(reason)).Inc()
+}
+
+// loadTracks is node.LoadTracks timed by the load type of the result
+func loadTracks(ctx context.Context, node disgolink.Node, identifier string) (*lavalink.LoadResult, error) {
+	start := time.Now()
+	result, err := node.LoadTracks(ctx, identifier)
+	loadType := "failed"
+	if err == nil {
+		loadType = string(result.LoadType)
+	}
+	loadTracksDuration.WithLabelValues(loadType).Observe(time.Since(start).Seconds())
+	return result, err
+}
+
+// restMetricsTransport counts the requests the disgo rest client sends to Discord
+type restMetricsTransport struct {
+	http.RoundTripper
+}
+
+func newRestMetricsClient() *http.Client {
+	return &http.Client{
+		Timeout:   20 * time.Second,
+		Transport: restMetricsTransport{RoundTripper: http.DefaultTransport},
+	}
+}
+
+func (t restMetricsTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	start := time.Now()
+	response, err := t.RoundTripper.RoundTrip(request)
+	restRequestDuration.WithLabelValues(request.Method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		restRequestsTotal.WithLabelValues(request.Method, "failed").Inc()
+		return response, err
+	}
+	restRequestsTotal.WithLabelValues(request.Method, strconv.Itoa(response.StatusCode)).Inc()
+	if response.StatusCode == http.StatusTooManyRequests {
+		scope := response.Header.Get("X-RateLimit-Scope")
+		if scope == "" {
+			scope = "unknown"
+		}
+		restRateLimitsTotal.WithLabelValues(scope).Inc()
+	}
+	return response, nil
+}
+
+// metricsDriver times the queries ent sends to the database, DB() is still reachable for ent.Client.Ping
+type metricsDriver struct {
+	*entsql.Driver
+}
+
+func (d metricsDriver) Exec(ctx context.Context, query string, args, v any) error {
+	defer observeQuery(query, time.Now())
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d metricsDriver) Query(ctx context.Context, query string, args, v any) error {
+	defer observeQuery(query, time.Now())
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d metricsDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return metricsTx{Tx: tx}, nil
+}
+
+func (d metricsDriver) BeginTx(ctx context.Context, opts *entsql.TxOptions) (dialect.Tx, error) {
+	tx, err := d.Driver.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return metricsTx{Tx: tx}, nil
+}
+
+type metricsTx struct {
+	dialect.Tx
+}
+
+func (tx metricsTx) Exec(ctx context.Context, query string, args, v any) error {
+	defer observeQuery(query, time.Now())
+	return tx.Tx.Exec(ctx, query, args, v)
+}
+
+func (tx metricsTx) Query(ctx context.Context, query string, args, v any) error {
+	defer observeQuery(query, time.Now())
+	return tx.Tx.Query(ctx, query, args, v)
+}
+
+// observeQuery records the query latency by its statement keyword, the full query would make too many series
+func observeQuery(query string, start time.Time) {
+	duration := time.Since(start)
+	dbLog.Debug("Query", "query", query, "duration", duration)
+	statement, _, _ := strings.Cut(strings.TrimSpace(query), " ")
+	switch statement = strings.ToUpper(statement); statement {
+	case "SELECT", "INSERT", "UPDATE", "DELETE", "WITH":
+	default:
+		statement = "OTHER"
+	}
+	dbQueryDuration.WithLabelValues(statement).Observe(duration.Seconds())
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// testMetricsMux serves the metrics of the first test bot, the collectors can only be registered once per process
+var (
+	testMetricsOnce sync.Once
+	testMetricsMux  = http.NewServeMux()
+)
+
+func TestRegisterMetrics(t *testing.T) {
+	b, _, _ := newTestBot(t)
+	testMetricsOnce.Do(func() {
+		b.registerMetrics(testMetricsMux)
+	})
+
+	recorder := httptest.NewRecorder()
+	testMetricsMux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), metricsNamespace+"_queue_tracks") {
+		t.Errorf("health listener served %d, want the metrics", recorder.Code)
+	}
+	recorder = httptest.NewRecorder()
+	b.HTTP.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("public listener served %d, want %d", recorder.Code, http.StatusNotFound)
+	}
+}
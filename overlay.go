@@ -0,0 +1,302 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+)
+
+// overlayKeepAlive is how often a comment is sent so proxies don't close idle event streams
+const overlayKeepAlive = 15 * time.Second
+
+//go:embed overlay.html
+var overlayPage []byte
+
+type OverlayTrack struct {
+	Title      string `json:"title"`
+	Author     string `json:"author"`
+	URI        string `json:"uri,omitempty"`
+	ArtworkURL string `json:"artwork_url,omitempty"`
+	Length     int64  `json:"length_ms"`
+	IsStream   bool   `json:"is_stream"`
+	Requester  string `json:"requester,omitempty"`
+	Autoplay   bool   `json:"autoplay"`
+}
+
+type OverlayAlert struct {
+	Text    string `json:"text"`
+	Bits    int    `json:"bits,omitempty"`
+	Color   string `json:"color,omitempty"`
+	GIFURL  string `json:"gif_url,omitempty"`
+	Playing bool   `json:"playing"`
+}
+
+// OverlayState is what the overlay page renders, position is the position at updated_at
+type OverlayState struct {
+	Track     *OverlayTrack  `json:"track"`
+	Position  int64          `json:"position_ms"`
+	Paused    bool           `json:"paused"`
+	Queue     []OverlayTrack `json:"queue"`
+	QueueSize int            `json:"queue_size"`
+	Alerts    []OverlayAlert `json:"alerts"`
+	UpdatedAt int64          `json:"updated_at"`
+}
+
+// OverlayHub streams the player state of a guild to its overlay pages
+type OverlayHub struct {
+	bot *Bot
+
+	mu          sync.Mutex
+	subscribers map[snowflake.ID]map[chan []byte]struct{}
+}
+
+func NewOverlayHub(bot *Bot) *OverlayHub {
+	return &OverlayHub{
+		bot:         bot,
+		subscribers: make(map[snowflake.ID]map[chan []byte]struct{}),
+	}
+}
+
+func newOverlayToken() string {
+	token := make([]byte, 24)
+	_, _ = rand.Read(token)
+	return hex.EncodeToString(token)
+}
+
+func newOverlayTrack(track lavalink.Track) OverlayTrack {
+	userData := getTrackUserData(track)
+	overlayTrack := OverlayTrack{
+		Title:     track.Info.Title,
+		Author:    track.Info.Author,
+		Length:    track.Info.Length.Milliseconds(),
+		IsStream:  track.Info.IsStream,
+		Requester: userData.Requester,
+		Autoplay:  userData.Autoplay,
+	}
+	if track.Info.URI != nil {
+		overlayTrack.URI = *track.Info.URI
+	}
+	if track.Info.ArtworkURL != nil {
+		overlayTrack.ArtworkURL = *track.Info.ArtworkURL
+	}
+	return overlayTrack
+}
+
+func (h *OverlayHub) hasSubscribers(guildID snowflake.ID) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subscribers[guildID]) > 0
+}
+
+func (h *OverlayHub) subscribe(guildID snowflake.ID) chan []byte {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	subscriber := make(chan []byte, 1)
+	if h.subscribers[guildID] == nil {
+		h.subscribers[guildID] = make(map[chan []byte]struct{})
+	}
+	h.subscribers[guildID][subscriber] = struct{}{}
+	return subscriber
+}
+
+func (h *OverlayHub) unsubscribe(guildID snowflake.ID, subscriber chan []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subscribers[guildID], subscriber)
+	if len(h.subscribers[guildID]) == 0 {
+		delete(h.subscribers, guildID)
+	}
+}
+
+// Publish sends the current state of the guild to its overlays, it's called whenever the player message is rendered
+func (h *OverlayHub) Publish(guildID snowflake.ID) {
+	if !h.hasSubscribers(guildID) {
+		return
+	}
+	state, err := json.Marshal(h.bot.overlayState(guildID))
+	if err != nil {
+		slog.Error("Failed to encode overlay state", "guild_id", guildID, "err", err)
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for subscriber := range h.subscribers[guildID] {
+		// a slow overlay only needs the latest state, drop the one it didn't read yet
+		select {
+		case <-subscriber:
+		default:
+		}
+		subscriber <- state
+	}
+}
+
+// overlayState collects the same player state updatePlayerMessage renders
+func (b *Bot) overlayState(guildID snowflake.ID) OverlayState {
+	state := OverlayState{
+		Queue:     make([]OverlayTrack, 0),
+		Alerts:    make([]OverlayAlert, 0),
+		UpdatedAt: time.Now().UnixMilli(),
+	}
+	guild := b.Guilds.Get(guildID)
+	guild.mu.Lock()
+	interrupted, announcements := guild.interrupted, slices.Clone(guild.announcements)
+	var interruptedTrack *lavalink.Track
+	var interruptedPosition lavalink.Duration
+	if interrupted != nil {
+		interruptedTrack, interruptedPosition = interrupted.track, interrupted.position
+	}
+	guild.mu.Unlock()
+
+	// an announcement is split into several clips, it's shown once
+	lastAnnouncement := ""
+	if player := b.Lavalink.ExistingPlayer(guildID); player != nil {
+		state.Paused = player.Paused()
+		if track := player.Track(); track != nil {
+			if isTTSTrack(*track) {
+				if announcement := getTrackUserData(*track).Announcement; announcement != nil {
+					state.Alerts = append(state.Alerts, newOverlayAlert(*announcement, true))
+					lastAnnouncement = announcement.ID
+				}
+				// the music resumes after the announcement, keep showing it meanwhile
+				if interruptedTrack != nil {
+					overlayTrack := newOverlayTrack(*interruptedTrack)
+					state.Track = &overlayTrack
+					state.Position = interruptedPosition.Milliseconds()
+					state.Paused = true
+				}
+			} else {
+				overlayTrack := newOverlayTrack(*track)
+				state.Track = &overlayTrack
+				state.Position = player.Position().Milliseconds()
+			}
+		}
+	}
+
+	for _, clip := range announcements {
+		announcement := getTrackUserData(clip).Announcement
+		if announcement == nil || announcement.ID == lastAnnouncement {
+			continue
+		}
+		lastAnnouncement = announcement.ID
+		state.Alerts = append(state.Alerts, newOverlayAlert(*announcement, false))
+	}
+
+	queue := guild.queue.Snapshot()
+	state.QueueSize = len(queue.Tracks)
+	for i := 0; i < min(5, len(queue.Tracks)); i++ {
+		state.Queue = append(state.Queue, newOverlayTrack(queue.Tracks[i]))
+	}
+	return state
+}
+
+func newOverlayAlert(announcement Announcement, playing bool) OverlayAlert {
+	alert := OverlayAlert{
+		Text:    announcement.Text,
+		Bits:    announcement.Bits,
+		Playing: playing,
+	}
+	if announcement.Bits > 0 {
+		tier := cheerTierFor(announcement.Bits)
+		alert.Color = fmt.Sprintf("#%06x", tier.Color)
+		alert.GIFURL = tier.GIFURL
+	}
+	return alert
+}
+
+// overlayGuildID looks up the guild an overlay token belongs to
+func (b *Bot) overlayGuildID(ctx context.Context, token string) (snowflake.ID, bool) {
+	if token == "" {
+		return 0, false
+	}
+	guildID, err := b.EntClient.Guild.Query().Where(guild.OverlayToken(token)).OnlyID(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			slog.Error("Failed to load overlay guild", "err", err)
+		}
+		return 0, false
+	}
+	return guildID, true
+}
+
+// overlayURL returns the overlay url of the guild, a token is generated on first use or when regenerate is set
+func (b *Bot) overlayURL(ctx context.Context, guildID snowflake.ID, regenerate bool) (string, error) {
+	dbGuild, err := b.EntClient.Guild.Get(ctx, guildID)
+	if err != nil {
+		return "", err
+	}
+	token := ""
+	if dbGuild.OverlayToken != nil {
+		token = *dbGuild.OverlayToken
+	}
+	if token == "" || regenerate {
+		token = newOverlayToken()
+		if _, err = dbGuild.Update().SetOverlayToken(token).Save(ctx); err != nil {
+			return "", err
+		}
+	}
+	return fmt.Sprintf("%s/overlay/%s", strings.TrimSuffix(currentConfig().HTTP.PublicURL, "/"), token), nil
+}
+
+func (b *Bot) serveOverlay(w http.ResponseWriter, r *http.Request) {
+	if _, ok := b.overlayGuildID(r.Context(), r.PathValue("token")); !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(overlayPage)
+}
+
+// serveOverlayEvents streams the player state as server-sent events
+func (b *Bot) serveOverlayEvents(w http.ResponseWriter, r *http.Request) {
+	guildID, ok := b.overlayGuildID(r.Context(), r.PathValue("token"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	subscriber := b.Overlays.subscribe(guildID)
+	defer b.Overlays.unsubscribe(guildID, subscriber)
+	b.Overlays.Publish(guildID)
+
+	keepAlive := time.NewTicker(overlayKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case state := <-subscriber:
+			if _, err := fmt.Fprintf(w, "event: state\ndata: %s\n\n", state); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
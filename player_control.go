@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// the player actions below are shared by the slash commands and the REST API
+
+var (
+	errNoPlayer      = errors.New("no player found")
+	errInvalidVolume = errors.New("volume must be between 0 and 100")
+	errNotInVoice    = errors.New("please join a voice channel")
+	errOtherChannel  = errors.New("bot is already in another voice channel")
+	errJoinVoice     = errors.New("failed to join voice channel")
+	errNoTracks      = errors.New("no tracks found")
+	errLoadTracks    = errors.New("failed to load tracks")
+)
+
+// queueTracks adds already loaded tracks to the queue and starts playing in the voice channel of the member
+func (b *Bot) queueTracks(guildID snowflake.ID, user discord.Member, tracks ...lavalink.Track) error {
+	voiceState, ok := b.Client.Caches().VoiceState(guildID, user.User.ID)
+	if !ok || voiceState.ChannelID == nil {
+		return errNotInVoice
+	}
+	botVoiceState, ok := b.Client.Caches().VoiceState(guildID, b.Client.ID())
+	if ok && botVoiceState.ChannelID != nil && *botVoiceState.ChannelID != *voiceState.ChannelID {
+		return errOtherChannel
+	}
+
+	for i, track := range tracks {
+		if track, err := track.WithUserData(TrackUserData{Requester: user.EffectiveName()}); err == nil {
+			tracks[i] = track
+		}
+	}
+	queue := b.Guilds.GetQueue(guildID)
+	player := b.Lavalink.Player(guildID)
+	playNow := player.Track() == nil || isAutoplayTrack(player.Track())
+	queue.Add(tracks...)
+
+	if playNow {
+		if track, ok := queue.Next(); ok {
+			if ok := b.updateVoiceState(guildID, voiceState.ChannelID); !ok {
+				return errJoinVoice
+			}
+			if err := player.Update(context.TODO(), lavalink.WithTrack(track)); err != nil {
+				return err
+			}
+		}
+	}
+	b.updatePlayerMessage(guildID)
+	return nil
+}
+
+// setPaused pauses or resumes the player
+func (b *Bot) setPaused(guildID snowflake.ID, paused bool) error {
+	player := b.Lavalink.ExistingPlayer(guildID)
+	if player == nil {
+		return errNoPlayer
+	}
+	if err := player.Update(context.TODO(), lavalink.WithPaused(paused)); err != nil {
+		return err
+	}
+	b.updatePlayerMessage(guildID)
+	return nil
+}
+
+// skipTracks plays the amount-th track of the queue, it returns false when the queue ran out and the player was stopped.
+// An empty queue is handled like the end of the last track: 24/7 guilds play the fallback, the others wait for the idle timeout.
+func (b *Bot) skipTracks(guildID snowflake.ID, amount int) (bool, error) {
+	player := b.Lavalink.ExistingPlayer(guildID)
+	if player == nil {
+		return false, errNoPlayer
+	}
+	queue := b.Guilds.GetQueue(guildID)
+
+	track, ok := queue.Skip(amount)
+	if !ok {
+		if err := player.Update(context.TODO(), lavalink.WithNullTrack()); err != nil {
+			return false, err
+		}
+		if b.playFallback(guildID) {
+			return true, nil
+		}
+		b.startIdleTimer(guildID, IdleReasonQueueEnded)
+		return false, nil
+	}
+
+	if err := player.Update(context.TODO(), lavalink.WithTrack(track)); err != nil {
+		return true, err
+	}
+	b.updatePlayerMessage(guildID)
+	return true, nil
+}
+
+func (b *Bot) seekTo(guildID snowflake.ID, position lavalink.Duration) error {
+	player := b.Lavalink.ExistingPlayer(guildID)
+	if player == nil {
+		return errNoPlayer
+	}
+	return player.Update(context.TODO(), lavalink.WithPosition(position))
+}
+
+func (b *Bot) setVolume(guildID snowflake.ID, volume int) error {
+	player := b.Lavalink.ExistingPlayer(guildID)
+	if player == nil {
+		return errNoPlayer
+	}
+	if volume < 0 || volume > 100 {
+		return errInvalidVolume
+	}
+	return b.setMusicVolume(player, volume)
+}
+
+func (b *Bot) setRepeatMode(guildID snowflake.ID, mode QueueType) {
+	queue := b.Guilds.GetQueue(guildID)
+	queue.SetType(mode)
+	b.Stream.Publish(guildID, StreamEventQueueRepeat, StreamRepeatEvent{RepeatMode: mode})
+	b.updatePlayerMessage(guildID)
+}
+
+// removeQueueTrack removes the track at the index of the queue
+func (b *Bot) removeQueueTrack(guildID snowflake.ID, index int) (lavalink.Track, bool) {
+	removedTrack, ok := b.Guilds.GetQueue(guildID).Remove(index)
+	if ok {
+		b.updatePlayerMessage(guildID)
+	}
+	return removedTrack, ok
+}
+
+// moveQueueTrack moves the track at from to the index to of the queue
+func (b *Bot) moveQueueTrack(guildID snowflake.ID, from int, to int) bool {
+	ok := b.Guilds.GetQueue(guildID).Move(from, to)
+	if ok {
+		b.updatePlayerMessage(guildID)
+	}
+	return ok
+}
@@ -6,64 +6,137 @@ import (
 
 	"github.com/disgoorg/disgolink/v3/disgolink"
 	"github.com/disgoorg/disgolink/v3/lavalink"
-	"github.com/disgoorg/log"
 )
 
 func (b *Bot) onPlayerPause(_ disgolink.Player, event lavalink.PlayerPauseEvent) {
+	b.Stream.Publish(event.GuildID(), StreamEventPlayerPause, nil)
 	// fmt.Printf("onPlayerPause: %v\n", event)
 }
 
 func (b *Bot) onPlayerResume(_ disgolink.Player, event lavalink.PlayerResumeEvent) {
+	b.Stream.Publish(event.GuildID(), StreamEventPlayerResume, nil)
 	// fmt.Printf("onPlayerResume: %v\n", event)
 }
 
+func (b *Bot) onPlayerUpdate(_ disgolink.Player, event lavalink.PlayerUpdateMessage) {
+	b.Stream.Publish(event.GuildID, StreamEventPlayerUpdate, StreamPositionEvent{
+		Position:  event.State.Position.Milliseconds(),
+		Connected: event.State.Connected,
+		Ping:      event.State.Ping,
+	})
+}
+
 func (b *Bot) onTrackStart(_ disgolink.Player, event lavalink.TrackStartEvent) {
+	observeTrackEvent(WebhookEventTrackStart, "")
+	trackEvent := newWebhookTrackEvent(event.Track)
+	b.Webhooks.Dispatch(event.GuildID(), WebhookEventTrackStart, trackEvent)
+	b.Stream.Publish(event.GuildID(), WebhookEventTrackStart, trackEvent)
+	guild := b.Guilds.Get(event.GuildID())
+	guild.mu.Lock()
+	guild.notice = ""
+	resumed := guild.resumed != "" && guild.resumed == event.Track.Encoded
+	guild.resumed = ""
+	guild.mu.Unlock()
+	if !isTTSTrack(event.Track) && !resumed {
+		guild.addHistory(event.Track)
+		go withTaskRecover("RecordTrackPlay", guildTags(event.GuildID()), func() {
+			b.recordTrackPlay(event.GuildID(), event.Track)
+		})()
+	}
+	b.stopIdleTimer(event.GuildID(), IdleReasonQueueEnded)
 	b.updatePlayerMessage(event.GuildID())
 	// fmt.Printf("onTrackStart: %v\n", event)
 }
 
 func (b *Bot) onTrackEnd(player disgolink.Player, event lavalink.TrackEndEvent) {
+	observeTrackEvent(WebhookEventTrackEnd, event.Reason)
+	trackEvent := newWebhookTrackEvent(event.Track)
+	trackEvent.Reason = string(event.Reason)
+	b.Webhooks.Dispatch(event.GuildID(), WebhookEventTrackEnd, trackEvent)
+	b.Stream.Publish(event.GuildID(), WebhookEventTrackEnd, trackEvent)
+
+	isTTS := isTTSTrack(event.Track)
+	if isTTS && b.nextAnnouncement(player, event) {
+		return
+	}
 	if !event.Reason.MayStartNext() {
 		return
 	}
 
+	if sleep := b.Guilds.Get(event.GuildID()).sleepTimer(); sleep != nil && sleep.EndOfTrack {
+		b.sleepNow(event.GuildID(), sleep)
+		return
+	}
+
 	queue := b.Guilds.GetQueue(event.GuildID())
 	var (
 		nextTrack lavalink.Track
 		ok        bool
 	)
 	event.Track.Info.Position = 0
-	switch queue.Type {
-	case QueueTypeNoRepeat:
+	queueType := queue.Type()
+	switch {
+	case isTTS, queueType == QueueTypeNoRepeat:
 		nextTrack, ok = queue.Next()
 
-	case QueueTypeRepeatTrack:
+	case queueType == QueueTypeRepeatTrack:
 		nextTrack = event.Track
 		ok = true
 
-	case QueueTypeRepeatQueue:
+	case queueType == QueueTypeRepeatQueue:
 		queue.Add(event.Track)
 		nextTrack, ok = queue.Next()
 	}
 
+	if !ok && !isTTS {
+		nextTrack, ok = b.autoplay(event.GuildID(), event.Track)
+	}
 	if !ok {
-		b.updatePlayerMessage(event.GuildID())
-		b.updateVoiceState(event.GuildID(), nil)
+		if b.playFallback(event.GuildID()) {
+			return
+		}
+		b.startIdleTimer(event.GuildID(), IdleReasonQueueEnded)
 		return
 	}
 	if err := player.Update(context.TODO(), lavalink.WithTrack(nextTrack)); err != nil {
-		log.Error("Failed to play next track: ", err)
+		lavalinkLog.Error("Failed to play next track", "guild_id", event.GuildID(), "track", nextTrack.Info.Identifier, "err", err)
 	}
 }
 
 func (b *Bot) onTrackException(_ disgolink.Player, event lavalink.TrackExceptionEvent) {
-	fmt.Printf("onTrackException: %v\n", event)
+	lavalinkLog.Warn("Track exception", "guild_id", event.GuildID(), "track", event.Track.Info.Identifier, "severity", event.Exception.Severity, "err", event.Exception.Error())
+	observeTrackEvent(WebhookEventTrackException, "")
+	trackEvent := newWebhookTrackEvent(event.Track)
+	trackEvent.Exception = event.Exception.Error()
+	b.Webhooks.Dispatch(event.GuildID(), WebhookEventTrackException, trackEvent)
+	b.Stream.Publish(event.GuildID(), WebhookEventTrackException, trackEvent)
 }
 
 func (b *Bot) onTrackStuck(_ disgolink.Player, event lavalink.TrackStuckEvent) {
-	fmt.Printf("onTrackStuck: %v\n", event)
+	lavalinkLog.Warn("Track stuck", "guild_id", event.GuildID(), "track", event.Track.Info.Identifier, "threshold", event.Threshold)
+	observeTrackEvent(WebhookEventTrackStuck, "")
+	trackEvent := newWebhookTrackEvent(event.Track)
+	trackEvent.Threshold = event.Threshold.Milliseconds()
+	b.Webhooks.Dispatch(event.GuildID(), WebhookEventTrackStuck, trackEvent)
+	b.Stream.Publish(event.GuildID(), WebhookEventTrackStuck, trackEvent)
 }
 
-func (b *Bot) onWebSocketClosed(_ disgolink.Player, event lavalink.WebSocketClosedEvent) {
-	// fmt.Printf("onWebSocketClosed: %v\n", event)
+func (b *Bot) onWebSocketClosed(player disgolink.Player, event lavalink.WebSocketClosedEvent) {
+	lavalinkLog.Warn("Voice websocket closed", "guild_id", event.GuildID(), "code", event.Code, "reason", event.Reason, "by_remote", event.ByRemote)
+	if event.Code == 1000 {
+		return
+	}
+	// leaving the channel ourselves also closes with 4014, there is nothing to report then or when nothing was playing
+	if event.Code == 4014 {
+		guild := b.Guilds.Get(event.GuildID())
+		if guild.leaving.Load() || player.Track() == nil {
+			guild.leaving.Store(false)
+			return
+		}
+	}
+
+	if isResumableVoiceCloseCode(event.Code) && b.reconnectVoice(player) {
+		return
+	}
+	b.teardownPlayer(event.GuildID(), fmt.Sprintf("Voice connection closed: %s", voiceCloseReason(event.Code, event.Reason)))
 }
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent"
+	"github.com/loukhin/probably-a-music-bot/ent/playlist"
+)
+
+// playlistMaxTracks is the largest playlist that can be saved
+const playlistMaxTracks = 500
+
+var (
+	errEmptyPlaylist    = errors.New("nothing to save, the queue is empty")
+	errPlaylistNotFound = errors.New("playlist not found")
+)
+
+type SavedPlaylist struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Tracks    int       `json:"tracks"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func newSavedPlaylist(dbPlaylist *ent.Playlist) SavedPlaylist {
+	return SavedPlaylist{
+		ID:        dbPlaylist.ID,
+		Name:      dbPlaylist.Name,
+		Tracks:    len(dbPlaylist.Tracks),
+		UpdatedAt: dbPlaylist.UpdatedAt,
+	}
+}
+
+func (b *Bot) playlists(ctx context.Context, guildID snowflake.ID) ([]SavedPlaylist, error) {
+	dbPlaylists, err := b.EntClient.Playlist.Query().
+		Where(playlist.GuildID(guildID)).
+		Order(ent.Asc(playlist.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	playlists := make([]SavedPlaylist, 0, len(dbPlaylists))
+	for _, dbPlaylist := range dbPlaylists {
+		playlists = append(playlists, newSavedPlaylist(dbPlaylist))
+	}
+	return playlists, nil
+}
+
+// saveQueueAsPlaylist saves the playing track and the queue under the name, an existing playlist with that name is replaced
+func (b *Bot) saveQueueAsPlaylist(ctx context.Context, guildID snowflake.ID, name string) (SavedPlaylist, error) {
+	var tracks []string
+	if player := b.Lavalink.ExistingPlayer(guildID); player != nil {
+		if track := player.Track(); track != nil && !isTTSTrack(*track) {
+			tracks = append(tracks, track.Encoded)
+		}
+	}
+	for _, track := range b.Guilds.GetQueue(guildID).Snapshot().Tracks {
+		tracks = append(tracks, track.Encoded)
+	}
+	if len(tracks) == 0 {
+		return SavedPlaylist{}, errEmptyPlaylist
+	}
+	if len(tracks) > playlistMaxTracks {
+		tracks = tracks[:playlistMaxTracks]
+	}
+
+	id, err := b.EntClient.Playlist.Create().
+		SetGuildID(guildID).
+		SetName(strings.TrimSpace(name)).
+		SetTracks(tracks).
+		OnConflict(
+			sql.ConflictColumns(playlist.FieldGuildID, playlist.FieldName),
+		).
+		Update(func(u *ent.PlaylistUpsert) {
+			u.UpdateTracks()
+			u.UpdateUpdatedAt()
+		}).
+		ID(ctx)
+	if err != nil {
+		return SavedPlaylist{}, err
+	}
+	dbPlaylist, err := b.EntClient.Playlist.Get(ctx, id)
+	if err != nil {
+		return SavedPlaylist{}, err
+	}
+	return newSavedPlaylist(dbPlaylist), nil
+}
+
+// loadPlaylist queues the tracks of the playlist for the member
+func (b *Bot) loadPlaylist(ctx context.Context, guildID snowflake.ID, id int, user discord.Member) (SavedPlaylist, error) {
+	dbPlaylist, err := b.EntClient.Playlist.Query().Where(playlist.ID(id), playlist.GuildID(guildID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return SavedPlaylist{}, errPlaylistNotFound
+	}
+	if err != nil {
+		return SavedPlaylist{}, err
+	}
+	tracks, err := b.Lavalink.BestNode().DecodeTracks(ctx, dbPlaylist.Tracks)
+	if err != nil {
+		return SavedPlaylist{}, err
+	}
+	if err = b.queueTracks(guildID, user, tracks...); err != nil {
+		return SavedPlaylist{}, err
+	}
+	return newSavedPlaylist(dbPlaylist), nil
+}
+
+func (b *Bot) deletePlaylist(ctx context.Context, guildID snowflake.ID, id int) error {
+	deleted, err := b.EntClient.Playlist.Delete().Where(playlist.ID(id), playlist.GuildID(guildID)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errPlaylistNotFound
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgolink/v3/disgolink"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent"
+	"github.com/loukhin/probably-a-music-bot/ent/member"
+)
+
+const (
+	// pointsInterval is how often members listening in the bot's voice channel earn the points per minute
+	pointsInterval = time.Minute
+	// pointsLeaderboardSize is the number of members shown by /leaderboard
+	pointsLeaderboardSize = 10
+
+	// ttsFreeLength is the number of characters of a TTS message that cost nothing,
+	// every started ttsCharactersPerPoint characters above it cost a point
+	ttsFreeLength         = 130
+	ttsMaxLength          = 400
+	ttsCharactersPerPoint = 10
+)
+
+// InsufficientPointsError is returned when a member can't pay for something
+type InsufficientPointsError struct {
+	Cost    int64
+	Balance int64
+}
+
+func (e *InsufficientPointsError) Error() string {
+	return fmt.Sprintf("this costs %d points but you only have %d", e.Cost, e.Balance)
+}
+
+// ttsLengthCost returns the points needed to read a message longer than ttsFreeLength
+func ttsLengthCost(text string) int64 {
+	extra := len([]rune(text)) - ttsFreeLength
+	if extra <= 0 {
+		return 0
+	}
+	return int64((extra + ttsCharactersPerPoint - 1) / ttsCharactersPerPoint)
+}
+
+func (b *Bot) pointsBalance(ctx context.Context, guildID snowflake.ID, userID snowflake.ID) (int64, error) {
+	dbMember, err := b.EntClient.Member.Query().Where(member.GuildID(guildID), member.UserID(userID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return dbMember.Points, nil
+}
+
+// addPoints adds points to the balance of the member, creating the member if needed
+func (b *Bot) addPoints(ctx context.Context, guildID snowflake.ID, userID snowflake.ID, amount int64) error {
+	return b.EntClient.Member.Create().
+		SetGuildID(guildID).
+		SetUserID(userID).
+		SetPoints(amount).
+		OnConflict(
+			sql.ConflictColumns(member.FieldGuildID, member.FieldUserID),
+		).
+		Update(func(u *ent.MemberUpsert) {
+			u.AddPoints(amount)
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+}
+
+// spendPoints takes the points from the balance of the member, the balance never goes below zero
+func (b *Bot) spendPoints(ctx context.Context, guildID snowflake.ID, userID snowflake.ID, cost int64) error {
+	if cost <= 0 {
+		return nil
+	}
+	updated, err := b.EntClient.Member.Update().
+		Where(member.GuildID(guildID), member.UserID(userID), member.PointsGTE(cost)).
+		AddPoints(-cost).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		balance, err := b.pointsBalance(ctx, guildID, userID)
+		if err != nil {
+			return err
+		}
+		return &InsufficientPointsError{Cost: cost, Balance: balance}
+	}
+	return nil
+}
+
+// refundPoints gives back points spent on something that failed
+func (b *Bot) refundPoints(ctx context.Context, guildID snowflake.ID, userID snowflake.ID, cost int64) {
+	if cost <= 0 {
+		return
+	}
+	if err := b.addPoints(ctx, guildID, userID, cost); err != nil {
+		slog.Error("Failed to refund points", "guild_id", guildID, "user_id", userID, "err", err)
+	}
+}
+
+// revokePoints takes up to amount points from the member and returns how many were taken
+func (b *Bot) revokePoints(ctx context.Context, guildID snowflake.ID, userID snowflake.ID, amount int64) (int64, error) {
+	for {
+		balance, err := b.pointsBalance(ctx, guildID, userID)
+		if err != nil || balance == 0 {
+			return 0, err
+		}
+		revoked := amount
+		if revoked > balance {
+			revoked = balance
+		}
+		// only update the balance that was read, a concurrent change retries with the new balance
+		updated, err := b.EntClient.Member.Update().
+			Where(member.GuildID(guildID), member.UserID(userID), member.Points(balance)).
+			SetPoints(balance - revoked).
+			Save(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if updated > 0 {
+			return revoked, nil
+		}
+	}
+}
+
+// startPointsTicker pays the members listening to music every pointsInterval, nothing is paid while the points per minute are 0
+func (b *Bot) startPointsTicker() {
+	pay := withTaskRecover("PointsTicker", nil, func() {
+		b.Lavalink.ForPlayers(b.payListeners)
+	})
+	go func() {
+		ticker := time.NewTicker(pointsInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			pay()
+		}
+	}()
+}
+
+func (b *Bot) payListeners(player disgolink.Player) {
+	pointsPerMinute := currentConfig().Points.PerMinute
+	if pointsPerMinute <= 0 {
+		return
+	}
+	track := player.Track()
+	if track == nil || player.Paused() || isTTSTrack(*track) {
+		return
+	}
+	guildID := player.GuildID()
+	botVoiceState, ok := b.Client.Caches().VoiceState(guildID, b.Client.ID())
+	if !ok || botVoiceState.ChannelID == nil {
+		return
+	}
+
+	var listeners []snowflake.ID
+	b.Client.Caches().VoiceStatesForEach(guildID, func(state discord.VoiceState) {
+		if state.UserID == b.Client.ID() || state.ChannelID == nil || *state.ChannelID != *botVoiceState.ChannelID {
+			return
+		}
+		// deafened members aren't listening
+		if state.SelfDeaf || state.GuildDeaf {
+			return
+		}
+		listeners = append(listeners, state.UserID)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for _, userID := range listeners {
+		if err := b.addPoints(ctx, guildID, userID, pointsPerMinute); err != nil {
+			slog.Error("Failed to add points", "guild_id", guildID, "user_id", userID, "err", err)
+		}
+	}
+}
+
+func (b *Bot) formatPointsLeaderboard(ctx context.Context, guildID snowflake.ID) (string, error) {
+	members, err := b.EntClient.Member.Query().
+		Where(member.GuildID(guildID), member.PointsGT(0)).
+		Order(ent.Desc(member.FieldPoints)).
+		Limit(pointsLeaderboardSize).
+		All(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(members) == 0 {
+		return "Nobody has any points yet", nil
+	}
+	var builder strings.Builder
+	builder.WriteString("Richest listeners:\n")
+	for i, dbMember := range members {
+		builder.WriteString(fmt.Sprintf("%d. <@%s> %d points\n", i+1, dbMember.UserID, dbMember.Points))
+	}
+	return builder.String(), nil
+}
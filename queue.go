@@ -2,6 +2,8 @@ package main
 
 import (
 	"math/rand"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/disgoorg/disgolink/v3/lavalink"
@@ -32,28 +34,123 @@ func (q QueueType) String() string {
 	}
 }
 
-type Queue struct {
-	Length lavalink.Duration
+// QueueSnapshot is a copy of the queue that can be read without holding its lock
+type QueueSnapshot struct {
 	Tracks []lavalink.Track
+	Length lavalink.Duration
 	Type   QueueType
 }
 
-func (q *Queue) RecalculateDuration() {
-	q.Length = 0
-	for _, track := range q.Tracks {
-		q.Length += track.Info.Length
+// Queue is shared by the listeners, timers and API requests of a guild, mu guards its fields
+type Queue struct {
+	mu        sync.Mutex
+	length    lavalink.Duration
+	tracks    []lavalink.Track
+	queueType QueueType
+	// onChange is called with the new state whenever tracks are added, removed or reordered,
+	// it runs on a single goroutine without the lock so the changes are reported in order
+	onChange func(QueueSnapshot)
+	// dirty is set when a change hasn't been reported yet, notifying while the goroutine runs
+	dirty     bool
+	notifying bool
+}
+
+func newQueue(onChange func(QueueSnapshot)) *Queue {
+	return &Queue{
+		tracks:    make([]lavalink.Track, 0),
+		queueType: QueueTypeNoRepeat,
+		onChange:  onChange,
+	}
+}
+
+// changed has to be called with the lock held
+func (q *Queue) changed() {
+	q.length = 0
+	for _, track := range q.tracks {
+		q.length += track.Info.Length
+	}
+	if q.onChange == nil {
+		return
+	}
+	q.dirty = true
+	if !q.notifying {
+		q.notifying = true
+		go q.notify()
+	}
+}
+
+// notify reports the latest state until there are no changes left,
+// changes made while onChange runs are reported together
+func (q *Queue) notify() {
+	for {
+		q.mu.Lock()
+		if !q.dirty {
+			q.notifying = false
+			q.mu.Unlock()
+			return
+		}
+		q.dirty = false
+		snapshot := q.snapshot()
+		q.mu.Unlock()
+
+		q.onChange(snapshot)
 	}
 }
 
+func (q *Queue) snapshot() QueueSnapshot {
+	return QueueSnapshot{
+		Tracks: slices.Clone(q.tracks),
+		Length: q.length,
+		Type:   q.queueType,
+	}
+}
+
+func (q *Queue) Snapshot() QueueSnapshot {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.snapshot()
+}
+
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.tracks)
+}
+
+func (q *Queue) Type() QueueType {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queueType
+}
+
+func (q *Queue) SetType(queueType QueueType) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.queueType = queueType
+}
+
 func (q *Queue) Shuffle() {
-	rand.Shuffle(len(q.Tracks), func(i, j int) {
-		q.Tracks[i], q.Tracks[j] = q.Tracks[j], q.Tracks[i]
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	rand.Shuffle(len(q.tracks), func(i, j int) {
+		q.tracks[i], q.tracks[j] = q.tracks[j], q.tracks[i]
 	})
+	q.changed()
 }
 
 func (q *Queue) Add(tracks ...lavalink.Track) {
-	q.Tracks = append(q.Tracks, tracks...)
-	q.RecalculateDuration()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.tracks = append(q.tracks, tracks...)
+	q.changed()
+}
+
+// AddNext puts the tracks in front of the queue
+func (q *Queue) AddNext(tracks ...lavalink.Track) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.tracks = append(append(make([]lavalink.Track, 0, len(tracks)+len(q.tracks)), tracks...), q.tracks...)
+	q.changed()
 }
 
 func (q *Queue) Next() (lavalink.Track, bool) {
@@ -61,29 +158,49 @@ func (q *Queue) Next() (lavalink.Track, bool) {
 }
 
 func (q *Queue) Skip(amount int) (lavalink.Track, bool) {
-	if len(q.Tracks) == 0 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.tracks) == 0 {
 		return lavalink.Track{}, false
 	}
-	if amount > len(q.Tracks) {
-		amount = len(q.Tracks)
+	if amount > len(q.tracks) {
+		amount = len(q.tracks)
 	}
 	var nextTrack lavalink.Track
-	nextTrack, q.Tracks = q.Tracks[amount-1], q.Tracks[amount:]
-	q.RecalculateDuration()
+	nextTrack, q.tracks = q.tracks[amount-1], q.tracks[amount:]
+	q.changed()
 	return nextTrack, true
 }
 
 func (q *Queue) Remove(index int) (removedTrack lavalink.Track, ok bool) {
-	if len(q.Tracks) == 0 || index < 0 || index >= len(q.Tracks) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.tracks) == 0 || index < 0 || index >= len(q.tracks) {
 		return lavalink.Track{}, false
 	}
-	removedTrack = q.Tracks[index]
-	q.Tracks = append(q.Tracks[:index], q.Tracks[index+1:]...)
-	q.RecalculateDuration()
+	removedTrack = q.tracks[index]
+	q.tracks = append(q.tracks[:index], q.tracks[index+1:]...)
+	q.changed()
 	return removedTrack, true
 }
 
 func (q *Queue) Clear() {
-	q.Tracks = make([]lavalink.Track, 0)
-	q.Length = 0
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.tracks = make([]lavalink.Track, 0)
+	q.changed()
+}
+
+// Move puts the track at index from at index to, the tracks in between shift by one
+func (q *Queue) Move(from int, to int) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if from < 0 || from >= len(q.tracks) || to < 0 || to >= len(q.tracks) {
+		return false
+	}
+	track := q.tracks[from]
+	q.tracks = append(q.tracks[:from], q.tracks[from+1:]...)
+	q.tracks = append(q.tracks[:to], append([]lavalink.Track{track}, q.tracks[to:]...)...)
+	q.changed()
+	return true
 }
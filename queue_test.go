@@ -0,0 +1,60 @@
+package main
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestQueueOnChange(t *testing.T) {
+	var (
+		queue   *Queue
+		mu      sync.Mutex
+		lengths []int
+	)
+	reported := make(chan struct{}, 100)
+	queue = newQueue(func(snapshot QueueSnapshot) {
+		// reading the queue from the callback deadlocks if it's called under the lock
+		if queue.Len() < len(snapshot.Tracks) {
+			t.Errorf("queue has fewer tracks than the reported state")
+		}
+		mu.Lock()
+		lengths = append(lengths, len(snapshot.Tracks))
+		mu.Unlock()
+		reported <- struct{}{}
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			queue.Add(newFakeTrack("track" + strconv.Itoa(i)))
+		}()
+	}
+	wg.Wait()
+
+	deadline := time.After(5 * time.Second)
+	for {
+		mu.Lock()
+		done := len(lengths) > 0 && lengths[len(lengths)-1] == 20
+		mu.Unlock()
+		if done {
+			break
+		}
+		select {
+		case <-reported:
+		case <-deadline:
+			t.Fatalf("the final state was not reported, got %v", lengths)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for i := 1; i < len(lengths); i++ {
+		if lengths[i] < lengths[i-1] {
+			t.Fatalf("changes reported out of order: %v", lengths)
+		}
+	}
+}
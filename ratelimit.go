@@ -0,0 +1,55 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter allows at most limit hits per key within a sliding window
+type RateLimiter[K comparable] struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	hits   map[K][]time.Time
+	// sweptAt is when the keys without hits in the window were last removed
+	sweptAt time.Time
+}
+
+func newRateLimiter[K comparable](limit int, window time.Duration) *RateLimiter[K] {
+	return &RateLimiter[K]{
+		limit:  limit,
+		window: window,
+		hits:   make(map[K][]time.Time),
+	}
+}
+
+// Allow records a hit for the key and reports whether it is within the limit
+func (r *RateLimiter[K]) Allow(key K) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(r.sweptAt) >= r.window {
+		r.sweep(now)
+	}
+	hits := r.hits[key]
+	for len(hits) > 0 && now.Sub(hits[0]) >= r.window {
+		hits = hits[1:]
+	}
+	if len(hits) >= r.limit {
+		r.hits[key] = hits
+		return false
+	}
+	r.hits[key] = append(hits, now)
+	return true
+}
+
+// sweep removes the keys whose last hit left the window, so keys that stop hitting don't pile up
+func (r *RateLimiter[K]) sweep(now time.Time) {
+	for key, hits := range r.hits {
+		if len(hits) == 0 || now.Sub(hits[len(hits)-1]) >= r.window {
+			delete(r.hits, key)
+		}
+	}
+	r.sweptAt = now
+}
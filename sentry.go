@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgolink/v3/disgolink"
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/getsentry/sentry-go"
+)
+
+// EventScope is what a listener knows about the event it handles, the logs and sentry events share the correlation id
+type EventScope struct {
+	Hub           *sentry.Hub
+	Logger        *slog.Logger
+	CorrelationID string
+}
+
+// withRecover wraps a gateway event listener, a panic is reported to sentry with the guild, user, command and track of the event
+func withRecover[E bot.Event](b *Bot, listener func(E)) func(E) {
+	return withScope(b, func(event E, _ *EventScope) {
+		listener(event)
+	})
+}
+
+// withScope is withRecover for listeners that log or report on their own
+func withScope[E bot.Event](b *Bot, listener func(E, *EventScope)) func(E) {
+	return func(event E) {
+		scope := newEventScope(event, b.eventTags(event), slog.Default())
+		defer scope.recoverPanic()
+		listener(event, scope)
+	}
+}
+
+// withPlayerRecover wraps a lavalink listener like withRecover
+func withPlayerRecover[E lavalink.Message](listener func(disgolink.Player, E)) func(disgolink.Player, E) {
+	return func(player disgolink.Player, event E) {
+		scope := newEventScope(event, playerEventTags(player, event), lavalinkLog)
+		defer scope.recoverPanic()
+		listener(player, event)
+	}
+}
+
+// withTaskRecover wraps a timer callback or the work of a background goroutine, a panic is reported like the ones of the listeners
+// with name as the listener. Loops wrap every iteration so a panic doesn't stop them.
+func withTaskRecover(name string, tags map[string]string, task func()) func() {
+	return func() {
+		scope := newScope(name, tags, slog.Default(), false)
+		defer scope.recoverPanic()
+		task()
+	}
+}
+
+func guildTags(guildID snowflake.ID) map[string]string {
+	return map[string]string{"guild_id": guildID.String()}
+}
+
+// newEventScope is the scope of a listener, the noisy events don't leave breadcrumbs
+func newEventScope(event any, tags map[string]string, logger *slog.Logger) *EventScope {
+	return newScope(listenerName(event), tags, logger, !isNoisyEvent(event))
+}
+
+// newScope clones the current hub so the tags of the event don't leak into the other listeners,
+// verbose scopes leave a breadcrumb and a debug log
+func newScope(name string, tags map[string]string, logger *slog.Logger, verbose bool) *EventScope {
+	if verbose {
+		addBreadcrumb(name, tags)
+	}
+
+	scope := &EventScope{
+		Hub:           sentry.CurrentHub().Clone(),
+		CorrelationID: newCorrelationID(),
+	}
+	scope.Hub.ConfigureScope(func(hubScope *sentry.Scope) {
+		hubScope.SetTag("listener", name)
+		hubScope.SetTag("correlation_id", scope.CorrelationID)
+		hubScope.SetTags(tags)
+		if userID, ok := tags["user_id"]; ok {
+			hubScope.SetUser(sentry.User{ID: userID})
+		}
+	})
+	attrs := make([]any, 0, len(tags)+2)
+	attrs = append(attrs, slog.String("event", name), slog.String("correlation_id", scope.CorrelationID))
+	for key, value := range tags {
+		attrs = append(attrs, slog.String(key, value))
+	}
+	scope.Logger = logger.With(attrs...)
+	if verbose {
+		scope.Logger.Debug("Handling event")
+	}
+	return scope
+}
+
+// recoverPanic has to be deferred, it reports the panic and keeps it from crashing the bot
+func (s *EventScope) recoverPanic() {
+	err := recover()
+	if err == nil {
+		return
+	}
+	s.Logger.Error("Listener panicked", slog.Any("panic", err), slog.String("stack", string(debug.Stack())))
+	s.Hub.Recover(err)
+}
+
+// listenerName is the event type without the package, e.g. GuildVoiceStateUpdate
+func listenerName(event any) string {
+	name := fmt.Sprintf("%T", event)
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// isNoisyEvent tells the events that come too often to be worth a breadcrumb, they'd push out the useful ones
+func isNoisyEvent(event any) bool {
+	switch event.(type) {
+	case *events.GuildMessageCreate, *events.GuildMessageUpdate, lavalink.PlayerUpdateMessage:
+		return true
+	}
+	return false
+}
+
+func (b *Bot) eventTags(event any) map[string]string {
+	tags := make(map[string]string)
+	var guildID *snowflake.ID
+	switch e := event.(type) {
+	case *events.ApplicationCommandInteractionCreate:
+		guildID = e.GuildID()
+		tags["user_id"] = e.User().ID.String()
+		tags["channel_id"] = e.Channel().ID().String()
+		tags["command"] = e.Data.CommandName()
+	case *events.AutocompleteInteractionCreate:
+		guildID = e.GuildID()
+		tags["user_id"] = e.User().ID.String()
+		tags["command"] = e.Data.CommandName
+	case *events.GuildVoiceStateUpdate:
+		guildID = &e.VoiceState.GuildID
+		tags["user_id"] = e.VoiceState.UserID.String()
+	case *events.VoiceServerUpdate:
+		guildID = &e.GuildID
+	case *events.GuildJoin:
+		guildID = &e.Guild.ID
+	case *events.GuildMessageCreate:
+		guildID = &e.GuildID
+		tags["channel_id"] = e.ChannelID.String()
+		tags["user_id"] = e.Message.Author.ID.String()
+	case *events.GuildMessageUpdate:
+		guildID = &e.GuildID
+		tags["channel_id"] = e.ChannelID.String()
+		tags["user_id"] = e.Message.Author.ID.String()
+	}
+	if guildID == nil {
+		return tags
+	}
+	tags["guild_id"] = guildID.String()
+	if b.Lavalink == nil {
+		return tags
+	}
+	if player := b.Lavalink.ExistingPlayer(*guildID); player != nil {
+		if track := player.Track(); track != nil {
+			tags["track"] = track.Info.Identifier
+		}
+	}
+	return tags
+}
+
+func playerEventTags(player disgolink.Player, event any) map[string]string {
+	tags := make(map[string]string)
+	var track *lavalink.Track
+	switch e := event.(type) {
+	case lavalink.TrackStartEvent:
+		track = &e.Track
+	case lavalink.TrackEndEvent:
+		track = &e.Track
+		tags["end_reason"] = string(e.Reason)
+	case lavalink.TrackExceptionEvent:
+		track = &e.Track
+	case lavalink.TrackStuckEvent:
+		track = &e.Track
+	}
+	if player != nil {
+		tags["guild_id"] = player.GuildID().String()
+		tags["node"] = player.Node().Config().Name
+		if track == nil {
+			track = player.Track()
+		}
+	}
+	if track != nil {
+		tags["track"] = track.Info.Identifier
+	}
+	return tags
+}
+
+func addBreadcrumb(name string, tags map[string]string) {
+	data := make(map[string]interface{}, len(tags))
+	for key, value := range tags {
+		data[key] = value
+	}
+	sentry.AddBreadcrumb(&sentry.Breadcrumb{
+		Category: "event",
+		Message:  name,
+		Data:     data,
+		Level:    sentry.LevelInfo,
+	})
+}
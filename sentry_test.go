@@ -0,0 +1,16 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestWithTaskRecover(t *testing.T) {
+	ran := false
+	withTaskRecover("Test", guildTags(testGuildID), func() {
+		ran = true
+		panic("boom")
+	})()
+	if !ran {
+		t.Error("the task didn't run")
+	}
+}
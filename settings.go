@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// settingsMaxTimeout is the longest idle and alone timeout in minutes
+const settingsMaxTimeout = 60
+
+// SettingsUpdate holds the settings to change, nil fields are left as they are
+type SettingsUpdate struct {
+	IdleTimeout  *int    `json:"idle_timeout,omitempty"`
+	AloneTimeout *int    `json:"alone_timeout,omitempty"`
+	TTSProvider  *string `json:"tts_provider,omitempty"`
+	Autoplay     *bool   `json:"autoplay,omitempty"`
+}
+
+// SettingsError is returned when a setting has an invalid value
+type SettingsError struct {
+	Message string
+}
+
+func (e *SettingsError) Error() string {
+	return e.Message
+}
+
+// saveSettings validates and stores the update, it's shared by the slash commands and the dashboard
+func (b *Bot) saveSettings(ctx context.Context, guildID snowflake.ID, update SettingsUpdate) (*GuildSettings, error) {
+	guild := b.Guilds.Get(guildID)
+	if update.IdleTimeout == nil && update.AloneTimeout == nil && update.TTSProvider == nil && update.Autoplay == nil {
+		return guild.currentSettings(), nil
+	}
+
+	dbUpdate := b.EntClient.Guild.UpdateOneID(guildID)
+	if update.IdleTimeout != nil {
+		if *update.IdleTimeout < 0 || *update.IdleTimeout > settingsMaxTimeout {
+			return nil, &SettingsError{Message: fmt.Sprintf("Idle timeout must be between 0 and %d minutes", settingsMaxTimeout)}
+		}
+		dbUpdate.SetIdleTimeout(*update.IdleTimeout)
+	}
+	if update.AloneTimeout != nil {
+		if *update.AloneTimeout < 0 || *update.AloneTimeout > settingsMaxTimeout {
+			return nil, &SettingsError{Message: fmt.Sprintf("Alone timeout must be between 0 and %d minutes", settingsMaxTimeout)}
+		}
+		dbUpdate.SetAloneTimeout(*update.AloneTimeout)
+	}
+	if update.TTSProvider != nil {
+		if _, ok := b.TTSProviders[*update.TTSProvider]; !ok {
+			return nil, &SettingsError{Message: fmt.Sprintf("TTS provider `%s` is not configured on this bot", *update.TTSProvider)}
+		}
+		dbUpdate.SetTtsProvider(*update.TTSProvider)
+	}
+	if update.Autoplay != nil {
+		dbUpdate.SetAutoplay(*update.Autoplay)
+	}
+
+	dbGuild, err := dbUpdate.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return guild.updateSettings(func(settings *GuildSettings) {
+		settings.IdleTimeout = time.Duration(dbGuild.IdleTimeout) * time.Minute
+		settings.AloneTimeout = time.Duration(dbGuild.AloneTimeout) * time.Minute
+		settings.TTSProvider = dbGuild.TtsProvider
+		settings.Autoplay = dbGuild.Autoplay
+	}), nil
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSaveSettingsConcurrent(t *testing.T) {
+	b, _, _ := newTestBot(t)
+	guild := b.Guilds.Get(testGuildID)
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 5; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			timeout := i
+			if _, err := b.saveSettings(context.Background(), testGuildID, SettingsUpdate{IdleTimeout: &timeout}); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			guild.updateSettings(func(settings *GuildSettings) {
+				settings.AlwaysOn = !settings.AlwaysOn
+			})
+			// the timers and listeners only read the settings
+			_ = guild.currentSettings().IdleTimeout
+		}()
+	}
+	wg.Wait()
+
+	settings := guild.currentSettings()
+	if settings.IdleTimeout < time.Minute || settings.IdleTimeout > 5*time.Minute {
+		t.Fatalf("idle timeout = %s, want one of the saved values", settings.IdleTimeout)
+	}
+	// every toggle is applied, none is lost to a concurrent update
+	if !settings.AlwaysOn {
+		t.Fatal("always on was toggled an odd number of times but is disabled")
+	}
+}
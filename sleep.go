@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent"
+	"github.com/loukhin/probably-a-music-bot/ent/guild"
+)
+
+const (
+	sleepFadeDuration = time.Minute
+	sleepFadeSteps    = 12
+	maxSleepDuration  = 24 * time.Hour
+)
+
+// SleepTimer stops the player at Deadline after fading out the volume, or after the current track when EndOfTrack is set
+type SleepTimer struct {
+	Deadline   time.Time
+	EndOfTrack bool
+	timer      *time.Timer
+	stop       chan struct{}
+	stopOnce   sync.Once
+}
+
+func newSleepTimer(deadline time.Time, endOfTrack bool) *SleepTimer {
+	return &SleepTimer{
+		Deadline:   deadline,
+		EndOfTrack: endOfTrack,
+		stop:       make(chan struct{}),
+	}
+}
+
+// sleepTimerFromEnt restores the sleep timer saved in the database, an end of track timer isn't restored
+// because the track it was waiting for ended with the previous run
+func sleepTimerFromEnt(dbGuild *ent.Guild) *SleepTimer {
+	if dbGuild.SleepAt != nil {
+		return newSleepTimer(*dbGuild.SleepAt, false)
+	}
+	return nil
+}
+
+func (t *SleepTimer) Stop() {
+	if t == nil {
+		return
+	}
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	t.stopOnce.Do(func() {
+		close(t.stop)
+	})
+}
+
+func (t *SleepTimer) stopped() bool {
+	select {
+	case <-t.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseSleepDuration accepts go durations like 45m or 1h30m, plain numbers are minutes
+func parseSleepDuration(value string) (time.Duration, error) {
+	if minutes, err := strconv.Atoi(value); err == nil {
+		return time.Duration(minutes) * time.Minute, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// armSleepTimer starts fading out sleepFadeDuration before the deadline
+func (b *Bot) armSleepTimer(guildID snowflake.ID, sleep *SleepTimer) {
+	if sleep.EndOfTrack {
+		return
+	}
+	fadeStart := time.Until(sleep.Deadline) - sleepFadeDuration
+	if fadeStart < 0 {
+		fadeStart = 0
+	}
+	sleep.timer = time.AfterFunc(fadeStart, withTaskRecover("SleepTimeout", guildTags(guildID), func() {
+		b.fadeOutAndSleep(guildID, sleep)
+	}))
+}
+
+// restoreSleepTimers loads the guilds with a saved sleep timer, loading a guild arms its timer.
+// The end of track timers are cleared, they'd stop the bot one track later than asked.
+func (b *Bot) restoreSleepTimers() {
+	if _, err := b.EntClient.Guild.Update().Where(guild.SleepEndOfTrack(true)).SetSleepEndOfTrack(false).Save(context.TODO()); err != nil {
+		slog.Error("Failed to clear end of track sleep timers", "err", err)
+	}
+	guildIDs, err := b.EntClient.Guild.Query().Where(guild.SleepAtNotNil()).IDs(context.TODO())
+	if err != nil {
+		slog.Error("Failed to query sleep timers", "err", err)
+		return
+	}
+	for _, guildID := range guildIDs {
+		b.Guilds.Get(guildID)
+	}
+}
+
+// setSleepTimer saves the sleep timer and replaces the running one
+func (b *Bot) setSleepTimer(guildID snowflake.ID, sleep *SleepTimer) error {
+	update := b.EntClient.Guild.UpdateOneID(guildID).SetSleepEndOfTrack(sleep.EndOfTrack)
+	if sleep.EndOfTrack {
+		update.ClearSleepAt()
+	} else {
+		update.SetSleepAt(sleep.Deadline)
+	}
+	if _, err := update.Save(context.TODO()); err != nil {
+		return err
+	}
+
+	guild := b.Guilds.Get(guildID)
+	guild.mu.Lock()
+	guild.sleep.Stop()
+	guild.sleep = sleep
+	b.armSleepTimer(guildID, sleep)
+	guild.mu.Unlock()
+	b.updatePlayerMessage(guildID)
+	return nil
+}
+
+func (b *Bot) clearSleepTimer(guildID snowflake.ID) error {
+	guild := b.Guilds.Get(guildID)
+	guild.mu.Lock()
+	guild.sleep.Stop()
+	guild.sleep = nil
+	guild.mu.Unlock()
+	_, err := b.EntClient.Guild.UpdateOneID(guildID).ClearSleepAt().SetSleepEndOfTrack(false).Save(context.TODO())
+	return err
+}
+
+func (b *Bot) fadeOutAndSleep(guildID snowflake.ID, sleep *SleepTimer) {
+	if player := b.Lavalink.ExistingPlayer(guildID); player != nil && player.Track() != nil {
+		startVolume := player.Volume()
+		interval := time.Until(sleep.Deadline) / sleepFadeSteps
+		if interval > 0 {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for step := 1; step <= sleepFadeSteps; step++ {
+				select {
+				case <-sleep.stop:
+					// the timer was cancelled, bring the volume back
+					if err := player.Update(context.TODO(), lavalink.WithVolume(startVolume)); err != nil {
+						lavalinkLog.Error("Failed to restore volume", "guild_id", guildID, "err", err)
+					}
+					return
+				case <-ticker.C:
+				}
+				if err := player.Update(context.TODO(), lavalink.WithVolume(startVolume*(sleepFadeSteps-step)/sleepFadeSteps)); err != nil {
+					lavalinkLog.Error("Failed to fade out volume", "guild_id", guildID, "err", err)
+				}
+			}
+		}
+	}
+	if sleep.stopped() {
+		return
+	}
+	b.sleepNow(guildID, sleep)
+}
+
+// sleepNow clears the queue, stops the player and leaves the voice channel unless 24/7 mode is enabled
+func (b *Bot) sleepNow(guildID snowflake.ID, sleep *SleepTimer) {
+	guild := b.Guilds.Get(guildID)
+	if guild.sleepTimer() != sleep {
+		return
+	}
+	if err := b.clearSleepTimer(guildID); err != nil {
+		slog.Error("Failed to clear sleep timer", "guild_id", guildID, "err", err)
+	}
+	slog.Info("Sleep timer reached", "guild_id", guildID)
+
+	// drop the announcement state first, the stopped clip would put the music it interrupted back in the queue
+	guild.mu.Lock()
+	guild.interrupted = nil
+	guild.announcements = nil
+	guild.mu.Unlock()
+	guild.queue.Clear()
+	if player := b.Lavalink.ExistingPlayer(guildID); player != nil {
+		if err := player.Update(context.TODO(), lavalink.WithNullTrack()); err != nil {
+			lavalinkLog.Error("Failed to stop player", "guild_id", guildID, "err", err)
+		}
+	}
+	if !guild.currentSettings().AlwaysOn {
+		b.updateVoiceState(guildID, nil)
+	}
+	b.updatePlayerMessage(guildID)
+}
+
+func formatSleepCountdown(sleep *SleepTimer) string {
+	if sleep.EndOfTrack {
+		return "💤 Stopping after the current track"
+	}
+	return fmt.Sprintf("💤 Stopping <t:%d:R>", sleep.Deadline.Unix())
+}
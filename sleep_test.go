@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRestoreSleepTimers(t *testing.T) {
+	tests := []struct {
+		name       string
+		endOfTrack bool
+		sleepAt    time.Time
+		restored   bool
+	}{
+		{name: "deadline", sleepAt: time.Now().Add(time.Hour), restored: true},
+		// the track it waited for ended with the previous run
+		{name: "end of track", endOfTrack: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _, _ := newTestBot(t)
+			update := b.EntClient.Guild.UpdateOneID(testGuildID).SetSleepEndOfTrack(tt.endOfTrack)
+			if !tt.sleepAt.IsZero() {
+				update.SetSleepAt(tt.sleepAt)
+			}
+			if err := update.Exec(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			b.restoreSleepTimers()
+			sleep := b.Guilds.Get(testGuildID).sleepTimer()
+			t.Cleanup(sleep.Stop)
+			if restored := sleep != nil; restored != tt.restored {
+				t.Fatalf("restored = %t, want %t", restored, tt.restored)
+			}
+			dbGuild, err := b.EntClient.Guild.Get(context.Background(), testGuildID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dbGuild.SleepEndOfTrack {
+				t.Fatal("end of track timer is still saved")
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// StreamEventSnapshot carries the full APIPlayerState, it's the first message of every connection
+	StreamEventSnapshot     = "snapshot"
+	StreamEventPlayerPause  = "player.pause"
+	StreamEventPlayerResume = "player.resume"
+	// StreamEventPlayerUpdate is the position tick lavalink sends every few seconds
+	StreamEventPlayerUpdate = "player.update"
+	StreamEventPlayerVolume = "player.volume"
+	StreamEventQueueRepeat  = "queue.repeat"
+
+	// streamClientBuffer is the number of messages a client may lag behind before it's disconnected
+	streamClientBuffer  = 64
+	streamWriteTimeout  = 10 * time.Second
+	streamPingInterval  = 30 * time.Second
+	streamPongTimeout   = 2 * streamPingInterval
+	streamMaxReadLength = 512
+)
+
+type StreamPositionEvent struct {
+	Position  int64 `json:"position_ms"`
+	Connected bool  `json:"connected"`
+	Ping      int   `json:"ping"`
+}
+
+type StreamVolumeEvent struct {
+	Volume int `json:"volume"`
+}
+
+type StreamRepeatEvent struct {
+	RepeatMode QueueType `json:"repeat_mode"`
+}
+
+// PlayerStream pushes the player events of a guild to websocket clients,
+// the messages use the same envelope as the outgoing webhooks
+type PlayerStream struct {
+	bot      *Bot
+	upgrader websocket.Upgrader
+
+	mu      sync.Mutex
+	clients map[snowflake.ID]map[*streamClient]struct{}
+}
+
+type streamClient struct {
+	conn *websocket.Conn
+	send chan []byte
+	// done is closed when the client has to be disconnected, closeCode tells it why
+	done      chan struct{}
+	closeOnce sync.Once
+	closeCode int
+}
+
+func NewPlayerStream(bot *Bot) *PlayerStream {
+	return &PlayerStream{
+		bot: bot,
+		upgrader: websocket.Upgrader{
+			// connections are authenticated with the api token, the dashboard may live on another origin
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+		clients: make(map[snowflake.ID]map[*streamClient]struct{}),
+	}
+}
+
+func (c *streamClient) close(code int) {
+	c.closeOnce.Do(func() {
+		c.closeCode = code
+		close(c.done)
+	})
+}
+
+func newStreamMessage(guildID snowflake.ID, event string, data any) ([]byte, error) {
+	return json.Marshal(WebhookPayload{
+		ID:        newWebhookID(),
+		Event:     event,
+		GuildID:   guildID,
+		Timestamp: time.Now().UTC(),
+		Data:      data,
+	})
+}
+
+// Publish sends the event to every client of the guild, clients that can't keep up are disconnected
+func (s *PlayerStream) Publish(guildID snowflake.ID, event string, data any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.clients[guildID]) == 0 {
+		return
+	}
+	message, err := newStreamMessage(guildID, event, data)
+	if err != nil {
+		slog.Error("Failed to encode stream event", "guild_id", guildID, "event", event, "err", err)
+		return
+	}
+	for client := range s.clients[guildID] {
+		select {
+		case client.send <- message:
+		default:
+			// the client reconnects and starts over with a fresh snapshot
+			delete(s.clients[guildID], client)
+			client.close(websocket.CloseTryAgainLater)
+		}
+	}
+}
+
+// register queues the snapshot and adds the client, the state is built before taking the lock
+// because reading it locks the guild and its queue, which publish events themselves
+func (s *PlayerStream) register(guildID snowflake.ID, client *streamClient) error {
+	snapshot, err := newStreamMessage(guildID, StreamEventSnapshot, s.bot.playerState(guildID))
+	if err != nil {
+		return err
+	}
+	client.send <- snapshot
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.clients[guildID] == nil {
+		s.clients[guildID] = make(map[*streamClient]struct{})
+	}
+	s.clients[guildID][client] = struct{}{}
+	return nil
+}
+
+func (s *PlayerStream) unregister(guildID snowflake.ID, client *streamClient) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.clients[guildID], client)
+	if len(s.clients[guildID]) == 0 {
+		delete(s.clients, guildID)
+	}
+}
+
+// ServeStream upgrades the request to a websocket that receives the events of the guild
+func (s *PlayerStream) ServeStream(w http.ResponseWriter, r *http.Request, guildID snowflake.ID) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied with an error
+		return
+	}
+	client := &streamClient{
+		conn: conn,
+		send: make(chan []byte, streamClientBuffer),
+		done: make(chan struct{}),
+	}
+	if err = s.register(guildID, client); err != nil {
+		slog.Error("Failed to encode stream snapshot", "guild_id", guildID, "err", err)
+		_ = conn.Close()
+		return
+	}
+	go client.writeLoop()
+	client.readLoop()
+	s.unregister(guildID, client)
+	client.close(websocket.CloseNormalClosure)
+}
+
+// readLoop discards incoming messages, it only keeps the read deadline going and notices closed connections
+func (c *streamClient) readLoop() {
+	c.conn.SetReadLimit(streamMaxReadLength)
+	_ = c.conn.SetReadDeadline(time.Now().Add(streamPongTimeout))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(streamPongTimeout))
+	})
+	for {
+		if _, _, err := c.conn.NextReader(); err != nil {
+			return
+		}
+	}
+}
+
+func (c *streamClient) writeLoop() {
+	ticker := time.NewTicker(streamPingInterval)
+	defer func() {
+		ticker.Stop()
+		_ = c.conn.Close()
+	}()
+	for {
+		select {
+		case message := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+			if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteTimeout)); err != nil {
+				return
+			}
+		case <-c.done:
+			_ = c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(c.closeCode, ""), time.Now().Add(streamWriteTimeout))
+			return
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+
+	"github.com/disgoorg/disgolink/v3/disgolink"
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+const ttsVolume = 100
+
+var errNothingToRead = errors.New("nothing to read")
+
+// Announcement is a TTS message made of one or more clips
+type Announcement struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+	Bits int    `json:"bits,omitempty"`
+}
+
+func newAnnouncement(text string, bits int) Announcement {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	return Announcement{ID: hex.EncodeToString(id), Text: text, Bits: bits}
+}
+
+// InterruptedTrack is the player state saved when a TTS clip preempts the music
+type InterruptedTrack struct {
+	track    *lavalink.Track
+	position lavalink.Duration
+	volume   int
+	paused   bool
+}
+
+func (b *Bot) addTTSProvider(provider TTSProvider) {
+	b.TTSProviders[provider.Name()] = provider
+}
+
+// ttsProvider returns the provider chosen by the guild, falling back to the lavalink plugin
+func (b *Bot) ttsProvider(guildID snowflake.ID) TTSProvider {
+	if provider, ok := b.TTSProviders[b.Guilds.Get(guildID).currentSettings().TTSProvider]; ok {
+		return provider
+	}
+	return b.TTSProviders[TTSProviderLavalink]
+}
+
+// synthesizeClips turns the text into the clips of an announcement, bits donations start with the cheer sound of their tier
+func (b *Bot) synthesizeClips(ctx context.Context, guildID snowflake.ID, voice TTSVoice, text string, bitsAmount int) ([]lavalink.Track, error) {
+	var clips []lavalink.Track
+	if bitsAmount != 0 {
+		cheerSound, err := b.loadCheerSound(ctx, guildID, cheerTierFor(bitsAmount))
+		if err != nil {
+			ttsLog.Error("Failed to load cheer sound", "guild_id", guildID, "err", err)
+		} else {
+			clips = append(clips, cheerSound)
+		}
+	}
+
+	spokenText := b.sanitizeTTSText(ctx, guildID, languageOf(voice.LanguageCode), text)
+	if spokenText == "" {
+		return nil, errNothingToRead
+	}
+
+	provider := b.ttsProvider(guildID)
+	for _, run := range detectLanguageRuns(spokenText, languageOf(voice.LanguageCode)) {
+		track, err := b.TTSCache.Synthesize(ctx, provider, TTSRequest{
+			Text:  run.Text,
+			Voice: voiceForLanguage(provider, voice, run.Language),
+		})
+		if err != nil {
+			return nil, err
+		}
+		clips = append(clips, track)
+	}
+	return clips, nil
+}
+
+func isTTSTrack(track lavalink.Track) bool {
+	return getTrackUserData(track).TTS
+}
+
+// playAnnouncement plays the clips right away, the interrupted track is resumed once all clips finished.
+// Clips requested while another announcement is playing are played after it.
+func (b *Bot) playAnnouncement(guildID snowflake.ID, channelID snowflake.ID, announcement Announcement, clips ...lavalink.Track) error {
+	if len(clips) == 0 {
+		return nil
+	}
+	for i, clip := range clips {
+		clip, err := clip.WithUserData(TrackUserData{TTS: true, Announcement: &announcement})
+		if err != nil {
+			return err
+		}
+		clips[i] = clip
+	}
+
+	// the lock is held until the first clip plays, the clips of a concurrent announcement are queued after it
+	guild := b.Guilds.Get(guildID)
+	guild.mu.Lock()
+	defer guild.mu.Unlock()
+	if guild.interrupted != nil {
+		guild.announcements = append(guild.announcements, clips...)
+		return nil
+	}
+
+	player := b.Lavalink.Player(guildID)
+	interrupted := &InterruptedTrack{
+		volume: player.Volume(),
+		paused: player.Paused(),
+	}
+	if track := player.Track(); track != nil {
+		interrupted.track = track
+		interrupted.position = player.Position()
+	}
+	if ok := b.updateGuildVoiceState(guild, guildID, &channelID); !ok {
+		return errors.New("failed to join voice channel")
+	}
+	guild.interrupted = interrupted
+	guild.announcements = append(guild.announcements, clips[1:]...)
+	return player.Update(context.TODO(), lavalink.WithTrack(clips[0]), lavalink.WithVolume(ttsVolume), lavalink.WithPaused(false))
+}
+
+// setMusicVolume changes the player volume, during an announcement it's applied once the music resumes
+func (b *Bot) setMusicVolume(player disgolink.Player, volume int) error {
+	guild := b.Guilds.Get(player.GuildID())
+	guild.mu.Lock()
+	var err error
+	if interrupted := guild.interrupted; interrupted != nil {
+		interrupted.volume = volume
+	} else {
+		err = player.Update(context.TODO(), lavalink.WithVolume(volume))
+	}
+	guild.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	b.Stream.Publish(player.GuildID(), StreamEventPlayerVolume, StreamVolumeEvent{Volume: volume})
+	return nil
+}
+
+// nextAnnouncement plays the next queued clip or resumes the interrupted track after a TTS clip ended,
+// it returns false when the player should continue with the queue
+func (b *Bot) nextAnnouncement(player disgolink.Player, event lavalink.TrackEndEvent) bool {
+	guild := b.Guilds.Get(event.GuildID())
+	guild.mu.Lock()
+	if guild.interrupted == nil {
+		guild.mu.Unlock()
+		return false
+	}
+	// the clip was stopped or replaced by a command, drop the remaining clips but keep the music at the front of the queue
+	if !event.Reason.MayStartNext() {
+		interrupted := guild.interrupted
+		guild.interrupted = nil
+		guild.announcements = nil
+		if err := player.Update(context.TODO(), lavalink.WithVolume(interrupted.volume)); err != nil {
+			ttsLog.Error("Failed to restore volume", "guild_id", player.GuildID(), "err", err)
+		}
+		// the player message reads the announcement state, it's rendered without the lock
+		guild.mu.Unlock()
+		if interrupted.track != nil && event.Reason != lavalink.TrackEndReasonCleanup {
+			guild.queue.AddNext(*interrupted.track)
+			b.updatePlayerMessage(player.GuildID())
+		}
+		return true
+	}
+
+	if len(guild.announcements) > 0 {
+		var clip lavalink.Track
+		clip, guild.announcements = guild.announcements[0], guild.announcements[1:]
+		if err := player.Update(context.TODO(), lavalink.WithTrack(clip)); err != nil {
+			ttsLog.Error("Failed to play next TTS clip", "guild_id", player.GuildID(), "err", err)
+		}
+		guild.mu.Unlock()
+		return true
+	}
+
+	defer guild.mu.Unlock()
+	interrupted := guild.interrupted
+	guild.interrupted = nil
+	if interrupted.track == nil {
+		if err := player.Update(context.TODO(), lavalink.WithVolume(interrupted.volume)); err != nil {
+			ttsLog.Error("Failed to restore volume", "guild_id", player.GuildID(), "err", err)
+		}
+		return false
+	}
+	guild.resumed = interrupted.track.Encoded
+	err := player.Update(context.TODO(),
+		lavalink.WithTrack(*interrupted.track),
+		lavalink.WithPosition(interrupted.position),
+		lavalink.WithVolume(interrupted.volume),
+		lavalink.WithPaused(interrupted.paused),
+	)
+	if err != nil {
+		ttsLog.Error("Failed to resume interrupted track", "guild_id", player.GuildID(), "err", err)
+	}
+	return true
+}
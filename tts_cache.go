@@ -0,0 +1,182 @@
+package main
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgolink/v3/lavalink"
+)
+
+// defaultTTSCacheSize is the size cap of the clip cache in bytes when TTS_CACHE_SIZE isn't set
+const defaultTTSCacheSize = 64 << 20
+
+// TTSFileProvider is implemented by providers that keep the synthesized audio in local files.
+// Cached clips retain their file until they're evicted.
+type TTSFileProvider interface {
+	// Retain keeps the file of the track until it's released and returns its size
+	Retain(track lavalink.Track) (int64, error)
+	Release(track lavalink.Track)
+}
+
+type ttsCacheEntry struct {
+	key      string
+	track    lavalink.Track
+	provider TTSProvider
+	size     int64
+}
+
+// TTSCacheStats is a snapshot of the cache counters
+type TTSCacheStats struct {
+	Entries int
+	Size    int64
+	MaxSize int64
+	Hits    uint64
+	Misses  uint64
+}
+
+func (s TTSCacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// TTSCache keeps synthesized clips so repeated phrases skip the provider.
+// Clips are addressed by the provider, voice and normalized text and evicted least recently used first.
+type TTSCache struct {
+	mu      sync.Mutex
+	maxSize int64
+	size    int64
+	entries map[string]*list.Element
+	lru     *list.List
+	hits    uint64
+	misses  uint64
+}
+
+func NewTTSCache(maxSize int64) *TTSCache {
+	return &TTSCache{
+		maxSize: maxSize,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// ttsCacheKey hashes everything that changes the synthesized audio
+func ttsCacheKey(provider TTSProvider, request TTSRequest) string {
+	text := strings.Join(strings.Fields(request.Text), " ")
+	hash := sha256.New()
+	for _, part := range []string{
+		provider.Name(),
+		request.Voice.LanguageCode,
+		request.Voice.Name,
+		strconv.FormatFloat(request.Voice.SpeakingRate, 'f', -1, 64),
+		strconv.FormatFloat(request.Voice.Pitch, 'f', -1, 64),
+		text,
+	} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Synthesize returns the cached clip for the request or synthesizes and caches it
+func (c *TTSCache) Synthesize(ctx context.Context, provider TTSProvider, request TTSRequest) (lavalink.Track, error) {
+	key := ttsCacheKey(provider, request)
+	if track, ok := c.get(key); ok {
+		ttsSynthesesTotal.WithLabelValues(provider.Name(), "cached").Inc()
+		return track, nil
+	}
+
+	start := time.Now()
+	track, err := provider.Synthesize(ctx, request)
+	ttsSynthesisDuration.WithLabelValues(provider.Name()).Observe(time.Since(start).Seconds())
+	if err != nil {
+		ttsSynthesesTotal.WithLabelValues(provider.Name(), "error").Inc()
+		return track, err
+	}
+	ttsSynthesesTotal.WithLabelValues(provider.Name(), "ok").Inc()
+	c.add(key, provider, track)
+	return track, nil
+}
+
+func (c *TTSCache) get(key string) (lavalink.Track, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return lavalink.Track{}, false
+	}
+	c.hits++
+	c.lru.MoveToFront(element)
+	return element.Value.(*ttsCacheEntry).track, true
+}
+
+func (c *TTSCache) add(key string, provider TTSProvider, track lavalink.Track) {
+	size := int64(len(track.Encoded))
+	if fileProvider, ok := provider.(TTSFileProvider); ok {
+		fileSize, err := fileProvider.Retain(track)
+		if err != nil {
+			return
+		}
+		size += fileSize
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		// another request synthesized the same clip meanwhile, keep the first one
+		c.lru.MoveToFront(element)
+		c.release(&ttsCacheEntry{track: track, provider: provider})
+		return
+	}
+	if size > c.maxSize {
+		c.release(&ttsCacheEntry{track: track, provider: provider})
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&ttsCacheEntry{key: key, track: track, provider: provider, size: size})
+	c.size += size
+	for c.size > c.maxSize {
+		c.evict(c.lru.Back())
+	}
+}
+
+func (c *TTSCache) evict(element *list.Element) {
+	entry := c.lru.Remove(element).(*ttsCacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+	c.release(entry)
+}
+
+func (c *TTSCache) release(entry *ttsCacheEntry) {
+	if fileProvider, ok := entry.provider.(TTSFileProvider); ok {
+		fileProvider.Release(entry.track)
+	}
+}
+
+// Clear evicts every clip, the counters are kept
+func (c *TTSCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.lru.Len() > 0 {
+		c.evict(c.lru.Back())
+	}
+}
+
+func (c *TTSCache) Stats() TTSCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return TTSCacheStats{
+		Entries: c.lru.Len(),
+		Size:    c.size,
+		MaxSize: c.maxSize,
+		Hits:    c.hits,
+		Misses:  c.misses,
+	}
+}
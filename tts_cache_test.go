@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/disgoorg/disgolink/v3/lavalink"
+)
+
+// stubTTSFileProvider is a stubTTSProvider whose clips are files of fileSize bytes
+type stubTTSFileProvider struct {
+	stubTTSProvider
+	fileSize int64
+
+	fileMu   sync.Mutex
+	retained map[string]int
+	released []string
+}
+
+func newStubTTSFileProvider(fileSize int64) *stubTTSFileProvider {
+	return &stubTTSFileProvider{
+		stubTTSProvider: stubTTSProvider{name: "file"},
+		fileSize:        fileSize,
+		retained:        make(map[string]int),
+	}
+}
+
+func (p *stubTTSFileProvider) Retain(track lavalink.Track) (int64, error) {
+	p.fileMu.Lock()
+	defer p.fileMu.Unlock()
+	p.retained[track.Encoded]++
+	return p.fileSize, nil
+}
+
+func (p *stubTTSFileProvider) Release(track lavalink.Track) {
+	p.fileMu.Lock()
+	defer p.fileMu.Unlock()
+	p.retained[track.Encoded]--
+	p.released = append(p.released, track.Encoded)
+}
+
+func TestTTSCacheSynthesize(t *testing.T) {
+	voice := TTSVoice{LanguageCode: "th-TH", Name: "th-TH-Neural2-C", SpeakingRate: 1}
+	tests := []struct {
+		name      string
+		first     TTSRequest
+		second    TTSRequest
+		wantCalls int
+	}{
+		{name: "identical", first: TTSRequest{Text: "hello", Voice: voice}, second: TTSRequest{Text: "hello", Voice: voice}, wantCalls: 1},
+		{name: "whitespace only differs", first: TTSRequest{Text: "hello  world", Voice: voice}, second: TTSRequest{Text: " hello\nworld ", Voice: voice}, wantCalls: 1},
+		{name: "normalized text differs", first: TTSRequest{Text: "hello world", Voice: voice}, second: TTSRequest{Text: "helloworld", Voice: voice}, wantCalls: 2},
+		{name: "case differs", first: TTSRequest{Text: "hello", Voice: voice}, second: TTSRequest{Text: "Hello", Voice: voice}, wantCalls: 2},
+		{name: "voice differs", first: TTSRequest{Text: "hello", Voice: voice}, second: TTSRequest{Text: "hello", Voice: TTSVoice{LanguageCode: "th-TH", Name: "th-TH-Standard-A", SpeakingRate: 1}}, wantCalls: 2},
+		{name: "rate differs", first: TTSRequest{Text: "hello", Voice: voice}, second: TTSRequest{Text: "hello", Voice: TTSVoice{LanguageCode: "th-TH", Name: "th-TH-Neural2-C", SpeakingRate: 1.5}}, wantCalls: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewTTSCache(defaultTTSCacheSize)
+			provider := &stubTTSProvider{name: "stub"}
+			first, err := cache.Synthesize(context.Background(), provider, tt.first)
+			if err != nil {
+				t.Fatal(err)
+			}
+			second, err := cache.Synthesize(context.Background(), provider, tt.second)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if calls := provider.calls(); calls != tt.wantCalls {
+				t.Fatalf("provider calls = %d, want %d", calls, tt.wantCalls)
+			}
+			if hit := tt.wantCalls == 1; hit && second.Encoded != first.Encoded {
+				t.Errorf("cached clip = %q, want %q", second.Encoded, first.Encoded)
+			}
+			stats := cache.Stats()
+			if wantHits := uint64(2 - tt.wantCalls); stats.Hits != wantHits || stats.Misses != uint64(tt.wantCalls) {
+				t.Errorf("stats = %+v, want %d hits and %d misses", stats, wantHits, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestTTSCacheProviderName(t *testing.T) {
+	cache := NewTTSCache(defaultTTSCacheSize)
+	request := TTSRequest{Text: "hello"}
+	first, second := &stubTTSProvider{name: "first"}, &stubTTSProvider{name: "second"}
+	for _, provider := range []*stubTTSProvider{first, second, first} {
+		if _, err := cache.Synthesize(context.Background(), provider, request); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if first.calls() != 1 || second.calls() != 1 {
+		t.Errorf("provider calls = %d and %d, want each provider called once", first.calls(), second.calls())
+	}
+}
+
+type failingTTSProvider struct {
+	stubTTSProvider
+}
+
+func (p *failingTTSProvider) Synthesize(ctx context.Context, request TTSRequest) (lavalink.Track, error) {
+	_, _ = p.stubTTSProvider.Synthesize(ctx, request)
+	return lavalink.Track{}, errors.New("quota exceeded")
+}
+
+func TestTTSCacheSynthesizeError(t *testing.T) {
+	cache := NewTTSCache(defaultTTSCacheSize)
+	provider := &failingTTSProvider{stubTTSProvider{name: "failing"}}
+	for range 2 {
+		if _, err := cache.Synthesize(context.Background(), provider, TTSRequest{Text: "hello"}); err == nil {
+			t.Fatal("Synthesize() error = nil, want the provider error")
+		}
+	}
+	if calls := provider.calls(); calls != 2 {
+		t.Errorf("provider calls = %d, want failures not to be cached", calls)
+	}
+	if stats := cache.Stats(); stats.Entries != 0 {
+		t.Errorf("entries = %d, want 0", stats.Entries)
+	}
+}
+
+func TestTTSCacheEviction(t *testing.T) {
+	// every clip takes 100 bytes of file and the 10 bytes of its encoded track, two of them fit
+	provider := newStubTTSFileProvider(100)
+	cache := NewTTSCache(250)
+	synthesize := func(text string) lavalink.Track {
+		t.Helper()
+		track, err := cache.Synthesize(context.Background(), provider, TTSRequest{Text: text})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return track
+	}
+
+	a, b := synthesize("a"), synthesize("b")
+	synthesize("a") // a is now the most recently used
+	c := synthesize("c")
+
+	if len(provider.released) != 1 || provider.released[0] != b.Encoded {
+		t.Fatalf("released %q, want the least recently used clip %q", provider.released, b.Encoded)
+	}
+	if stats := cache.Stats(); stats.Entries != 2 || stats.Size != 220 {
+		t.Errorf("stats = %+v, want 2 entries of 220 bytes", stats)
+	}
+	synthesize("b")
+	if calls := provider.calls(); calls != 4 {
+		t.Errorf("provider calls = %d, want the evicted clip synthesized again", calls)
+	}
+
+	cache.Clear()
+	for _, track := range []lavalink.Track{a, b, c} {
+		if retained := provider.retained[track.Encoded]; retained != 0 {
+			t.Errorf("%s retained %d times after Clear, want every file released", track.Encoded, retained)
+		}
+	}
+}
+
+func TestTTSCacheOversizedClip(t *testing.T) {
+	provider := newStubTTSFileProvider(1000)
+	cache := NewTTSCache(250)
+	track, err := cache.Synthesize(context.Background(), provider, TTSRequest{Text: "long"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retained := provider.retained[track.Encoded]; retained != 0 || len(provider.released) != 1 {
+		t.Errorf("retained %d, released %q, want the clip released right away", retained, provider.released)
+	}
+	if stats := cache.Stats(); stats.Entries != 0 || stats.Size != 0 {
+		t.Errorf("stats = %+v, want an empty cache", stats)
+	}
+}
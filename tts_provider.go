@@ -0,0 +1,341 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgolink/v3/disgolink"
+	"github.com/disgoorg/disgolink/v3/lavalink"
+)
+
+const (
+	TTSProviderLavalink = "lavalink"
+	TTSProviderHTTP     = "http"
+	TTSProviderLocal    = "local"
+
+	// localTTSFileLifetime is how long synthesized files are served for lavalink to fetch them
+	localTTSFileLifetime = 10 * time.Minute
+)
+
+// TTSVoice selects the voice a provider speaks with
+type TTSVoice struct {
+	LanguageCode string
+	Name         string
+	SpeakingRate float64
+	Pitch        float64
+}
+
+type TTSRequest struct {
+	Text  string
+	Voice TTSVoice
+}
+
+// TTSProvider synthesizes text into a track lavalink can play
+type TTSProvider interface {
+	Name() string
+	// Voices returns the catalog of voices, an empty catalog accepts any voice name
+	Voices() []TTSVoice
+	Synthesize(ctx context.Context, request TTSRequest) (lavalink.Track, error)
+}
+
+// googleTTSVoices is the part of the Google Cloud TTS catalog offered for the lavalink plugin
+var googleTTSVoices = []TTSVoice{
+	{LanguageCode: "th-TH", Name: "th-TH-Neural2-C"},
+	{LanguageCode: "th-TH", Name: "th-TH-Standard-A"},
+	{LanguageCode: "en-US", Name: "en-US-Neural2-A"},
+	{LanguageCode: "en-US", Name: "en-US-Neural2-C"},
+	{LanguageCode: "en-US", Name: "en-US-Neural2-D"},
+	{LanguageCode: "en-US", Name: "en-US-Neural2-F"},
+	{LanguageCode: "en-GB", Name: "en-GB-Neural2-A"},
+	{LanguageCode: "en-GB", Name: "en-GB-Neural2-B"},
+	{LanguageCode: "ja-JP", Name: "ja-JP-Neural2-B"},
+	{LanguageCode: "ja-JP", Name: "ja-JP-Neural2-C"},
+	{LanguageCode: "ko-KR", Name: "ko-KR-Neural2-A"},
+	{LanguageCode: "cmn-CN", Name: "cmn-CN-Standard-A"},
+}
+
+// parseTTSVoices parses a comma separated catalog of language:name pairs, e.g. "en-US:en-us,th-TH:th"
+func parseTTSVoices(catalog string) []TTSVoice {
+	var voices []TTSVoice
+	for _, entry := range strings.Split(catalog, ",") {
+		languageCode, name, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || name == "" {
+			continue
+		}
+		voices = append(voices, TTSVoice{LanguageCode: languageCode, Name: name})
+	}
+	return voices
+}
+
+// loadSingleTrack loads an identifier that is expected to resolve to exactly one track
+func loadSingleTrack(ctx context.Context, node disgolink.Node, identifier string) (lavalink.Track, error) {
+	loadResult, err := loadTracks(ctx, node, identifier)
+	if err != nil {
+		return lavalink.Track{}, err
+	}
+	switch data := loadResult.Data.(type) {
+	case lavalink.Track:
+		return data, nil
+	case lavalink.Search:
+		if len(data) > 0 {
+			return data[0], nil
+		}
+	case lavalink.Exception:
+		return lavalink.Track{}, data
+	}
+	return lavalink.Track{}, fmt.Errorf("no track found for %s", identifier)
+}
+
+// lavalinkTTSPadding keeps the last word audible, the lavalink plugin cuts off the end of the clip otherwise.
+// The http and local providers get the whole clip from their engine and don't need it.
+const lavalinkTTSPadding = " / / / /"
+
+// LavalinkTTSProvider uses the tts:// source of the lavalink TTS plugin, the config is shaped like a Google Cloud TTS request
+type LavalinkTTSProvider struct {
+	lavalink disgolink.Client
+}
+
+func NewLavalinkTTSProvider(client disgolink.Client) *LavalinkTTSProvider {
+	return &LavalinkTTSProvider{lavalink: client}
+}
+
+func (p *LavalinkTTSProvider) Name() string {
+	return TTSProviderLavalink
+}
+
+func (p *LavalinkTTSProvider) Voices() []TTSVoice {
+	return googleTTSVoices
+}
+
+func (p *LavalinkTTSProvider) Synthesize(ctx context.Context, request TTSRequest) (lavalink.Track, error) {
+	type Input struct {
+		Text string `json:"text"`
+	}
+
+	type Voice struct {
+		LanguageCode string `json:"languageCode"`
+		Name         string `json:"name"`
+		SsmlGender   string `json:"ssmlGender"`
+	}
+
+	type AudioConfig struct {
+		AudioEncoding string  `json:"audioEncoding"`
+		SpeakingRate  float64 `json:"speakingRate"`
+		Pitch         float64 `json:"pitch"`
+	}
+
+	type Config struct {
+		Input       Input       `json:"input"`
+		Voice       Voice       `json:"voice"`
+		AudioConfig AudioConfig `json:"audioConfig"`
+	}
+
+	jsonStr, err := json.Marshal(&Config{
+		Input: Input{
+			Text: request.Text + lavalinkTTSPadding,
+		},
+		Voice: Voice{
+			LanguageCode: request.Voice.LanguageCode,
+			Name:         request.Voice.Name,
+		},
+		AudioConfig: AudioConfig{
+			AudioEncoding: "OGG_OPUS",
+			SpeakingRate:  request.Voice.SpeakingRate,
+			Pitch:         request.Voice.Pitch,
+		},
+	})
+	if err != nil {
+		return lavalink.Track{}, err
+	}
+
+	query := url.Values{}
+	query.Add("config", string(jsonStr))
+	return loadSingleTrack(ctx, p.lavalink.BestNode(), fmt.Sprintf("tts://?%s", query.Encode()))
+}
+
+// HTTPTTSProvider lets lavalink fetch the audio from a synthesizer reachable over http.
+// The url template may contain {text}, {language}, {voice}, {rate} and {pitch}.
+type HTTPTTSProvider struct {
+	lavalink    disgolink.Client
+	urlTemplate string
+	voices      []TTSVoice
+}
+
+func NewHTTPTTSProvider(client disgolink.Client, urlTemplate string, voices []TTSVoice) *HTTPTTSProvider {
+	return &HTTPTTSProvider{
+		lavalink:    client,
+		urlTemplate: urlTemplate,
+		voices:      voices,
+	}
+}
+
+func (p *HTTPTTSProvider) Name() string {
+	return TTSProviderHTTP
+}
+
+func (p *HTTPTTSProvider) Voices() []TTSVoice {
+	return p.voices
+}
+
+func (p *HTTPTTSProvider) Synthesize(ctx context.Context, request TTSRequest) (lavalink.Track, error) {
+	replacer := strings.NewReplacer(
+		"{text}", url.QueryEscape(request.Text),
+		"{language}", url.QueryEscape(request.Voice.LanguageCode),
+		"{voice}", url.QueryEscape(request.Voice.Name),
+		"{rate}", strconv.FormatFloat(request.Voice.SpeakingRate, 'f', -1, 64),
+		"{pitch}", strconv.FormatFloat(request.Voice.Pitch, 'f', -1, 64),
+	)
+	return loadSingleTrack(ctx, p.lavalink.BestNode(), replacer.Replace(p.urlTemplate))
+}
+
+// LocalTTSProvider runs a command line engine like espeak-ng or piper and serves the audio over the bot http server.
+// The text is written to stdin, the command may contain {output}, {language}, {voice}, {rate} and {pitch}.
+type LocalTTSProvider struct {
+	lavalink  disgolink.Client
+	command   []string
+	directory string
+	publicURL string
+	voices    []TTSVoice
+	// fileLifetime is localTTSFileLifetime, tests shorten it
+	fileLifetime time.Duration
+
+	mu sync.Mutex
+	// removals holds the pending removal of every synthesized file, retained files have none
+	removals map[string]*time.Timer
+}
+
+func NewLocalTTSProvider(client disgolink.Client, command string, directory string, publicURL string, voices []TTSVoice) (*LocalTTSProvider, error) {
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, err
+	}
+	return &LocalTTSProvider{
+		lavalink:     client,
+		command:      strings.Fields(command),
+		directory:    directory,
+		publicURL:    strings.TrimSuffix(publicURL, "/"),
+		voices:       voices,
+		fileLifetime: localTTSFileLifetime,
+		removals:     make(map[string]*time.Timer),
+	}, nil
+}
+
+func (p *LocalTTSProvider) Name() string {
+	return TTSProviderLocal
+}
+
+func (p *LocalTTSProvider) Voices() []TTSVoice {
+	return p.voices
+}
+
+func (p *LocalTTSProvider) Synthesize(ctx context.Context, request TTSRequest) (lavalink.Track, error) {
+	if len(p.command) == 0 {
+		return lavalink.Track{}, errors.New("no local tts command configured")
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return lavalink.Track{}, err
+	}
+	fileName := hex.EncodeToString(id) + ".wav"
+	output := filepath.Join(p.directory, fileName)
+
+	replacer := strings.NewReplacer(
+		"{output}", output,
+		"{language}", request.Voice.LanguageCode,
+		"{voice}", request.Voice.Name,
+		"{rate}", strconv.FormatFloat(request.Voice.SpeakingRate, 'f', -1, 64),
+		"{pitch}", strconv.FormatFloat(request.Voice.Pitch, 'f', -1, 64),
+	)
+	args := make([]string, len(p.command))
+	for i, arg := range p.command {
+		args[i] = replacer.Replace(arg)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdin = strings.NewReader(request.Text)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return lavalink.Track{}, fmt.Errorf("failed to run %s: %w: %s", args[0], err, stderr.String())
+	}
+	p.scheduleRemoval(fileName)
+
+	return loadSingleTrack(ctx, p.lavalink.BestNode(), fmt.Sprintf("%s/tts/%s", p.publicURL, fileName))
+}
+
+// scheduleRemoval removes the file once lavalink had enough time to fetch it
+func (p *LocalTTSProvider) scheduleRemoval(fileName string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if timer, ok := p.removals[fileName]; ok {
+		timer.Stop()
+	}
+	p.removals[fileName] = time.AfterFunc(p.fileLifetime, func() {
+		p.mu.Lock()
+		delete(p.removals, fileName)
+		p.mu.Unlock()
+		if err := os.Remove(filepath.Join(p.directory, fileName)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			ttsLog.Error("Failed to remove TTS file", "file", fileName, "err", err)
+		}
+	})
+}
+
+func (p *LocalTTSProvider) trackFileName(track lavalink.Track) string {
+	if track.Info.URI == nil {
+		return ""
+	}
+	return path.Base(*track.Info.URI)
+}
+
+// Retain keeps the file of a cached clip until it's released
+func (p *LocalTTSProvider) Retain(track lavalink.Track) (int64, error) {
+	fileName := p.trackFileName(track)
+	if fileName == "" {
+		return 0, errors.New("track has no file")
+	}
+	p.mu.Lock()
+	if timer, ok := p.removals[fileName]; ok {
+		timer.Stop()
+		delete(p.removals, fileName)
+	}
+	p.mu.Unlock()
+
+	info, err := os.Stat(filepath.Join(p.directory, fileName))
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// Release removes the file of an evicted clip after the usual lifetime, it may still be playing
+func (p *LocalTTSProvider) Release(track lavalink.Track) {
+	if fileName := p.trackFileName(track); fileName != "" {
+		p.scheduleRemoval(fileName)
+	}
+}
+
+// ServeHTTP serves the synthesized files to lavalink
+func (p *LocalTTSProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fileName := filepath.Base(r.PathValue("file"))
+	if filepath.Ext(fileName) != ".wav" {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "audio/wav")
+	http.ServeFile(w, r, filepath.Join(p.directory, fileName))
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgolink/v3/lavalink"
+)
+
+func TestHTTPTTSProviderSynthesize(t *testing.T) {
+	client := newFakeLavalink()
+	provider := NewHTTPTTSProvider(client, "https://tts.example/speak?text={text}&lang={language}&voice={voice}&rate={rate}&pitch={pitch}", nil)
+
+	track, err := provider.Synthesize(context.Background(), TTSRequest{
+		Text:  "hello world & more",
+		Voice: TTSVoice{LanguageCode: "en-US", Name: "en us/1", SpeakingRate: 1.25, Pitch: -2},
+	})
+	if err != nil {
+		t.Fatalf("Synthesize() error = %v", err)
+	}
+	want := "https://tts.example/speak?text=hello+world+%26+more&lang=en-US&voice=en+us%2F1&rate=1.25&pitch=-2"
+	if loaded := client.node.loaded(); len(loaded) != 1 || loaded[0] != want {
+		t.Fatalf("loaded %q, want %q", loaded, want)
+	}
+	if track.Info.Identifier != want {
+		t.Errorf("track identifier = %q, want %q", track.Info.Identifier, want)
+	}
+}
+
+func TestLavalinkTTSProviderSynthesize(t *testing.T) {
+	client := newFakeLavalink()
+	provider := NewLavalinkTTSProvider(client)
+
+	_, err := provider.Synthesize(context.Background(), TTSRequest{
+		Text:  "สวัสดี",
+		Voice: TTSVoice{LanguageCode: "th-TH", Name: "th-TH-Neural2-C", SpeakingRate: 0.8},
+	})
+	if err != nil {
+		t.Fatalf("Synthesize() error = %v", err)
+	}
+	loaded := client.node.loaded()
+	if len(loaded) != 1 || !strings.HasPrefix(loaded[0], "tts://?") {
+		t.Fatalf("loaded %q, want a tts:// identifier", loaded)
+	}
+	query, err := url.ParseQuery(strings.TrimPrefix(loaded[0], "tts://?"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var config struct {
+		Input struct {
+			Text string `json:"text"`
+		} `json:"input"`
+		Voice struct {
+			LanguageCode string `json:"languageCode"`
+			Name         string `json:"name"`
+		} `json:"voice"`
+		AudioConfig struct {
+			SpeakingRate float64 `json:"speakingRate"`
+		} `json:"audioConfig"`
+	}
+	if err = json.Unmarshal([]byte(query.Get("config")), &config); err != nil {
+		t.Fatal(err)
+	}
+	if config.Input.Text != "สวัสดี"+lavalinkTTSPadding {
+		t.Errorf("text = %q, want the padded text", config.Input.Text)
+	}
+	if config.Voice.LanguageCode != "th-TH" || config.Voice.Name != "th-TH-Neural2-C" || config.AudioConfig.SpeakingRate != 0.8 {
+		t.Errorf("config = %+v, want the requested voice", config)
+	}
+}
+
+func TestLoadSingleTrack(t *testing.T) {
+	track := newFakeTrack("a")
+	tests := []struct {
+		name    string
+		result  *lavalink.LoadResult
+		err     error
+		want    string
+		wantErr bool
+	}{
+		{name: "track", result: &lavalink.LoadResult{LoadType: lavalink.LoadTypeTrack, Data: track}, want: "a"},
+		{name: "search takes the first result", result: &lavalink.LoadResult{LoadType: lavalink.LoadTypeSearch, Data: lavalink.Search{track, newFakeTrack("b")}}, want: "a"},
+		{name: "empty search", result: &lavalink.LoadResult{LoadType: lavalink.LoadTypeSearch, Data: lavalink.Search{}}, wantErr: true},
+		{name: "empty", result: &lavalink.LoadResult{LoadType: lavalink.LoadTypeEmpty, Data: lavalink.Empty{}}, wantErr: true},
+		{name: "exception", result: &lavalink.LoadResult{LoadType: lavalink.LoadTypeError, Data: lavalink.Exception{Message: "blocked"}}, wantErr: true},
+		{name: "request failed", err: errors.New("connection refused"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newFakeLavalink()
+			client.node.load = func(string) (*lavalink.LoadResult, error) {
+				return tt.result, tt.err
+			}
+			got, err := loadSingleTrack(context.Background(), client.node, "identifier")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loadSingleTrack() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Info.Identifier != tt.want {
+				t.Errorf("loadSingleTrack() = %q, want %q", got.Info.Identifier, tt.want)
+			}
+		})
+	}
+}
+
+func newTestLocalTTSProvider(t *testing.T, lifetime time.Duration) (*LocalTTSProvider, *fakeLavalink) {
+	t.Helper()
+	client := newFakeLavalink()
+	// the command writes the text it reads from stdin as the clip
+	provider, err := NewLocalTTSProvider(client, "sh -c cat>{output}", t.TempDir(), "https://bot.example/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if provider.fileLifetime != localTTSFileLifetime {
+		t.Fatalf("fileLifetime = %s, want %s", provider.fileLifetime, localTTSFileLifetime)
+	}
+	provider.fileLifetime = lifetime
+	return provider, client
+}
+
+func serveTTSFile(provider *LocalTTSProvider, fileName string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodGet, "/tts/"+fileName, nil)
+	request.SetPathValue("file", fileName)
+	recorder := httptest.NewRecorder()
+	provider.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestLocalTTSProviderSynthesize(t *testing.T) {
+	provider, client := newTestLocalTTSProvider(t, 50*time.Millisecond)
+
+	track, err := provider.Synthesize(context.Background(), TTSRequest{Text: "hello"})
+	if err != nil {
+		t.Fatalf("Synthesize() error = %v", err)
+	}
+	loaded := client.node.loaded()
+	if len(loaded) != 1 || !strings.HasPrefix(loaded[0], "https://bot.example/tts/") || !strings.HasSuffix(loaded[0], ".wav") {
+		t.Fatalf("loaded %q, want the url of the clip", loaded)
+	}
+	fileName := path.Base(*track.Info.URI)
+
+	recorder := serveTTSFile(provider, fileName)
+	if recorder.Code != http.StatusOK || recorder.Body.String() != "hello" {
+		t.Fatalf("served %d %q, want 200 with the clip", recorder.Code, recorder.Body.String())
+	}
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "audio/wav" {
+		t.Errorf("Content-Type = %q, want audio/wav", contentType)
+	}
+
+	waitForRemoval(t, filepath.Join(provider.directory, fileName))
+	if recorder = serveTTSFile(provider, fileName); recorder.Code != http.StatusNotFound {
+		t.Errorf("served %d after the lifetime, want 404", recorder.Code)
+	}
+}
+
+func TestLocalTTSProviderSynthesizeFailure(t *testing.T) {
+	client := newFakeLavalink()
+	provider, err := NewLocalTTSProvider(client, "sh -c exit\\ 3", t.TempDir(), "https://bot.example", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = provider.Synthesize(context.Background(), TTSRequest{Text: "hello"}); err == nil {
+		t.Fatal("Synthesize() error = nil, want the failure of the command")
+	}
+	if loaded := client.node.loaded(); len(loaded) != 0 {
+		t.Errorf("loaded %q after a failed command, want nothing", loaded)
+	}
+}
+
+func TestLocalTTSProviderServeHTTP(t *testing.T) {
+	provider, _ := newTestLocalTTSProvider(t, time.Minute)
+	if err := os.WriteFile(filepath.Join(provider.directory, "clip.wav"), []byte("clip"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(provider.directory, "notes.txt"), []byte("notes"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		fileName string
+		want     int
+	}{
+		{fileName: "clip.wav", want: http.StatusOK},
+		{fileName: "missing.wav", want: http.StatusNotFound},
+		{fileName: "notes.txt", want: http.StatusNotFound},
+		{fileName: "../clip.wav", want: http.StatusBadRequest},
+		{fileName: "../../etc/passwd", want: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fileName, func(t *testing.T) {
+			if recorder := serveTTSFile(provider, tt.fileName); recorder.Code != tt.want {
+				t.Errorf("served %d, want %d", recorder.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalTTSProviderRetainRelease(t *testing.T) {
+	provider, _ := newTestLocalTTSProvider(t, 50*time.Millisecond)
+	track, err := provider.Synthesize(context.Background(), TTSRequest{Text: "hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(provider.directory, path.Base(*track.Info.URI))
+
+	size, err := provider.Retain(track)
+	if err != nil || size != int64(len("hello")) {
+		t.Fatalf("Retain() = %d, %v, want the size of the clip", size, err)
+	}
+	time.Sleep(4 * provider.fileLifetime)
+	if _, err = os.Stat(file); err != nil {
+		t.Fatalf("retained file was removed: %v", err)
+	}
+
+	provider.Release(track)
+	waitForRemoval(t, file)
+}
+
+func waitForRemoval(t *testing.T, file string) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if _, err := os.Stat(file); errors.Is(err, os.ErrNotExist) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("%s wasn't removed", file)
+}
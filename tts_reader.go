@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent"
+	"github.com/loukhin/probably-a-music-bot/ent/member"
+)
+
+const (
+	// ttsReaderMaxLength is the number of characters read from a single message, reading is free so it's capped to the free length
+	ttsReaderMaxLength = ttsFreeLength
+	// ttsReaderSpeakerGap is how long the name of the last speaker is left out for consecutive messages
+	ttsReaderSpeakerGap = 30 * time.Second
+
+	ttsReaderUserLimit   = 3
+	ttsReaderUserWindow  = 10 * time.Second
+	ttsReaderGuildLimit  = 10
+	ttsReaderGuildWindow = 30 * time.Second
+
+	// ttsReaderTimeout is how long synthesizing and playing a single message may take
+	ttsReaderTimeout = 10 * time.Second
+)
+
+// ttsReaderCommandPrefixes are the prefixes of text commands for other bots, these messages are not read
+var ttsReaderCommandPrefixes = []string{"/", "!", "?", "$", ";", "%"}
+
+// TTSReader holds the state of the channel reader of a guild
+type TTSReader struct {
+	users *RateLimiter[snowflake.ID]
+	guild *RateLimiter[snowflake.ID]
+
+	mu          sync.Mutex
+	lastSpeaker snowflake.ID
+	lastReadAt  time.Time
+	// pending are the messages waiting for the worker, reading tells whether it's running
+	pending []discord.Message
+	reading bool
+}
+
+func newTTSReader() *TTSReader {
+	return &TTSReader{
+		users: newRateLimiter[snowflake.ID](ttsReaderUserLimit, ttsReaderUserWindow),
+		guild: newRateLimiter[snowflake.ID](ttsReaderGuildLimit, ttsReaderGuildWindow),
+	}
+}
+
+// speakerPrefix returns the name to announce before the message, it's left out while the same member keeps talking
+func (r *TTSReader) speakerPrefix(userID snowflake.ID, name string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prefix := name + ": "
+	if r.lastSpeaker == userID && time.Since(r.lastReadAt) < ttsReaderSpeakerGap {
+		prefix = ""
+	}
+	r.lastSpeaker = userID
+	r.lastReadAt = time.Now()
+	return prefix
+}
+
+// enqueue hands the message to the worker of the guild, messages are read one at a time in the order they were sent.
+// The worker only runs while there are messages, the guild rate limit keeps the backlog short.
+func (r *TTSReader) enqueue(message discord.Message, read func(message discord.Message)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending = append(r.pending, message)
+	if r.reading {
+		return
+	}
+	r.reading = true
+	go func() {
+		for {
+			r.mu.Lock()
+			if len(r.pending) == 0 {
+				r.reading = false
+				r.mu.Unlock()
+				return
+			}
+			next := r.pending[0]
+			r.pending = r.pending[1:]
+			r.mu.Unlock()
+			withTaskRecover("TTSReader", ttsReaderTags(next), func() {
+				read(next)
+			})()
+		}
+	}()
+}
+
+func ttsReaderTags(message discord.Message) map[string]string {
+	tags := map[string]string{
+		"channel_id": message.ChannelID.String(),
+		"user_id":    message.Author.ID.String(),
+	}
+	if message.GuildID != nil {
+		tags["guild_id"] = message.GuildID.String()
+	}
+	return tags
+}
+
+func isTTSReaderCommand(content string) bool {
+	for _, prefix := range ttsReaderCommandPrefixes {
+		if strings.HasPrefix(content, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ttsReaderText returns the text to read for a message, an empty string means the message is skipped
+func ttsReaderText(message discord.Message) string {
+	if message.Author.Bot || message.WebhookID != nil || message.Member == nil {
+		return ""
+	}
+	if message.Type != discord.MessageTypeDefault && message.Type != discord.MessageTypeReply {
+		return ""
+	}
+	content := strings.TrimSpace(message.Content)
+	if isTTSReaderCommand(content) {
+		return ""
+	}
+	if content == "" && len(message.Attachments) > 0 {
+		return "sent an attachment"
+	}
+	if runes := []rune(content); len(runes) > ttsReaderMaxLength {
+		content = string(runes[:ttsReaderMaxLength])
+	}
+	return content
+}
+
+func (b *Bot) isTTSReaderOptedOut(guildID snowflake.ID, userID snowflake.ID) bool {
+	dbMember, err := b.EntClient.Member.Query().Where(member.GuildID(guildID), member.UserID(userID)).Only(context.TODO())
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			dbLog.Error("Failed to load member", "guild_id", guildID, "user_id", userID, "err", err)
+		}
+		return false
+	}
+	return dbMember.TtsReaderOptOut
+}
+
+// readMessage queues a message of the reader channel, it's only read while the bot is in a voice channel
+func (b *Bot) readMessage(event *events.GuildMessageCreate) {
+	guild := b.Guilds.Get(event.GuildID)
+	channelID := guild.currentSettings().TTSReaderChannelID
+	if channelID == nil || *channelID != event.ChannelID {
+		return
+	}
+	if ttsReaderText(event.Message) == "" {
+		return
+	}
+	if _, ok := b.botVoiceChannel(event.GuildID); !ok {
+		return
+	}
+	if !guild.reader.users.Allow(event.Message.Author.ID) || !guild.reader.guild.Allow(event.GuildID) {
+		ttsLog.Debug("Skipped reading message, rate limited", "guild_id", event.GuildID, "channel_id", event.ChannelID, "user_id", event.Message.Author.ID, "message_id", event.MessageID)
+		return
+	}
+	guild.reader.enqueue(event.Message, func(message discord.Message) {
+		b.readQueuedMessage(event.GuildID, guild.reader, message)
+	})
+}
+
+// readQueuedMessage reads the message in the channel the bot is in, the author doesn't have to be in voice
+func (b *Bot) readQueuedMessage(guildID snowflake.ID, reader *TTSReader, message discord.Message) {
+	if b.isTTSReaderOptedOut(guildID, message.Author.ID) {
+		return
+	}
+	channelID, ok := b.botVoiceChannel(guildID)
+	if !ok {
+		ttsLog.Debug("Skipped reading message, not in a voice channel", "guild_id", guildID, "message_id", message.ID)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ttsReaderTimeout)
+	defer cancel()
+
+	voice, err := b.resolveTTSVoice(ctx, guildID, message.Author.ID, "")
+	if err != nil {
+		ttsLog.Error("Failed to choose voice", "guild_id", guildID, "user_id", message.Author.ID, "err", err)
+		return
+	}
+	user := *message.Member
+	user.User = message.Author
+	text := reader.speakerPrefix(user.User.ID, user.EffectiveName()) + ttsReaderText(message)
+	clips, err := b.synthesizeClips(ctx, guildID, voice, text, 0)
+	if errors.Is(err, errNothingToRead) {
+		return
+	}
+	if err != nil {
+		ttsLog.Error("Failed to synthesize message", "guild_id", guildID, "user_id", message.Author.ID, "message_id", message.ID, "err", err)
+		return
+	}
+	if err = b.playAnnouncement(guildID, channelID, newAnnouncement(text, 0), clips...); err != nil {
+		ttsLog.Error("Failed to read message", "guild_id", guildID, "user_id", message.Author.ID, "message_id", message.ID, "err", err)
+		return
+	}
+	ttsLog.Debug("Read message", "guild_id", guildID, "user_id", message.Author.ID, "message_id", message.ID)
+	b.updatePlayerMessage(guildID)
+}
+
+// botVoiceChannel returns the voice channel the bot is connected to
+func (b *Bot) botVoiceChannel(guildID snowflake.ID) (snowflake.ID, bool) {
+	voiceState, ok := b.Client.Caches().VoiceState(guildID, b.Client.ID())
+	if !ok || voiceState.ChannelID == nil {
+		return 0, false
+	}
+	return *voiceState.ChannelID, true
+}
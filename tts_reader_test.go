@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+const (
+	testReaderChannelID = snowflake.ID(3000)
+	testVoiceChannelID  = snowflake.ID(4000)
+	testAuthorID        = snowflake.ID(5000)
+)
+
+func newTestReaderMessage(channelID snowflake.ID, content string) *events.GuildMessageCreate {
+	author := discord.User{ID: testAuthorID, Username: "mint"}
+	return &events.GuildMessageCreate{GenericGuildMessage: &events.GenericGuildMessage{
+		MessageID: 1,
+		ChannelID: channelID,
+		GuildID:   testGuildID,
+		Message: discord.Message{
+			ID:        1,
+			ChannelID: channelID,
+			Type:      discord.MessageTypeDefault,
+			Content:   content,
+			Author:    author,
+			Member:    &discord.Member{User: author},
+		},
+	}}
+}
+
+func TestReadMessage(t *testing.T) {
+	voiceChannelID, otherVoiceChannelID := testVoiceChannelID, snowflake.ID(4001)
+	tests := []struct {
+		name        string
+		botChannel  *snowflake.ID
+		authorVoice *snowflake.ID
+		channelID   snowflake.ID
+		optedOut    bool
+		wantRead    bool
+		wantChannel *snowflake.ID
+	}{
+		{name: "author outside voice", botChannel: &voiceChannelID, channelID: testReaderChannelID, wantRead: true, wantChannel: &voiceChannelID},
+		{name: "author in another channel", botChannel: &voiceChannelID, authorVoice: &otherVoiceChannelID, channelID: testReaderChannelID, wantRead: true, wantChannel: &voiceChannelID},
+		{name: "bot not in voice doesn't join", authorVoice: &voiceChannelID, channelID: testReaderChannelID},
+		{name: "other text channel", botChannel: &voiceChannelID, channelID: 3001, wantChannel: &voiceChannelID},
+		{name: "opted out", botChannel: &voiceChannelID, channelID: testReaderChannelID, optedOut: true, wantChannel: &voiceChannelID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, discordClient, lavalinkClient := newTestBot(t)
+			provider := &stubTTSProvider{name: TTSProviderLavalink}
+			b.addTTSProvider(provider)
+			readerChannelID := testReaderChannelID
+			b.Guilds.Get(testGuildID).updateSettings(func(settings *GuildSettings) {
+				settings.TTSReaderChannelID = &readerChannelID
+			})
+			discordClient.joinVoice(testGuildID, testBotID, tt.botChannel)
+			discordClient.joinVoice(testGuildID, testAuthorID, tt.authorVoice)
+			if tt.optedOut {
+				if err := b.EntClient.Member.Create().SetGuildID(testGuildID).SetUserID(testAuthorID).SetTtsReaderOptOut(true).Exec(context.Background()); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			b.readMessage(newTestReaderMessage(tt.channelID, "hello"))
+			waitForReader(b.Guilds.Get(testGuildID).reader)
+
+			player := lavalinkClient.ExistingPlayer(testGuildID)
+			if read := player != nil && player.Track() != nil; read != tt.wantRead {
+				t.Fatalf("read = %t, want %t", read, tt.wantRead)
+			}
+			if tt.wantRead {
+				if track := player.Track(); !strings.Contains(track.Encoded, "mint: hello") || !isTTSTrack(*track) {
+					t.Errorf("played %q, want the message with the name of the author", track.Encoded)
+				}
+			}
+			botChannel, inVoice := b.botVoiceChannel(testGuildID)
+			if inVoice != (tt.wantChannel != nil) || (inVoice && botChannel != *tt.wantChannel) {
+				t.Errorf("bot voice channel = %s, %t, want %v", botChannel, inVoice, tt.wantChannel)
+			}
+		})
+	}
+}
+
+func TestReadMessageOrder(t *testing.T) {
+	b, discordClient, _ := newTestBot(t)
+	provider := &stubTTSProvider{name: TTSProviderLavalink}
+	b.addTTSProvider(provider)
+	readerChannelID := testReaderChannelID
+	b.Guilds.Get(testGuildID).updateSettings(func(settings *GuildSettings) {
+		settings.TTSReaderChannelID = &readerChannelID
+	})
+	voiceChannelID := testVoiceChannelID
+	discordClient.joinVoice(testGuildID, testBotID, &voiceChannelID)
+
+	for _, content := range []string{"one", "two", "three"} {
+		b.readMessage(newTestReaderMessage(testReaderChannelID, content))
+	}
+	waitForReader(b.Guilds.Get(testGuildID).reader)
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+	var texts []string
+	for _, request := range provider.requests {
+		texts = append(texts, request.Text)
+	}
+	if got := strings.Join(texts, "|"); got != "mint: one|two|three" {
+		t.Errorf("synthesized %q, want the messages in order with the name only once", got)
+	}
+	// the user limit drops the messages after the third
+	b.readMessage(newTestReaderMessage(testReaderChannelID, "four"))
+	waitForReader(b.Guilds.Get(testGuildID).reader)
+	if len(provider.requests) != len(texts) {
+		t.Errorf("synthesized %d texts after the limit, want %d", len(provider.requests), len(texts))
+	}
+}
+
+func waitForReader(reader *TTSReader) {
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		reader.mu.Lock()
+		reading := reader.reading
+		reader.mu.Unlock()
+		if !reading {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestTTSReaderEnqueue(t *testing.T) {
+	reader := newTTSReader()
+	var (
+		mu   sync.Mutex
+		read []string
+		done = make(chan struct{})
+	)
+	for _, content := range []string{"a", "b", "c", "d"} {
+		reader.enqueue(discord.Message{Content: content}, func(message discord.Message) {
+			mu.Lock()
+			defer mu.Unlock()
+			read = append(read, message.Content)
+			if len(read) == 4 {
+				close(done)
+			}
+		})
+	}
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("the worker didn't read the messages")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if got := strings.Join(read, ""); got != "abcd" {
+		t.Errorf("read %q, want abcd", got)
+	}
+}
+
+func TestTTSReaderEnqueuePanic(t *testing.T) {
+	reader := newTTSReader()
+	read := make(chan string, 2)
+	for _, content := range []string{"panic", "after"} {
+		reader.enqueue(discord.Message{Content: content}, func(message discord.Message) {
+			if message.Content == "panic" {
+				panic("synthesis failed")
+			}
+			read <- message.Content
+		})
+	}
+	select {
+	case content := <-read:
+		if content != "after" {
+			t.Errorf("read %q, want the message after the panic", content)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("the worker stopped after the panic")
+	}
+	waitForReader(reader)
+	reader.mu.Lock()
+	defer reader.mu.Unlock()
+	if reader.reading {
+		t.Error("reading = true, want the worker to exit once the messages were read")
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter[int](2, 50*time.Millisecond)
+	for i, want := range []bool{true, true, false} {
+		if got := limiter.Allow(1); got != want {
+			t.Errorf("Allow() #%d = %t, want %t", i, got, want)
+		}
+	}
+	if !limiter.Allow(2) {
+		t.Error("Allow() of another key = false, want true")
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if !limiter.Allow(1) {
+		t.Error("Allow() after the window = false, want true")
+	}
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	if _, ok := limiter.hits[2]; ok || len(limiter.hits) != 1 {
+		t.Errorf("hits = %v, want the expired key removed", limiter.hits)
+	}
+}
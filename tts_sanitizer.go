@@ -0,0 +1,417 @@
+package main
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent/pronunciation"
+)
+
+// ttsNameLifetime is how long the name of a mentioned member fetched over REST is reused
+const ttsNameLifetime = 10 * time.Minute
+
+var (
+	userMentionPattern    = regexp.MustCompile(`<@!?(\d+)>`)
+	roleMentionPattern    = regexp.MustCompile(`<@&(\d+)>`)
+	channelMentionPattern = regexp.MustCompile(`<#(\d+)>`)
+	customEmojiPattern    = regexp.MustCompile(`<a?:(\w+):\d+>`)
+	ttsURLPattern         = regexp.MustCompile(`https?://[^\s<>]+`)
+	// numberPattern skips digits inside words like mp3 or v2, \b only counts ASCII letters so Thai text still matches
+	numberPattern     = regexp.MustCompile(`\b(?:\d{1,3}(?:,\d{3})+|\d+)\b`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// emojiWords is used to read the most common emoji, other emoji are dropped
+var emojiWords = map[rune]string{
+	'😂': "laughing",
+	'🤣': "rolling on the floor laughing",
+	'😭': "crying",
+	'😢': "sad",
+	'😊': "smiling",
+	'😀': "grinning",
+	'😁': "grinning",
+	'😅': "sweating",
+	'😍': "heart eyes",
+	'🥰': "in love",
+	'😎': "cool",
+	'🤔': "thinking",
+	'😡': "angry",
+	'😱': "screaming",
+	'😴': "sleeping",
+	'🥺': "pleading",
+	'🙏': "please",
+	'👍': "thumbs up",
+	'👎': "thumbs down",
+	'👏': "clapping",
+	'🙌': "hooray",
+	'💀': "dead",
+	'🔥': "fire",
+	'🎉': "party",
+	'❤': "heart",
+	'💕': "hearts",
+	'💯': "hundred",
+	'✨': "sparkles",
+	'👀': "eyes",
+	'🎵': "music",
+	'🎶': "music",
+}
+
+// ttsAbbreviations are expanded before numbers so slang like 555 isn't read as a number
+var ttsAbbreviations = map[string]string{
+	"555":  "ฮ่าฮ่าฮ่า",
+	"lol":  "laughing out loud",
+	"lmao": "laughing my ass off",
+	"brb":  "be right back",
+	"btw":  "by the way",
+	"idk":  "I don't know",
+	"imo":  "in my opinion",
+	"omg":  "oh my god",
+	"pls":  "please",
+	"plz":  "please",
+	"thx":  "thanks",
+	"ty":   "thank you",
+	"gg":   "good game",
+	"ppl":  "people",
+}
+
+var ttsAbbreviationPattern = regexp.MustCompile(`\b\w+\b`)
+
+// sanitizeTTSText turns a discord message into text a TTS engine can read:
+// mentions become names, emoji become words, links become their domain, the guild dictionary
+// and abbreviations are applied and numbers are spelled out in the given language.
+func (b *Bot) sanitizeTTSText(ctx context.Context, guildID snowflake.ID, language string, text string) string {
+	text = b.resolveMentions(guildID, text)
+	text = customEmojiPattern.ReplaceAllStringFunc(text, func(match string) string {
+		name := customEmojiPattern.FindStringSubmatch(match)[1]
+		return " " + strings.ReplaceAll(name, "_", " ") + " "
+	})
+	text = replaceEmoji(text)
+	text = shortenURLs(text)
+	text = b.applyPronunciations(ctx, guildID, text)
+	text = expandAbbreviations(text)
+	text = expandNumbers(text, language)
+	return strings.TrimSpace(whitespacePattern.ReplaceAllString(text, " "))
+}
+
+func (b *Bot) resolveMentions(guildID snowflake.ID, text string) string {
+	text = userMentionPattern.ReplaceAllStringFunc(text, func(match string) string {
+		userID, err := snowflake.Parse(userMentionPattern.FindStringSubmatch(match)[1])
+		if err != nil {
+			return match
+		}
+		if member, ok := b.Client.Caches().Member(guildID, userID); ok {
+			return member.EffectiveName()
+		}
+		if name, ok := b.ttsNames.get(guildID, userID); ok {
+			return name
+		}
+		name := "someone"
+		if member, err := b.Client.Rest().GetMember(guildID, userID); err != nil {
+			ttsLog.Debug("Failed to get mentioned member", "guild_id", guildID, "user_id", userID, "err", err)
+		} else {
+			name = member.EffectiveName()
+		}
+		b.ttsNames.set(guildID, userID, name)
+		return name
+	})
+	text = roleMentionPattern.ReplaceAllStringFunc(text, func(match string) string {
+		roleID, err := snowflake.Parse(roleMentionPattern.FindStringSubmatch(match)[1])
+		if err != nil {
+			return match
+		}
+		if role, ok := b.Client.Caches().Role(guildID, roleID); ok {
+			return role.Name
+		}
+		return "a role"
+	})
+	return channelMentionPattern.ReplaceAllStringFunc(text, func(match string) string {
+		channelID, err := snowflake.Parse(channelMentionPattern.FindStringSubmatch(match)[1])
+		if err != nil {
+			return match
+		}
+		if channel, ok := b.Client.Caches().Channel(channelID); ok {
+			return channel.Name()
+		}
+		return "a channel"
+	})
+}
+
+type ttsNameKey struct {
+	guildID snowflake.ID
+	userID  snowflake.ID
+}
+
+type ttsName struct {
+	name      string
+	expiresAt time.Time
+}
+
+// ttsNameCache remembers the names of mentioned members the member cache doesn't have,
+// so a member mentioned in every message is fetched once
+type ttsNameCache struct {
+	mu    sync.Mutex
+	names map[ttsNameKey]ttsName
+}
+
+func (c *ttsNameCache) get(guildID snowflake.ID, userID snowflake.ID) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	name, ok := c.names[ttsNameKey{guildID: guildID, userID: userID}]
+	if !ok || time.Now().After(name.expiresAt) {
+		return "", false
+	}
+	return name.name, true
+}
+
+func (c *ttsNameCache) set(guildID snowflake.ID, userID snowflake.ID, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if c.names == nil {
+		c.names = make(map[ttsNameKey]ttsName)
+	}
+	for key, cached := range c.names {
+		if now.After(cached.expiresAt) {
+			delete(c.names, key)
+		}
+	}
+	c.names[ttsNameKey{guildID: guildID, userID: userID}] = ttsName{name: name, expiresAt: now.Add(ttsNameLifetime)}
+}
+
+func replaceEmoji(text string) string {
+	var builder strings.Builder
+	for _, r := range text {
+		if word, ok := emojiWords[r]; ok {
+			builder.WriteString(" " + word + " ")
+			continue
+		}
+		// drop the remaining emoji, skin tone modifiers and variation selectors, other symbols like ° are kept for the engine
+		if isEmoji(r) || unicode.Is(unicode.Variation_Selector, r) || r == '\u200d' {
+			continue
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+// emojiRanges are the blocks emoji are taken from, skin tone modifiers and regional indicators included
+var emojiRanges = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x2600, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2b00, Hi: 0x2bff, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f000, Hi: 0x1faff, Stride: 1},
+	},
+}
+
+func isEmoji(r rune) bool {
+	return unicode.Is(emojiRanges, r) && (unicode.Is(unicode.So, r) || unicode.Is(unicode.Sk, r))
+}
+
+func shortenURLs(text string) string {
+	return ttsURLPattern.ReplaceAllStringFunc(text, func(match string) string {
+		parsedURL, err := url.Parse(match)
+		if err != nil || parsedURL.Hostname() == "" {
+			return "a link"
+		}
+		return strings.TrimPrefix(parsedURL.Hostname(), "www.")
+	})
+}
+
+// applyPronunciations replaces the words of the guild dictionary, latin words only match whole words
+// while words in scripts without spaces like thai match anywhere
+func (b *Bot) applyPronunciations(ctx context.Context, guildID snowflake.ID, text string) string {
+	rules, err := b.pronunciationRules(ctx, guildID)
+	if err != nil {
+		dbLog.Error("Failed to load pronunciations", "guild_id", guildID, "err", err)
+		return text
+	}
+	for _, rule := range rules {
+		if rule.pattern != nil {
+			text = rule.pattern.ReplaceAllLiteralString(text, rule.replacement)
+			continue
+		}
+		text = strings.ReplaceAll(text, rule.word, rule.replacement)
+	}
+	return text
+}
+
+type pronunciationRule struct {
+	word        string
+	replacement string
+	// pattern matches whole latin words, it's nil for words replaced anywhere
+	pattern *regexp.Regexp
+}
+
+// pronunciationRules returns the dictionary of the guild, it's loaded once and dropped by /tts-dict when it changes
+func (b *Bot) pronunciationRules(ctx context.Context, guildID snowflake.ID) ([]pronunciationRule, error) {
+	if rules, ok := b.pronunciations.get(guildID); ok {
+		return rules, nil
+	}
+	entries, err := b.EntClient.Pronunciation.Query().Where(pronunciation.GuildID(guildID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]pronunciationRule, 0, len(entries))
+	for _, entry := range entries {
+		rule := pronunciationRule{word: entry.Word, replacement: entry.Replacement}
+		if isASCIIWord(entry.Word) {
+			if rule.pattern, err = regexp.Compile(`(?i)\b` + regexp.QuoteMeta(entry.Word) + `\b`); err != nil {
+				continue
+			}
+		}
+		rules = append(rules, rule)
+	}
+	b.pronunciations.set(guildID, rules)
+	return rules, nil
+}
+
+type pronunciationCache struct {
+	mu    sync.Mutex
+	rules map[snowflake.ID][]pronunciationRule
+}
+
+func (c *pronunciationCache) get(guildID snowflake.ID) ([]pronunciationRule, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	rules, ok := c.rules[guildID]
+	return rules, ok
+}
+
+func (c *pronunciationCache) set(guildID snowflake.ID, rules []pronunciationRule) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.rules == nil {
+		c.rules = make(map[snowflake.ID][]pronunciationRule)
+	}
+	c.rules[guildID] = rules
+}
+
+// drop forgets the dictionary of the guild so the next message loads it again
+func (c *pronunciationCache) drop(guildID snowflake.ID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.rules, guildID)
+}
+
+func isASCIIWord(word string) bool {
+	for _, r := range word {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
+
+func expandAbbreviations(text string) string {
+	return ttsAbbreviationPattern.ReplaceAllStringFunc(text, func(word string) string {
+		if expanded, ok := ttsAbbreviations[strings.ToLower(word)]; ok {
+			return expanded
+		}
+		return word
+	})
+}
+
+func expandNumbers(text string, language string) string {
+	return numberPattern.ReplaceAllStringFunc(text, func(match string) string {
+		number, err := strconv.ParseInt(strings.ReplaceAll(match, ",", ""), 10, 64)
+		if err != nil {
+			return match
+		}
+		switch language {
+		case "th":
+			return thaiNumberWords(number)
+		case "en":
+			return englishNumberWords(number)
+		default:
+			return strconv.FormatInt(number, 10)
+		}
+	})
+}
+
+var (
+	englishOnes = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	englishTens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	englishScales = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+)
+
+func englishNumberWords(number int64) string {
+	if number < 20 {
+		return englishOnes[number]
+	}
+
+	var groups []string
+	for scale := 0; number > 0; scale++ {
+		group := number % 1000
+		number /= 1000
+		if group == 0 {
+			continue
+		}
+		var words []string
+		if group >= 100 {
+			words = append(words, englishOnes[group/100], "hundred")
+			group %= 100
+		}
+		switch {
+		case group >= 20 && group%10 != 0:
+			words = append(words, englishTens[group/10]+"-"+englishOnes[group%10])
+		case group >= 20:
+			words = append(words, englishTens[group/10])
+		case group > 0:
+			words = append(words, englishOnes[group])
+		}
+		if englishScales[scale] != "" {
+			words = append(words, englishScales[scale])
+		}
+		groups = append([]string{strings.Join(words, " ")}, groups...)
+	}
+	return strings.Join(groups, " ")
+}
+
+var (
+	thaiDigits = []string{"ศูนย์", "หนึ่ง", "สอง", "สาม", "สี่", "ห้า", "หก", "เจ็ด", "แปด", "เก้า"}
+	thaiPlaces = []string{"", "สิบ", "ร้อย", "พัน", "หมื่น", "แสน"}
+)
+
+// thaiNumberWords spells out a number in thai, every six digits are grouped by ล้าน
+func thaiNumberWords(number int64) string {
+	if number == 0 {
+		return thaiDigits[0]
+	}
+	if number >= 1_000_000 {
+		millions := thaiNumberWords(number / 1_000_000)
+		if number%1_000_000 == 0 {
+			return millions + "ล้าน"
+		}
+		return millions + "ล้าน" + thaiNumberWords(number%1_000_000)
+	}
+
+	digits := strconv.FormatInt(number, 10)
+	var builder strings.Builder
+	for i, r := range digits {
+		digit := int(r - '0')
+		place := len(digits) - i - 1
+		switch {
+		case digit == 0:
+			continue
+		case place == 0 && digit == 1 && len(digits) > 1:
+			builder.WriteString("เอ็ด")
+		case place == 1 && digit == 1:
+			builder.WriteString(thaiPlaces[place])
+		case place == 1 && digit == 2:
+			builder.WriteString("ยี่" + thaiPlaces[place])
+		default:
+			builder.WriteString(thaiDigits[digit] + thaiPlaces[place])
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+func TestResolveMentions(t *testing.T) {
+	b, discordClient, _ := newTestBot(t)
+	nick := "Nick"
+	discordClient.caches.AddMember(discord.Member{GuildID: testGuildID, User: discord.User{ID: 1, Username: "cached"}, Nick: &nick})
+	discordClient.rest.members[2] = discord.Member{GuildID: testGuildID, User: discord.User{ID: 2, Username: "fetched"}}
+	discordClient.caches.AddRole(discord.Role{GuildID: testGuildID, ID: 10, Name: "DJ"})
+	var channel discord.GuildTextChannel
+	if err := json.Unmarshal([]byte(`{"id":"20","guild_id":"2000","type":0,"name":"general"}`), &channel); err != nil {
+		t.Fatal(err)
+	}
+	discordClient.caches.AddChannel(channel)
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "cached member", text: "hi <@1>", want: "hi Nick"},
+		{name: "nickname mention", text: "hi <@!1>", want: "hi Nick"},
+		{name: "fetched member", text: "hi <@2>", want: "hi fetched"},
+		{name: "unknown member", text: "hi <@3>", want: "hi someone"},
+		{name: "cached role", text: "<@&10> come", want: "DJ come"},
+		{name: "unknown role", text: "<@&11> come", want: "a role come"},
+		{name: "cached channel", text: "go to <#20>", want: "go to general"},
+		{name: "unknown channel", text: "go to <#21>", want: "go to a channel"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := b.resolveMentions(testGuildID, tt.text); got != tt.want {
+				t.Errorf("resolveMentions(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+
+	// the fetched and the unknown member were each requested once, later mentions reuse the names
+	requests := discordClient.rest.memberRequests
+	b.resolveMentions(testGuildID, "<@2> <@3> <@2>")
+	if discordClient.rest.memberRequests != requests || requests != 2 {
+		t.Errorf("member requests = %d then %d, want 2 without new requests", requests, discordClient.rest.memberRequests)
+	}
+}
+
+func TestReplaceEmoji(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "lol😂", want: "lol laughing "},
+		{text: "👍🏽", want: " thumbs up "},
+		{text: "❤️", want: " heart "},
+		{text: "🦄 ok", want: " ok"},
+		{text: "🇹🇭", want: ""},
+		{text: "👨‍👩‍👧", want: ""},
+		{text: "30°C", want: "30°C"},
+		{text: "© ™ ^_^", want: "© ™ ^_^"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := replaceEmoji(tt.text); got != tt.want {
+				t.Errorf("replaceEmoji(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShortenURLs(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "listen https://www.youtube.com/watch?v=abc now", want: "listen youtube.com now"},
+		{text: "http://example.com", want: "example.com"},
+		{text: "<https://example.com/a>", want: "<example.com>"},
+		{text: "https://", want: "https://"},
+		{text: "https://:80/path", want: "a link"},
+		{text: "example.com", want: "example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := shortenURLs(tt.text); got != tt.want {
+				t.Errorf("shortenURLs(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandAbbreviations(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "lol", want: "laughing out loud"},
+		{text: "LOL ok", want: "laughing out loud ok"},
+		{text: "thx, ty!", want: "thanks, thank you!"},
+		{text: "555", want: "ฮ่าฮ่าฮ่า"},
+		{text: "5555", want: "5555"},
+		{text: "lollipop", want: "lollipop"},
+		{text: "ggez", want: "ggez"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := expandAbbreviations(tt.text); got != tt.want {
+				t.Errorf("expandAbbreviations(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandNumbers(t *testing.T) {
+	tests := []struct {
+		text     string
+		language string
+		want     string
+	}{
+		{text: "0", language: "en", want: "zero"},
+		{text: "15", language: "en", want: "fifteen"},
+		{text: "20", language: "en", want: "twenty"},
+		{text: "21", language: "en", want: "twenty-one"},
+		{text: "100", language: "en", want: "one hundred"},
+		{text: "1,234", language: "en", want: "one thousand two hundred thirty-four"},
+		{text: "2000005", language: "en", want: "two million five"},
+		{text: "room 7b", language: "en", want: "room 7b"},
+		{text: "send the mp3", language: "en", want: "send the mp3"},
+		{text: "v2 is out", language: "en", want: "v2 is out"},
+		{text: "(3)", language: "en", want: "(three)"},
+		{text: "0", language: "th", want: "ศูนย์"},
+		{text: "1", language: "th", want: "หนึ่ง"},
+		{text: "11", language: "th", want: "สิบเอ็ด"},
+		{text: "21", language: "th", want: "ยี่สิบเอ็ด"},
+		{text: "101", language: "th", want: "หนึ่งร้อยเอ็ด"},
+		{text: "1,000,000", language: "th", want: "หนึ่งล้าน"},
+		{text: "1000001", language: "th", want: "หนึ่งล้านหนึ่ง"},
+		{text: "ราคา 250 บาท", language: "th", want: "ราคา สองร้อยห้าสิบ บาท"},
+		{text: "ราคา250บาท", language: "th", want: "ราคาสองร้อยห้าสิบบาท"},
+		{text: "1,234", language: "ja", want: "1234"},
+		{text: "99999999999999999999", language: "en", want: "99999999999999999999"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.language+" "+tt.text, func(t *testing.T) {
+			if got := expandNumbers(tt.text, tt.language); got != tt.want {
+				t.Errorf("expandNumbers(%q, %s) = %q, want %q", tt.text, tt.language, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyPronunciations(t *testing.T) {
+	b, _, _ := newTestBot(t)
+	ctx := context.Background()
+	addWord := func(word string, replacement string) {
+		t.Helper()
+		if err := b.EntClient.Pronunciation.Create().SetGuildID(testGuildID).SetWord(word).SetReplacement(replacement).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	addWord("gg", "จีจี")
+	addWord("ครับ", "คับ")
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "GG everyone", want: "จีจี everyone"},
+		{text: "eggs", want: "eggs"},
+		{text: "ขอบคุณครับผม", want: "ขอบคุณคับผม"},
+	}
+	for _, tt := range tests {
+		if got := b.applyPronunciations(ctx, testGuildID, tt.text); got != tt.want {
+			t.Errorf("applyPronunciations(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+
+	// the dictionary is cached until it's dropped by /tts-dict
+	addWord("eggs", "ไข่")
+	if got := b.applyPronunciations(ctx, testGuildID, "eggs"); got != "eggs" {
+		t.Errorf("applyPronunciations() = %q before the cache was dropped, want the cached dictionary", got)
+	}
+	b.pronunciations.drop(testGuildID)
+	if got := b.applyPronunciations(ctx, testGuildID, "eggs"); got != "ไข่" {
+		t.Errorf("applyPronunciations() = %q after the cache was dropped, want the new word", got)
+	}
+	if got := b.applyPronunciations(ctx, snowflake.ID(1), "gg"); got != "gg" {
+		t.Errorf("applyPronunciations() = %q in another guild, want the text unchanged", got)
+	}
+}
+
+func TestSanitizeTTSText(t *testing.T) {
+	b, discordClient, _ := newTestBot(t)
+	discordClient.caches.AddMember(discord.Member{GuildID: testGuildID, User: discord.User{ID: 1, Username: "Mint"}})
+
+	tests := []struct {
+		text     string
+		language string
+		want     string
+	}{
+		{text: "<@1> lol 555 😂", language: "th", want: "Mint laughing out loud ฮ่าฮ่าฮ่า laughing"},
+		{text: "check   https://www.youtube.com/watch?v=1 at 21:30", language: "en", want: "check youtube.com at twenty-one:thirty"},
+		{text: "<:pepe_hands:123> 2 คน", language: "th", want: "pepe hands สอง คน"},
+		{text: "  🦄  ", language: "en", want: ""},
+		{text: "play track2 from the mp3 folder at 3", language: "en", want: "play track2 from the mp3 folder at three"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := b.sanitizeTTSText(context.Background(), testGuildID, tt.language, tt.text); got != tt.want {
+				t.Errorf("sanitizeTTSText(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
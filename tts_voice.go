@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent"
+	"github.com/loukhin/probably-a-music-bot/ent/member"
+)
+
+const maxAutocompleteChoices = 25
+
+// findTTSVoice looks up a voice by name in the catalog of the provider
+func findTTSVoice(provider TTSProvider, name string) (TTSVoice, bool) {
+	for _, voice := range provider.Voices() {
+		if strings.EqualFold(voice.Name, name) {
+			return voice, true
+		}
+	}
+	return TTSVoice{}, false
+}
+
+// withVoiceName switches the voice to the given name, taking the language from the catalog when the provider has one
+func withVoiceName(provider TTSProvider, voice TTSVoice, name string) (TTSVoice, error) {
+	if len(provider.Voices()) == 0 {
+		voice.Name = name
+		return voice, nil
+	}
+	catalogVoice, ok := findTTSVoice(provider, name)
+	if !ok {
+		return voice, fmt.Errorf("unknown voice `%s`", name)
+	}
+	voice.Name = catalogVoice.Name
+	voice.LanguageCode = catalogVoice.LanguageCode
+	return voice, nil
+}
+
+// resolveTTSVoice layers the member preference and the per message override on top of the guild default voice
+func (b *Bot) resolveTTSVoice(ctx context.Context, guildID snowflake.ID, userID snowflake.ID, override string) (TTSVoice, error) {
+	voice := b.Guilds.Get(guildID).currentSettings().TTSVoice
+
+	dbMember, err := b.EntClient.Member.Query().Where(member.GuildID(guildID), member.UserID(userID)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return voice, err
+	}
+	if dbMember != nil {
+		if dbMember.TtsLanguage != nil {
+			voice.LanguageCode = *dbMember.TtsLanguage
+		}
+		if dbMember.TtsVoice != nil {
+			voice.Name = *dbMember.TtsVoice
+		}
+		if dbMember.TtsRate != nil {
+			voice.SpeakingRate = *dbMember.TtsRate
+		}
+		if dbMember.TtsPitch != nil {
+			voice.Pitch = *dbMember.TtsPitch
+		}
+	}
+
+	if override != "" {
+		return withVoiceName(b.ttsProvider(guildID), voice, override)
+	}
+	return voice, nil
+}
+
+// ttsVoiceChoices returns the catalog voices matching what the user typed so far
+func ttsVoiceChoices(provider TTSProvider, input string) []discord.AutocompleteChoice {
+	input = strings.ToLower(input)
+	choices := make([]discord.AutocompleteChoice, 0, maxAutocompleteChoices)
+	for _, voice := range provider.Voices() {
+		if len(choices) == maxAutocompleteChoices {
+			break
+		}
+		name := fmt.Sprintf("%s (%s)", voice.Name, voice.LanguageCode)
+		if !strings.Contains(strings.ToLower(name), input) {
+			continue
+		}
+		choices = append(choices, discord.AutocompleteChoiceString{
+			Name:  name,
+			Value: voice.Name,
+		})
+	}
+	return choices
+}
+
+func formatTTSVoice(voice TTSVoice) string {
+	return fmt.Sprintf("Voice: `%s`\nLanguage: `%s`\nRate: `%g`\nPitch: `%g`", voice.Name, voice.LanguageCode, voice.SpeakingRate, voice.Pitch)
+}
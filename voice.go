@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/disgoorg/disgolink/v3/disgolink"
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+const (
+	maxVoiceReconnectAttempts = 3
+	voiceReconnectWindow      = time.Minute
+)
+
+// https://discord.com/developers/docs/topics/opcodes-and-status-codes#voice-voice-close-event-codes
+var voiceCloseReasons = map[int]string{
+	1000: "Normal closure",
+	1006: "Abnormal closure",
+	4000: "Unknown error",
+	4001: "Unknown opcode",
+	4002: "Failed to decode payload",
+	4003: "Not authenticated",
+	4004: "Authentication failed",
+	4005: "Already authenticated",
+	4006: "Session no longer valid",
+	4009: "Session timeout",
+	4011: "Server not found",
+	4012: "Unknown protocol",
+	4014: "Disconnected",
+	4015: "Voice server crashed",
+	4016: "Unknown encryption mode",
+}
+
+// isResumableVoiceCloseCode reports whether rejoining the same channel can recover the voice connection
+func isResumableVoiceCloseCode(code int) bool {
+	switch code {
+	case 1006, 4000, 4006, 4009, 4015:
+		return true
+	default:
+		return false
+	}
+}
+
+func voiceCloseReason(code int, reason string) string {
+	if reason == "" {
+		reason = voiceCloseReasons[code]
+	}
+	if reason == "" {
+		reason = "Unknown reason"
+	}
+	return fmt.Sprintf("%s (%d)", reason, code)
+}
+
+// reconnectVoice rejoins the channel the player is connected to and remembers the playing track,
+// the track is restored by resumeVoice once discord sends the new voice server
+func (b *Bot) reconnectVoice(player disgolink.Player) bool {
+	guildID := player.GuildID()
+	channelID := player.ChannelID()
+	if channelID == nil {
+		return false
+	}
+
+	guild := b.Guilds.Get(guildID)
+	guild.mu.Lock()
+	reconnect := guild.reconnect
+	if reconnect == nil || time.Since(reconnect.attemptedAt) > voiceReconnectWindow {
+		reconnect = &VoiceReconnect{}
+	}
+	if reconnect.attempts >= maxVoiceReconnectAttempts {
+		guild.reconnect = nil
+		guild.mu.Unlock()
+		return false
+	}
+	if !reconnect.pending {
+		reconnect.track = player.Track()
+		reconnect.position = player.Position()
+		reconnect.paused = player.Paused()
+	}
+	reconnect.channelID = *channelID
+	reconnect.pending = true
+	reconnect.attempts++
+	reconnect.attemptedAt = time.Now()
+	guild.reconnect = reconnect
+	reconnectChannelID, attempts := reconnect.channelID, reconnect.attempts
+	guild.mu.Unlock()
+
+	slog.Info("Reconnecting to voice channel", "guild_id", guildID, "channel_id", reconnectChannelID, "attempt", attempts, "max_attempts", maxVoiceReconnectAttempts)
+	return b.updateVoiceState(guildID, &reconnectChannelID)
+}
+
+// resumeVoice restores the track saved by reconnectVoice
+func (b *Bot) resumeVoice(guildID snowflake.ID) {
+	guild := b.Guilds.Get(guildID)
+	guild.mu.Lock()
+	reconnect := guild.reconnect
+	if reconnect == nil || !reconnect.pending {
+		guild.mu.Unlock()
+		return
+	}
+	reconnect.pending = false
+
+	player := b.Lavalink.ExistingPlayer(guildID)
+	if player == nil || reconnect.track == nil {
+		guild.mu.Unlock()
+		return
+	}
+	track, position, paused := *reconnect.track, reconnect.position, reconnect.paused
+	guild.resumed = track.Encoded
+	guild.mu.Unlock()
+	err := player.Update(context.TODO(),
+		lavalink.WithTrack(track),
+		lavalink.WithPosition(position),
+		lavalink.WithPaused(paused),
+	)
+	if err != nil {
+		lavalinkLog.Error("Failed to resume track after reconnect", "guild_id", guildID, "err", err)
+	}
+}
+
+// teardownPlayer destroys the player, leaves the voice channel and shows the reason in the player message
+func (b *Bot) teardownPlayer(guildID snowflake.ID, reason string) {
+	if player := b.Lavalink.ExistingPlayer(guildID); player != nil {
+		if err := player.Destroy(context.TODO()); err != nil {
+			lavalinkLog.Error("Failed to destroy player", "guild_id", guildID, "err", err)
+		}
+	}
+	b.Guilds.Delete(guildID)
+	guild := b.Guilds.Get(guildID)
+	guild.mu.Lock()
+	guild.notice = reason
+	guild.mu.Unlock()
+	b.updateVoiceState(guildID, nil)
+	b.updatePlayerMessage(guildID)
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+func TestTeardownPlayerNotice(t *testing.T) {
+	b, discordClient, lavalinkClient := newTestBot(t)
+	playerChannelID, playerMessageID := snowflake.ID(3100), snowflake.ID(3200)
+	err := b.EntClient.Guild.UpdateOneID(testGuildID).
+		SetPlayerChannelID(playerChannelID).
+		SetPlayerMessageID(playerMessageID).
+		Exec(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	discordClient.rest.messages[playerMessageID] = discord.Message{ID: playerMessageID, ChannelID: playerChannelID}
+
+	voiceChannelID := testVoiceChannelID
+	discordClient.joinVoice(testGuildID, testBotID, &voiceChannelID)
+	lavalinkClient.Player(testGuildID)
+
+	const reason = "Voice connection closed: session timed out"
+	b.teardownPlayer(testGuildID, reason)
+	// the bot leaving the channel resets the guild again
+	b.onVoiceStateUpdate(&events.GuildVoiceStateUpdate{
+		GenericGuildVoiceState: &events.GenericGuildVoiceState{
+			VoiceState: discord.VoiceState{GuildID: testGuildID, UserID: testBotID},
+		},
+		OldVoiceState: discord.VoiceState{GuildID: testGuildID, UserID: testBotID, ChannelID: &voiceChannelID},
+	})
+
+	message := discordClient.rest.message(playerMessageID)
+	if len(message.Embeds) == 0 {
+		t.Fatal("player message was not updated")
+	}
+	if description := message.Embeds[0].Description; !strings.Contains(description, reason) {
+		t.Fatalf("player embed description = %q, want the disconnect reason", description)
+	}
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+const (
+	// webhookMaxSkew is how far the timestamp of a request may be from now, older requests are rejected as replays
+	webhookMaxSkew     = 5 * time.Minute
+	webhookMaxBodySize = 64 << 10
+
+	webhookSourceLimit  = 10
+	webhookSourceWindow = time.Minute
+
+	webhookSourceHeader    = "X-Webhook-Source"
+	webhookTimestampHeader = "X-Webhook-Timestamp"
+	webhookSignatureHeader = "X-Webhook-Signature"
+)
+
+// DonationPayload is the body of a donation webhook request
+type DonationPayload struct {
+	GuildID snowflake.ID `json:"guild_id"`
+	Amount  int          `json:"amount"`
+	Name    string       `json:"name"`
+	Message string       `json:"message"`
+}
+
+// DonationWebhook lets external alert tools make the bot read donations.
+// Requests are signed with the secret of their source: X-Webhook-Signature is
+// sha256=hex(hmac_sha256(secret, timestamp + "." + body)) with the unix timestamp of X-Webhook-Timestamp.
+type DonationWebhook struct {
+	bot     *Bot
+	secrets map[string][]byte
+	limiter *RateLimiter[string]
+
+	mu sync.Mutex
+	// seen holds the signatures accepted within webhookMaxSkew, a signature is only accepted once
+	seen map[string]time.Time
+}
+
+func NewDonationWebhook(bot *Bot, secrets map[string][]byte) *DonationWebhook {
+	return &DonationWebhook{
+		bot:     bot,
+		secrets: secrets,
+		limiter: newRateLimiter[string](webhookSourceLimit, webhookSourceWindow),
+		seen:    make(map[string]time.Time),
+	}
+}
+
+// parseWebhookSecrets parses a comma separated list of source:secret pairs
+func parseWebhookSecrets(secrets string) map[string][]byte {
+	parsed := make(map[string][]byte)
+	for _, entry := range strings.Split(secrets, ",") {
+		source, secret, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || source == "" || secret == "" {
+			continue
+		}
+		parsed[source] = []byte(secret)
+	}
+	return parsed
+}
+
+func signWebhook(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// markSeen reports whether the signature is new and remembers it until it can't pass the timestamp check anymore
+func (w *DonationWebhook) markSeen(signature string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	now := time.Now()
+	for seenSignature, seenAt := range w.seen {
+		if now.Sub(seenAt) > 2*webhookMaxSkew {
+			delete(w.seen, seenSignature)
+		}
+	}
+	if _, ok := w.seen[signature]; ok {
+		return false
+	}
+	w.seen[signature] = now
+	return true
+}
+
+func (w *DonationWebhook) verify(r *http.Request, body []byte) (string, int, error) {
+	source := r.Header.Get(webhookSourceHeader)
+	secret, ok := w.secrets[source]
+	if !ok {
+		return source, http.StatusUnauthorized, errors.New("unknown source")
+	}
+
+	timestamp := r.Header.Get(webhookTimestampHeader)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return source, http.StatusUnauthorized, errors.New("invalid timestamp")
+	}
+	if skew := time.Since(time.Unix(unix, 0)); skew > webhookMaxSkew || skew < -webhookMaxSkew {
+		return source, http.StatusUnauthorized, errors.New("timestamp too far from now")
+	}
+
+	signature := r.Header.Get(webhookSignatureHeader)
+	if !hmac.Equal([]byte(signature), []byte(signWebhook(secret, timestamp, body))) {
+		return source, http.StatusUnauthorized, errors.New("invalid signature")
+	}
+	if !w.markSeen(signature) {
+		return source, http.StatusConflict, errors.New("request was already received")
+	}
+	if !w.limiter.Allow(source) {
+		return source, http.StatusTooManyRequests, errors.New("too many requests")
+	}
+	return source, http.StatusOK, nil
+}
+
+func writeWebhookResponse(rw http.ResponseWriter, status int, message string) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	_ = json.NewEncoder(rw).Encode(map[string]string{"message": message})
+}
+
+func (w *DonationWebhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(rw, r.Body, webhookMaxBodySize))
+	if err != nil {
+		writeWebhookResponse(rw, http.StatusRequestEntityTooLarge, "body too large")
+		return
+	}
+	source, status, err := w.verify(r, body)
+	if err != nil {
+		slog.Info("Rejected donation webhook", "source", source, "err", err)
+		writeWebhookResponse(rw, status, err.Error())
+		return
+	}
+
+	var payload DonationPayload
+	if err = json.Unmarshal(body, &payload); err != nil {
+		writeWebhookResponse(rw, http.StatusBadRequest, "invalid payload")
+		return
+	}
+	payload.Name = strings.TrimSpace(payload.Name)
+	if payload.Name == "" {
+		payload.Name = "Someone"
+	}
+	payload.Message = strings.TrimSpace(payload.Message)
+	if runes := []rune(payload.Message); len(runes) > ttsMaxLength {
+		payload.Message = string(runes[:ttsMaxLength])
+	}
+	if payload.Amount < 0 || (payload.Amount == 0 && payload.Message == "") {
+		writeWebhookResponse(rw, http.StatusBadRequest, "payload needs an amount or a message")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+	if status, err = w.bot.announceDonation(ctx, payload); err != nil {
+		writeWebhookResponse(rw, status, err.Error())
+		return
+	}
+	writeWebhookResponse(rw, http.StatusAccepted, "queued")
+}
+
+// announceDonation reads the donation in the voice channel of the bot and posts it in the player channel
+func (b *Bot) announceDonation(ctx context.Context, payload DonationPayload) (int, error) {
+	if _, ok := b.Client.Caches().Guild(payload.GuildID); !ok {
+		return http.StatusNotFound, errors.New("unknown guild")
+	}
+	guild := b.Guilds.Get(payload.GuildID)
+	settings := guild.currentSettings()
+
+	var channelID snowflake.ID
+	if botVoiceState, ok := b.Client.Caches().VoiceState(payload.GuildID, b.Client.ID()); ok && botVoiceState.ChannelID != nil {
+		channelID = *botVoiceState.ChannelID
+	} else if settings.AlwaysOnChannelID != nil {
+		channelID = *settings.AlwaysOnChannelID
+	} else {
+		return http.StatusConflict, errors.New("bot is not in a voice channel")
+	}
+
+	text := fmt.Sprintf("%s: %s", payload.Name, payload.Message)
+	if payload.Amount > 0 {
+		text = fmt.Sprintf("%s cheered %d bits. %s", payload.Name, payload.Amount, payload.Message)
+	}
+	clips, err := b.synthesizeClips(ctx, payload.GuildID, settings.TTSVoice, text, payload.Amount)
+	if err != nil {
+		ttsLog.Error("Failed to synthesize donation", "guild_id", payload.GuildID, "err", err)
+		return http.StatusBadGateway, errors.New("failed to synthesize message")
+	}
+	if err = b.playAnnouncement(payload.GuildID, channelID, newAnnouncement(text, payload.Amount), clips...); err != nil {
+		ttsLog.Error("Failed to play donation", "guild_id", payload.GuildID, "err", err)
+		return http.StatusInternalServerError, errors.New("failed to play message")
+	}
+
+	if guild.guildPlayer.channelID != nil {
+		var embed discord.EmbedBuilder
+		embed.SetColor(16705372)
+		embed.SetTitle(payload.Name)
+		if payload.Amount > 0 {
+			tier := cheerTierFor(payload.Amount)
+			embed.SetColor(tier.Color)
+			embed.SetTitle(fmt.Sprintf("%s cheered %d bits", payload.Name, payload.Amount))
+			embed.SetThumbnail(tier.GIFURL)
+		}
+		embed.SetDescription(payload.Message)
+		_, err = b.Client.Rest().CreateMessage(*guild.guildPlayer.channelID, discord.NewMessageCreateBuilder().SetEmbeds(embed.Build()).Build())
+		if err != nil {
+			slog.Error("Failed to post donation", "guild_id", payload.GuildID, "err", err)
+		}
+	}
+	b.updatePlayerMessage(payload.GuildID)
+	return http.StatusAccepted, nil
+}
@@ -0,0 +1,501 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/disgoorg/disgolink/v3/lavalink"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/loukhin/probably-a-music-bot/ent"
+	"github.com/loukhin/probably-a-music-bot/ent/webhookdelivery"
+	"github.com/loukhin/probably-a-music-bot/ent/webhooksubscription"
+)
+
+const (
+	WebhookEventTrackStart     = "track.start"
+	WebhookEventTrackEnd       = "track.end"
+	WebhookEventTrackException = "track.exception"
+	WebhookEventTrackStuck     = "track.stuck"
+	WebhookEventQueueUpdate    = "queue.update"
+	WebhookEventTest           = "test"
+
+	webhookEventHeader    = "X-Webhook-Event"
+	webhookDeliveryHeader = "X-Webhook-Delivery"
+
+	webhookMaxAttempts = 5
+	// webhookRetryDelay is the delay before the first retry, it doubles for every following attempt
+	webhookRetryDelay = 2 * time.Second
+	// webhookMaxPending is the number of events a subscription may fall behind, newer events are dropped
+	webhookMaxPending = 100
+	// webhookDeliveryRetention is how long the delivery log is kept, it's pruned every webhookPruneInterval
+	webhookDeliveryRetention = 7 * 24 * time.Hour
+	webhookPruneInterval     = time.Hour
+	// webhookQueuePreview is the number of upcoming tracks included in queue events
+	webhookQueuePreview = 10
+)
+
+var webhookEvents = []string{
+	WebhookEventTrackStart,
+	WebhookEventTrackEnd,
+	WebhookEventTrackException,
+	WebhookEventTrackStuck,
+	WebhookEventQueueUpdate,
+}
+
+// WebhookPayload is the body sent to subscriptions
+type WebhookPayload struct {
+	ID        string       `json:"id"`
+	Event     string       `json:"event"`
+	GuildID   snowflake.ID `json:"guild_id"`
+	Timestamp time.Time    `json:"timestamp"`
+	Data      any          `json:"data"`
+}
+
+type WebhookTrack struct {
+	Title      string `json:"title"`
+	Author     string `json:"author"`
+	URI        string `json:"uri,omitempty"`
+	Identifier string `json:"identifier"`
+	SourceName string `json:"source_name"`
+	Length     int64  `json:"length_ms"`
+	IsStream   bool   `json:"is_stream"`
+	Requester  string `json:"requester,omitempty"`
+}
+
+func newWebhookTrack(track lavalink.Track) WebhookTrack {
+	webhookTrack := WebhookTrack{
+		Title:      track.Info.Title,
+		Author:     track.Info.Author,
+		Identifier: track.Info.Identifier,
+		SourceName: track.Info.SourceName,
+		Length:     track.Info.Length.Milliseconds(),
+		IsStream:   track.Info.IsStream,
+		Requester:  getTrackUserData(track).Requester,
+	}
+	if track.Info.URI != nil {
+		webhookTrack.URI = *track.Info.URI
+	}
+	return webhookTrack
+}
+
+type WebhookTrackEvent struct {
+	Track     WebhookTrack `json:"track"`
+	TTS       bool         `json:"tts"`
+	Autoplay  bool         `json:"autoplay"`
+	Reason    string       `json:"reason,omitempty"`
+	Exception string       `json:"exception,omitempty"`
+	Threshold int64        `json:"threshold_ms,omitempty"`
+}
+
+func newWebhookTrackEvent(track lavalink.Track) WebhookTrackEvent {
+	userData := getTrackUserData(track)
+	return WebhookTrackEvent{
+		Track:    newWebhookTrack(track),
+		TTS:      userData.TTS,
+		Autoplay: userData.Autoplay,
+	}
+}
+
+type WebhookQueueEvent struct {
+	Type     QueueType      `json:"type"`
+	Count    int            `json:"count"`
+	Length   int64          `json:"length_ms"`
+	Upcoming []WebhookTrack `json:"upcoming"`
+}
+
+func newWebhookQueueEvent(queue QueueSnapshot) WebhookQueueEvent {
+	upcoming := make([]WebhookTrack, 0, webhookQueuePreview)
+	for i, track := range queue.Tracks {
+		if i == webhookQueuePreview {
+			break
+		}
+		upcoming = append(upcoming, newWebhookTrack(track))
+	}
+	return WebhookQueueEvent{
+		Type:     queue.Type,
+		Count:    len(queue.Tracks),
+		Length:   queue.Length.Milliseconds(),
+		Upcoming: upcoming,
+	}
+}
+
+// WebhookDispatcher signs and delivers player events to the subscriptions of a guild.
+// Every subscription has its own worker so the events reach it in order and a slow endpoint doesn't hold up the others.
+type WebhookDispatcher struct {
+	bot    *Bot
+	client *http.Client
+	// retryDelay is webhookRetryDelay, tests shorten it
+	retryDelay time.Duration
+
+	mu sync.Mutex
+	// subscriptions caches the subscriptions of each guild, /webhooks drops the guild when they change
+	subscriptions map[snowflake.ID][]*ent.WebhookSubscription
+	workers       map[int]*webhookWorker
+}
+
+// webhookWorker delivers the pending events of a subscription one after the other, it only runs while there are events
+type webhookWorker struct {
+	subscription *ent.WebhookSubscription
+	pending      []WebhookPayload
+	running      bool
+	removed      bool
+}
+
+func NewWebhookDispatcher(bot *Bot) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		bot:           bot,
+		client:        newWebhookClient(),
+		retryDelay:    webhookRetryDelay,
+		subscriptions: make(map[snowflake.ID][]*ent.WebhookSubscription),
+		workers:       make(map[int]*webhookWorker),
+	}
+}
+
+var errWebhookTarget = errors.New("webhook urls must be public https urls")
+
+// validateWebhookURL accepts https urls that don't point at an address of the bot's network,
+// hostnames are checked again when they're dialed as they may resolve to anything
+func validateWebhookURL(rawURL string) (*url.URL, error) {
+	webhookURL, err := url.Parse(rawURL)
+	if err != nil || webhookURL.Scheme != "https" || webhookURL.Hostname() == "" || webhookURL.User != nil {
+		return nil, errWebhookTarget
+	}
+	if strings.EqualFold(webhookURL.Hostname(), "localhost") || strings.HasSuffix(strings.ToLower(webhookURL.Hostname()), ".localhost") {
+		return nil, errWebhookTarget
+	}
+	if addr, err := netip.ParseAddr(webhookURL.Hostname()); err == nil && !isPublicAddr(addr) {
+		return nil, errWebhookTarget
+	}
+	return webhookURL, nil
+}
+
+// isPublicAddr rejects loopback, private, link-local, shared and unspecified addresses
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, it's as internal as the private ranges
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// newWebhookClient checks the address of every connection, redirects and dns answers can't reach the internal network
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !isPublicAddr(addrPort.Addr()) {
+				return errWebhookTarget
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: transport,
+		CheckRedirect: func(request *http.Request, via []*http.Request) error {
+			if request.URL.Scheme != "https" {
+				return errWebhookTarget
+			}
+			if len(via) >= 3 {
+				return errors.New("too many redirects")
+			}
+			return nil
+		},
+	}
+}
+
+func newWebhookID() string {
+	id := make([]byte, 12)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// Dispatch queues the event for every subscription of the guild, the first event of a guild loads its
+// subscriptions from the database so it mustn't be called while holding the lock of a guild or queue
+func (d *WebhookDispatcher) Dispatch(guildID snowflake.ID, event string, data any) {
+	payload := WebhookPayload{
+		ID:        newWebhookID(),
+		Event:     event,
+		GuildID:   guildID,
+		Timestamp: time.Now().UTC(),
+		Data:      data,
+	}
+	subscriptions, err := d.guildSubscriptions(guildID)
+	if err != nil {
+		dbLog.Error("Failed to load webhook subscriptions", "guild_id", guildID, "err", err)
+		return
+	}
+	for _, subscription := range subscriptions {
+		if len(subscription.Events) > 0 && !slices.Contains(subscription.Events, event) {
+			continue
+		}
+		d.enqueue(subscription, payload)
+	}
+}
+
+// guildSubscriptions returns the cached subscriptions of the guild, they're loaded on the first event
+func (d *WebhookDispatcher) guildSubscriptions(guildID snowflake.ID) ([]*ent.WebhookSubscription, error) {
+	d.mu.Lock()
+	subscriptions, ok := d.subscriptions[guildID]
+	d.mu.Unlock()
+	if ok {
+		return subscriptions, nil
+	}
+
+	subscriptions, err := d.bot.EntClient.WebhookSubscription.Query().
+		Where(webhooksubscription.GuildID(guildID)).
+		All(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if cached, ok := d.subscriptions[guildID]; ok {
+		return cached, nil
+	}
+	d.subscriptions[guildID] = subscriptions
+	return subscriptions, nil
+}
+
+// Reload drops the cached subscriptions of the guild after they changed, workers of removed subscriptions stop
+// before their next delivery
+func (d *WebhookDispatcher) Reload(guildID snowflake.ID) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.subscriptions, guildID)
+	for id, worker := range d.workers {
+		if worker.subscription.GuildID == guildID {
+			worker.removed = true
+			worker.pending = nil
+			delete(d.workers, id)
+		}
+	}
+}
+
+func (d *WebhookDispatcher) enqueue(subscription *ent.WebhookSubscription, payload WebhookPayload) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	worker, ok := d.workers[subscription.ID]
+	if !ok {
+		worker = &webhookWorker{subscription: subscription}
+		d.workers[subscription.ID] = worker
+	}
+	if len(worker.pending) >= webhookMaxPending {
+		slog.Warn("Dropped webhook event, the subscription is too far behind", "guild_id", payload.GuildID, "subscription", subscription.ID, "event", payload.Event)
+		return
+	}
+	worker.pending = append(worker.pending, payload)
+	if worker.running {
+		return
+	}
+	worker.running = true
+	go d.work(worker)
+}
+
+func (d *WebhookDispatcher) work(worker *webhookWorker) {
+	for {
+		d.mu.Lock()
+		if len(worker.pending) == 0 || worker.removed {
+			worker.running = false
+			d.mu.Unlock()
+			return
+		}
+		payload := worker.pending[0]
+		worker.pending = worker.pending[1:]
+		d.mu.Unlock()
+		tags := guildTags(payload.GuildID)
+		tags["subscription"] = strconv.Itoa(worker.subscription.ID)
+		withTaskRecover("WebhookDelivery", tags, func() {
+			d.deliver(worker, payload)
+		})()
+	}
+}
+
+// StartPruning removes the deliveries older than webhookDeliveryRetention every webhookPruneInterval
+func (d *WebhookDispatcher) StartPruning() {
+	prune := withTaskRecover("WebhookPrune", nil, func() {
+		d.prune(context.Background())
+	})
+	go func() {
+		ticker := time.NewTicker(webhookPruneInterval)
+		defer ticker.Stop()
+		for ; true; <-ticker.C {
+			prune()
+		}
+	}()
+}
+
+func (d *WebhookDispatcher) prune(ctx context.Context) {
+	pruned, err := d.bot.EntClient.WebhookDelivery.Delete().
+		Where(webhookdelivery.CreatedAtLT(time.Now().Add(-webhookDeliveryRetention))).
+		Exec(ctx)
+	if err != nil {
+		dbLog.Error("Failed to prune webhook deliveries", "err", err)
+		return
+	}
+	if pruned > 0 {
+		dbLog.Debug("Pruned webhook deliveries", "count", pruned)
+	}
+}
+
+// Send delivers the event to a single subscription and waits for the first attempt, it's used for test events
+func (d *WebhookDispatcher) Send(subscription *ent.WebhookSubscription, event string, data any) (*ent.WebhookDelivery, error) {
+	payload := WebhookPayload{
+		ID:        newWebhookID(),
+		Event:     event,
+		GuildID:   subscription.GuildID,
+		Timestamp: time.Now().UTC(),
+		Data:      data,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := d.createDelivery(subscription, payload, body)
+	if err != nil {
+		return nil, err
+	}
+	return d.attempt(subscription, delivery, body), nil
+}
+
+func (d *WebhookDispatcher) createDelivery(subscription *ent.WebhookSubscription, payload WebhookPayload, body []byte) (*ent.WebhookDelivery, error) {
+	return d.bot.EntClient.WebhookDelivery.Create().
+		SetSubscriptionID(subscription.ID).
+		SetDeliveryID(payload.ID).
+		SetEvent(payload.Event).
+		SetPayload(string(body)).
+		Save(context.Background())
+}
+
+// deliver retries the delivery with exponential backoff until it succeeds, runs out of attempts or the subscription is removed,
+// the next event of the subscription waits for it
+func (d *WebhookDispatcher) deliver(worker *webhookWorker, payload WebhookPayload) {
+	subscription := worker.subscription
+	body, err := json.Marshal(payload)
+	if err != nil {
+		slog.Error("Failed to encode webhook payload", "guild_id", payload.GuildID, "subscription", subscription.ID, "event", payload.Event, "err", err)
+		return
+	}
+	delivery, err := d.createDelivery(subscription, payload, body)
+	if err != nil {
+		dbLog.Error("Failed to log webhook delivery", "guild_id", payload.GuildID, "subscription", subscription.ID, "err", err)
+		return
+	}
+
+	delay := d.retryDelay
+	for {
+		delivery = d.attempt(subscription, delivery, body)
+		if delivery.Delivered || delivery.Attempts >= webhookMaxAttempts {
+			return
+		}
+		time.Sleep(delay)
+		delay *= 2
+		d.mu.Lock()
+		removed := worker.removed
+		d.mu.Unlock()
+		if removed {
+			return
+		}
+	}
+}
+
+// attempt posts the payload once and records the outcome in the delivery log
+func (d *WebhookDispatcher) attempt(subscription *ent.WebhookSubscription, delivery *ent.WebhookDelivery, body []byte) *ent.WebhookDelivery {
+	statusCode, err := d.post(subscription, delivery, body)
+
+	update := delivery.Update().AddAttempts(1).SetStatusCode(statusCode)
+	if err != nil {
+		update.SetError(err.Error()).SetDelivered(false)
+	} else {
+		update.ClearError().SetDelivered(true)
+	}
+	updated, saveErr := update.Save(context.Background())
+	if saveErr != nil {
+		dbLog.Error("Failed to update webhook delivery", "subscription", subscription.ID, "err", saveErr)
+		// keep counting the attempts so a broken database doesn't retry forever
+		delivery.Attempts++
+		delivery.Delivered = err == nil
+		return delivery
+	}
+	return updated
+}
+
+func (d *WebhookDispatcher) post(subscription *ent.WebhookSubscription, delivery *ent.WebhookDelivery, body []byte) (int, error) {
+	// subscriptions saved before the urls were validated may still use http, the addresses are checked by the client
+	if !strings.HasPrefix(subscription.URL, "https://") {
+		return 0, errWebhookTarget
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request, err := http.NewRequest(http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "probably-a-music-bot")
+	request.Header.Set(webhookEventHeader, delivery.Event)
+	request.Header.Set(webhookDeliveryHeader, delivery.DeliveryID)
+	request.Header.Set(webhookTimestampHeader, timestamp)
+	request.Header.Set(webhookSignatureHeader, signWebhook([]byte(subscription.Secret), timestamp, body))
+
+	response, err := d.client.Do(request)
+	if errors.Is(err, errWebhookTarget) {
+		return 0, errWebhookTarget
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, fmt.Errorf("unexpected status %s", response.Status)
+	}
+	return response.StatusCode, nil
+}
+
+// parseWebhookEvents validates a comma separated list of events, an empty list subscribes to every event
+func parseWebhookEvents(events string) ([]string, error) {
+	parsed := make([]string, 0)
+	for _, event := range strings.Split(events, ",") {
+		event = strings.ToLower(strings.TrimSpace(event))
+		if event == "" || slices.Contains(parsed, event) {
+			continue
+		}
+		if !slices.Contains(webhookEvents, event) {
+			return nil, fmt.Errorf("unknown event `%s`", event)
+		}
+		parsed = append(parsed, event)
+	}
+	return parsed, nil
+}
+
+func newWebhookSecret() string {
+	secret := make([]byte, 32)
+	_, _ = rand.Read(secret)
+	return hex.EncodeToString(secret)
+}
+
+func formatWebhookEvents(events []string) string {
+	if len(events) == 0 {
+		return "all events"
+	}
+	return strings.Join(events, ", ")
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testWebhookSecret = []byte("secret")
+
+func newTestWebhookRequest(source string, secret []byte, timestamp time.Time, body string) *http.Request {
+	request := httptest.NewRequest(http.MethodPost, "/webhooks/donation", strings.NewReader(body))
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	request.Header.Set(webhookSourceHeader, source)
+	request.Header.Set(webhookTimestampHeader, unix)
+	request.Header.Set(webhookSignatureHeader, signWebhook(secret, unix, []byte(body)))
+	return request
+}
+
+func TestDonationWebhookVerify(t *testing.T) {
+	body := `{"guild_id":"2000","amount":100}`
+	tests := []struct {
+		name    string
+		request func() *http.Request
+		want    int
+	}{
+		{
+			name:    "valid",
+			request: func() *http.Request { return newTestWebhookRequest("test", testWebhookSecret, time.Now(), body) },
+			want:    http.StatusOK,
+		},
+		{
+			name:    "unknown source",
+			request: func() *http.Request { return newTestWebhookRequest("other", testWebhookSecret, time.Now(), body) },
+			want:    http.StatusUnauthorized,
+		},
+		{
+			name:    "wrong secret",
+			request: func() *http.Request { return newTestWebhookRequest("test", []byte("guess"), time.Now(), body) },
+			want:    http.StatusUnauthorized,
+		},
+		{
+			name: "body changed after signing",
+			request: func() *http.Request {
+				request := newTestWebhookRequest("test", testWebhookSecret, time.Now(), body)
+				return newTestWebhookRequestWithHeaders(request, `{"guild_id":"2000","amount":100000}`)
+			},
+			want: http.StatusUnauthorized,
+		},
+		{
+			name: "missing signature",
+			request: func() *http.Request {
+				request := newTestWebhookRequest("test", testWebhookSecret, time.Now(), body)
+				request.Header.Del(webhookSignatureHeader)
+				return request
+			},
+			want: http.StatusUnauthorized,
+		},
+		{
+			name: "timestamp not a number",
+			request: func() *http.Request {
+				request := newTestWebhookRequest("test", testWebhookSecret, time.Now(), body)
+				request.Header.Set(webhookTimestampHeader, "yesterday")
+				return request
+			},
+			want: http.StatusUnauthorized,
+		},
+		{
+			name: "timestamp within the skew",
+			request: func() *http.Request {
+				return newTestWebhookRequest("test", testWebhookSecret, time.Now().Add(-4*time.Minute), body)
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "timestamp slightly ahead",
+			request: func() *http.Request {
+				return newTestWebhookRequest("test", testWebhookSecret, time.Now().Add(4*time.Minute), body)
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "timestamp too old",
+			request: func() *http.Request {
+				return newTestWebhookRequest("test", testWebhookSecret, time.Now().Add(-6*time.Minute), body)
+			},
+			want: http.StatusUnauthorized,
+		},
+		{
+			name: "timestamp too far ahead",
+			request: func() *http.Request {
+				return newTestWebhookRequest("test", testWebhookSecret, time.Now().Add(6*time.Minute), body)
+			},
+			want: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhook := NewDonationWebhook(nil, map[string][]byte{"test": testWebhookSecret})
+			request := tt.request()
+			requestBody := readTestBody(t, request)
+			if _, status, err := webhook.verify(request, requestBody); status != tt.want {
+				t.Errorf("verify() = %d, %v, want %d", status, err, tt.want)
+			}
+		})
+	}
+}
+
+// newTestWebhookRequestWithHeaders sends another body with the headers of the request
+func newTestWebhookRequestWithHeaders(request *http.Request, body string) *http.Request {
+	tampered := httptest.NewRequest(http.MethodPost, "/webhooks/donation", strings.NewReader(body))
+	tampered.Header = request.Header.Clone()
+	return tampered
+}
+
+func readTestBody(t *testing.T, request *http.Request) []byte {
+	t.Helper()
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func TestDonationWebhookReplay(t *testing.T) {
+	webhook := NewDonationWebhook(nil, map[string][]byte{"test": testWebhookSecret})
+	body := `{"guild_id":"2000","amount":100}`
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		request *http.Request
+		want    int
+	}{
+		{name: "first", request: newTestWebhookRequest("test", testWebhookSecret, now, body), want: http.StatusOK},
+		{name: "replayed", request: newTestWebhookRequest("test", testWebhookSecret, now, body), want: http.StatusConflict},
+		{name: "same body later", request: newTestWebhookRequest("test", testWebhookSecret, now.Add(time.Second), body), want: http.StatusOK},
+		{name: "other body", request: newTestWebhookRequest("test", testWebhookSecret, now, `{"guild_id":"2000","amount":5}`), want: http.StatusOK},
+	}
+	// the cases depend on each other, they run in order against the same webhook
+	for _, tt := range tests {
+		if _, status, err := webhook.verify(tt.request, readTestBody(t, tt.request)); status != tt.want {
+			t.Errorf("%s: verify() = %d, %v, want %d", tt.name, status, err, tt.want)
+		}
+	}
+}
+
+func TestDonationWebhookRateLimit(t *testing.T) {
+	secrets := map[string][]byte{"first": testWebhookSecret, "second": []byte("other secret")}
+	webhook := NewDonationWebhook(nil, secrets)
+	now := time.Now()
+	verify := func(source string, i int) int {
+		request := newTestWebhookRequest(source, secrets[source], now, `{"amount":`+strconv.Itoa(i)+`}`)
+		_, status, _ := webhook.verify(request, readTestBody(t, request))
+		return status
+	}
+
+	for i := range webhookSourceLimit {
+		if status := verify("first", i); status != http.StatusOK {
+			t.Fatalf("request %d = %d, want %d", i, status, http.StatusOK)
+		}
+	}
+	if status := verify("first", webhookSourceLimit); status != http.StatusTooManyRequests {
+		t.Errorf("request over the limit = %d, want %d", status, http.StatusTooManyRequests)
+	}
+	if status := verify("second", 0); status != http.StatusOK {
+		t.Errorf("request of another source = %d, want the limit to be per source", status)
+	}
+}
+
+func TestDonationWebhookServeHTTP(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{name: "not json", body: `amount=100`, want: http.StatusBadRequest},
+		{name: "negative amount", body: `{"guild_id":"2000","amount":-5,"message":"hi"}`, want: http.StatusBadRequest},
+		{name: "nothing to read", body: `{"guild_id":"2000","message":"   "}`, want: http.StatusBadRequest},
+		{name: "too large", body: `{"message":"` + strings.Repeat("a", webhookMaxBodySize) + `"}`, want: http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhook := NewDonationWebhook(nil, map[string][]byte{"test": testWebhookSecret})
+			recorder := httptest.NewRecorder()
+			webhook.ServeHTTP(recorder, newTestWebhookRequest("test", testWebhookSecret, time.Now(), tt.body))
+			if recorder.Code != tt.want {
+				t.Errorf("served %d %s, want %d", recorder.Code, recorder.Body.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseWebhookSecrets(t *testing.T) {
+	tests := []struct {
+		secrets string
+		want    map[string][]byte
+	}{
+		{secrets: "", want: map[string][]byte{}},
+		{secrets: "a:one", want: map[string][]byte{"a": []byte("one")}},
+		{secrets: " a:one , b:two:three ", want: map[string][]byte{"a": []byte("one"), "b": []byte("two:three")}},
+		{secrets: "a,:one,b:", want: map[string][]byte{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.secrets, func(t *testing.T) {
+			if got := parseWebhookSecrets(tt.secrets); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseWebhookSecrets(%q) = %q, want %q", tt.secrets, got, tt.want)
+			}
+		})
+	}
+}